@@ -52,6 +52,52 @@ type MustGatherImage struct {
 	Url string `json:"url"`
 }
 
+// ExternalDatabase references an existing PostgreSQL database to be used by
+// the assisted-service instead of deploying one in the cluster.
+type ExternalDatabase struct {
+	// SecretRef is the reference to the secret, in the namespace of the operator,
+	// holding the connection details of the database. The secret must contain the
+	// keys db.host, db.port, db.name, db.user and db.password.
+	SecretRef corev1.LocalObjectReference `json:"secretRef"`
+	// SSLMode is the SSL mode of the connections to the database, as in the
+	// sslmode parameter of libpq. Defaults to disable.
+	// +kubebuilder:validation:Enum=disable;allow;prefer;require;verify-ca;verify-full
+	// +optional
+	SSLMode string `json:"sslMode,omitempty"`
+	// CACertificateRef is the reference to the config map, in the namespace of the
+	// operator, holding the CA certificates used to verify the database server in
+	// its ca-bundle.crt key.
+	// +optional
+	CACertificateRef *corev1.LocalObjectReference `json:"caCertificateRef,omitempty"`
+}
+
+// ExternalObjectStorage references an existing S3 compatible bucket to be used
+// by the assisted-service instead of the filesystem storage.
+type ExternalObjectStorage struct {
+	// SecretRef is the reference to the secret, in the namespace of the operator,
+	// holding the details of the bucket. The secret must contain the keys
+	// endpoint, bucket, aws_region, aws_access_key_id and aws_secret_access_key.
+	SecretRef corev1.LocalObjectReference `json:"secretRef"`
+}
+
+// DeploymentConfig defines the compute resources, replicas and scheduling
+// of a component deployed by the operator.
+type DeploymentConfig struct {
+	// Replicas is the number of pods of the component.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+	// Resources overrides the default compute resources of the component container.
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+	// NodeSelector overrides the node selector the operator propagates to the component pods.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// Tolerations overrides the tolerations the operator propagates to the component pods.
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+}
+
 // AgentServiceConfigSpec defines the desired state of AgentServiceConfig.
 type AgentServiceConfigSpec struct {
 	// FileSystemStorage defines the spec of the PersistentVolumeClaim to be
@@ -60,13 +106,27 @@ type AgentServiceConfigSpec struct {
 	// consumed will depend largely on the number of clusters created (~200MB
 	// per cluster and ~2-3GiB per supported OpenShift version). Minimum 100GiB
 	// recommended.
+	// Ignored when ExternalObjectStorage is set.
+	// +optional
 	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Storage for service filesystem"
-	FileSystemStorage corev1.PersistentVolumeClaimSpec `json:"filesystemStorage"`
+	FileSystemStorage corev1.PersistentVolumeClaimSpec `json:"filesystemStorage,omitempty"`
 	// DatabaseStorage defines the spec of the PersistentVolumeClaim to be
 	// created for the database's filesystem.
 	// With respect to the resource requests, minimum 10GiB is recommended.
+	// Ignored when ExternalDatabase is set.
+	// +optional
 	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Storage for database"
-	DatabaseStorage corev1.PersistentVolumeClaimSpec `json:"databaseStorage"`
+	DatabaseStorage corev1.PersistentVolumeClaimSpec `json:"databaseStorage,omitempty"`
+	// ExternalDatabase references an existing PostgreSQL database. When set, the
+	// database is not deployed in the cluster and DatabaseStorage is ignored.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="External database"
+	ExternalDatabase *ExternalDatabase `json:"externalDatabase,omitempty"`
+	// ExternalObjectStorage references an existing S3 compatible bucket. When set,
+	// it is used to store the service files and FileSystemStorage is ignored.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="External object storage"
+	ExternalObjectStorage *ExternalObjectStorage `json:"externalObjectStorage,omitempty"`
 	// ImageStorage defines the spec of the PersistentVolumeClaim to be
 	// created for each replica of the image service.
 	// If a PersistentVolumeClaim is provided 2GiB per OSImage entry is required
@@ -109,6 +169,20 @@ type AgentServiceConfigSpec struct {
 	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="List of container registries without authentication"
 	// +optional
 	UnauthenticatedRegistries []string `json:"unauthenticatedRegistries,omitempty"`
+	// AssistedServiceDeployment configures the assisted-service deployment.
+	// More than one replica requires ExternalDatabase and ExternalObjectStorage.
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Assisted service deployment"
+	// +optional
+	AssistedServiceDeployment *DeploymentConfig `json:"assistedServiceDeployment,omitempty"`
+	// ImageServiceDeployment configures the assisted-image-service stateful set.
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Image service deployment"
+	// +optional
+	ImageServiceDeployment *DeploymentConfig `json:"imageServiceDeployment,omitempty"`
+	// DatabaseResources overrides the default compute resources of the database container.
+	// Ignored when ExternalDatabase is set.
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Database resources"
+	// +optional
+	DatabaseResources *corev1.ResourceRequirements `json:"databaseResources,omitempty"`
 }
 
 // ConditionType related to our reconcile loop in addition to all the reasons
@@ -118,6 +192,8 @@ const (
 	ConditionReconcileCompleted conditionsv1.ConditionType = "ReconcileCompleted"
 	// ConditionDeploymentsHealthy reports whether deployments are healthy.
 	ConditionDeploymentsHealthy conditionsv1.ConditionType = "DeploymentsHealthy"
+	// ConditionExternalStorageAvailable reports whether the external database and object storage are reachable.
+	ConditionExternalStorageAvailable conditionsv1.ConditionType = "ExternalStorageAvailable"
 
 	// ReasonReconcileSucceeded when the reconcile completes all operations without error.
 	ReasonReconcileSucceeded string = "ReconcileSucceeded"
@@ -125,6 +201,14 @@ const (
 	ReasonDeploymentSucceeded string = "DeploymentSucceeded"
 	// ReasonStorageFailure when there was a failure configuring/deploying storage.
 	ReasonStorageFailure string = "StorageFailure"
+	// ReasonDeploymentConfigFailure when the deployment configuration of a component isn't valid.
+	ReasonDeploymentConfigFailure string = "DeploymentConfigFailure"
+	// ReasonExternalStorageAvailable when the external database and object storage are reachable.
+	ReasonExternalStorageAvailable string = "ExternalStorageAvailable"
+	// ReasonExternalDatabaseFailure when the external database isn't configured correctly or can't be reached.
+	ReasonExternalDatabaseFailure string = "ExternalDatabaseFailure"
+	// ReasonExternalObjectStorageFailure when the external object storage isn't configured correctly or can't be reached.
+	ReasonExternalObjectStorageFailure string = "ExternalObjectStorageFailure"
 	// ReasonImageHandlerServiceFailure when there was a failure related to the assisted-image-service's service.
 	ReasonImageHandlerServiceFailure string = "ImageHandlerServiceFailure"
 	// ReasonAgentServiceFailure when there was a failure related to the assisted-service's service.
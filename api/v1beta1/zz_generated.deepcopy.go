@@ -248,6 +248,16 @@ func (in *AgentServiceConfigSpec) DeepCopyInto(out *AgentServiceConfigSpec) {
 	*out = *in
 	in.FileSystemStorage.DeepCopyInto(&out.FileSystemStorage)
 	in.DatabaseStorage.DeepCopyInto(&out.DatabaseStorage)
+	if in.ExternalDatabase != nil {
+		in, out := &in.ExternalDatabase, &out.ExternalDatabase
+		*out = new(ExternalDatabase)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExternalObjectStorage != nil {
+		in, out := &in.ExternalObjectStorage, &out.ExternalObjectStorage
+		*out = new(ExternalObjectStorage)
+		**out = **in
+	}
 	if in.ImageStorage != nil {
 		in, out := &in.ImageStorage, &out.ImageStorage
 		*out = new(corev1.PersistentVolumeClaimSpec)
@@ -273,6 +283,21 @@ func (in *AgentServiceConfigSpec) DeepCopyInto(out *AgentServiceConfigSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.AssistedServiceDeployment != nil {
+		in, out := &in.AssistedServiceDeployment, &out.AssistedServiceDeployment
+		*out = new(DeploymentConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImageServiceDeployment != nil {
+		in, out := &in.ImageServiceDeployment, &out.ImageServiceDeployment
+		*out = new(DeploymentConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DatabaseResources != nil {
+		in, out := &in.DatabaseResources, &out.DatabaseResources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentServiceConfigSpec.
@@ -422,6 +447,82 @@ func (in *DebugInfo) DeepCopy() *DebugInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentConfig) DeepCopyInto(out *DeploymentConfig) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentConfig.
+func (in *DeploymentConfig) DeepCopy() *DeploymentConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalDatabase) DeepCopyInto(out *ExternalDatabase) {
+	*out = *in
+	out.SecretRef = in.SecretRef
+	if in.CACertificateRef != nil {
+		in, out := &in.CACertificateRef, &out.CACertificateRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalDatabase.
+func (in *ExternalDatabase) DeepCopy() *ExternalDatabase {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalDatabase)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalObjectStorage) DeepCopyInto(out *ExternalObjectStorage) {
+	*out = *in
+	out.SecretRef = in.SecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalObjectStorage.
+func (in *ExternalObjectStorage) DeepCopy() *ExternalObjectStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalObjectStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HostBoot) DeepCopyInto(out *HostBoot) {
 	*out = *in
@@ -31,6 +31,9 @@ type API interface {
 	/*
 	   GetClusterSupportedPlatforms A list of platforms that this cluster can support in its current configuration.*/
 	GetClusterSupportedPlatforms(ctx context.Context, params *GetClusterSupportedPlatformsParams) (*GetClusterSupportedPlatformsOK, error)
+	/*
+	   GetClusterSupportedPlatformsDetails The platforms that this cluster can support in its current configuration, including the reasons why unsupported platforms were excluded.*/
+	GetClusterSupportedPlatformsDetails(ctx context.Context, params *GetClusterSupportedPlatformsDetailsParams) (*GetClusterSupportedPlatformsDetailsOK, error)
 	/*
 	   GetInfraEnv Retrieves the details of the infra-env.*/
 	GetInfraEnv(ctx context.Context, params *GetInfraEnvParams) (*GetInfraEnvOK, error)
@@ -61,6 +64,9 @@ type API interface {
 	/*
 	   UpdateInfraEnv Updates an infra-env.*/
 	UpdateInfraEnv(ctx context.Context, params *UpdateInfraEnvParams) (*UpdateInfraEnvCreated, error)
+	/*
+	   V2BulkHostsAction Applies an action to the hosts of the infra-env whose labels match a label selector and returns the result per host.*/
+	V2BulkHostsAction(ctx context.Context, params *V2BulkHostsActionParams) (*V2BulkHostsActionOK, error)
 	/*
 	   V2CancelInstallation Cancels an ongoing installation.*/
 	V2CancelInstallation(ctx context.Context, params *V2CancelInstallationParams) (*V2CancelInstallationAccepted, error)
@@ -73,6 +79,10 @@ type API interface {
 	/*
 	   V2DownloadClusterLogs Download cluster logs.*/
 	V2DownloadClusterLogs(ctx context.Context, params *V2DownloadClusterLogsParams, writer io.Writer) (*V2DownloadClusterLogsOK, error)
+	/*
+	   V2GarbageCollectionDryRun Lists the clusters, hosts and infra-envs that the next garbage collection would deregister or permanently
+delete, according to the garbage collection policies. Nothing is deregistered or deleted.*/
+	V2GarbageCollectionDryRun(ctx context.Context, params *V2GarbageCollectionDryRunParams) (*V2GarbageCollectionDryRunOK, error)
 	/*
 	   V2GetClusterDefaultConfig Get the default values for various cluster properties.*/
 	V2GetClusterDefaultConfig(ctx context.Context, params *V2GetClusterDefaultConfigParams) (*V2GetClusterDefaultConfigOK, error)
@@ -106,12 +116,25 @@ type API interface {
 	/*
 	   V2DownloadInfraEnvFiles Downloads the customized ignition file for this host*/
 	V2DownloadInfraEnvFiles(ctx context.Context, params *V2DownloadInfraEnvFilesParams, writer io.Writer) (*V2DownloadInfraEnvFilesOK, error)
+	/*
+	   V2ExportCluster Exports the desired configuration of a cluster as a versioned bundle that can be imported to register a similar cluster. The bundle contains no credentials.*/
+	V2ExportCluster(ctx context.Context, params *V2ExportClusterParams) (*V2ExportClusterOK, error)
 	/*
 	   V2GetCluster Retrieves the details of the OpenShift cluster.*/
 	V2GetCluster(ctx context.Context, params *V2GetClusterParams) (*V2GetClusterOK, error)
 	/*
 	   V2GetClusterInstallConfig Get the cluster's install config YAML.*/
 	V2GetClusterInstallConfig(ctx context.Context, params *V2GetClusterInstallConfigParams) (*V2GetClusterInstallConfigOK, error)
+	/*
+	   V2GetClusterInstallationTimeline Builds the timeline of the last installation of the cluster from its events: the stages of the hosts, the
+status changes of the cluster, the status reports of the monitored operators and the log uploads, with their
+durations, the critical path of the installation and a compact text summary.*/
+	V2GetClusterInstallationTimeline(ctx context.Context, params *V2GetClusterInstallationTimelineParams) (*V2GetClusterInstallationTimelineOK, error)
+	/*
+	   V2GetClusterRenderedFiles Renders the install config and the manifests the service would generate if the cluster was installed now,
+without storing anything. Manifests generated by openshift-install itself are not included.
+*/
+	V2GetClusterRenderedFiles(ctx context.Context, params *V2GetClusterRenderedFilesParams) (*V2GetClusterRenderedFilesOK, error)
 	/*
 	   V2GetHost Retrieves the details of the OpenShift host.*/
 	V2GetHost(ctx context.Context, params *V2GetHostParams) (*V2GetHostOK, error)
@@ -127,12 +150,22 @@ type API interface {
 	/*
 	   V2ImportCluster Import an AI cluster using minimal data assosiated with existing OCP cluster, in order to allow adding day2 hosts to that cluster*/
 	V2ImportCluster(ctx context.Context, params *V2ImportClusterParams) (*V2ImportClusterCreated, error)
+	/*
+	   V2ImportClusterBundle Registers a new cluster and infra-env from a cluster bundle. Conflicts of the bundle with the service and with other clusters are reported; the import is refused if any of them is blocking.*/
+	V2ImportClusterBundle(ctx context.Context, params *V2ImportClusterBundleParams) (*V2ImportClusterBundleCreated, error)
 	/*
 	   V2InstallCluster Installs the OpenShift cluster.*/
 	V2InstallCluster(ctx context.Context, params *V2InstallClusterParams) (*V2InstallClusterAccepted, error)
 	/*
 	   V2InstallHost install specific host for day2 cluster.*/
 	V2InstallHost(ctx context.Context, params *V2InstallHostParams) (*V2InstallHostAccepted, error)
+	/*
+	   V2ListClusterInstallConfigRevisions Lists the changes made to the install config overrides of the cluster, oldest first.*/
+	V2ListClusterInstallConfigRevisions(ctx context.Context, params *V2ListClusterInstallConfigRevisionsParams) (*V2ListClusterInstallConfigRevisionsOK, error)
+	/*
+	   V2ListClusterRevisions Lists the revisions of the configuration of the cluster, oldest first. Each update of the cluster is recorded
+as a revision, and the first revision holds the configuration the cluster had before its first update.*/
+	V2ListClusterRevisions(ctx context.Context, params *V2ListClusterRevisionsParams) (*V2ListClusterRevisionsOK, error)
 	/*
 	   V2ListClusters Retrieves the list of OpenShift clusters.*/
 	V2ListClusters(ctx context.Context, params *V2ListClustersParams) (*V2ListClustersOK, error)
@@ -145,6 +178,9 @@ type API interface {
 	/*
 	   V2PostStepReply Posts the result of the operations from the host agent.*/
 	V2PostStepReply(ctx context.Context, params *V2PostStepReplyParams) (*V2PostStepReplyNoContent, error)
+	/*
+	   V2ReclaimHost Reclaims an installed host of a day-2 cluster back into its infra-env. The assisted agent is started on the host's node using the cluster's kubeconfig, the host reboots into discovery and is unbound from the cluster.*/
+	V2ReclaimHost(ctx context.Context, params *V2ReclaimHostParams) (*V2ReclaimHostOK, error)
 	/*
 	   V2RegisterCluster Creates a new OpenShift cluster definition.*/
 	V2RegisterCluster(ctx context.Context, params *V2RegisterClusterParams) (*V2RegisterClusterCreated, error)
@@ -162,6 +198,11 @@ type API interface {
 
 	   Reset failed host validation. It may be performed on any host validation with persistent validation result.*/
 	V2ResetHostValidation(ctx context.Context, params *V2ResetHostValidationParams) (*V2ResetHostValidationOK, error)
+	/*
+	   V2RollbackCluster Rolls the configuration of a cluster that wasn't installed yet back to a prior revision. The cluster is
+updated as it is with v2UpdateCluster, and is validated again. Credentials aren't part of revisions and
+are left unchanged.*/
+	V2RollbackCluster(ctx context.Context, params *V2RollbackClusterParams) (*V2RollbackClusterAccepted, error)
 	/*
 	   V2UpdateClusterInstallConfig Override values in the install config.*/
 	V2UpdateClusterInstallConfig(ctx context.Context, params *V2UpdateClusterInstallConfigParams) (*V2UpdateClusterInstallConfigCreated, error)
@@ -313,6 +354,31 @@ func (a *Client) GetClusterSupportedPlatforms(ctx context.Context, params *GetCl
 
 }
 
+/*
+GetClusterSupportedPlatformsDetails The platforms that this cluster can support in its current configuration, including the reasons why unsupported platforms were excluded.
+*/
+func (a *Client) GetClusterSupportedPlatformsDetails(ctx context.Context, params *GetClusterSupportedPlatformsDetailsParams) (*GetClusterSupportedPlatformsDetailsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetClusterSupportedPlatformsDetails",
+		Method:             "GET",
+		PathPattern:        "/v2/clusters/{cluster_id}/supported-platforms/details",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetClusterSupportedPlatformsDetailsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetClusterSupportedPlatformsDetailsOK), nil
+
+}
+
 /*
 GetInfraEnv Retrieves the details of the infra-env.
 */
@@ -563,6 +629,31 @@ func (a *Client) UpdateInfraEnv(ctx context.Context, params *UpdateInfraEnvParam
 
 }
 
+/*
+V2BulkHostsAction Applies an action to the hosts of the infra-env whose labels match a label selector and returns the result per host.
+*/
+func (a *Client) V2BulkHostsAction(ctx context.Context, params *V2BulkHostsActionParams) (*V2BulkHostsActionOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "V2BulkHostsAction",
+		Method:             "POST",
+		PathPattern:        "/v2/infra-envs/{infra_env_id}/hosts/actions/bulk",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2BulkHostsActionReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2BulkHostsActionOK), nil
+
+}
+
 /*
 V2CancelInstallation Cancels an ongoing installation.
 */
@@ -663,6 +754,32 @@ func (a *Client) V2DownloadClusterLogs(ctx context.Context, params *V2DownloadCl
 
 }
 
+/*
+V2GarbageCollectionDryRun Lists the clusters, hosts and infra-envs that the next garbage collection would deregister or permanently
+delete, according to the garbage collection policies. Nothing is deregistered or deleted.
+*/
+func (a *Client) V2GarbageCollectionDryRun(ctx context.Context, params *V2GarbageCollectionDryRunParams) (*V2GarbageCollectionDryRunOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "V2GarbageCollectionDryRun",
+		Method:             "GET",
+		PathPattern:        "/v2/garbage-collection/dry-run",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2GarbageCollectionDryRunReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2GarbageCollectionDryRunOK), nil
+
+}
+
 /*
 V2GetClusterDefaultConfig Get the default values for various cluster properties.
 */
@@ -938,6 +1055,31 @@ func (a *Client) V2DownloadInfraEnvFiles(ctx context.Context, params *V2Download
 
 }
 
+/*
+V2ExportCluster Exports the desired configuration of a cluster as a versioned bundle that can be imported to register a similar cluster. The bundle contains no credentials.
+*/
+func (a *Client) V2ExportCluster(ctx context.Context, params *V2ExportClusterParams) (*V2ExportClusterOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2ExportCluster",
+		Method:             "GET",
+		PathPattern:        "/v2/clusters/{cluster_id}/export",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2ExportClusterReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2ExportClusterOK), nil
+
+}
+
 /*
 V2GetCluster Retrieves the details of the OpenShift cluster.
 */
@@ -988,6 +1130,60 @@ func (a *Client) V2GetClusterInstallConfig(ctx context.Context, params *V2GetClu
 
 }
 
+/*
+V2GetClusterInstallationTimeline Builds the timeline of the last installation of the cluster from its events: the stages of the hosts, the
+status changes of the cluster, the status reports of the monitored operators and the log uploads, with their
+durations, the critical path of the installation and a compact text summary.
+*/
+func (a *Client) V2GetClusterInstallationTimeline(ctx context.Context, params *V2GetClusterInstallationTimelineParams) (*V2GetClusterInstallationTimelineOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2GetClusterInstallationTimeline",
+		Method:             "GET",
+		PathPattern:        "/v2/clusters/{cluster_id}/installation-timeline",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2GetClusterInstallationTimelineReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2GetClusterInstallationTimelineOK), nil
+
+}
+
+/*
+V2GetClusterRenderedFiles Renders the install config and the manifests the service would generate if the cluster was installed now,
+without storing anything. Manifests generated by openshift-install itself are not included.
+
+*/
+func (a *Client) V2GetClusterRenderedFiles(ctx context.Context, params *V2GetClusterRenderedFilesParams) (*V2GetClusterRenderedFilesOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2GetClusterRenderedFiles",
+		Method:             "GET",
+		PathPattern:        "/v2/clusters/{cluster_id}/rendered-files",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2GetClusterRenderedFilesReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2GetClusterRenderedFilesOK), nil
+
+}
+
 /*
 V2GetHost Retrieves the details of the OpenShift host.
 */
@@ -1113,6 +1309,31 @@ func (a *Client) V2ImportCluster(ctx context.Context, params *V2ImportClusterPar
 
 }
 
+/*
+V2ImportClusterBundle Registers a new cluster and infra-env from a cluster bundle. Conflicts of the bundle with the service and with other clusters are reported; the import is refused if any of them is blocking.
+*/
+func (a *Client) V2ImportClusterBundle(ctx context.Context, params *V2ImportClusterBundleParams) (*V2ImportClusterBundleCreated, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2ImportClusterBundle",
+		Method:             "POST",
+		PathPattern:        "/v2/clusters/import-bundle",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2ImportClusterBundleReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2ImportClusterBundleCreated), nil
+
+}
+
 /*
 V2InstallCluster Installs the OpenShift cluster.
 */
@@ -1163,6 +1384,57 @@ func (a *Client) V2InstallHost(ctx context.Context, params *V2InstallHostParams)
 
 }
 
+/*
+V2ListClusterInstallConfigRevisions Lists the changes made to the install config overrides of the cluster, oldest first.
+*/
+func (a *Client) V2ListClusterInstallConfigRevisions(ctx context.Context, params *V2ListClusterInstallConfigRevisionsParams) (*V2ListClusterInstallConfigRevisionsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2ListClusterInstallConfigRevisions",
+		Method:             "GET",
+		PathPattern:        "/v2/clusters/{cluster_id}/install-config/revisions",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2ListClusterInstallConfigRevisionsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2ListClusterInstallConfigRevisionsOK), nil
+
+}
+
+/*
+V2ListClusterRevisions Lists the revisions of the configuration of the cluster, oldest first. Each update of the cluster is recorded
+as a revision, and the first revision holds the configuration the cluster had before its first update.
+*/
+func (a *Client) V2ListClusterRevisions(ctx context.Context, params *V2ListClusterRevisionsParams) (*V2ListClusterRevisionsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2ListClusterRevisions",
+		Method:             "GET",
+		PathPattern:        "/v2/clusters/{cluster_id}/revisions",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2ListClusterRevisionsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2ListClusterRevisionsOK), nil
+
+}
+
 /*
 V2ListClusters Retrieves the list of OpenShift clusters.
 */
@@ -1263,6 +1535,31 @@ func (a *Client) V2PostStepReply(ctx context.Context, params *V2PostStepReplyPar
 
 }
 
+/*
+V2ReclaimHost Reclaims an installed host of a day-2 cluster back into its infra-env. The assisted agent is started on the host's node using the cluster's kubeconfig, the host reboots into discovery and is unbound from the cluster.
+*/
+func (a *Client) V2ReclaimHost(ctx context.Context, params *V2ReclaimHostParams) (*V2ReclaimHostOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "V2ReclaimHost",
+		Method:             "POST",
+		PathPattern:        "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/reclaim",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2ReclaimHostReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2ReclaimHostOK), nil
+
+}
+
 /*
 V2RegisterCluster Creates a new OpenShift cluster definition.
 */
@@ -1390,6 +1687,33 @@ func (a *Client) V2ResetHostValidation(ctx context.Context, params *V2ResetHostV
 
 }
 
+/*
+V2RollbackCluster Rolls the configuration of a cluster that wasn't installed yet back to a prior revision. The cluster is
+updated as it is with v2UpdateCluster, and is validated again. Credentials aren't part of revisions and
+are left unchanged.
+*/
+func (a *Client) V2RollbackCluster(ctx context.Context, params *V2RollbackClusterParams) (*V2RollbackClusterAccepted, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "v2RollbackCluster",
+		Method:             "POST",
+		PathPattern:        "/v2/clusters/{cluster_id}/revisions/{revision}/actions/rollback",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2RollbackClusterReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2RollbackClusterAccepted), nil
+
+}
+
 /*
 V2UpdateClusterInstallConfig Override values in the install config.
 */
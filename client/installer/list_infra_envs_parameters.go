@@ -67,6 +67,12 @@ type ListInfraEnvsParams struct {
 	*/
 	ClusterID *strfmt.UUID
 
+	/* LabelSelector.
+
+	   If provided, returns only infra-envs whose labels match this Kubernetes label selector, e.g. env=prod,team in (a,b).
+	*/
+	LabelSelector *string
+
 	/* Owner.
 
 	   If provided, returns only infra-envs that are owned by the specified user.
@@ -137,6 +143,17 @@ func (o *ListInfraEnvsParams) SetClusterID(clusterID *strfmt.UUID) {
 	o.ClusterID = clusterID
 }
 
+// WithLabelSelector adds the labelSelector to the list infra envs params
+func (o *ListInfraEnvsParams) WithLabelSelector(labelSelector *string) *ListInfraEnvsParams {
+	o.SetLabelSelector(labelSelector)
+	return o
+}
+
+// SetLabelSelector adds the labelSelector to the list infra envs params
+func (o *ListInfraEnvsParams) SetLabelSelector(labelSelector *string) {
+	o.LabelSelector = labelSelector
+}
+
 // WithOwner adds the owner to the list infra envs params
 func (o *ListInfraEnvsParams) WithOwner(owner *string) *ListInfraEnvsParams {
 	o.SetOwner(owner)
@@ -173,6 +190,23 @@ func (o *ListInfraEnvsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt
 		}
 	}
 
+	if o.LabelSelector != nil {
+
+		// query param label_selector
+		var qrLabelSelector string
+
+		if o.LabelSelector != nil {
+			qrLabelSelector = *o.LabelSelector
+		}
+		qLabelSelector := qrLabelSelector
+		if qLabelSelector != "" {
+
+			if err := r.SetQueryParam("label_selector", qLabelSelector); err != nil {
+				return err
+			}
+		}
+	}
+
 	if o.Owner != nil {
 
 		// query param owner
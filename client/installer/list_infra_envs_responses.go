@@ -29,6 +29,12 @@ func (o *ListInfraEnvsReader) ReadResponse(response runtime.ClientResponse, cons
 			return nil, err
 		}
 		return result, nil
+	case 400:
+		result := NewListInfraEnvsBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 401:
 		result := NewListInfraEnvsUnauthorized()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -100,6 +106,38 @@ func (o *ListInfraEnvsOK) readResponse(response runtime.ClientResponse, consumer
 	return nil
 }
 
+// NewListInfraEnvsBadRequest creates a ListInfraEnvsBadRequest with default headers values
+func NewListInfraEnvsBadRequest() *ListInfraEnvsBadRequest {
+	return &ListInfraEnvsBadRequest{}
+}
+
+/* ListInfraEnvsBadRequest describes a response with status code 400, with default header values.
+
+Error.
+*/
+type ListInfraEnvsBadRequest struct {
+	Payload *models.Error
+}
+
+func (o *ListInfraEnvsBadRequest) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs][%d] listInfraEnvsBadRequest  %+v", 400, o.Payload)
+}
+func (o *ListInfraEnvsBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListInfraEnvsBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewListInfraEnvsUnauthorized creates a ListInfraEnvsUnauthorized with default headers values
 func NewListInfraEnvsUnauthorized() *ListInfraEnvsUnauthorized {
 	return &ListInfraEnvsUnauthorized{}
@@ -72,6 +72,12 @@ type V2ListClustersParams struct {
 	*/
 	GetUnregisteredClusters *bool
 
+	/* LabelSelector.
+
+	   If provided, returns only clusters whose labels match this Kubernetes label selector, e.g. env=prod,team in (a,b).
+	*/
+	LabelSelector *string
+
 	/* OpenshiftClusterID.
 
 	   A specific cluster to retrieve.
@@ -181,6 +187,17 @@ func (o *V2ListClustersParams) SetGetUnregisteredClusters(getUnregisteredCluster
 	o.GetUnregisteredClusters = getUnregisteredClusters
 }
 
+// WithLabelSelector adds the labelSelector to the v2 list clusters params
+func (o *V2ListClustersParams) WithLabelSelector(labelSelector *string) *V2ListClustersParams {
+	o.SetLabelSelector(labelSelector)
+	return o
+}
+
+// SetLabelSelector adds the labelSelector to the v2 list clusters params
+func (o *V2ListClustersParams) SetLabelSelector(labelSelector *string) {
+	o.LabelSelector = labelSelector
+}
+
 // WithOpenshiftClusterID adds the openshiftClusterID to the v2 list clusters params
 func (o *V2ListClustersParams) WithOpenshiftClusterID(openshiftClusterID *strfmt.UUID) *V2ListClustersParams {
 	o.SetOpenshiftClusterID(openshiftClusterID)
@@ -241,6 +258,23 @@ func (o *V2ListClustersParams) WriteToRequest(r runtime.ClientRequest, reg strfm
 		}
 	}
 
+	if o.LabelSelector != nil {
+
+		// query param label_selector
+		var qrLabelSelector string
+
+		if o.LabelSelector != nil {
+			qrLabelSelector = *o.LabelSelector
+		}
+		qLabelSelector := qrLabelSelector
+		if qLabelSelector != "" {
+
+			if err := r.SetQueryParam("label_selector", qLabelSelector); err != nil {
+				return err
+			}
+		}
+	}
+
 	if o.OpenshiftClusterID != nil {
 
 		// query param openshift_cluster_id
@@ -29,6 +29,12 @@ func (o *V2ListClustersReader) ReadResponse(response runtime.ClientResponse, con
 			return nil, err
 		}
 		return result, nil
+	case 400:
+		result := NewV2ListClustersBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 401:
 		result := NewV2ListClustersUnauthorized()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -94,6 +100,38 @@ func (o *V2ListClustersOK) readResponse(response runtime.ClientResponse, consume
 	return nil
 }
 
+// NewV2ListClustersBadRequest creates a V2ListClustersBadRequest with default headers values
+func NewV2ListClustersBadRequest() *V2ListClustersBadRequest {
+	return &V2ListClustersBadRequest{}
+}
+
+/* V2ListClustersBadRequest describes a response with status code 400, with default header values.
+
+Error.
+*/
+type V2ListClustersBadRequest struct {
+	Payload *models.Error
+}
+
+func (o *V2ListClustersBadRequest) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters][%d] v2ListClustersBadRequest  %+v", 400, o.Payload)
+}
+func (o *V2ListClustersBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ListClustersBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewV2ListClustersUnauthorized creates a V2ListClustersUnauthorized with default headers values
 func NewV2ListClustersUnauthorized() *V2ListClustersUnauthorized {
 	return &V2ListClustersUnauthorized{}
@@ -19,6 +19,7 @@ import (
 
 type registerCase struct {
 	files       []string
+	uploaded    []string
 	clientError string
 
 	expectedFilesSent int
@@ -41,6 +42,9 @@ var _ = DescribeTable(
 		}
 
 		fakeTransport := NewMockTransport()
+		for _, f := range tc.uploaded {
+			fakeTransport.AddManifest(models.ManifestFolderOpenshift, f)
+		}
 		if tc.clientError != "" {
 			fakeTransport.SetError(tc.clientError)
 		}
@@ -91,6 +95,16 @@ var _ = DescribeTable(
 		files:             []string{},
 		expectedFilesSent: 0,
 	}),
+	Entry("skip-uploaded-manifests", registerCase{
+		files: []string{
+			"config-map-1.yaml",
+			"config-map-2.yaml",
+		},
+		uploaded: []string{
+			"config-map-1.yaml",
+		},
+		expectedFilesSent: 1,
+	}),
 	Entry("client-error", registerCase{
 		files: []string{
 			"config-map-1.yaml",
@@ -110,6 +124,7 @@ func TestAgentbasedinstaller(t *testing.T) {
 
 type mockTransport struct {
 	filesReceived map[string]string
+	manifests     models.ListManifests
 	result        manifests.V2CreateClusterManifestCreated
 	err           error
 }
@@ -123,6 +138,10 @@ func NewMockTransport() *mockTransport {
 
 func (m *mockTransport) Submit(op *runtime.ClientOperation) (interface{}, error) {
 
+	if _, ok := op.Params.(*manifests.V2ListClusterManifestsParams); ok {
+		return &manifests.V2ListClusterManifestsOK{Payload: m.manifests}, nil
+	}
+
 	params, _ := op.Params.(*manifests.V2CreateClusterManifestParams)
 	m.filesReceived[*params.CreateManifestParams.FileName] = *params.CreateManifestParams.Content
 
@@ -137,6 +156,10 @@ func (m *mockTransport) SetResult(res manifests.V2CreateClusterManifestCreated)
 	m.result = res
 }
 
+func (m *mockTransport) AddManifest(folder, fileName string) {
+	m.manifests = append(m.manifests, &models.Manifest{Folder: folder, FileName: fileName})
+}
+
 func (m *mockTransport) SetError(errMsg string) {
 	m.err = errors.New(errMsg)
 }
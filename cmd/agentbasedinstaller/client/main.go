@@ -23,6 +23,7 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/url"
 	"os"
@@ -39,6 +40,24 @@ import (
 )
 
 const failureOutputPath = "/var/run/agent-installer/host-config-failures"
+const registerStatusOutputPath = "/var/run/agent-installer/register-status.json"
+
+// Steps of the register subcommand, as reported in its status file
+const (
+	registerStepCluster        = "cluster"
+	registerStepInfraEnv       = "infraenv"
+	registerStepExtraManifests = "extra-manifests"
+)
+
+// registerStatus describes the progress of the register subcommand. It is written to registerStatusOutputPath after
+// every step, so that the services waiting for the registration can tell how far it got and why it failed.
+type registerStatus struct {
+	ClusterID      string   `json:"cluster_id,omitempty"`
+	InfraEnvID     string   `json:"infra_env_id,omitempty"`
+	CompletedSteps []string `json:"completed_steps"`
+	FailedStep     string   `json:"failed_step,omitempty"`
+	Error          string   `json:"error,omitempty"`
+}
 
 var Options struct {
 	ServiceBaseUrl string `envconfig:"SERVICE_BASE_URL" default:""`
@@ -95,6 +114,8 @@ func main() {
 		os.WriteFile("/etc/assisted/client_config", []byte("INFRA_ENV_ID="+infraEnvID), 0644)
 	case "configure":
 		configure(ctx, log, bmInventory)
+	case "validate":
+		validate(log)
 	default:
 		log.Fatalf("Unknown subcommand %s", os.Args[1])
 	}
@@ -106,30 +127,67 @@ func register(ctx context.Context, log *log.Logger, bmInventory *client.Assisted
 		log.Fatal(err.Error())
 	}
 
+	status := &registerStatus{CompletedSteps: []string{}}
+	recordRegisterStatus(log, status)
+
 	pullSecret, err := agentbasedinstaller.GetPullSecret(RegisterOptions.PullSecretFile)
 	if err != nil {
+		status.fail(registerStepCluster, err)
+		recordRegisterStatus(log, status)
 		log.Fatal("Failed to get pull secret: ", err.Error())
 	}
 
 	modelsCluster, err := agentbasedinstaller.RegisterCluster(ctx, log, bmInventory, pullSecret,
 		RegisterOptions.ClusterDeploymentFile, RegisterOptions.AgentClusterInstallFile, RegisterOptions.ClusterImageSetFile, RegisterOptions.ReleaseImageMirror)
 	if err != nil {
+		status.fail(registerStepCluster, err)
+		recordRegisterStatus(log, status)
 		log.Fatal("Failed to register cluster with assisted-service: ", err)
 	}
+	status.ClusterID = modelsCluster.ID.String()
+	status.complete(registerStepCluster)
+	recordRegisterStatus(log, status)
 
 	modelsInfraEnv, err := agentbasedinstaller.RegisterInfraEnv(ctx, log, bmInventory, pullSecret,
 		modelsCluster, RegisterOptions.InfraEnvFile, RegisterOptions.NMStateConfigFile, RegisterOptions.ImageTypeISO)
 	if err != nil {
+		status.fail(registerStepInfraEnv, err)
+		recordRegisterStatus(log, status)
 		log.Fatal("Failed to register infraenv with assisted-service: ", err)
 	}
+	status.InfraEnvID = modelsInfraEnv.ID.String()
+	status.complete(registerStepInfraEnv)
+	recordRegisterStatus(log, status)
+
 	err = agentbasedinstaller.RegisterExtraManifests(os.DirFS(RegisterOptions.ExtraManifests), ctx, log, bmInventory.Manifests, modelsCluster)
 	if err != nil {
+		status.fail(registerStepExtraManifests, err)
+		recordRegisterStatus(log, status)
 		log.Fatal("Failed to register extra manifests with assisted-service: ", err)
 	}
+	status.complete(registerStepExtraManifests)
+	recordRegisterStatus(log, status)
 
 	return modelsInfraEnv.ID.String()
 }
 
+func validate(log *log.Logger) {
+	err := envconfig.Process("", &RegisterOptions)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	errs := agentbasedinstaller.ValidateManifests(log, RegisterOptions.ClusterDeploymentFile, RegisterOptions.AgentClusterInstallFile,
+		RegisterOptions.ClusterImageSetFile, RegisterOptions.InfraEnvFile, RegisterOptions.NMStateConfigFile)
+	for _, err := range errs {
+		log.Error(err)
+	}
+	if len(errs) > 0 {
+		log.Fatalf("Found %d errors in the manifests", len(errs))
+	}
+	log.Info("The manifests are valid")
+}
+
 func configure(ctx context.Context, log *log.Logger, bmInventory *client.AssistedInstall) {
 	err := envconfig.Process("", &ConfigureOptions)
 	if err != nil {
@@ -144,6 +202,15 @@ func configure(ctx context.Context, log *log.Logger, bmInventory *client.Assiste
 	if err != nil {
 		log.Fatal("Failed to load host configuration: ", err)
 	}
+	if failures := hostConfigs.Validate(); len(failures) > 0 {
+		for _, f := range failures {
+			log.Errorf("%s: %s", f.Hostname(), f.DescribeFailure())
+		}
+		if err := recordFailures(failures); err != nil {
+			log.Fatal("Unable to record failures to disk: ", err)
+		}
+		log.Fatal("Invalid host configuration")
+	}
 
 	done := false
 	sleepTime := 1 * time.Second
@@ -190,3 +257,26 @@ func recordFailures(failures []agentbasedinstaller.Failure) error {
 		[]byte(strings.Join(messages, "")),
 		0644)
 }
+
+func (s *registerStatus) complete(step string) {
+	s.CompletedSteps = append(s.CompletedSteps, step)
+}
+
+func (s *registerStatus) fail(step string, err error) {
+	s.FailedStep = step
+	s.Error = err.Error()
+}
+
+// recordRegisterStatus writes the status of the register subcommand to disk. Failing to do so doesn't fail the
+// registration itself.
+func recordRegisterStatus(log *log.Logger, status *registerStatus) {
+	data, err := json.Marshal(status)
+	if err == nil {
+		if err = os.MkdirAll(path.Dir(registerStatusOutputPath), 0755); err == nil {
+			err = os.WriteFile(registerStatusOutputPath, data, 0644)
+		}
+	}
+	if err != nil {
+		log.WithError(err).Warn("Unable to record the registration status to disk")
+	}
+}
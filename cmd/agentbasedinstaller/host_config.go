@@ -2,20 +2,32 @@ package agentbasedinstaller
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net"
 	"os"
 	"path"
 	"path/filepath"
+	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 	bmh_v1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
 	"github.com/openshift/assisted-service/client"
 	"github.com/openshift/assisted-service/client/installer"
+	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/internal/ignition"
 	"github.com/openshift/assisted-service/models"
 	errorutil "github.com/openshift/assisted-service/pkg/error"
+	"github.com/openshift/assisted-service/pkg/validations"
 	log "github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/yaml"
 )
 
@@ -88,27 +100,84 @@ func applyHostConfig(ctx context.Context, log *log.Logger, bmInventory *client.A
 		changed = true
 	}
 
-	if !changed {
-		log.Info("No configuration changes needed")
-		return nil
+	hostname, err := config.Hostname()
+	if err != nil {
+		return err
+	}
+	if applyHostname(log, host, hostname, updateParams) {
+		changed = true
+	}
+
+	nodeLabels, err := config.NodeLabels()
+	if err != nil {
+		return err
+	}
+	if applyNodeLabels(log, host, nodeLabels, updateParams) {
+		changed = true
 	}
 
-	log.Info("Updating host")
-	params := installer.NewV2UpdateHostParams().
-		WithHostID(*host.ID).
-		WithInfraEnvID(host.InfraEnvID).
-		WithHostUpdateParams(updateParams)
-	_, err = bmInventory.Installer.V2UpdateHost(ctx, params)
+	skipFormattingDisks, err := config.SkipFormattingDisks()
 	if err != nil {
-		if errorResponse, ok := err.(errorutil.AssistedServiceErrorAPI); ok {
-			return &UpdateFailure{
-				response:  errorResponse,
-				params:    updateParams,
-				host:      host,
-				inventory: inventory,
+		return err
+	}
+	if applySkipFormattingDisks(log, host, skipFormattingDisks, updateParams) {
+		changed = true
+	}
+
+	if changed {
+		log.Info("Updating host")
+		params := installer.NewV2UpdateHostParams().
+			WithHostID(*host.ID).
+			WithInfraEnvID(host.InfraEnvID).
+			WithHostUpdateParams(updateParams)
+		_, err = bmInventory.Installer.V2UpdateHost(ctx, params)
+		if err != nil {
+			if errorResponse, ok := err.(errorutil.AssistedServiceErrorAPI); ok {
+				return &UpdateFailure{
+					response:  errorResponse,
+					params:    updateParams,
+					host:      host,
+					inventory: inventory,
+				}
 			}
+			return fmt.Errorf("failed to update Host: %w", err)
 		}
-		return fmt.Errorf("failed to update Host: %w", err)
+	}
+
+	installerArgs, err := config.InstallerArgs()
+	if err != nil {
+		return err
+	}
+	if installerArgsChanged(log, host, installerArgs) {
+		changed = true
+		log.Info("Updating host installer args")
+		params := installer.NewV2UpdateHostInstallerArgsParams().
+			WithHostID(*host.ID).
+			WithInfraEnvID(host.InfraEnvID).
+			WithInstallerArgsParams(&models.InstallerArgsParams{Args: installerArgs})
+		if _, err = bmInventory.Installer.V2UpdateHostInstallerArgs(ctx, params); err != nil {
+			return settingFailure(err, "installer args", host, inventory)
+		}
+	}
+
+	ignitionConfigOverride, err := config.IgnitionConfigOverride()
+	if err != nil {
+		return err
+	}
+	if ignitionConfigOverride != nil && *ignitionConfigOverride != host.IgnitionConfigOverrides {
+		changed = true
+		log.Info("Updating host ignition config override")
+		params := installer.NewV2UpdateHostIgnitionParams().
+			WithHostID(*host.ID).
+			WithInfraEnvID(host.InfraEnvID).
+			WithHostIgnitionParams(&models.HostIgnitionParams{Config: *ignitionConfigOverride})
+		if _, err = bmInventory.Installer.V2UpdateHostIgnition(ctx, params); err != nil {
+			return settingFailure(err, "ignition config override", host, inventory)
+		}
+	}
+
+	if !changed {
+		log.Info("No configuration changes needed")
 	}
 	return nil
 }
@@ -153,6 +222,98 @@ func applyRole(log *log.Logger, host *models.Host, inventory *models.Inventory,
 	return true
 }
 
+func applyHostname(log *log.Logger, host *models.Host, hostname *string, updateParams *models.HostUpdateParams) bool {
+	if hostname == nil {
+		log.Info("No hostname configured")
+		return false
+	}
+
+	if host.RequestedHostname == *hostname {
+		log.Infof("Hostname %s already configured", *hostname)
+		return false
+	}
+
+	updateParams.HostName = hostname
+	return true
+}
+
+func applyNodeLabels(log *log.Logger, host *models.Host, nodeLabels map[string]string, updateParams *models.HostUpdateParams) bool {
+	if nodeLabels == nil {
+		log.Info("No node labels configured")
+		return false
+	}
+
+	current := map[string]string{}
+	if host.NodeLabels != "" {
+		if err := json.Unmarshal([]byte(host.NodeLabels), &current); err != nil {
+			log.WithError(err).Warn("Failed to parse the node labels of the host")
+		}
+	}
+	if reflect.DeepEqual(current, nodeLabels) {
+		log.Info("Node labels already configured")
+		return false
+	}
+
+	keys := make([]string, 0, len(nodeLabels))
+	for key := range nodeLabels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	updateParams.NodeLabels = []*models.NodeLabelParams{}
+	for _, key := range keys {
+		updateParams.NodeLabels = append(updateParams.NodeLabels, &models.NodeLabelParams{
+			Key:   swag.String(key),
+			Value: swag.String(nodeLabels[key]),
+		})
+	}
+	return true
+}
+
+func applySkipFormattingDisks(log *log.Logger, host *models.Host, disks []string, updateParams *models.HostUpdateParams) bool {
+	if disks == nil {
+		log.Info("No disks to skip formatting configured")
+		return false
+	}
+
+	current := common.GetSkippedFormattingDiskIdentifiers(host)
+	for _, disk := range disks {
+		if !funk.ContainsString(current, disk) {
+			updateParams.DisksSkipFormatting = append(updateParams.DisksSkipFormatting,
+				&models.DiskSkipFormattingParams{DiskID: swag.String(disk), SkipFormatting: swag.Bool(true)})
+		}
+	}
+	for _, disk := range current {
+		if !funk.ContainsString(disks, disk) {
+			updateParams.DisksSkipFormatting = append(updateParams.DisksSkipFormatting,
+				&models.DiskSkipFormattingParams{DiskID: swag.String(disk), SkipFormatting: swag.Bool(false)})
+		}
+	}
+	if len(updateParams.DisksSkipFormatting) == 0 {
+		log.Info("Disks to skip formatting already configured")
+		return false
+	}
+	return true
+}
+
+func installerArgsChanged(log *log.Logger, host *models.Host, args []string) bool {
+	if args == nil {
+		log.Info("No installer args configured")
+		return false
+	}
+
+	current := []string{}
+	if host.InstallerArgs != "" {
+		if err := json.Unmarshal([]byte(host.InstallerArgs), &current); err != nil {
+			log.WithError(err).Warn("Failed to parse the installer args of the host")
+		}
+	}
+	if len(current) == len(args) && (len(args) == 0 || reflect.DeepEqual(current, args)) {
+		log.Info("Installer args already configured")
+		return false
+	}
+	return true
+}
+
 func LoadHostConfigs(hostConfigDir string) (HostConfigs, error) {
 	log.Infof("Loading host configurations from disk in %s", hostConfigDir)
 
@@ -244,8 +405,179 @@ func (hc hostConfig) Role() (*string, error) {
 	return &role, nil
 }
 
+func (hc hostConfig) Hostname() (*string, error) {
+	hostnameData, err := hc.readFile("hostname")
+	if hostnameData == nil || err != nil {
+		return nil, err
+	}
+
+	hostname := strings.TrimSpace(string(hostnameData))
+	if len(hostname) == 0 {
+		log.Info("Empty hostname")
+		return nil, nil
+	}
+	if err := validations.ValidateHostname(hostname); err != nil {
+		return nil, err
+	}
+
+	log.Infof("Found hostname %s", hostname)
+	return &hostname, nil
+}
+
+func (hc hostConfig) InstallerArgs() ([]string, error) {
+	argsData, err := hc.readFile("installer-args.yaml")
+	if argsData == nil || err != nil {
+		return nil, err
+	}
+
+	args := []string{}
+	if err := yaml.UnmarshalStrict(argsData, &args); err != nil {
+		return nil, fmt.Errorf("failed to parse installer args file: %w", err)
+	}
+	if args == nil {
+		args = []string{}
+	}
+	if err := validations.ValidateInstallerArgs(args); err != nil {
+		return nil, err
+	}
+
+	log.Info("Read installer args file")
+	return args, nil
+}
+
+func (hc hostConfig) IgnitionConfigOverride() (*string, error) {
+	ignitionData, err := hc.readFile("ignition-config-override.json")
+	if ignitionData == nil || err != nil {
+		return nil, err
+	}
+
+	config := strings.TrimSpace(string(ignitionData))
+	if len(config) == 0 {
+		log.Info("Empty ignition config override")
+		return nil, nil
+	}
+	if _, err := ignition.ParseToLatest([]byte(config)); err != nil {
+		return nil, fmt.Errorf("failed to parse ignition config override file: %w", err)
+	}
+
+	log.Info("Read ignition config override file")
+	return &config, nil
+}
+
+func (hc hostConfig) NodeLabels() (map[string]string, error) {
+	labelsData, err := hc.readFile("node-labels.yaml")
+	if labelsData == nil || err != nil {
+		return nil, err
+	}
+
+	labels := map[string]string{}
+	if err := yaml.UnmarshalStrict(labelsData, &labels); err != nil {
+		return nil, fmt.Errorf("failed to parse node labels file: %w", err)
+	}
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	if errs := validation.ValidateLabels(labels, field.NewPath("node-labels")); len(errs) != 0 {
+		return nil, errs.ToAggregate()
+	}
+
+	log.Infof("Found %d node labels", len(labels))
+	return labels, nil
+}
+
+func (hc hostConfig) SkipFormattingDisks() ([]string, error) {
+	disksData, err := hc.readFile("skip-formatting-disks")
+	if disksData == nil || err != nil {
+		return nil, err
+	}
+
+	disks := []string{}
+	for _, l := range strings.Split(string(disksData), "\n") {
+		disk := strings.TrimSpace(l)
+		if len(disk) > 0 {
+			disks = append(disks, disk)
+		}
+	}
+
+	log.Infof("Found %d disks to skip formatting", len(disks))
+	return disks, nil
+}
+
+// readFile returns the contents of a file of the host configuration, or nil when the file doesn't exist
+func (hc hostConfig) readFile(name string) ([]byte, error) {
+	data, err := os.ReadFile(path.Join(hc.configDir, name))
+	if err != nil {
+		if os.IsNotExist(err) {
+			log.Infof("No %s file found for host", name)
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read %s file: %w", name, err)
+	}
+	if data == nil {
+		data = []byte{}
+	}
+	return data, nil
+}
+
+// validate returns a failure for every invalid field of the host configuration
+func (hc *hostConfig) validate() []Failure {
+	failures := []Failure{}
+	fail := func(field string, err error) {
+		failures = append(failures, &ConfigFailure{config: hc, field: field, err: err})
+	}
+
+	if len(hc.macAddresses) == 0 {
+		fail("mac_addresses", errors.New("no MAC addresses found"))
+	}
+	for _, mac := range hc.macAddresses {
+		if _, err := net.ParseMAC(mac); err != nil {
+			fail("mac_addresses", err)
+		}
+	}
+	if _, err := hc.RootDeviceHints(); err != nil {
+		fail("root-device-hints.yaml", err)
+	}
+	role, err := hc.Role()
+	if err != nil {
+		fail("role", err)
+	} else if role != nil && !funk.ContainsString(validRoles, *role) {
+		fail("role", fmt.Errorf("role %s is not one of %s", *role, strings.Join(validRoles, ", ")))
+	}
+	if _, err := hc.Hostname(); err != nil {
+		fail("hostname", err)
+	}
+	if _, err := hc.InstallerArgs(); err != nil {
+		fail("installer-args.yaml", err)
+	}
+	if _, err := hc.IgnitionConfigOverride(); err != nil {
+		fail("ignition-config-override.json", err)
+	}
+	if _, err := hc.NodeLabels(); err != nil {
+		fail("node-labels.yaml", err)
+	}
+	if _, err := hc.SkipFormattingDisks(); err != nil {
+		fail("skip-formatting-disks", err)
+	}
+	return failures
+}
+
+var validRoles = []string{
+	models.HostUpdateParamsHostRoleAutoAssign,
+	models.HostUpdateParamsHostRoleMaster,
+	models.HostUpdateParamsHostRoleWorker,
+}
+
 type HostConfigs []*hostConfig
 
+// Validate checks the configuration of every host up front, before any of it is applied
+func (configs HostConfigs) Validate() []Failure {
+	failures := []Failure{}
+	for _, hc := range configs {
+		failures = append(failures, hc.validate()...)
+	}
+	return failures
+}
+
 func (configs HostConfigs) findHostConfig(hostID strfmt.UUID, inventory *models.Inventory) *hostConfig {
 	log.Infof("Searching for config for host %s", hostID)
 
@@ -320,6 +652,30 @@ func (uf *UpdateFailure) DescribeFailure() string {
 			*uf.params.HostRole,
 			uf.host.SuggestedRole))
 	}
+	if uf.params.HostName != nil {
+		changes = append(changes, fmt.Sprintf(
+			"hostname to %s (from %s)",
+			*uf.params.HostName,
+			uf.host.RequestedHostname))
+	}
+	if uf.params.NodeLabels != nil {
+		labels := make([]string, len(uf.params.NodeLabels))
+		for i, label := range uf.params.NodeLabels {
+			labels[i] = fmt.Sprintf("%s=%s", swag.StringValue(label.Key), swag.StringValue(label.Value))
+		}
+		changes = append(changes, fmt.Sprintf(
+			"node labels to [%s]",
+			strings.Join(labels, ", ")))
+	}
+	if len(uf.params.DisksSkipFormatting) > 0 {
+		disks := make([]string, len(uf.params.DisksSkipFormatting))
+		for i, disk := range uf.params.DisksSkipFormatting {
+			disks[i] = fmt.Sprintf("%s=%t", swag.StringValue(disk.DiskID), swag.BoolValue(disk.SkipFormatting))
+		}
+		changes = append(changes, fmt.Sprintf(
+			"skip formatting of disks [%s]",
+			strings.Join(disks, ", ")))
+	}
 
 	reason := "unknown reason"
 	if payload := uf.response.GetPayload(); payload != nil && payload.Reason != nil {
@@ -331,6 +687,72 @@ func (uf *UpdateFailure) DescribeFailure() string {
 		reason)
 }
 
+// SettingFailure is returned when a host setting that is applied through its own API is refused
+type SettingFailure struct {
+	response  errorutil.AssistedServiceErrorAPI
+	setting   string
+	host      *models.Host
+	inventory *models.Inventory
+}
+
+func settingFailure(err error, setting string, host *models.Host, inventory *models.Inventory) error {
+	if errorResponse, ok := err.(errorutil.AssistedServiceErrorAPI); ok {
+		return &SettingFailure{
+			response:  errorResponse,
+			setting:   setting,
+			host:      host,
+			inventory: inventory,
+		}
+	}
+	return fmt.Errorf("failed to update host %s: %w", setting, err)
+}
+
+func (sf *SettingFailure) Error() string {
+	return fmt.Sprintf("Host %s %s update refused: %s", sf.Hostname(), sf.setting, errorutil.GetAssistedError(sf.response).Error())
+}
+
+func (sf *SettingFailure) Unwrap() error {
+	return sf.response
+}
+
+func (sf *SettingFailure) Hostname() string {
+	if sf.inventory != nil {
+		return sf.inventory.Hostname
+	}
+	return sf.host.ID.String()
+}
+
+func (sf *SettingFailure) DescribeFailure() string {
+	reason := "unknown reason"
+	if payload := sf.response.GetPayload(); payload != nil && payload.Reason != nil {
+		reason = *payload.Reason
+	}
+	return fmt.Sprintf("Failed to update host %s: %s", sf.setting, reason)
+}
+
+// ConfigFailure describes an invalid field of a host configuration
+type ConfigFailure struct {
+	config *hostConfig
+	field  string
+	err    error
+}
+
+func (cf *ConfigFailure) Error() string {
+	return fmt.Sprintf("Invalid %s in host configuration %s: %s", cf.field, cf.config.configDir, cf.err.Error())
+}
+
+func (cf *ConfigFailure) Unwrap() error {
+	return cf.err
+}
+
+func (cf *ConfigFailure) Hostname() string {
+	return path.Base(cf.config.configDir)
+}
+
+func (cf *ConfigFailure) DescribeFailure() string {
+	return fmt.Sprintf("Invalid %s: %s", cf.field, cf.err.Error())
+}
+
 type missingHost struct {
 	config *hostConfig
 }
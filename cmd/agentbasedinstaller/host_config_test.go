@@ -0,0 +1,149 @@
+package agentbasedinstaller
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/go-openapi/swag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus/hooks/test"
+)
+
+var _ = Describe("Host configs", func() {
+	var hostConfigDir string
+
+	BeforeEach(func() {
+		var err error
+		hostConfigDir, err = os.MkdirTemp("", "hostconfig")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(hostConfigDir)
+	})
+
+	writeHostConfig := func(host string, files map[string]string) {
+		hostPath := filepath.Join(hostConfigDir, host)
+		Expect(os.MkdirAll(hostPath, 0755)).To(Succeed())
+		for name, content := range files {
+			Expect(os.WriteFile(filepath.Join(hostPath, name), []byte(content), 0600)).To(Succeed())
+		}
+	}
+
+	loadHostConfig := func() *hostConfig {
+		configs, err := LoadHostConfigs(hostConfigDir)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(configs).To(HaveLen(1))
+		return configs[0]
+	}
+
+	It("reads the full host config", func() {
+		writeHostConfig("master-0", map[string]string{
+			"mac_addresses":                 "52:54:01:aa:aa:a1\n",
+			"role":                          "master\n",
+			"hostname":                      "master-0.example.com\n",
+			"installer-args.yaml":           "- --append-karg\n- console=ttyS0\n",
+			"ignition-config-override.json": `{"ignition": {"version": "3.1.0"}}`,
+			"node-labels.yaml":              "node-role.kubernetes.io/infra: \"\"\n",
+			"skip-formatting-disks":         "/dev/disk/by-id/wwn-0x1\n/dev/disk/by-id/wwn-0x2\n",
+		})
+		config := loadHostConfig()
+		Expect(HostConfigs{config}.Validate()).To(BeEmpty())
+
+		hostname, err := config.Hostname()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(swag.StringValue(hostname)).To(Equal("master-0.example.com"))
+		args, err := config.InstallerArgs()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(args).To(Equal([]string{"--append-karg", "console=ttyS0"}))
+		ignitionConfig, err := config.IgnitionConfigOverride()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(swag.StringValue(ignitionConfig)).To(Equal(`{"ignition": {"version": "3.1.0"}}`))
+		labels, err := config.NodeLabels()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(labels).To(Equal(map[string]string{"node-role.kubernetes.io/infra": ""}))
+		disks, err := config.SkipFormattingDisks()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(disks).To(Equal([]string{"/dev/disk/by-id/wwn-0x1", "/dev/disk/by-id/wwn-0x2"}))
+	})
+
+	It("doesn't configure settings without a file", func() {
+		writeHostConfig("master-0", map[string]string{"mac_addresses": "52:54:01:aa:aa:a1\n"})
+		config := loadHostConfig()
+		Expect(HostConfigs{config}.Validate()).To(BeEmpty())
+
+		hostname, err := config.Hostname()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(hostname).To(BeNil())
+		args, err := config.InstallerArgs()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(args).To(BeNil())
+		labels, err := config.NodeLabels()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(labels).To(BeNil())
+		disks, err := config.SkipFormattingDisks()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(disks).To(BeNil())
+	})
+
+	It("describes every invalid field", func() {
+		writeHostConfig("master-0", map[string]string{
+			"mac_addresses":                 "not-a-mac\n",
+			"role":                          "bootstrap\n",
+			"hostname":                      "Master_0\n",
+			"installer-args.yaml":           "- --unknown-flag\n",
+			"ignition-config-override.json": `{"ignition": {}}`,
+			"node-labels.yaml":              "invalid label: value\n",
+		})
+		failures := HostConfigs{loadHostConfig()}.Validate()
+		descriptions := []string{}
+		for _, f := range failures {
+			Expect(f.Hostname()).To(Equal("master-0"))
+			descriptions = append(descriptions, f.DescribeFailure())
+		}
+		Expect(descriptions).To(ConsistOf(
+			HavePrefix("Invalid mac_addresses: "),
+			HavePrefix("Invalid role: role bootstrap is not one of"),
+			HavePrefix("Invalid hostname: "),
+			HavePrefix("Invalid installer-args.yaml: found unexpected flag --unknown-flag"),
+			HavePrefix("Invalid ignition-config-override.json: "),
+			HavePrefix("Invalid node-labels.yaml: "),
+		))
+	})
+
+	Context("applying settings", func() {
+		log, _ := test.NewNullLogger()
+
+		It("updates changed node labels only", func() {
+			host := &models.Host{NodeLabels: `{"a":"1"}`}
+			updateParams := &models.HostUpdateParams{}
+			Expect(applyNodeLabels(log, host, map[string]string{"a": "1"}, updateParams)).To(BeFalse())
+			Expect(applyNodeLabels(log, host, map[string]string{"b": "2", "a": "1"}, updateParams)).To(BeTrue())
+			Expect(updateParams.NodeLabels).To(Equal([]*models.NodeLabelParams{
+				{Key: swag.String("a"), Value: swag.String("1")},
+				{Key: swag.String("b"), Value: swag.String("2")},
+			}))
+		})
+
+		It("skips and restores the formatting of disks", func() {
+			host := &models.Host{SkipFormattingDisks: "/dev/sda,/dev/sdb"}
+			updateParams := &models.HostUpdateParams{}
+			Expect(applySkipFormattingDisks(log, host, []string{"/dev/sdb", "/dev/sda"}, updateParams)).To(BeFalse())
+			Expect(applySkipFormattingDisks(log, host, []string{"/dev/sdb", "/dev/sdc"}, updateParams)).To(BeTrue())
+			Expect(updateParams.DisksSkipFormatting).To(Equal([]*models.DiskSkipFormattingParams{
+				{DiskID: swag.String("/dev/sdc"), SkipFormatting: swag.Bool(true)},
+				{DiskID: swag.String("/dev/sda"), SkipFormatting: swag.Bool(false)},
+			}))
+		})
+
+		It("detects changed installer args", func() {
+			host := &models.Host{InstallerArgs: `["--append-karg","console=ttyS0"]`}
+			Expect(installerArgsChanged(log, host, nil)).To(BeFalse())
+			Expect(installerArgsChanged(log, host, []string{"--append-karg", "console=ttyS0"})).To(BeFalse())
+			Expect(installerArgsChanged(log, host, []string{})).To(BeTrue())
+			Expect(installerArgsChanged(log, &models.Host{}, []string{})).To(BeFalse())
+		})
+	})
+})
@@ -8,6 +8,8 @@ import (
 	"os"
 	"reflect"
 
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 	hiveext "github.com/openshift/assisted-service/api/hiveextension/v1beta1"
 	aiv1beta1 "github.com/openshift/assisted-service/api/v1beta1"
 	"github.com/openshift/assisted-service/client"
@@ -19,6 +21,7 @@ import (
 	errorutil "github.com/openshift/assisted-service/pkg/error"
 	"github.com/openshift/assisted-service/pkg/executer"
 	"github.com/openshift/assisted-service/pkg/mirrorregistries"
+	"github.com/openshift/assisted-service/pkg/staticnetworkconfig"
 	hivev1 "github.com/openshift/hive/apis/hive/v1"
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
@@ -56,7 +59,7 @@ func RegisterCluster(ctx context.Context, log *log.Logger, bmInventory *client.A
 	if releaseError != nil {
 		return nil, releaseError
 	}
-	releaseImageVersion, releaseImageCPUArch, versionArchError := getReleaseVersionAndCpuArch(log, releaseImage, releaseImageMirror, pullSecret)
+	releaseImageVersion, releaseImageCPUArch, versionArchError := getReleaseVersionAndCpuArch(ctx, log, releaseImage, releaseImageMirror, pullSecret)
 	if versionArchError != nil {
 		return nil, versionArchError
 	}
@@ -69,21 +72,41 @@ func RegisterCluster(ctx context.Context, log *log.Logger, bmInventory *client.A
 		clusterParams.NetworkType = &aci.Spec.Networking.NetworkType
 	}
 
-	clientClusterParams := &installer.V2RegisterClusterParams{
-		NewClusterParams: clusterParams,
+	// The client may be started again after a reboot of the rendezvous host, in which case the cluster that
+	// it registered before is reused
+	existingCluster, findErr := findCluster(ctx, bmInventory, swag.StringValue(clusterParams.Name), clusterParams.BaseDNSDomain)
+	if findErr != nil {
+		return nil, findErr
 	}
-	clusterResult, registerClusterErr := bmInventory.Installer.V2RegisterCluster(ctx, clientClusterParams)
-	if registerClusterErr != nil {
-		return nil, errorutil.GetAssistedError(registerClusterErr)
-	}
-	result = clusterResult.GetPayload()
+	if existingCluster != nil {
+		log.Infof("Found existing cluster with id: %s", existingCluster.ID)
+		result = existingCluster
+		if updateParams := clusterUpdateParams(existingCluster, clusterParams); updateParams != nil {
+			updateResult, updateErr := bmInventory.Installer.V2UpdateCluster(ctx, installer.NewV2UpdateClusterParams().
+				WithClusterID(*existingCluster.ID).WithClusterUpdateParams(updateParams))
+			if updateErr != nil {
+				return nil, errorutil.GetAssistedError(updateErr)
+			}
+			result = updateResult.GetPayload()
+			log.Infof("Updated existing cluster %s to match the manifests", existingCluster.ID)
+		}
+	} else {
+		clientClusterParams := &installer.V2RegisterClusterParams{
+			NewClusterParams: clusterParams,
+		}
+		clusterResult, registerClusterErr := bmInventory.Installer.V2RegisterCluster(ctx, clientClusterParams)
+		if registerClusterErr != nil {
+			return nil, errorutil.GetAssistedError(registerClusterErr)
+		}
+		result = clusterResult.GetPayload()
 
-	log.Infof("Registered cluster with id: %s", clusterResult.Payload.ID)
+		log.Infof("Registered cluster with id: %s", clusterResult.Payload.ID)
+	}
 
 	annotations := aci.GetAnnotations()
-	if installConfigOverrides, ok := annotations[controllers.InstallConfigOverrides]; ok {
+	if installConfigOverrides, ok := annotations[controllers.InstallConfigOverrides]; ok && installConfigOverrides != result.InstallConfigOverrides {
 		updateInstallConfigParams := &installer.V2UpdateClusterInstallConfigParams{
-			ClusterID:           *clusterResult.Payload.ID,
+			ClusterID:           *result.ID,
 			InstallConfigParams: installConfigOverrides,
 		}
 		_, updateClusterErr := bmInventory.Installer.V2UpdateClusterInstallConfig(ctx, updateInstallConfigParams)
@@ -91,15 +114,15 @@ func RegisterCluster(ctx context.Context, log *log.Logger, bmInventory *client.A
 			return nil, errorutil.GetAssistedError(updateClusterErr)
 		}
 
-		log.Infof("Updated cluster %s with installConfigOverrides %s", clusterResult.Payload.ID, installConfigOverrides)
+		log.Infof("Updated cluster %s with installConfigOverrides %s", result.ID, installConfigOverrides)
 
 		// Need to GET cluster again so we can give a proper return value
 		getClusterResult, err := bmInventory.Installer.V2GetCluster(ctx, &installer.V2GetClusterParams{
-			ClusterID: *clusterResult.Payload.ID,
+			ClusterID: *result.ID,
 		})
 
 		if err != nil {
-			log.Warnf("Updated cluster %s with installConfigOverrides %s", clusterResult.Payload.ID, installConfigOverrides)
+			log.Warnf("Updated cluster %s with installConfigOverrides %s", result.ID, installConfigOverrides)
 		} else {
 			result = getClusterResult.GetPayload()
 		}
@@ -108,6 +131,114 @@ func RegisterCluster(ctx context.Context, log *log.Logger, bmInventory *client.A
 	return result, nil
 }
 
+// findCluster returns the cluster with the given name and base domain, or nil when there is none
+func findCluster(ctx context.Context, bmInventory *client.AssistedInstall, name string, baseDNSDomain string) (*models.Cluster, error) {
+	clusters, err := bmInventory.Installer.V2ListClusters(ctx, installer.NewV2ListClustersParams())
+	if err != nil {
+		return nil, errorutil.GetAssistedError(err)
+	}
+	for _, cluster := range clusters.Payload {
+		if cluster.Name == name && cluster.BaseDNSDomain == baseDNSDomain {
+			return cluster, nil
+		}
+	}
+	return nil, nil
+}
+
+// clusterUpdateParams returns the update params that reconcile an existing cluster with the params it would be
+// registered with, or nil when they match. Properties that can only be set on registration, such as the OpenShift
+// version, aren't reconciled.
+func clusterUpdateParams(cluster *models.Cluster, params *models.ClusterCreateParams) *models.V2ClusterUpdateParams {
+	updateParams := &models.V2ClusterUpdateParams{}
+	changed := false
+
+	if params.SSHPublicKey != cluster.SSHPublicKey {
+		updateParams.SSHPublicKey = swag.String(params.SSHPublicKey)
+		changed = true
+	}
+	if params.APIVip != cluster.APIVip {
+		updateParams.APIVip = swag.String(params.APIVip)
+		changed = true
+	}
+	if params.IngressVip != cluster.IngressVip {
+		updateParams.IngressVip = swag.String(params.IngressVip)
+		changed = true
+	}
+	if swag.StringValue(params.HTTPProxy) != cluster.HTTPProxy ||
+		swag.StringValue(params.HTTPSProxy) != cluster.HTTPSProxy ||
+		swag.StringValue(params.NoProxy) != cluster.NoProxy {
+		updateParams.HTTPProxy = swag.String(swag.StringValue(params.HTTPProxy))
+		updateParams.HTTPSProxy = swag.String(swag.StringValue(params.HTTPSProxy))
+		updateParams.NoProxy = swag.String(swag.StringValue(params.NoProxy))
+		changed = true
+	}
+	if params.Hyperthreading != nil && swag.StringValue(params.Hyperthreading) != cluster.Hyperthreading {
+		updateParams.Hyperthreading = params.Hyperthreading
+		changed = true
+	}
+	if swag.BoolValue(params.UserManagedNetworking) != swag.BoolValue(cluster.UserManagedNetworking) {
+		updateParams.UserManagedNetworking = params.UserManagedNetworking
+		changed = true
+	}
+	if params.NetworkType != nil && swag.StringValue(params.NetworkType) != swag.StringValue(cluster.NetworkType) {
+		updateParams.NetworkType = params.NetworkType
+		changed = true
+	}
+	if !clusterNetworksEqual(params.ClusterNetworks, cluster.ClusterNetworks) {
+		updateParams.ClusterNetworks = params.ClusterNetworks
+		changed = true
+	}
+	if !serviceNetworksEqual(params.ServiceNetworks, cluster.ServiceNetworks) {
+		updateParams.ServiceNetworks = params.ServiceNetworks
+		changed = true
+	}
+	if !machineNetworksEqual(params.MachineNetworks, cluster.MachineNetworks) {
+		updateParams.MachineNetworks = params.MachineNetworks
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+	return updateParams
+}
+
+func clusterNetworksEqual(a, b []*models.ClusterNetwork) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Cidr != b[i].Cidr || a[i].HostPrefix != b[i].HostPrefix {
+			return false
+		}
+	}
+	return true
+}
+
+func serviceNetworksEqual(a, b []*models.ServiceNetwork) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Cidr != b[i].Cidr {
+			return false
+		}
+	}
+	return true
+}
+
+func machineNetworksEqual(a, b []*models.MachineNetwork) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Cidr != b[i].Cidr {
+			return false
+		}
+	}
+	return true
+}
+
 func RegisterInfraEnv(ctx context.Context, log *log.Logger, bmInventory *client.AssistedInstall, pullSecret string, modelsCluster *models.Cluster,
 	infraEnvPath string, nmStateConfigPath string, imageTypeISO string) (*models.InfraEnv, error) {
 
@@ -139,6 +270,25 @@ func RegisterInfraEnv(ctx context.Context, log *log.Logger, bmInventory *client.
 		}
 	}
 
+	existingInfraEnv, findErr := findInfraEnv(ctx, bmInventory, modelsCluster.ID, infraEnv.Name)
+	if findErr != nil {
+		return nil, findErr
+	}
+	if existingInfraEnv != nil {
+		log.Infof("Found existing infraenv with id: %s", existingInfraEnv.ID)
+		updateParams := infraEnvUpdateParams(existingInfraEnv, infraEnvParams.InfraenvCreateParams)
+		if updateParams == nil {
+			return existingInfraEnv, nil
+		}
+		updateResult, updateErr := bmInventory.Installer.UpdateInfraEnv(ctx, installer.NewUpdateInfraEnvParams().
+			WithInfraEnvID(*existingInfraEnv.ID).WithInfraEnvUpdateParams(updateParams))
+		if updateErr != nil {
+			return nil, errorutil.GetAssistedError(updateErr)
+		}
+		log.Infof("Updated existing infraenv %s to match the manifests", existingInfraEnv.ID)
+		return updateResult.Payload, nil
+	}
+
 	clientInfraEnvParams := &installer.RegisterInfraEnvParams{
 		InfraenvCreateParams: infraEnvParams.InfraenvCreateParams,
 	}
@@ -153,6 +303,69 @@ func RegisterInfraEnv(ctx context.Context, log *log.Logger, bmInventory *client.
 	return infraEnvResult.Payload, nil
 }
 
+// findInfraEnv returns the infraenv of the cluster with the given name, or nil when there is none
+func findInfraEnv(ctx context.Context, bmInventory *client.AssistedInstall, clusterID *strfmt.UUID, name string) (*models.InfraEnv, error) {
+	infraEnvs, err := bmInventory.Installer.ListInfraEnvs(ctx, installer.NewListInfraEnvsParams().WithClusterID(clusterID))
+	if err != nil {
+		return nil, errorutil.GetAssistedError(err)
+	}
+	for _, infraEnv := range infraEnvs.Payload {
+		if swag.StringValue(infraEnv.Name) == name {
+			return infraEnv, nil
+		}
+	}
+	return nil, nil
+}
+
+// infraEnvUpdateParams returns the update params that reconcile an existing infraenv with the params it would be
+// registered with, or nil when they match. The static network config is compared in the format assisted-service
+// stores it in.
+func infraEnvUpdateParams(infraEnv *models.InfraEnv, params *models.InfraEnvCreateParams) *models.InfraEnvUpdateParams {
+	updateParams := &models.InfraEnvUpdateParams{}
+	changed := false
+
+	if swag.StringValue(params.SSHAuthorizedKey) != infraEnv.SSHAuthorizedKey {
+		updateParams.SSHAuthorizedKey = params.SSHAuthorizedKey
+		changed = true
+	}
+	if swag.StringValue(params.AdditionalNtpSources) != infraEnv.AdditionalNtpSources {
+		updateParams.AdditionalNtpSources = swag.String(swag.StringValue(params.AdditionalNtpSources))
+		changed = true
+	}
+	if params.IgnitionConfigOverride != infraEnv.IgnitionConfigOverride {
+		updateParams.IgnitionConfigOverride = params.IgnitionConfigOverride
+		changed = true
+	}
+	if infraEnv.Type == nil || params.ImageType != *infraEnv.Type {
+		updateParams.ImageType = params.ImageType
+		changed = true
+	}
+	if params.Proxy != nil && (infraEnv.Proxy == nil ||
+		swag.StringValue(params.Proxy.HTTPProxy) != swag.StringValue(infraEnv.Proxy.HTTPProxy) ||
+		swag.StringValue(params.Proxy.HTTPSProxy) != swag.StringValue(infraEnv.Proxy.HTTPSProxy) ||
+		swag.StringValue(params.Proxy.NoProxy) != swag.StringValue(infraEnv.Proxy.NoProxy)) {
+		updateParams.Proxy = params.Proxy
+		changed = true
+	}
+	if staticNetworkConfigChanged(infraEnv.StaticNetworkConfig, params.StaticNetworkConfig) {
+		updateParams.StaticNetworkConfig = params.StaticNetworkConfig
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+	return updateParams
+}
+
+func staticNetworkConfigChanged(current string, staticNetworkConfig []*models.HostStaticNetworkConfig) bool {
+	// Formatting sorts the configs, so it is done on a copy
+	configs := append([]*models.HostStaticNetworkConfig{}, staticNetworkConfig...)
+	formatted, err := staticnetworkconfig.New(log.StandardLogger(), staticnetworkconfig.Config{}).FormatStaticNetworkConfigForDB(configs)
+	return err != nil || formatted != current
+}
+
+// RegisterExtraManifests uploads the extra manifests to the cluster, skipping those that it already has
 func RegisterExtraManifests(fsys fs.FS, ctx context.Context, log *log.Logger, client *manifests.Client, cluster *models.Cluster) error {
 
 	extras, err := fs.Glob(fsys, "*.y*ml")
@@ -166,10 +379,25 @@ func RegisterExtraManifests(fsys fs.FS, ctx context.Context, log *log.Logger, cl
 
 	log.Info("Registering extra manifests")
 
-	extraManifestsFolder := "openshift"
+	extraManifestsFolder := models.ManifestFolderOpenshift
+
+	listResult, err := client.V2ListClusterManifests(ctx, manifests.NewV2ListClusterManifestsParams().WithClusterID(*cluster.ID))
+	if err != nil {
+		return errorutil.GetAssistedError(err)
+	}
+	uploaded := map[string]bool{}
+	for _, manifest := range listResult.Payload {
+		if manifest.Folder == extraManifestsFolder {
+			uploaded[manifest.FileName] = true
+		}
+	}
 
 	for _, f := range extras {
 		extraManifestFileName := f
+		if uploaded[extraManifestFileName] {
+			log.Infof("Skipping extra manifest %s that was already registered", extraManifestFileName)
+			continue
+		}
 		bytes, err := fs.ReadFile(fsys, extraManifestFileName)
 		if err != nil {
 			return err
@@ -214,18 +442,18 @@ func getReleaseVersion(clusterImageSetPath string) (string, error) {
 	return clusterImageSet.Spec.ReleaseImage, nil
 }
 
-func getReleaseVersionAndCpuArch(log *log.Logger, releaseImage string, releaseMirror string, pullSecret string) (string, string, error) {
+func getReleaseVersionAndCpuArch(ctx context.Context, log *log.Logger, releaseImage string, releaseMirror string, pullSecret string) (string, string, error) {
 	// releaseImage is in the form: quay.io:443/openshift-release-dev/ocp-release:4.9.17-x86_64
 	mirrorRegistriesBuilder := mirrorregistries.New()
 	releaseHandler := oc.NewRelease(&executer.CommonExecuter{},
 		oc.Config{MaxTries: oc.DefaultTries, RetryDelay: oc.DefaltRetryDelay}, mirrorRegistriesBuilder)
 
-	version, versionError := releaseHandler.GetOpenshiftVersion(log, releaseImage, releaseMirror, pullSecret)
+	version, versionError := releaseHandler.GetOpenshiftVersion(ctx, log, releaseImage, releaseMirror, pullSecret)
 	if versionError != nil {
 		return "", "", versionError
 	}
 
-	cpuArchs, archError := releaseHandler.GetReleaseArchitecture(log, releaseImage, releaseMirror, pullSecret)
+	cpuArchs, archError := releaseHandler.GetReleaseArchitecture(ctx, log, releaseImage, releaseMirror, pullSecret)
 	if archError != nil {
 		return "", "", archError
 	}
@@ -0,0 +1,120 @@
+package agentbasedinstaller
+
+import (
+	"github.com/go-openapi/swag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("Reconcile existing resources", func() {
+	Context("clusterUpdateParams", func() {
+		var (
+			cluster *models.Cluster
+			params  *models.ClusterCreateParams
+		)
+
+		BeforeEach(func() {
+			cluster = &models.Cluster{
+				Name:                  "ostest",
+				BaseDNSDomain:         "example.com",
+				APIVip:                "192.168.111.5",
+				IngressVip:            "192.168.111.4",
+				SSHPublicKey:          "ssh-rsa key",
+				UserManagedNetworking: swag.Bool(false),
+				ClusterNetworks:       []*models.ClusterNetwork{{Cidr: "10.128.0.0/14", HostPrefix: 23}},
+				ServiceNetworks:       []*models.ServiceNetwork{{Cidr: "172.30.0.0/16"}},
+				MachineNetworks:       []*models.MachineNetwork{{Cidr: "192.168.111.0/24"}},
+			}
+			params = &models.ClusterCreateParams{
+				Name:                  swag.String("ostest"),
+				BaseDNSDomain:         "example.com",
+				APIVip:                "192.168.111.5",
+				IngressVip:            "192.168.111.4",
+				SSHPublicKey:          "ssh-rsa key",
+				UserManagedNetworking: swag.Bool(false),
+				ClusterNetworks:       []*models.ClusterNetwork{{Cidr: "10.128.0.0/14", HostPrefix: 23}},
+				ServiceNetworks:       []*models.ServiceNetwork{{Cidr: "172.30.0.0/16"}},
+				MachineNetworks:       []*models.MachineNetwork{{Cidr: "192.168.111.0/24"}},
+			}
+		})
+
+		It("doesn't update a matching cluster", func() {
+			Expect(clusterUpdateParams(cluster, params)).To(BeNil())
+		})
+
+		It("updates the properties that differ", func() {
+			params.APIVip = "192.168.111.6"
+			params.MachineNetworks = []*models.MachineNetwork{{Cidr: "192.168.112.0/24"}}
+			updateParams := clusterUpdateParams(cluster, params)
+			Expect(updateParams).ToNot(BeNil())
+			Expect(swag.StringValue(updateParams.APIVip)).To(Equal("192.168.111.6"))
+			Expect(updateParams.MachineNetworks).To(Equal(params.MachineNetworks))
+			Expect(updateParams.IngressVip).To(BeNil())
+			Expect(updateParams.SSHPublicKey).To(BeNil())
+			Expect(updateParams.ClusterNetworks).To(BeNil())
+		})
+
+		It("clears a proxy that was removed", func() {
+			cluster.HTTPProxy = "http://proxy.example.com"
+			updateParams := clusterUpdateParams(cluster, params)
+			Expect(updateParams).ToNot(BeNil())
+			Expect(updateParams.HTTPProxy).To(Equal(swag.String("")))
+		})
+	})
+
+	Context("infraEnvUpdateParams", func() {
+		var (
+			infraEnv *models.InfraEnv
+			params   *models.InfraEnvCreateParams
+		)
+
+		BeforeEach(func() {
+			fullIso := models.ImageTypeFullIso
+			infraEnv = &models.InfraEnv{
+				Name:                swag.String("ostest"),
+				Type:                &fullIso,
+				SSHAuthorizedKey:    "ssh-rsa key",
+				StaticNetworkConfig: `[{"network_yaml":"interfaces: []"}]`,
+			}
+			params = &models.InfraEnvCreateParams{
+				Name:             swag.String("ostest"),
+				ImageType:        models.ImageTypeFullIso,
+				SSHAuthorizedKey: swag.String("ssh-rsa key"),
+				StaticNetworkConfig: []*models.HostStaticNetworkConfig{{
+					NetworkYaml: "interfaces: []",
+				}},
+			}
+		})
+
+		It("doesn't update a matching infraenv", func() {
+			Expect(infraEnvUpdateParams(infraEnv, params)).To(BeNil())
+		})
+
+		It("updates the properties that differ", func() {
+			params.SSHAuthorizedKey = swag.String("ssh-rsa other")
+			updateParams := infraEnvUpdateParams(infraEnv, params)
+			Expect(updateParams).ToNot(BeNil())
+			Expect(swag.StringValue(updateParams.SSHAuthorizedKey)).To(Equal("ssh-rsa other"))
+			Expect(updateParams.ImageType).To(BeEmpty())
+			Expect(updateParams.StaticNetworkConfig).To(BeNil())
+		})
+
+		It("updates the static network config when only it differs", func() {
+			params.StaticNetworkConfig[0].NetworkYaml = "interfaces: [{name: eth0}]"
+			updateParams := infraEnvUpdateParams(infraEnv, params)
+			Expect(updateParams).ToNot(BeNil())
+			Expect(updateParams.SSHAuthorizedKey).To(BeNil())
+			Expect(updateParams.StaticNetworkConfig).To(Equal(params.StaticNetworkConfig))
+		})
+
+		It("compares the static network config regardless of the order of the hosts", func() {
+			params.StaticNetworkConfig = []*models.HostStaticNetworkConfig{
+				{NetworkYaml: "interfaces: [{name: eth1}]"},
+				{NetworkYaml: "interfaces: [{name: eth0}]"},
+			}
+			infraEnv.StaticNetworkConfig = `[{"network_yaml":"interfaces: [{name: eth0}]"},{"network_yaml":"interfaces: [{name: eth1}]"}]`
+			Expect(infraEnvUpdateParams(infraEnv, params)).To(BeNil())
+		})
+	})
+})
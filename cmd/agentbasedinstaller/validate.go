@@ -0,0 +1,201 @@
+package agentbasedinstaller
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	hiveext "github.com/openshift/assisted-service/api/hiveextension/v1beta1"
+	aiv1beta1 "github.com/openshift/assisted-service/api/v1beta1"
+	"github.com/openshift/assisted-service/internal/cluster/validations"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/controller/controllers"
+	"github.com/openshift/assisted-service/internal/featuresupport"
+	"github.com/openshift/assisted-service/internal/network"
+	"github.com/openshift/assisted-service/models"
+	pkgvalidations "github.com/openshift/assisted-service/pkg/validations"
+	aciwebhook "github.com/openshift/assisted-service/pkg/webhooks/hiveextension/v1beta1"
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	log "github.com/sirupsen/logrus"
+)
+
+// releaseImageTagRegex matches the tag of release images, e.g. quay.io/openshift-release-dev/ocp-release:4.11.0-x86_64
+var releaseImageTagRegex = regexp.MustCompile(`:(\d+\.\d+\.\d+[^-]*(?:-[a-z]+\.\d+)?)-(x86_64|aarch64|arm64|ppc64le|s390x|multi)$`)
+
+// ValidateManifests runs the validations of assisted-service that don't need hosts on the ZTP manifests. It doesn't
+// connect to assisted-service nor to the release image registry, so that the manifests can be validated before they
+// are embedded in the ISO. It returns all the errors found.
+func ValidateManifests(log *log.Logger, clusterDeploymentPath, agentClusterInstallPath, clusterImageSetPath,
+	infraEnvPath, nmStateConfigPath string) []error {
+
+	errs := []error{}
+
+	var cd hivev1.ClusterDeployment
+	if err := getFileData(clusterDeploymentPath, &cd); err != nil {
+		errs = append(errs, err)
+	}
+	var aci hiveext.AgentClusterInstall
+	if err := getFileData(agentClusterInstallPath, &aci); err != nil {
+		errs = append(errs, err)
+	}
+	releaseImage, err := getReleaseVersion(clusterImageSetPath)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	var infraEnv aiv1beta1.InfraEnv
+	if err = getFileData(infraEnvPath, &infraEnv); err != nil {
+		errs = append(errs, err)
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+
+	releaseImageVersion, releaseImageCPUArch := releaseImageVersionFromTag(releaseImage)
+	if releaseImageVersion == "" {
+		log.Warnf("Unable to find the OpenShift version in the tag of release image %s, skipping version specific validations", releaseImage)
+	}
+
+	if err = aciwebhook.ValidateAgentClusterInstall(&aci); err != nil {
+		errs = append(errs, fmt.Errorf("invalid AgentClusterInstall: %w", err))
+	}
+
+	clusterParams := controllers.CreateClusterParams(&cd, &aci, "", releaseImageVersion, releaseImageCPUArch, nil)
+	if aci.Spec.Networking.NetworkType != "" {
+		clusterParams.NetworkType = &aci.Spec.Networking.NetworkType
+	}
+	errs = append(errs, validateClusterParams(clusterParams)...)
+
+	if fileInfo, _ := os.Stat(nmStateConfigPath); fileInfo != nil {
+		var nmStateConfig aiv1beta1.NMStateConfig
+		if err = getFileData(nmStateConfigPath, &nmStateConfig); err != nil {
+			errs = append(errs, err)
+		} else if err = validateNMStateConfigAndInfraEnv(nmStateConfig, infraEnv); err != nil {
+			errs = append(errs, fmt.Errorf("invalid NMStateConfig: %w", err))
+		}
+	}
+	errs = append(errs, validateInfraEnv(&infraEnv, clusterParams)...)
+
+	return errs
+}
+
+// releaseImageVersionFromTag returns the OpenShift version and CPU architecture of a release image according to its
+// tag, or empty strings when the tag doesn't include them
+func releaseImageVersionFromTag(releaseImage string) (string, string) {
+	match := releaseImageTagRegex.FindStringSubmatch(releaseImage)
+	if match == nil {
+		return "", ""
+	}
+	cpuArch := match[2]
+	if cpuArch == common.AARCH64CPUArchitecture {
+		cpuArch = common.ARM64CPUArchitecture
+	}
+	return match[1], cpuArch
+}
+
+func validateClusterParams(params *models.ClusterCreateParams) []error {
+	errs := []error{}
+	fail := func(format string, err error) {
+		errs = append(errs, fmt.Errorf(format, err))
+	}
+
+	if err := validations.ValidateClusterNameFormat(swag.StringValue(params.Name)); err != nil {
+		fail("invalid cluster name: %w", err)
+	}
+	if _, err := pkgvalidations.ValidateDomainNameFormat(params.BaseDNSDomain); err != nil {
+		fail("invalid base domain: %w", err)
+	}
+	if params.SSHPublicKey != "" {
+		if err := validations.ValidateSSHPublicKey(params.SSHPublicKey); err != nil {
+			fail("invalid SSH public key: %w", err)
+		}
+	}
+	for _, proxy := range []*string{params.HTTPProxy, params.HTTPSProxy} {
+		if swag.StringValue(proxy) != "" {
+			if err := pkgvalidations.ValidateHTTPProxyFormat(*proxy); err != nil {
+				fail("invalid proxy: %w", err)
+			}
+		}
+	}
+	if swag.StringValue(params.NoProxy) != "" {
+		if err := validations.ValidateNoProxyFormat(*params.NoProxy, swag.StringValue(params.OpenshiftVersion)); err != nil {
+			fail("invalid proxy: %w", err)
+		}
+	}
+
+	isSNO := swag.StringValue(params.HighAvailabilityMode) == models.ClusterHighAvailabilityModeNone
+	for _, clusterNetwork := range params.ClusterNetworks {
+		if err := network.VerifyClusterOrServiceCIDR(string(clusterNetwork.Cidr)); err != nil {
+			fail("invalid cluster network: %w", err)
+		}
+		if err := network.VerifyNetworkHostPrefix(clusterNetwork.HostPrefix); err != nil {
+			fail("invalid cluster network: %w", err)
+		}
+	}
+	for _, serviceNetwork := range params.ServiceNetworks {
+		if err := network.VerifyClusterOrServiceCIDR(string(serviceNetwork.Cidr)); err != nil {
+			fail("invalid service network: %w", err)
+		}
+	}
+	for _, machineNetwork := range params.MachineNetworks {
+		if err := network.VerifyMachineCIDR(string(machineNetwork.Cidr), isSNO); err != nil {
+			fail("invalid machine network: %w", err)
+		}
+	}
+	if len(params.ClusterNetworks) > 0 && len(params.ServiceNetworks) > 0 {
+		machineNetworkCidr := ""
+		if len(params.MachineNetworks) > 0 {
+			machineNetworkCidr = string(params.MachineNetworks[0].Cidr)
+		}
+		if err := network.VerifyClusterCIDRsNotOverlap(machineNetworkCidr, string(params.ClusterNetworks[0].Cidr),
+			string(params.ServiceNetworks[0].Cidr), machineNetworkCidr != ""); err != nil {
+			fail("invalid networks: %w", err)
+		}
+	}
+	if err := validations.ValidateClusterCreateIPAddresses(true, strfmt.UUID(uuid.New().String()), params); err != nil {
+		fail("invalid networks: %w", err)
+	}
+
+	if params.Platform != nil {
+		if err := validations.ValidateHighAvailabilityModeWithPlatform(params.HighAvailabilityMode, params.Platform); err != nil {
+			fail("invalid platform: %w", err)
+		}
+	}
+
+	openshiftVersion := swag.StringValue(params.OpenshiftVersion)
+	if openshiftVersion != "" {
+		if isSNO && !featuresupport.IsFeatureSupported(openshiftVersion, models.FeatureSupportLevelFeaturesItems0FeatureIDSNO) {
+			errs = append(errs, fmt.Errorf("single node OpenShift is not supported in OpenShift %s", openshiftVersion))
+		}
+		if params.CPUArchitecture != "" && params.CPUArchitecture != common.X86CPUArchitecture &&
+			params.CPUArchitecture != common.MultiCPUArchitecture && !swag.BoolValue(params.UserManagedNetworking) &&
+			!featuresupport.IsFeatureSupported(openshiftVersion, models.FeatureSupportLevelFeaturesItems0FeatureIDARM64ARCHITECTUREWITHCLUSTERMANAGEDNETWORKING) {
+			errs = append(errs, fmt.Errorf("non x86_64 CPU architectures for version %s are supported only with User Managed Networking", openshiftVersion))
+		}
+	}
+
+	return errs
+}
+
+func validateInfraEnv(infraEnv *aiv1beta1.InfraEnv, clusterParams *models.ClusterCreateParams) []error {
+	errs := []error{}
+
+	if infraEnv.Spec.SSHAuthorizedKey != "" {
+		if err := validations.ValidateSSHPublicKey(infraEnv.Spec.SSHAuthorizedKey); err != nil {
+			errs = append(errs, fmt.Errorf("invalid InfraEnv SSH authorized key: %w", err))
+		}
+	}
+	for _, ntpSource := range infraEnv.Spec.AdditionalNTPSources {
+		if !pkgvalidations.ValidateNTPSource(ntpSource) {
+			errs = append(errs, fmt.Errorf("invalid InfraEnv NTP source: %s", ntpSource))
+		}
+	}
+	if infraEnv.Spec.CpuArchitecture != "" && clusterParams.CPUArchitecture != "" &&
+		clusterParams.CPUArchitecture != common.MultiCPUArchitecture && infraEnv.Spec.CpuArchitecture != clusterParams.CPUArchitecture {
+		errs = append(errs, fmt.Errorf("InfraEnv CPU architecture %s doesn't match the release image CPU architecture %s",
+			infraEnv.Spec.CpuArchitecture, clusterParams.CPUArchitecture))
+	}
+	return errs
+}
@@ -0,0 +1,181 @@
+package agentbasedinstaller
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus/hooks/test"
+)
+
+const (
+	validClusterDeployment = `apiVersion: hive.openshift.io/v1
+kind: ClusterDeployment
+metadata:
+  name: ostest
+  namespace: cluster0
+spec:
+  baseDomain: test.metalkube.org
+  clusterName: ostest
+  clusterInstallRef:
+    group: extensions.hive.openshift.io
+    kind: AgentClusterInstall
+    name: test-agent-cluster-install
+    version: v1beta1
+  platform:
+    agentBareMetal:
+      agentSelector: {}
+  pullSecretRef:
+    name: pull-secret
+`
+	validAgentClusterInstall = `apiVersion: extensions.hive.openshift.io/v1beta1
+kind: AgentClusterInstall
+metadata:
+  name: test-agent-cluster-install
+  namespace: cluster0
+spec:
+  clusterDeploymentRef:
+    name: ostest
+  imageSetRef:
+    name: openshift-v4.11.0
+  networking:
+    clusterNetwork:
+    - cidr: 10.128.0.0/14
+      hostPrefix: 23
+    serviceNetwork:
+    - 172.30.0.0/16
+  provisionRequirements:
+    controlPlaneAgents: 3
+    workerAgents: 2
+  apiVIP: 192.168.111.5
+  ingressVIP: 192.168.111.4
+`
+	validClusterImageSet = `apiVersion: hive.openshift.io/v1
+kind: ClusterImageSet
+metadata:
+  name: openshift-v4.11.0
+spec:
+  releaseImage: quay.io/openshift-release-dev/ocp-release:4.11.0-x86_64
+`
+	validInfraEnv = `apiVersion: agent-install.openshift.io/v1beta1
+kind: InfraEnv
+metadata:
+  name: myinfraenv
+  namespace: cluster0
+spec:
+  clusterRef:
+    name: ostest
+    namespace: cluster0
+  pullSecretRef:
+    name: pull-secret
+`
+)
+
+var _ = DescribeTable("releaseImageVersionFromTag",
+	func(releaseImage, expectedVersion, expectedCPUArch string) {
+		version, cpuArch := releaseImageVersionFromTag(releaseImage)
+		Expect(version).To(Equal(expectedVersion))
+		Expect(cpuArch).To(Equal(expectedCPUArch))
+	},
+	Entry("x86_64", "quay.io/openshift-release-dev/ocp-release:4.11.0-x86_64", "4.11.0", "x86_64"),
+	Entry("aarch64", "quay.io/openshift-release-dev/ocp-release:4.11.2-aarch64", "4.11.2", "arm64"),
+	Entry("pre-release", "quay.io/openshift-release-dev/ocp-release:4.12.0-rc.1-multi", "4.12.0-rc.1", "multi"),
+	Entry("registry with port", "registry.example.com:5000/ocp-release:4.10.3-x86_64", "4.10.3", "x86_64"),
+	Entry("digest", "quay.io/openshift-release-dev/ocp-release@sha256:1234", "", ""),
+	Entry("CI tag", "registry.ci.openshift.org/ocp/release:4.11.0-0.nightly-2022-07-12-123456", "", ""),
+)
+
+var _ = Describe("ValidateManifests", func() {
+	var (
+		manifestsDir string
+		manifests    map[string]string
+	)
+
+	BeforeEach(func() {
+		var err error
+		manifestsDir, err = os.MkdirTemp("", "manifests")
+		Expect(err).ToNot(HaveOccurred())
+		manifests = map[string]string{
+			"cluster-deployment.yaml":    validClusterDeployment,
+			"agent-cluster-install.yaml": validAgentClusterInstall,
+			"cluster-image-set.yaml":     validClusterImageSet,
+			"infraenv.yaml":              validInfraEnv,
+		}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(manifestsDir)
+	})
+
+	validate := func() []error {
+		for name, content := range manifests {
+			Expect(os.WriteFile(filepath.Join(manifestsDir, name), []byte(content), 0600)).To(Succeed())
+		}
+		log, _ := test.NewNullLogger()
+		return ValidateManifests(log,
+			filepath.Join(manifestsDir, "cluster-deployment.yaml"),
+			filepath.Join(manifestsDir, "agent-cluster-install.yaml"),
+			filepath.Join(manifestsDir, "cluster-image-set.yaml"),
+			filepath.Join(manifestsDir, "infraenv.yaml"),
+			filepath.Join(manifestsDir, "nmstateconfig.yaml"))
+	}
+
+	It("accepts valid manifests", func() {
+		Expect(validate()).To(BeEmpty())
+	})
+
+	It("reports missing manifests", func() {
+		delete(manifests, "cluster-image-set.yaml")
+		errs := validate()
+		Expect(errs).To(HaveLen(1))
+		Expect(errs[0].Error()).To(ContainSubstring("cluster-image-set.yaml"))
+	})
+
+	It("reports every invalid setting", func() {
+		manifests["agent-cluster-install.yaml"] = `apiVersion: extensions.hive.openshift.io/v1beta1
+kind: AgentClusterInstall
+metadata:
+  name: test-agent-cluster-install
+spec:
+  networking:
+    clusterNetwork:
+    - cidr: 172.28.0.0/14
+      hostPrefix: 23
+    serviceNetwork:
+    - 172.30.0.0/16
+  provisionRequirements:
+    controlPlaneAgents: 3
+  sshPublicKey: not-a-key
+`
+		manifests["infraenv.yaml"] = validInfraEnv + "  cpuArchitecture: arm64\n  additionalNTPSources:\n  - not_a_host\n"
+		errs := validate()
+		messages := []string{}
+		for _, err := range errs {
+			messages = append(messages, err.Error())
+		}
+		Expect(messages).To(ConsistOf(
+			HavePrefix("invalid SSH public key: "),
+			HavePrefix("invalid networks: "),
+			HavePrefix("invalid InfraEnv NTP source: not_a_host"),
+			HavePrefix("InfraEnv CPU architecture arm64 doesn't match the release image CPU architecture x86_64"),
+		))
+	})
+
+	It("rejects single node OpenShift with cluster managed networking", func() {
+		manifests["agent-cluster-install.yaml"] = `apiVersion: extensions.hive.openshift.io/v1beta1
+kind: AgentClusterInstall
+metadata:
+  name: test-agent-cluster-install
+spec:
+  networking:
+    userManagedNetworking: false
+  provisionRequirements:
+    controlPlaneAgents: 1
+`
+		errs := validate()
+		Expect(errs).ToNot(BeEmpty())
+		Expect(errs[0].Error()).To(Equal("invalid AgentClusterInstall: UserManagedNetworking must be set to true with SNO"))
+	})
+})
@@ -26,6 +26,7 @@ import (
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/connectivity"
 	"github.com/openshift/assisted-service/internal/controller/controllers"
+	"github.com/openshift/assisted-service/internal/csrapprover"
 	"github.com/openshift/assisted-service/internal/dns"
 	"github.com/openshift/assisted-service/internal/domains"
 	"github.com/openshift/assisted-service/internal/events"
@@ -65,6 +66,7 @@ import (
 	"github.com/openshift/assisted-service/pkg/s3wrapper"
 	"github.com/openshift/assisted-service/pkg/staticnetworkconfig"
 	"github.com/openshift/assisted-service/pkg/thread"
+	"github.com/openshift/assisted-service/pkg/tracing"
 	"github.com/openshift/assisted-service/restapi"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
@@ -107,10 +109,13 @@ var Options struct {
 	InstructionConfig              hostcommands.InstructionConfig
 	OperatorsConfig                operators.Options
 	GCConfig                       garbagecollector.Config
+	CSRApproverConfig              csrapprover.Config
 	StaticNetworkConfig            staticnetworkconfig.Config
+	TracingConfig                  tracing.Config
 	ClusterStateMonitorInterval    time.Duration `envconfig:"CLUSTER_MONITOR_INTERVAL" default:"10s"`
 	S3Config                       s3wrapper.Config
 	HostStateMonitorInterval       time.Duration `envconfig:"HOST_MONITOR_INTERVAL" default:"8s"`
+	HostSafetyNetMonitorInterval   time.Duration `envconfig:"HOST_SAFETY_NET_MONITOR_INTERVAL" default:"2m"`
 	Versions                       versions.Versions
 	OsImages                       string        `envconfig:"OS_IMAGES" default:""`
 	ReleaseImages                  string        `envconfig:"RELEASE_IMAGES" default:""`
@@ -125,6 +130,7 @@ var Options struct {
 	HostConfig                     host.Config
 	LogConfig                      logconfig.Config
 	LeaderConfig                   leader.Config
+	PartitionConfig                leader.PartitionConfig
 	ValidationsConfig              validations.Config
 	ManifestsGeneratorConfig       network.Config
 	EnableKubeAPI                  bool `envconfig:"ENABLE_KUBE_API" default:"false"`
@@ -141,6 +147,7 @@ var Options struct {
 	MaxIdleConns                   int           `envconfig:"DB_MAX_IDLE_CONNECTIONS" default:"50"`
 	MaxOpenConns                   int           `envconfig:"DB_MAX_OPEN_CONNECTIONS" default:"90"`
 	ConnMaxLifetime                time.Duration `envconfig:"DB_CONNECTIONS_MAX_LIFETIME" default:"30m"`
+	DBSlowQueryThreshold           time.Duration `envconfig:"DB_SLOW_QUERY_THRESHOLD" default:"1s"`
 	FileSystemUsageThreshold       int           `envconfig:"FILESYSTEM_USAGE_THRESHOLD" default:"80"`
 	EnableElasticAPM               bool          `envconfig:"ENABLE_ELASTIC_APM" default:"false"`
 	WorkDir                        string        `envconfig:"WORK_DIR" default:"/data/"`
@@ -212,6 +219,14 @@ func main() {
 		}
 	}
 
+	shutdownTracing, err := tracing.Init(context.Background(), &Options.TracingConfig, log.WithField("pkg", "tracing"))
+	failOnError(err, "failed to initialize tracing")
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			log.WithError(err).Error("failed to flush traces")
+		}
+	}()
+
 	port := flag.String("port", "8090", "define port that the service will listen to")
 	flag.Parse()
 
@@ -273,6 +288,8 @@ func main() {
 		//in the constructor due to a cyclic dependency with the event handler
 		ocmClient.SetMetrics(metricsManager)
 	}
+	failOnError(db.Use(metrics.NewGormPlugin(log.WithField("pkg", "db-metrics"), metricsManager, Options.DBSlowQueryThreshold)),
+		"failed to register the database metrics plugin")
 
 	Options.InstructionConfig.ReleaseImageMirror = Options.ReleaseImageMirror
 	Options.InstructionConfig.CheckClusterVersion = Options.CheckClusterVersion
@@ -311,6 +328,7 @@ func main() {
 	connectivityValidator := connectivity.NewValidator(log.WithField("pkg", "validators"))
 	Options.InstructionConfig.DisabledSteps = disableFreeAddressesIfNeeded(Options.EnableKubeAPI, Options.InstructionConfig.DisabledSteps)
 	Options.InstructionConfig.HostFSMountDir = hostFSMountDir
+	Options.BMConfig.HostFSMountDir = hostFSMountDir
 	instructionApi := hostcommands.NewInstructionManager(log.WithField("pkg", "instructions"), db, hwValidator,
 		releaseHandler, Options.InstructionConfig, connectivityValidator, eventsHandler, versionHandler)
 
@@ -349,6 +367,16 @@ func main() {
 
 		failOnError(lead.StartLeaderElection(context.Background()), "Failed to start leader")
 
+		// Share the monitoring and garbage collection between the replicas
+		if Options.PartitionConfig.Enabled {
+			partitioner, perr := leader.NewLeasePartitioner(k8sClient.CoordinationV1().Leases(Options.LeaderConfig.Namespace),
+				Options.PartitionConfig, lead, log.WithField("pkg", "work-partitioner"))
+			failOnError(perr, "Failed to create work partitioner")
+			failOnError(partitioner.Start(context.Background()), "Failed to start work partitioner")
+			defer partitioner.Stop()
+			lead = partitioner
+		}
+
 		ocpClient, err = k8sclient.NewK8SClient("", log)
 		failOnError(err, "Failed to create client for OCP")
 
@@ -373,16 +401,25 @@ func main() {
 	dnsApi := dns.NewDNSHandler(Options.BMConfig.BaseDNSDomains, log)
 	manifestsGenerator := network.NewManifestsGenerator(manifestsApi, Options.ManifestsGeneratorConfig)
 	clusterApi := cluster.NewManager(Options.ClusterConfig, log.WithField("pkg", "cluster-state"), db,
-		eventsHandler, hostApi, metricsManager, manifestsGenerator, lead, operatorsManager, ocmClient, objectHandler, dnsApi, authHandler)
-	infraEnvApi := infraenv.NewManager(log.WithField("pkg", "host-state"), db, objectHandler)
+		eventsHandler, hostApi, metricsManager, manifestsGenerator, lead, operatorsManager, ocmClient, objectHandler, dnsApi, authHandler, providerRegistry)
+	infraEnvApi := infraenv.NewManager(log.WithField("pkg", "host-state"), db, objectHandler, eventsHandler)
 
 	clusterStateMonitor := thread.New(
 		log.WithField("pkg", "cluster-monitor"), "Cluster State Monitor", Options.ClusterStateMonitorInterval, clusterApi.ClusterMonitoring)
 	clusterStateMonitor.Start()
 	defer clusterStateMonitor.Stop()
 
+	// With event driven monitoring hosts are refreshed when they report changes or reach a deadline,
+	// the periodic monitor is only a safety net for anything missed
+	hostMonitorInterval := Options.HostStateMonitorInterval
+	if Options.HostConfig.EnableEventDrivenMonitoring {
+		refreshCtx, stopRefresh := context.WithCancel(context.Background())
+		defer stopRefresh()
+		hostApi.StartRefreshWorkers(refreshCtx)
+		hostMonitorInterval = Options.HostSafetyNetMonitorInterval
+	}
 	hostStateMonitor := thread.New(
-		log.WithField("pkg", "host-monitor"), "Host State Monitor", Options.HostStateMonitorInterval, hostApi.HostMonitoring)
+		log.WithField("pkg", "host-monitor"), "Host State Monitor", hostMonitorInterval, hostApi.HostMonitoring)
 	hostStateMonitor.Start()
 	defer hostStateMonitor.Stop()
 
@@ -438,6 +475,20 @@ func main() {
 		}
 	}
 
+	// In operator-deployment, the agent controller approves the CSRs of day2 hosts
+	if !Options.EnableKubeAPI && Options.CSRApproverConfig.EnableDay2CSRApproval {
+		approver := csrapprover.NewApprover(Options.CSRApproverConfig, db, log.WithField("pkg", "csr-approver"), eventsHandler,
+			objectHandler, spoke_k8s_client.NewSpokeK8sClientFactory(log.WithField("pkg", "spoke-client")), lead)
+		csrApproverWorker := thread.New(
+			log.WithField("pkg", "csr-approver"),
+			"Day2 CSR Approver",
+			Options.CSRApproverConfig.Interval,
+			approver.ApproveDay2HostsCSRs)
+
+		csrApproverWorker.Start()
+		defer csrApproverWorker.Stop()
+	}
+
 	// Determine if IPXE artifact URLs need to be http
 	serverInfo := servers.New(Options.HTTPListenPort, swag.StringValue(port), Options.HTTPSKeyFile, Options.HTTPSCertFile)
 	generateInsecureIPXEURLs := serverInfo.HTTP != nil
@@ -463,6 +514,11 @@ func main() {
 				wrapped = apmhttp.Wrap(wrapped, apmOptions)
 			}
 
+			if Options.TracingConfig.Enabled {
+				// Span names are the operation ids, as for the APM transactions
+				wrapped = tracing.Middleware(wrapped, generateAPMTransactionName)
+			}
+
 			wrapped = paramctx.ContextHandler()(wrapped)
 			return wrapped
 		}
@@ -495,6 +551,7 @@ func main() {
 		h = app.SetupCORSMiddleware(h, allowedDomains)
 	}
 
+	h = app.WithClusterBundleYAMLMiddleware(h)
 	h = gziphandler.GzipHandler(h)
 	h = app.WithMetricsResponderMiddleware(h)
 	h = app.WithHealthMiddleware(h, []*thread.Thread{hostStateMonitor, clusterStateMonitor},
@@ -645,8 +702,7 @@ func generateAPMTransactionName(request *http.Request) string {
 }
 
 func setupDB(log logrus.FieldLogger) *gorm.DB {
-	dbConnectionStr := fmt.Sprintf("host=%s port=%s user=%s database=%s password=%s sslmode=disable",
-		Options.DBConfig.Host, Options.DBConfig.Port, Options.DBConfig.User, Options.DBConfig.Name, Options.DBConfig.Pass)
+	dbConnectionStr := Options.DBConfig.DSN()
 	var db *gorm.DB
 	var err error
 	// Tries to open a db connection every 2 seconds
@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/kelseyhightower/envconfig"
 	routev1 "github.com/openshift/api/route/v1"
 	aiv1beta1 "github.com/openshift/assisted-service/api/v1beta1"
 	"github.com/openshift/assisted-service/internal/controller/controllers"
@@ -142,7 +143,18 @@ func main() {
 
 	log := logrus.New()
 	spokeClientFactory := spoke_k8s_client.NewSpokeK8sClientFactory(log)
-	spokeClientCache := controllers.NewSpokeClientCache(spokeClientFactory)
+	var spokeClientCacheConfig controllers.SpokeClientCacheConfig
+	if err = envconfig.Process("", &spokeClientCacheConfig); err != nil {
+		setupLog.Error(err, "unable to process spoke client cache config")
+		os.Exit(1)
+	}
+	spokeClientCache := controllers.NewSpokeClientCache(spokeClientFactory, spokeClientCacheConfig, log)
+	if err = mgr.Add(spokeClientCache); err != nil {
+		setupLog.Error(err, "unable to add spoke client cache health checks to the manager")
+		os.Exit(1)
+	}
+
+	externalStorageChecker := controllers.NewExternalStorageChecker(log)
 
 	if err = (&controllers.AgentServiceConfigReconciler{
 		AgentServiceConfigReconcileContext: controllers.AgentServiceConfigReconcileContext{
@@ -152,6 +164,8 @@ func main() {
 			NodeSelector: nodeSelector,
 			Tolerations:  tolerations,
 			Recorder:     mgr.GetEventRecorderFor("agentserviceconfig-controller"),
+
+			ExternalStorageChecker: externalStorageChecker,
 		},
 		Namespace: ns,
 	}).SetupWithManager(mgr); err != nil {
@@ -167,6 +181,8 @@ func main() {
 			NodeSelector: nodeSelector,
 			Tolerations:  tolerations,
 			Recorder:     mgr.GetEventRecorderFor("agentserviceconfig-controller"),
+
+			ExternalStorageChecker: externalStorageChecker,
 		},
 		SpokeClients: spokeClientCache,
 		Namespace:    ns,
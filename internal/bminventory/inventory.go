@@ -40,11 +40,16 @@ import (
 	installcfg "github.com/openshift/assisted-service/internal/installcfg/builder"
 	"github.com/openshift/assisted-service/internal/isoeditor"
 	"github.com/openshift/assisted-service/internal/manifests"
+	manifestsapi "github.com/openshift/assisted-service/internal/manifests/api"
 	"github.com/openshift/assisted-service/internal/metrics"
 	"github.com/openshift/assisted-service/internal/network"
 	"github.com/openshift/assisted-service/internal/operators"
 	"github.com/openshift/assisted-service/internal/provider"
 	"github.com/openshift/assisted-service/internal/provider/registry"
+	"github.com/openshift/assisted-service/internal/provider/vsphere"
+	"github.com/openshift/assisted-service/internal/reclaim"
+	"github.com/openshift/assisted-service/internal/spoke_k8s_client"
+	"github.com/openshift/assisted-service/internal/timeline"
 	"github.com/openshift/assisted-service/internal/usage"
 	"github.com/openshift/assisted-service/internal/versions"
 	"github.com/openshift/assisted-service/models"
@@ -118,6 +123,8 @@ type Config struct {
 	ISOImageType                        string            `envconfig:"ISO_IMAGE_TYPE" default:"full-iso"`
 	IPv6Support                         bool              `envconfig:"IPV6_SUPPORT" default:"true"`
 	DiskEncryptionSupport               bool              `envconfig:"DISK_ENCRYPTION_SUPPORT" default:"true"`
+	VsphereCredentialsValidation        vsphere.CredentialsValidatorConfig
+	HostFSMountDir                      string `ignored:"true"`
 
 	// InfraEnv ID for the ephemeral installer. Should not be set explicitly.Ephemeral (agent) installer sets this env var
 	InfraEnvID strfmt.UUID `envconfig:"INFRA_ENV_ID" default:""`
@@ -210,6 +217,10 @@ type bareMetalInventory struct {
 	gcConfig             garbagecollector.Config
 	providerRegistry     registry.ProviderRegistry
 	insecureIPXEURLs     bool
+	vsphereValidator     vsphere.CredentialsValidator
+	spokeClientFactory   spoke_k8s_client.SpokeK8sClientFactory
+	reclaimer            reclaim.Reclaimer
+	manifestsApi         manifestsapi.ClusterManifestsInternals
 }
 
 func NewBareMetalInventory(
@@ -271,6 +282,17 @@ func NewBareMetalInventory(
 		gcConfig:             gcConfig,
 		providerRegistry:     providerRegistry,
 		insecureIPXEURLs:     insecureIPXEURLs,
+		vsphereValidator:     vsphere.NewCredentialsValidator(log, cfg.VsphereCredentialsValidation),
+		spokeClientFactory:   spoke_k8s_client.NewSpokeK8sClientFactory(log),
+		reclaimer: reclaim.NewReclaimer(reclaim.Config{
+			AgentContainerImage:  cfg.AgentDockerImg,
+			AuthType:             authHandler.AuthType(),
+			ServiceBaseURL:       cfg.ServiceBaseURL,
+			ServiceCACertPath:    cfg.ServiceCACertPath,
+			SkipCertVerification: cfg.SkipCertVerification,
+			HostFSMountDir:       cfg.HostFSMountDir,
+		}),
+		manifestsApi: manifests.NewManifestsAPI(db, log, objectHandler, usageApi),
 	}
 }
 
@@ -378,7 +400,8 @@ func getDefaultNetworkType(params installer.V2RegisterClusterParams) (*string, e
 	}
 }
 
-func (b *bareMetalInventory) validateRegisterClusterInternalParams(params *installer.V2RegisterClusterParams, log logrus.FieldLogger) error {
+func (b *bareMetalInventory) validateRegisterClusterInternalParams(params *installer.V2RegisterClusterParams, vspherePasswordOptional bool,
+	log logrus.FieldLogger) error {
 	var err error
 
 	if err = validateProxySettings(params.NewClusterParams.HTTPProxy,
@@ -427,6 +450,9 @@ func (b *bareMetalInventory) validateRegisterClusterInternalParams(params *insta
 		if err := validations.ValidateHighAvailabilityModeWithPlatform(params.NewClusterParams.HighAvailabilityMode, params.NewClusterParams.Platform); err != nil {
 			return common.NewApiError(http.StatusBadRequest, err)
 		}
+		if err := validateVspherePlatform(params.NewClusterParams.Platform, vspherePasswordOptional); err != nil {
+			return common.NewApiError(http.StatusBadRequest, err)
+		}
 	}
 
 	return nil
@@ -436,6 +462,16 @@ func (b *bareMetalInventory) RegisterClusterInternal(
 	ctx context.Context,
 	kubeKey *types.NamespacedName,
 	params installer.V2RegisterClusterParams) (*common.Cluster, error) {
+	return b.registerClusterInternal(ctx, kubeKey, params, false)
+}
+
+// registerClusterInternal registers a cluster. The vCenter password of a vSphere platform is optional for clusters
+// registered from a bundle, which never holds credentials; the password is then set by updating the cluster.
+func (b *bareMetalInventory) registerClusterInternal(
+	ctx context.Context,
+	kubeKey *types.NamespacedName,
+	params installer.V2RegisterClusterParams,
+	vspherePasswordOptional bool) (*common.Cluster, error) {
 
 	id := strfmt.UUID(uuid.New().String())
 	url := installer.V2GetClusterURL{ClusterID: id}
@@ -471,12 +507,15 @@ func (b *bareMetalInventory) RegisterClusterInternal(
 		return nil, common.NewApiError(http.StatusBadRequest, err)
 	}
 
+	// The platform is defaulted below, the platform of the clusters whose user chose it is never selected automatically
+	platformSelectedByUser := params.NewClusterParams.Platform != nil && common.PlatformTypeValue(params.NewClusterParams.Platform.Type) != ""
+
 	params, err = b.setDefaultRegisterClusterParams(ctx, params, id)
 	if err != nil {
 		return nil, err
 	}
 
-	if err = b.validateRegisterClusterInternalParams(&params, log); err != nil {
+	if err = b.validateRegisterClusterInternalParams(&params, vspherePasswordOptional, log); err != nil {
 		return nil, err
 	}
 
@@ -522,6 +561,13 @@ func (b *bareMetalInventory) RegisterClusterInternal(
 		kubeKey = &types.NamespacedName{}
 	}
 
+	var labels string
+	if params.NewClusterParams.Labels != nil {
+		if labels, err = common.MarshalLabels(params.NewClusterParams.Labels); err != nil {
+			return nil, common.NewApiError(http.StatusBadRequest, err)
+		}
+	}
+
 	monitoredOperators := b.operatorManagerApi.GetSupportedOperatorsByType(models.OperatorTypeBuiltin)
 
 	cluster := common.Cluster{
@@ -560,11 +606,13 @@ func (b *bareMetalInventory) RegisterClusterInternal(
 			CPUArchitecture:              cpuArchitecture,
 			IgnitionEndpoint:             params.NewClusterParams.IgnitionEndpoint,
 			Tags:                         swag.StringValue(params.NewClusterParams.Tags),
+			Labels:                       labels,
 		},
 		KubeKeyName:                 kubeKey.Name,
 		KubeKeyNamespace:            kubeKey.Namespace,
 		TriggerMonitorTimestamp:     time.Now(),
 		MachineNetworkCidrUpdatedAt: time.Now(),
+		PlatformSelectedByUser:      platformSelectedByUser,
 	}
 
 	if params.NewClusterParams.OlmOperators != nil {
@@ -598,6 +646,13 @@ func (b *bareMetalInventory) RegisterClusterInternal(
 	}
 	setPullSecret(&cluster, ps)
 
+	if cluster.Platform != nil && cluster.Platform.Vsphere != nil && cluster.Platform.Vsphere.Password != "" {
+		cluster.VsphereEncryptedPassword, err = gencrypto.EncryptSecret(cluster.Platform.Vsphere.Password.String())
+		if err != nil {
+			return nil, common.NewApiError(http.StatusInternalServerError, errors.Wrap(err, "failed to encrypt the vCenter password"))
+		}
+	}
+
 	if err = validations.ValidateClusterNameFormat(swag.StringValue(params.NewClusterParams.Name)); err != nil {
 		return nil, common.NewApiError(http.StatusBadRequest, err)
 	}
@@ -868,6 +923,13 @@ func (b *bareMetalInventory) V2ImportClusterInternal(ctx context.Context, kubeKe
 		return nil, common.NewApiError(http.StatusBadRequest, err)
 	}
 
+	kubeconfig := params.NewImportClusterParams.Kubeconfig
+	if kubeconfig != "" {
+		if _, err = clientcmd.Load([]byte(kubeconfig)); err != nil {
+			return nil, common.NewApiError(http.StatusBadRequest, errors.Wrap(err, "invalid kubeconfig"))
+		}
+	}
+
 	// After registering the cluster, its status should be 'ClusterStatusAddingHosts'
 	err = b.clusterApi.RegisterAddHostsCluster(ctx, &newCluster)
 	if err != nil {
@@ -875,6 +937,13 @@ func (b *bareMetalInventory) V2ImportClusterInternal(ctx context.Context, kubeKe
 		return nil, common.NewApiError(http.StatusInternalServerError, err)
 	}
 
+	if kubeconfig != "" {
+		if err = b.objectHandler.Upload(ctx, []byte(kubeconfig), fmt.Sprintf("%s/%s", id, constants.Kubeconfig)); err != nil {
+			log.WithError(err).Errorf("failed to upload kubeconfig of cluster %s", id)
+			return nil, common.NewApiError(http.StatusInternalServerError, err)
+		}
+	}
+
 	b.metricApi.ClusterRegistered()
 	return &newCluster, nil
 }
@@ -1198,7 +1267,7 @@ func (b *bareMetalInventory) InstallClusterInternal(ctx context.Context, params
 	var autoAssigned bool
 
 	// auto select hosts roles if not selected yet.
-	err = b.db.Transaction(func(tx *gorm.DB) error {
+	err = transaction.Transaction(ctx, b.db, "auto assign host roles", func(tx *gorm.DB) error {
 		var updated bool
 		sortedHosts, canRefreshRoles := host.SortHosts(cluster.Hosts)
 		if canRefreshRoles {
@@ -1242,8 +1311,12 @@ func (b *bareMetalInventory) InstallClusterInternal(ctx context.Context, params
 			errors.Errorf("Cluster is not ready for installation, %s validation_info=%s", reason, cluster.ValidationsInfo))
 	}
 
+	if err = b.validateVsphereCredentials(ctx, cluster); err != nil {
+		return nil, err
+	}
+
 	// prepare cluster and hosts for installation
-	err = b.db.Transaction(func(tx *gorm.DB) error {
+	err = transaction.Transaction(ctx, b.db, "prepare cluster for installation", func(tx *gorm.DB) error {
 		if err = b.clusterApi.PrepareForInstallation(ctx, cluster, tx); err != nil {
 			return err
 		}
@@ -1318,7 +1391,7 @@ func (b *bareMetalInventory) InstallSingleDay2HostInternal(ctx context.Context,
 		return err
 	}
 	// auto select host roles if not selected yet.
-	err = b.db.Transaction(func(tx *gorm.DB) error {
+	err = transaction.Transaction(ctx, b.db, "auto assign host roles", func(tx *gorm.DB) error {
 		if _, err = b.hostApi.AutoAssignRole(ctx, &h.Host, tx); err != nil {
 			return err
 		}
@@ -1332,42 +1405,28 @@ func (b *bareMetalInventory) InstallSingleDay2HostInternal(ctx context.Context,
 		return err
 	}
 
-	txSuccess := false
-	tx := b.db.Begin()
-	defer func() {
-		if !txSuccess {
-			log.Error("InstallSingleDay2HostInternal failed")
-			tx.Rollback()
-		}
-		if r := recover(); r != nil {
-			log.Errorf("InstallSingleDay2HostInternal failed to recover: %s", r)
-			log.Error(string(debug.Stack()))
-			tx.Rollback()
+	err = transaction.Transaction(ctx, b.db, "install day2 host", func(tx *gorm.DB) error {
+		// in case host monitor already updated the state we need to use FOR UPDATE option
+		if cluster, err = common.GetClusterFromDBForUpdate(tx, clusterId, common.UseEagerLoading); err != nil {
+			return err
 		}
-	}()
 
-	// in case host monitor already updated the state we need to use FOR UPDATE option
-	if cluster, err = common.GetClusterFromDBForUpdate(tx, clusterId, common.UseEagerLoading); err != nil {
-		return err
-	}
-
-	// move host to installing
-	err = b.createAndUploadDay2NodeIgnition(ctx, cluster, &h.Host, h.IgnitionEndpointToken)
-	if err != nil {
-		log.Errorf("Failed to upload ignition for host %s", h.RequestedHostname)
-		return err
-	}
-	if installErr := b.hostApi.Install(ctx, &h.Host, tx); installErr != nil {
-		log.WithError(installErr).Errorf("Failed to move host %s to installing", h.RequestedHostname)
-		return installErr
-	}
-
-	err = tx.Commit().Error
+		// move host to installing
+		err = b.createAndUploadDay2NodeIgnition(ctx, cluster, &h.Host, h.IgnitionEndpointToken)
+		if err != nil {
+			log.Errorf("Failed to upload ignition for host %s", h.RequestedHostname)
+			return err
+		}
+		if installErr := b.hostApi.Install(ctx, &h.Host, tx); installErr != nil {
+			log.WithError(installErr).Errorf("Failed to move host %s to installing", h.RequestedHostname)
+			return installErr
+		}
+		return nil
+	})
 	if err != nil {
-		log.Error(err)
+		log.WithError(err).Error("InstallSingleDay2HostInternal failed")
 		return err
 	}
-	txSuccess = true
 	eventgen.SendHostInstallationStartedEvent(ctx, b.eventsHandler, *h.ID, h.InfraEnvID, h.ClusterID, hostutil.GetHostnameForMsg(&h.Host))
 
 	return nil
@@ -1521,53 +1580,83 @@ func (b *bareMetalInventory) GetClusterSupportedPlatforms(ctx context.Context, p
 	return installer.NewGetClusterSupportedPlatformsOK().WithPayload(*supportedPlatforms)
 }
 
+// GetClusterSupportedPlatformsDetails returns whether each platform can be used with the current hosts of the cluster,
+// with the reasons why the unsupported platforms were excluded. GetClusterSupportedPlatforms keeps returning only
+// the supported platforms, for the existing clients.
+func (b *bareMetalInventory) GetClusterSupportedPlatformsDetails(ctx context.Context, params installer.GetClusterSupportedPlatformsDetailsParams) middleware.Responder {
+	cluster, err := b.GetClusterInternal(ctx, installer.V2GetClusterParams{ClusterID: params.ClusterID})
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	platformsSupport, err := b.providerRegistry.GetProvidersSupportByHosts(cluster.Hosts)
+	if err != nil {
+		return common.GenerateErrorResponder(common.NewApiError(http.StatusInternalServerError,
+			fmt.Errorf("error while checking supported platforms, error: %w", err)))
+	}
+	// no hosts or SNO, same as GetClusterSupportedPlatforms
+	var reason string
+	if len(cluster.Hosts) == 0 {
+		reason = "The cluster has no hosts, only the none platform is supported"
+	} else if common.IsSingleNodeCluster(cluster) {
+		reason = "Single node OpenShift clusters support only the none platform"
+	}
+	if reason != "" {
+		for _, platformSupport := range platformsSupport {
+			supported := *platformSupport.PlatformType == models.PlatformTypeNone
+			platformSupport.Supported = swag.Bool(supported)
+			if !supported {
+				platformSupport.UnsupportedReasons = append([]string{reason}, platformSupport.UnsupportedReasons...)
+			}
+		}
+	}
+	return installer.NewGetClusterSupportedPlatformsDetailsOK().WithPayload(platformsSupport)
+}
+
 func (b *bareMetalInventory) UpdateClusterInstallConfigInternal(ctx context.Context, params installer.V2UpdateClusterInstallConfigParams) (*common.Cluster, error) {
 	log := logutil.FromContext(ctx, b.log)
 	var cluster *common.Cluster
 	var err error
 	query := "id = ?"
 
-	txSuccess := false
-	tx := b.db.Begin()
-	defer func() {
-		if !txSuccess {
-			log.Error("UpdateClusterInstallConfigInternal failed")
-			tx.Rollback()
-		}
-		if r := recover(); r != nil {
-			log.Errorf("UpdateClusterInstallConfigInternal failed to recover: %s", r)
-			log.Error(string(debug.Stack()))
-			tx.Rollback()
+	err = transaction.Transaction(ctx, b.db, "update cluster install config", func(tx *gorm.DB) error {
+		if cluster, err = common.GetClusterFromDBForUpdate(tx, params.ClusterID, common.UseEagerLoading); err != nil {
+			log.WithError(err).Errorf("failed to find cluster %s", params.ClusterID)
+			return err
 		}
-	}()
 
-	if cluster, err = common.GetClusterFromDBForUpdate(tx, params.ClusterID, common.UseEagerLoading); err != nil {
-		log.WithError(err).Errorf("failed to find cluster %s", params.ClusterID)
-		return nil, err
-	}
+		overrides := params.InstallConfigParams
+		if installcfg.IsJSONPatch(overrides) {
+			if overrides, err = installcfg.ApplyJSONPatch(cluster.InstallConfigOverrides, params.InstallConfigParams); err != nil {
+				return common.NewApiError(http.StatusBadRequest, err)
+			}
+		}
 
-	if err = b.installConfigBuilder.ValidateInstallConfigPatch(cluster, params.InstallConfigParams); err != nil {
-		return nil, common.NewApiError(http.StatusBadRequest, err)
-	}
+		if err = b.installConfigBuilder.ValidateInstallConfigPatch(cluster, overrides); err != nil {
+			return common.NewApiError(http.StatusBadRequest, err)
+		}
 
-	// Set install config overrides feature usage
-	err = b.setInstallConfigOverridesUsage(cluster.Cluster.FeatureUsage, params.InstallConfigParams, params.ClusterID, tx)
-	if err != nil {
-		// Failure to set the feature usage isn't a failure to update the install config override so we only print the error instead of returning it
-		log.WithError(err).Errorf("failed to set install config overrides feature usage for cluster %s", params.ClusterID)
-	}
+		// Set install config overrides feature usage
+		err = b.setInstallConfigOverridesUsage(cluster.Cluster.FeatureUsage, overrides, params.ClusterID, tx)
+		if err != nil {
+			// Failure to set the feature usage isn't a failure to update the install config override so we only print the error instead of returning it
+			log.WithError(err).Errorf("failed to set install config overrides feature usage for cluster %s", params.ClusterID)
+		}
 
-	err = tx.Model(&common.Cluster{}).Where(query, params.ClusterID).Update("install_config_overrides", params.InstallConfigParams).Error
-	if err != nil {
-		log.WithError(err).Errorf("failed to update install config overrides")
-		return nil, common.NewApiError(http.StatusInternalServerError, err)
-	}
-	err = tx.Commit().Error
+		err = tx.Model(&common.Cluster{}).Where(query, params.ClusterID).Update("install_config_overrides", overrides).Error
+		if err != nil {
+			log.WithError(err).Errorf("failed to update install config overrides")
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+		if err = b.addInstallConfigOverridesRevision(ctx, tx, params.ClusterID, cluster.InstallConfigOverrides, overrides); err != nil {
+			log.WithError(err).Errorf("failed to add install config overrides revision")
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+		return nil
+	})
 	if err != nil {
-		log.Error(err)
-		return nil, common.NewApiError(http.StatusInternalServerError, err)
+		log.WithError(err).Error("UpdateClusterInstallConfigInternal failed")
+		return nil, err
 	}
-	txSuccess = true
 	eventgen.SendInstallConfigAppliedEvent(ctx, b.eventsHandler, params.ClusterID)
 	log.Infof("Custom install config was applied to cluster %s", params.ClusterID)
 	return cluster, nil
@@ -1607,7 +1696,7 @@ func (b *bareMetalInventory) setInstallConfigOverridesUsage(featureUsages string
 func (b *bareMetalInventory) generateClusterInstallConfig(ctx context.Context, cluster common.Cluster) error {
 	log := logutil.FromContext(ctx, b.log)
 
-	cfg, err := b.installConfigBuilder.GetInstallConfig(&cluster, b.Config.InstallRHCa, ignition.RedhatRootCA)
+	cfg, err := b.installConfigBuilder.GetInstallConfigWithSecrets(&cluster, b.Config.InstallRHCa, ignition.RedhatRootCA)
 	if err != nil {
 		log.WithError(err).Errorf("failed to get install config for cluster %s", cluster.ID)
 		return errors.Wrapf(err, "failed to get install config for cluster %s", cluster.ID)
@@ -1786,7 +1875,7 @@ func (b *bareMetalInventory) v2UpdateClusterInternal(ctx context.Context, params
 	log.Infof("update cluster %s with params: %+v", params.ClusterID, params.ClusterUpdateParams)
 
 	txSuccess := false
-	tx := b.db.Begin()
+	tx := transaction.Begin(ctx, b.db, "update cluster")
 	defer func() {
 		if !txSuccess {
 			log.Error("update cluster failed")
@@ -1810,6 +1899,10 @@ func (b *bareMetalInventory) v2UpdateClusterInternal(ctx context.Context, params
 		log.WithError(err).Errorf("failed to get cluster: %s", params.ClusterID)
 		return nil, common.NewApiError(http.StatusNotFound, err)
 	}
+	previousConfiguration, err := clusterRevisionConfiguration(cluster)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
 
 	if params, err = b.validateAndUpdateClusterParams(ctx, cluster, &params); err != nil {
 		return nil, common.NewApiError(http.StatusBadRequest, err)
@@ -1830,6 +1923,8 @@ func (b *bareMetalInventory) v2UpdateClusterInternal(ctx context.Context, params
 		return nil, common.NewApiError(http.StatusConflict, err)
 	}
 
+	// The platform may be changed below to match the user-managed networking, only a platform set by the user counts as chosen
+	platformSelectedByUser := params.ClusterUpdateParams.Platform != nil && common.PlatformTypeValue(params.ClusterUpdateParams.Platform.Type) != ""
 	log.Infof("Current cluster platform is set to %s and user-managed-networking is set to %t", getPlatformType(cluster.Platform), swag.BoolValue(cluster.UserManagedNetworking))
 	log.Infof("Verifying cluster platform and user-managed-networking, got platform=%s and userManagedNetworking=%t", getPlatformType(params.ClusterUpdateParams.Platform), swag.BoolValue(params.ClusterUpdateParams.UserManagedNetworking))
 	platform, userManagedNetworking, err := provider.GetActualUpdateClusterPlatformParams(params.ClusterUpdateParams.Platform, params.ClusterUpdateParams.UserManagedNetworking, cluster)
@@ -1859,6 +1954,10 @@ func (b *bareMetalInventory) v2UpdateClusterInternal(ctx context.Context, params
 		return nil, common.NewApiError(http.StatusBadRequest, err)
 	}
 
+	if err = validateVspherePlatform(platform, cluster.VsphereEncryptedPassword != ""); err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+
 	usages, err := usage.Unmarshal(cluster.Cluster.FeatureUsage)
 	if err != nil {
 		log.WithError(err).Errorf("failed to read feature usage from cluster %s", params.ClusterID)
@@ -1871,11 +1970,23 @@ func (b *bareMetalInventory) v2UpdateClusterInternal(ctx context.Context, params
 		return nil, err
 	}
 
+	if platformSelectedByUser && !cluster.PlatformSelectedByUser {
+		if err = tx.Model(&common.Cluster{}).Where("id = ?", params.ClusterID.String()).Update("platform_selected_by_user", true).Error; err != nil {
+			log.WithError(err).Errorf("failed to record that the platform of cluster %s was chosen by the user", params.ClusterID)
+			return nil, common.NewApiError(http.StatusInternalServerError, err)
+		}
+	}
+
 	err = b.updateOperatorsData(ctx, cluster, params, usages, tx, log)
 	if err != nil {
 		return nil, err
 	}
 
+	if err = b.addClusterRevision(ctx, tx, params.ClusterID, previousConfiguration); err != nil {
+		log.WithError(err).Errorf("failed to record a revision of cluster %s", params.ClusterID)
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+
 	if interactivity == Interactive {
 		err = b.updateHostsAndClusterStatus(ctx, cluster, tx, log)
 		if err != nil {
@@ -1904,6 +2015,9 @@ func (b *bareMetalInventory) v2UpdateClusterInternal(ctx context.Context, params
 	}
 	txSuccess = true
 
+	// The validations of the hosts depend on the configuration of the cluster
+	b.hostApi.RequestClusterHostsRefresh(params.ClusterID)
+
 	if proxySettingsChanged(params.ClusterUpdateParams, cluster) {
 		eventgen.SendProxySettingsChangedEvent(ctx, b.eventsHandler, params.ClusterID)
 	}
@@ -2118,6 +2232,10 @@ func (b *bareMetalInventory) updateClusterData(_ context.Context, cluster *commo
 		return err
 	}
 
+	if err = b.updateClusterLabels(params, updates, log); err != nil {
+		return err
+	}
+
 	if params.ClusterUpdateParams.PullSecret != nil {
 		cluster.PullSecret = *params.ClusterUpdateParams.PullSecret
 		updates["pull_secret"] = *params.ClusterUpdateParams.PullSecret
@@ -2376,6 +2494,9 @@ func (b *bareMetalInventory) updateNetworkTables(db *gorm.DB, cluster *common.Cl
 func (b *bareMetalInventory) updatePlatformParams(params installer.V2UpdateClusterParams, updates map[string]interface{}, usages map[string]models.Usage) error {
 	if params.ClusterUpdateParams.Platform != nil && common.PlatformTypeValue(params.ClusterUpdateParams.Platform.Type) != "" {
 		updates["platform_type"] = params.ClusterUpdateParams.Platform.Type
+		if err := setVspherePlatformUpdates(params.ClusterUpdateParams.Platform, updates); err != nil {
+			return err
+		}
 
 		err := b.providerRegistry.SetPlatformUsages(
 			common.PlatformTypeValue(params.ClusterUpdateParams.Platform.Type), usages, b.usageApi)
@@ -2386,6 +2507,62 @@ func (b *bareMetalInventory) updatePlatformParams(params installer.V2UpdateClust
 	return nil
 }
 
+// validateVspherePlatform validates the vCenter configuration. A password must be provided along with the
+// configuration unless one is already stored for the cluster.
+func validateVspherePlatform(platform *models.Platform, hasStoredPassword bool) error {
+	if err := validations.ValidateVspherePlatform(platform); err != nil {
+		return err
+	}
+	if platform != nil && platform.Vsphere != nil && platform.Vsphere.Password == "" && !hasStoredPassword {
+		return errors.New("vSphere configuration is missing the following fields: password")
+	}
+	return nil
+}
+
+// setVspherePlatformUpdates stores the vCenter configuration of the platform, encrypting its password.
+// Switching to another platform clears the stored configuration.
+func setVspherePlatformUpdates(platform *models.Platform, updates map[string]interface{}) error {
+	vsphere := platform.Vsphere
+	if common.PlatformTypeValue(platform.Type) != models.PlatformTypeVsphere {
+		vsphere = &models.VspherePlatform{}
+		updates["vsphere_encrypted_password"] = ""
+	} else if vsphere == nil {
+		return nil
+	}
+	updates["platform_vsphere_vcenter"] = vsphere.Vcenter
+	updates["platform_vsphere_datacenter"] = vsphere.Datacenter
+	updates["platform_vsphere_cluster"] = vsphere.Cluster
+	updates["platform_vsphere_default_datastore"] = vsphere.DefaultDatastore
+	updates["platform_vsphere_network"] = vsphere.Network
+	updates["platform_vsphere_username"] = vsphere.Username
+	if vsphere.Password != "" {
+		encrypted, err := gencrypto.EncryptSecret(vsphere.Password.String())
+		if err != nil {
+			return common.NewApiError(http.StatusInternalServerError, errors.Wrap(err, "failed to encrypt the vCenter password"))
+		}
+		updates["vsphere_encrypted_password"] = encrypted
+	}
+	return nil
+}
+
+// validateVsphereCredentials verifies, before installing a cluster on the vSphere platform, that the
+// vCenter server is reachable and accepts the stored credentials.
+func (b *bareMetalInventory) validateVsphereCredentials(ctx context.Context, cluster *common.Cluster) error {
+	if cluster.Platform == nil || common.PlatformTypeValue(cluster.Platform.Type) != models.PlatformTypeVsphere ||
+		cluster.Platform.Vsphere == nil || cluster.Platform.Vsphere.Vcenter == "" {
+		return nil
+	}
+	password, err := gencrypto.DecryptSecret(cluster.VsphereEncryptedPassword)
+	if err != nil {
+		return common.NewApiError(http.StatusInternalServerError, errors.Wrap(err, "failed to decrypt the vCenter password"))
+	}
+	if err = b.vsphereValidator.ValidateCredentials(ctx, cluster.Platform.Vsphere, password); err != nil {
+		eventgen.SendVsphereCredentialsValidationFailedEvent(ctx, b.eventsHandler, *cluster.ID, err.Error())
+		return common.NewApiError(http.StatusBadRequest, errors.Wrap(err, "vSphere credentials validation failed"))
+	}
+	return nil
+}
+
 // updateNetworkParams takes care of 3 modes:
 // 1. Bare metal installation
 // 2. None-platform multi-node
@@ -2616,6 +2793,18 @@ func (b *bareMetalInventory) updateClusterTags(params installer.V2UpdateClusterP
 	return nil
 }
 
+func (b *bareMetalInventory) updateClusterLabels(params installer.V2UpdateClusterParams, updates map[string]interface{}, log logrus.FieldLogger) error {
+	if params.ClusterUpdateParams.Labels != nil {
+		labels, err := common.MarshalLabels(params.ClusterUpdateParams.Labels)
+		if err != nil {
+			log.WithError(err).Errorf("invalid labels for cluster %s", params.ClusterID)
+			return common.NewApiError(http.StatusBadRequest, err)
+		}
+		updates["labels"] = labels
+	}
+	return nil
+}
+
 func (b *bareMetalInventory) updateClusterNetworkVMUsage(cluster *common.Cluster, updateParams *models.V2ClusterUpdateParams, usages map[string]models.Usage, log logrus.FieldLogger) {
 	platform := cluster.Platform
 	usageEnable := true
@@ -2834,6 +3023,14 @@ func (b *bareMetalInventory) listClustersInternal(ctx context.Context, params in
 		db = db.Where("ams_subscription_id IN (?)", params.AmsSubscriptionIds)
 	}
 
+	if params.LabelSelector != nil {
+		labelSelector, err := common.LabelSelectorScope(*params.LabelSelector)
+		if err != nil {
+			return nil, common.NewApiError(http.StatusBadRequest, err)
+		}
+		db = db.Scopes(labelSelector)
+	}
+
 	dbClusters, err := common.GetClustersFromDBWhere(db, common.UseEagerLoading,
 		common.DeleteRecordsState(swag.BoolValue(params.GetUnregisteredClusters)))
 	if err != nil {
@@ -3718,7 +3915,7 @@ func (b *bareMetalInventory) CancelInstallationInternal(ctx context.Context, par
 	cluster := &common.Cluster{}
 
 	txSuccess := false
-	tx := b.db.Begin()
+	tx := transaction.Begin(ctx, b.db, "cancel installation")
 	defer func() {
 		if !txSuccess {
 			log.Error("cancel installation failed")
@@ -3769,37 +3966,45 @@ func (b *bareMetalInventory) CancelInstallationInternal(ctx context.Context, par
 }
 
 func (b *bareMetalInventory) V2ResetHost(ctx context.Context, params installer.V2ResetHostParams) middleware.Responder {
+	host, err := b.V2ResetHostInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2ResetHostOK().WithPayload(&host.Host)
+}
+
+func (b *bareMetalInventory) V2ResetHostInternal(ctx context.Context, params installer.V2ResetHostParams) (*common.Host, error) {
 	log := logutil.FromContext(ctx, b.log)
 	log.Info("Resetting host: ", params.HostID)
 	host, err := common.GetHostFromDB(b.db, params.InfraEnvID.String(), params.HostID.String())
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			log.WithError(err).Errorf("host %s not found", params.HostID.String())
-			return common.NewApiError(http.StatusNotFound, err)
+			return nil, common.NewApiError(http.StatusNotFound, err)
 		}
 		log.WithError(err).Errorf("failed to get host %s", params.HostID.String())
 		eventgen.SendHostResetFetchFailedEvent(ctx, b.eventsHandler, params.HostID, params.InfraEnvID, hostutil.GetHostnameForMsg(&host.Host))
-		return common.NewApiError(http.StatusInternalServerError, err)
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
 	}
 
 	if !hostutil.IsDay2Host(&host.Host) {
 		log.Errorf("ResetHost for host %s is forbidden: not a Day2 hosts", params.HostID.String())
-		return common.NewApiError(http.StatusConflict, fmt.Errorf("method only allowed when adding hosts to an existing cluster"))
+		return nil, common.NewApiError(http.StatusConflict, fmt.Errorf("method only allowed when adding hosts to an existing cluster"))
 	}
 
 	if host.ClusterID == nil {
 		log.Errorf("host %s is not bound to any cluster, cannot reset host", params.HostID.String())
-		return common.NewApiError(http.StatusConflict, fmt.Errorf("method only allowed when host assigned to an existing cluster"))
+		return nil, common.NewApiError(http.StatusConflict, fmt.Errorf("method only allowed when host assigned to an existing cluster"))
 	}
 
 	cluster, err := common.GetClusterFromDB(b.db, *host.ClusterID, common.SkipEagerLoading)
 	if err != nil {
 		err = fmt.Errorf("can not find a cluster for host %s, cannot reset host", params.HostID.String())
 		log.Errorln(err.Error())
-		return common.NewApiError(http.StatusInternalServerError, err)
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
 	}
 
-	err = b.db.Transaction(func(tx *gorm.DB) error {
+	err = transaction.Transaction(ctx, b.db, "reset host", func(tx *gorm.DB) error {
 		if errResponse := b.hostApi.ResetHost(ctx, &host.Host, "host was reset by user", tx); errResponse != nil {
 			return errResponse
 		}
@@ -3808,10 +4013,10 @@ func (b *bareMetalInventory) V2ResetHost(ctx context.Context, params installer.V
 	})
 
 	if err != nil {
-		return common.GenerateErrorResponder(err)
+		return nil, err
 	}
 
-	return installer.NewV2ResetHostOK().WithPayload(&host.Host)
+	return host, nil
 }
 
 func (b *bareMetalInventory) deleteDNSRecordSets(ctx context.Context, cluster common.Cluster) error {
@@ -4092,7 +4297,7 @@ func (b *bareMetalInventory) AddReleaseImage(ctx context.Context, releaseImageUr
 	}
 	log.Debug(debugTemplate)
 
-	releaseImage, err := b.versionsHandler.AddReleaseImage(releaseImageUrl, pullSecret, ocpReleaseVersion, cpuArchitectures)
+	releaseImage, err := b.versionsHandler.AddReleaseImage(ctx, releaseImageUrl, pullSecret, ocpReleaseVersion, cpuArchitectures)
 	if err != nil {
 		log.WithError(err).Errorf("Failed to add OCP version for release image: %s", releaseImageUrl)
 		return nil, err
@@ -4185,6 +4390,14 @@ func (b *bareMetalInventory) ListInfraEnvs(ctx context.Context, params installer
 		db = db.Where("cluster_id = ?", params.ClusterID)
 	}
 
+	if params.LabelSelector != nil {
+		labelSelector, err := common.LabelSelectorScope(*params.LabelSelector)
+		if err != nil {
+			return common.NewApiError(http.StatusBadRequest, err)
+		}
+		db = db.Scopes(labelSelector)
+	}
+
 	dbInfraEnvs, err := common.GetInfraEnvsFromDBWhere(db)
 	if err != nil {
 		log.WithError(err).Error("Failed to list infraEnvs in db")
@@ -4224,7 +4437,7 @@ func (b *bareMetalInventory) RegisterInfraEnvInternal(
 	log = log.WithField(ctxparams.ClusterId, id)
 	log.Infof("Register infraenv: %s with id %s", swag.StringValue(params.InfraenvCreateParams.Name), id)
 
-	tx := b.db.Begin()
+	tx := transaction.Begin(ctx, b.db, "register infra-env")
 	success := false
 	var err error
 	defer func() {
@@ -4304,6 +4517,13 @@ func (b *bareMetalInventory) RegisterInfraEnvInternal(
 		kernelArguments = swag.String(string(b))
 	}
 
+	var labels string
+	if params.InfraenvCreateParams.Labels != nil {
+		if labels, err = common.MarshalLabels(params.InfraenvCreateParams.Labels); err != nil {
+			return nil, common.NewApiError(http.StatusBadRequest, err)
+		}
+	}
+
 	infraEnv := common.InfraEnv{
 		Generated: false,
 		InfraEnv: models.InfraEnv{
@@ -4322,6 +4542,8 @@ func (b *bareMetalInventory) RegisterInfraEnvInternal(
 			SSHAuthorizedKey:       swag.StringValue(params.InfraenvCreateParams.SSHAuthorizedKey),
 			CPUArchitecture:        params.InfraenvCreateParams.CPUArchitecture,
 			KernelArguments:        kernelArguments,
+			Labels:                 labels,
+			RootDeviceHints:        params.InfraenvCreateParams.RootDeviceHints,
 		},
 		KubeKeyNamespace: kubeKey.Namespace,
 		ImageTokenKey:    imageTokenKey,
@@ -4577,7 +4799,7 @@ func (b *bareMetalInventory) UpdateInfraEnvInternal(ctx context.Context, params
 	}
 
 	success := false
-	tx := b.db.Begin()
+	tx := transaction.Begin(ctx, b.db, "update infra-env")
 	defer func() {
 		if success {
 			msg := fmt.Sprintf("Successfully updated InfraEnv with id %s", params.InfraEnvID)
@@ -4690,6 +4912,20 @@ func (b *bareMetalInventory) updateInfraEnvData(ctx context.Context, infraEnv *c
 		}
 	}
 
+	if params.InfraEnvUpdateParams.Labels != nil {
+		labels, err := common.MarshalLabels(params.InfraEnvUpdateParams.Labels)
+		if err != nil {
+			return common.NewApiError(http.StatusBadRequest, err)
+		}
+		updates["labels"] = labels
+	}
+
+	if params.InfraEnvUpdateParams.RootDeviceHints != nil {
+		for column, value := range common.RootDeviceHintsUpdates(params.InfraEnvUpdateParams.RootDeviceHints) {
+			updates[column] = value
+		}
+	}
+
 	inputSSHKey := swag.StringValue(params.InfraEnvUpdateParams.SSHAuthorizedKey)
 	if inputSSHKey != "" && inputSSHKey != infraEnv.SSHAuthorizedKey {
 		updates["ssh_authorized_key"] = inputSSHKey
@@ -4832,7 +5068,7 @@ func (b *bareMetalInventory) V2RegisterHost(ctx context.Context, params installe
 	log.Infof("Register host: %+v", params)
 
 	txSuccess := false
-	tx := b.db.Begin()
+	tx := transaction.Begin(ctx, b.db, "register host")
 	defer func() {
 		if !txSuccess {
 			log.Error("RegisterHost failed")
@@ -5007,7 +5243,7 @@ func (b *bareMetalInventory) V2GetNextSteps(ctx context.Context, params installe
 	var steps models.Steps
 
 	txSuccess := false
-	tx := b.db.Begin()
+	tx := transaction.Begin(ctx, b.db, "get next steps")
 	defer func() {
 		if !txSuccess {
 			log.Error("get next steps failed")
@@ -5104,7 +5340,9 @@ func (b *bareMetalInventory) V2PostStepReply(ctx context.Context, params install
 			WithPayload(common.GenerateError(http.StatusBadRequest, err))
 	}
 
+	stepReplyStartedAt := time.Now()
 	err = handleReplyByType(params, b, ctx, host.Host, stepReply)
+	b.metricApi.StepReplyDuration(params.Reply.StepType, time.Since(stepReplyStartedAt))
 	if err != nil {
 		log.WithError(err).Errorf("Failed to update step reply for host <%s> infra-env <%s> step <%s>",
 			params.HostID, params.InfraEnvID, params.Reply.StepID)
@@ -5177,10 +5415,7 @@ func (b *bareMetalInventory) V2UpdateHostInstallProgressInternal(ctx context.Con
 			return host, err
 		}
 
-		event := fmt.Sprintf("reached installation stage %s", params.HostProgress.CurrentStage)
-		if params.HostProgress.ProgressInfo != "" {
-			event += fmt.Sprintf(": %s", params.HostProgress.ProgressInfo)
-		}
+		event := timeline.HostStageEvent(params.HostProgress.CurrentStage, params.HostProgress.ProgressInfo)
 
 		log.Info(fmt.Sprintf("Host %s in cluster %s: %s", host.ID, host.ClusterID, event))
 		eventgen.SendHostInstallProgressUpdatedEvent(ctx, b.eventsHandler, *host.ID, host.InfraEnvID, host.ClusterID, hostutil.GetHostnameForMsg(&host.Host), event)
@@ -5316,6 +5551,94 @@ func (b *bareMetalInventory) UnbindHost(ctx context.Context, params installer.Un
 	return installer.NewUnbindHostOK().WithPayload(&h.Host)
 }
 
+func (b *bareMetalInventory) V2ReclaimHost(ctx context.Context, params installer.V2ReclaimHostParams) middleware.Responder {
+	h, err := b.reclaimHost(ctx, params)
+	if err != nil {
+		// there is no host to send the event for when the requested host doesn't exist
+		var apiErr *common.ApiErrorResponse
+		if !errors.As(err, &apiErr) || apiErr.StatusCode() != http.StatusNotFound {
+			eventgen.SendHostReclaimStartFailedEvent(ctx, b.eventsHandler, params.HostID, params.InfraEnvID, err.Error())
+		}
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2ReclaimHostOK().WithPayload(&h.Host)
+}
+
+// reclaimHost starts the assisted agent on the node of an installed day-2 host, using the
+// kubeconfig stored for its cluster, and then unbinds the host so that it goes through the
+// reclaiming states and boots back into discovery in its infra-env.
+// The kubeconfig is stored when the cluster is installed by the service, or when an imported
+// cluster is given its kubeconfig, so hosts of clusters imported without it can't be reclaimed.
+func (b *bareMetalInventory) reclaimHost(ctx context.Context, params installer.V2ReclaimHostParams) (*common.Host, error) {
+	log := logutil.FromContext(ctx, b.log)
+	log.Infof("Reclaiming host %s", params.HostID)
+	host, err := common.GetHostFromDB(b.db, params.InfraEnvID.String(), params.HostID.String())
+	if err != nil {
+		log.WithError(err).Errorf("failed to find host <%s> in infraEnv <%s>",
+			params.HostID, params.InfraEnvID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, common.NewApiError(http.StatusNotFound, err)
+		}
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if host.ClusterID == nil {
+		return nil, common.NewApiError(http.StatusConflict, errors.Errorf("Host %s is not bound to any cluster", params.HostID))
+	}
+	if !funk.ContainsString([]string{models.HostStatusInstalled, models.HostStatusAddedToExistingCluster}, swag.StringValue(host.Status)) {
+		return nil, common.NewApiError(http.StatusConflict,
+			errors.Errorf("Host %s is in status %s, only installed hosts can be reclaimed", params.HostID, swag.StringValue(host.Status)))
+	}
+
+	infraEnv, err := common.GetInfraEnvFromDB(b.db, params.InfraEnvID)
+	if err != nil {
+		log.WithError(err).Errorf("Failed to get infra env %s", params.InfraEnvID)
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if infraEnv.ClusterID != "" {
+		return nil, common.NewApiError(http.StatusConflict, errors.Errorf("Cannot reclaim Host %s. InfraEnv %s is bound to Cluster %s", params.HostID, params.InfraEnvID, infraEnv.ClusterID))
+	}
+
+	cluster, err := common.GetClusterFromDB(b.db, *host.ClusterID, common.SkipEagerLoading)
+	if err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s", host.ClusterID.String())
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if swag.StringValue(cluster.Kind) != models.ClusterKindAddHostsCluster {
+		return nil, common.NewApiError(http.StatusConflict,
+			errors.Errorf("Cannot reclaim Host %s. Cluster %s is not a day-2 cluster", params.HostID, host.ClusterID))
+	}
+
+	kubeconfigExists, err := b.objectHandler.DoesObjectExist(ctx, fmt.Sprintf("%s/%s", host.ClusterID, constants.Kubeconfig))
+	if err != nil {
+		log.WithError(err).Errorf("failed to look up the kubeconfig of cluster %s", host.ClusterID)
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if !kubeconfigExists {
+		return nil, common.NewApiError(http.StatusConflict,
+			errors.Errorf("Cannot reclaim Host %s. The service has no kubeconfig for cluster %s, import the cluster with its kubeconfig to reclaim its hosts", params.HostID, host.ClusterID))
+	}
+	spokeClient, err := b.spokeClientFactory.CreateFromStorageKubeconfig(ctx, host.ClusterID, b.objectHandler)
+	if err != nil {
+		log.WithError(err).Errorf("failed to create kube client for cluster %s", host.ClusterID)
+		return nil, common.NewApiError(http.StatusConflict,
+			errors.Wrapf(err, "Cannot reclaim Host %s. Failed to access cluster %s", params.HostID, host.ClusterID))
+	}
+
+	nodeName := hostutil.GetHostnameForMsg(&host.Host)
+	if err = b.reclaimer.StartReclaimAgent(ctx, spokeClient, log, nodeName, params.InfraEnvID.String(), params.HostID.String()); err != nil {
+		log.WithError(err).Errorf("failed to start agent for reclaim on node %s", nodeName)
+		return nil, common.NewApiError(http.StatusInternalServerError,
+			errors.Wrapf(err, "failed to start agent for reclaim on node %s", nodeName))
+	}
+
+	host, err = b.UnbindHostInternal(ctx, installer.UnbindHostParams{HostID: params.HostID, InfraEnvID: params.InfraEnvID}, true, Interactive)
+	if err != nil {
+		return nil, err
+	}
+	eventgen.SendHostReclaimStartedEvent(ctx, b.eventsHandler, params.HostID, params.InfraEnvID, cluster.ID, nodeName)
+	return host, nil
+}
+
 func (b *bareMetalInventory) V2ListHosts(ctx context.Context, params installer.V2ListHostsParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
 	// Check that the InfraEnv exists in DB before searching for hosts bound to it.
@@ -5408,7 +5731,7 @@ func (b *bareMetalInventory) V2UpdateHostIgnitionInternal(ctx context.Context, p
 	log := logutil.FromContext(ctx, b.log)
 
 	txSuccess := false
-	tx := b.db.Begin()
+	tx := transaction.Begin(ctx, b.db, "update host ignition")
 	defer func() {
 		if !txSuccess {
 			log.Error("UpdateHostIgnition failed")
@@ -5605,7 +5928,7 @@ func (b *bareMetalInventory) V2UpdateHostInternal(ctx context.Context, params in
 	var err error
 
 	txSuccess := false
-	tx := b.db.Begin()
+	tx := transaction.Begin(ctx, b.db, "update host")
 
 	defer func() {
 		if !txSuccess {
@@ -5663,6 +5986,14 @@ func (b *bareMetalInventory) V2UpdateHostInternal(ctx context.Context, params in
 	if err != nil {
 		return nil, err
 	}
+	err = b.updateHostLabels(ctx, host, params.HostUpdateParams.Labels, tx)
+	if err != nil {
+		return nil, err
+	}
+	err = b.updateHostRootDeviceHints(ctx, host, params.HostUpdateParams.RootDeviceHints, tx)
+	if err != nil {
+		return nil, err
+	}
 	err = b.updateHostSkipFormattingDisks(ctx, host, params.HostUpdateParams.DisksSkipFormatting, tx)
 	if err != nil {
 		return nil, err
@@ -5840,6 +6171,42 @@ func (b *bareMetalInventory) updateNodeLabels(ctx context.Context, host *common.
 	return nil
 }
 
+func (b *bareMetalInventory) updateHostLabels(ctx context.Context, host *common.Host, labelsList []*models.LabelParams, db *gorm.DB) error {
+	log := logutil.FromContext(ctx, b.log)
+	if labelsList == nil {
+		log.Infof("No request for labels update for host %s", host.ID)
+		return nil
+	}
+
+	labels, err := common.MarshalLabels(labelsList)
+	if err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+
+	if err = db.Model(&common.Host{}).Where("id = ? and infra_env_id = ?", host.ID, host.InfraEnvID).Update("labels", labels).Error; err != nil {
+		log.WithError(err).Errorf("failed to set labels <%s> host <%s>, infra env <%s>", labels, host.ID, host.InfraEnvID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	host.Labels = labels
+	return nil
+}
+
+func (b *bareMetalInventory) updateHostRootDeviceHints(ctx context.Context, host *common.Host, hints *models.RootDeviceHints, db *gorm.DB) error {
+	log := logutil.FromContext(ctx, b.log)
+	if hints == nil {
+		log.Infof("No request for root device hints update for host %s", host.ID)
+		return nil
+	}
+
+	if err := db.Model(&common.Host{}).Where("id = ? and infra_env_id = ?", host.ID, host.InfraEnvID).Updates(common.RootDeviceHintsUpdates(hints)).Error; err != nil {
+		log.WithError(err).Errorf("failed to set root device hints of host <%s>, infra env <%s>", host.ID, host.InfraEnvID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	// The installation disk is selected by the new hints when the inventory is refreshed after the update
+	host.RootDeviceHints = hints
+	return nil
+}
+
 func (b *bareMetalInventory) updateHostSkipFormattingDisks(ctx context.Context, host *common.Host, diskSkipFormattingParams []*models.DiskSkipFormattingParams, db *gorm.DB) error {
 	log := logutil.FromContext(ctx, b.log)
 
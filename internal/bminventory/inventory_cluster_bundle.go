@@ -0,0 +1,598 @@
+package bminventory
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	eventgen "github.com/openshift/assisted-service/internal/common/events"
+	"github.com/openshift/assisted-service/internal/manifests"
+	"github.com/openshift/assisted-service/internal/usage"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/auth"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/restapi/operations/installer"
+	manifestsops "github.com/openshift/assisted-service/restapi/operations/manifests"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// ExportClusterInternal returns the desired configuration of a cluster as a cluster bundle. Credentials, such as the
+// pull secret and the vCenter password, are never part of the bundle.
+func (b *bareMetalInventory) ExportClusterInternal(ctx context.Context, clusterID strfmt.UUID) (*models.ClusterBundle, error) {
+	cluster, err := b.getCluster(ctx, clusterID.String(), common.UseEagerLoading)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, common.NewApiError(http.StatusNotFound, err)
+		}
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return b.exportClusterBundle(ctx, cluster)
+}
+
+func (b *bareMetalInventory) exportClusterBundle(ctx context.Context, cluster *common.Cluster) (*models.ClusterBundle, error) {
+	log := logutil.FromContext(ctx, b.log)
+	clusterID := *cluster.ID
+
+	bundle := &models.ClusterBundle{
+		Version:         swag.String(models.ClusterBundleVersionV1),
+		ExportedAt:      strfmt.DateTime(time.Now()),
+		SourceClusterID: clusterID,
+		Cluster:         exportBundleCluster(cluster),
+		Hosts:           exportBundleHosts(log, cluster.Hosts),
+	}
+
+	infraEnvs, err := common.GetInfraEnvsFromDBWhere(b.db, "cluster_id = ?", clusterID.String())
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, errors.Wrapf(err, "failed to get the infra-env of cluster %s", clusterID))
+	}
+	if len(infraEnvs) > 0 {
+		if bundle.InfraEnv, err = exportBundleInfraEnv(&infraEnvs[0].InfraEnv); err != nil {
+			return nil, common.NewApiError(http.StatusInternalServerError, err)
+		}
+	}
+
+	if bundle.Manifests, err = b.exportBundleManifests(ctx, clusterID); err != nil {
+		return nil, err
+	}
+	return bundle, nil
+}
+
+func exportBundleCluster(cluster *common.Cluster) *models.ClusterBundleCluster {
+	ret := &models.ClusterBundleCluster{
+		Name:                   swag.String(cluster.Name),
+		OpenshiftVersion:       swag.String(cluster.OpenshiftVersion),
+		CPUArchitecture:        cluster.CPUArchitecture,
+		HighAvailabilityMode:   swag.StringValue(cluster.HighAvailabilityMode),
+		BaseDNSDomain:          cluster.BaseDNSDomain,
+		VipDhcpAllocation:      cluster.VipDhcpAllocation,
+		UserManagedNetworking:  cluster.UserManagedNetworking,
+		NetworkType:            swag.StringValue(cluster.NetworkType),
+		HTTPProxy:              cluster.HTTPProxy,
+		HTTPSProxy:             cluster.HTTPSProxy,
+		NoProxy:                cluster.NoProxy,
+		DiskEncryption:         cluster.DiskEncryption,
+		AdditionalNtpSource:    cluster.AdditionalNtpSource,
+		Hyperthreading:         cluster.Hyperthreading,
+		SchedulableMasters:     cluster.SchedulableMasters,
+		SSHPublicKey:           cluster.SSHPublicKey,
+		Tags:                   cluster.Tags,
+		InstallConfigOverrides: cluster.InstallConfigOverrides,
+	}
+	for _, network := range cluster.ClusterNetworks {
+		ret.ClusterNetworks = append(ret.ClusterNetworks, &models.ClusterNetwork{Cidr: network.Cidr, HostPrefix: network.HostPrefix})
+	}
+	for _, network := range cluster.ServiceNetworks {
+		ret.ServiceNetworks = append(ret.ServiceNetworks, &models.ServiceNetwork{Cidr: network.Cidr})
+	}
+	for _, network := range cluster.MachineNetworks {
+		ret.MachineNetworks = append(ret.MachineNetworks, &models.MachineNetwork{Cidr: network.Cidr})
+	}
+	// VIPs allocated by DHCP belong to the cluster they were allocated for
+	if !swag.BoolValue(cluster.VipDhcpAllocation) {
+		for _, vip := range cluster.APIVips {
+			ret.APIVips = append(ret.APIVips, &models.APIVip{IP: vip.IP})
+		}
+		for _, vip := range cluster.IngressVips {
+			ret.IngressVips = append(ret.IngressVips, &models.IngressVip{IP: vip.IP})
+		}
+	}
+	if cluster.Platform != nil {
+		platform := *cluster.Platform
+		if platform.Vsphere != nil {
+			vsphere := *platform.Vsphere
+			vsphere.Password = ""
+			platform.Vsphere = &vsphere
+		}
+		ret.Platform = &platform
+	}
+	for _, operator := range cluster.MonitoredOperators {
+		if operator.OperatorType == models.OperatorTypeOlm {
+			ret.OlmOperators = append(ret.OlmOperators, &models.OperatorCreateParams{Name: operator.Name, Properties: operator.Properties})
+		}
+	}
+	return ret
+}
+
+func exportBundleHosts(log logrus.FieldLogger, hosts []*models.Host) []*models.ClusterBundleHost {
+	ret := make([]*models.ClusterBundleHost, 0, len(hosts))
+	for _, h := range hosts {
+		if h.Inventory == "" {
+			continue
+		}
+		inventory, err := common.UnmarshalInventory(h.Inventory)
+		if err != nil {
+			log.WithError(err).Warnf("not exporting host %s with an invalid inventory", h.ID)
+			continue
+		}
+		macAddresses := make([]string, 0, len(inventory.Interfaces))
+		for _, iface := range inventory.Interfaces {
+			if iface.MacAddress != "" {
+				macAddresses = append(macAddresses, iface.MacAddress)
+			}
+		}
+		if len(macAddresses) == 0 {
+			continue
+		}
+		role := h.Role
+		if role == models.HostRoleBootstrap {
+			role = models.HostRoleMaster
+		}
+		ret = append(ret, &models.ClusterBundleHost{
+			MacAddresses: macAddresses,
+			Hostname:     h.RequestedHostname,
+			Role:         models.HostRoleUpdateParams(role),
+		})
+	}
+	return ret
+}
+
+func exportBundleInfraEnv(infraEnv *models.InfraEnv) (*models.ClusterBundleInfraEnv, error) {
+	ret := &models.ClusterBundleInfraEnv{
+		ImageType:              common.ImageTypeValue(infraEnv.Type),
+		AdditionalNtpSources:   infraEnv.AdditionalNtpSources,
+		SSHAuthorizedKey:       infraEnv.SSHAuthorizedKey,
+		IgnitionConfigOverride: infraEnv.IgnitionConfigOverride,
+		Proxy:                  infraEnv.Proxy,
+	}
+	if infraEnv.StaticNetworkConfig != "" {
+		if err := json.Unmarshal([]byte(infraEnv.StaticNetworkConfig), &ret.StaticNetworkConfig); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse the static network configuration of infra-env %s", infraEnv.ID)
+		}
+	}
+	if swag.StringValue(infraEnv.KernelArguments) != "" {
+		if err := json.Unmarshal([]byte(*infraEnv.KernelArguments), &ret.KernelArguments); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse the kernel arguments of infra-env %s", infraEnv.ID)
+		}
+	}
+	return ret, nil
+}
+
+func (b *bareMetalInventory) exportBundleManifests(ctx context.Context, clusterID strfmt.UUID) ([]*models.ClusterBundleManifest, error) {
+	list, err := b.manifestsApi.ListClusterManifestsInternal(ctx, manifestsops.V2ListClusterManifestsParams{ClusterID: clusterID})
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*models.ClusterBundleManifest, 0, len(list))
+	for _, manifest := range list {
+		objectName := manifests.GetManifestObjectName(clusterID, filepath.Join(manifest.Folder, manifest.FileName))
+		reader, _, err := b.objectHandler.Download(ctx, objectName)
+		if err != nil {
+			return nil, common.NewApiError(http.StatusInternalServerError, errors.Wrapf(err, "failed to download manifest %s", objectName))
+		}
+		content, err := io.ReadAll(reader)
+		reader.Close()
+		if err != nil {
+			return nil, common.NewApiError(http.StatusInternalServerError, errors.Wrapf(err, "failed to read manifest %s", objectName))
+		}
+		ret = append(ret, &models.ClusterBundleManifest{
+			Folder:   swag.String(manifest.Folder),
+			FileName: swag.String(manifest.FileName),
+			Content:  swag.String(base64.StdEncoding.EncodeToString(content)),
+		})
+	}
+	return ret, nil
+}
+
+// ImportClusterBundleInternal registers a new cluster and infra-env from a cluster bundle. The conflicts of the bundle
+// are returned in the result; when any of them is blocking nothing is registered. Parts of the bundle that fail to be
+// applied after the cluster was registered are reported as non-blocking conflicts.
+func (b *bareMetalInventory) ImportClusterBundleInternal(ctx context.Context, params installer.V2ImportClusterBundleParams) (*models.ClusterBundleImportResult, error) {
+	bundle := params.ImportClusterBundleParams.Bundle
+	if bundle == nil || bundle.Cluster == nil {
+		return nil, common.NewApiError(http.StatusBadRequest, errors.New("The bundle has no cluster"))
+	}
+	name := swag.StringValue(bundle.Cluster.Name)
+	if params.ImportClusterBundleParams.Name != "" {
+		name = params.ImportClusterBundleParams.Name
+	}
+	baseDNSDomain := bundle.Cluster.BaseDNSDomain
+	if params.ImportClusterBundleParams.BaseDNSDomain != "" {
+		baseDNSDomain = params.ImportClusterBundleParams.BaseDNSDomain
+	}
+
+	return b.importClusterBundle(ctx, bundle, name, baseDNSDomain, swag.StringValue(params.ImportClusterBundleParams.PullSecret), "", true)
+}
+
+// importClusterBundle registers a cluster from a bundle. The encrypted vCenter password, when given, is stored for the
+// cluster, otherwise a vSphere cluster is registered without a password.
+func (b *bareMetalInventory) importClusterBundle(ctx context.Context, bundle *models.ClusterBundle, name, baseDNSDomain,
+	pullSecret, vsphereEncryptedPassword string, withInfraEnv bool) (*models.ClusterBundleImportResult, error) {
+	log := logutil.FromContext(ctx, b.log)
+
+	result := &models.ClusterBundleImportResult{}
+	conflicts, err := b.clusterBundleConflicts(bundle, name, baseDNSDomain, vsphereEncryptedPassword != "")
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	result.Conflicts = conflicts
+	if hasBlockingConflicts(conflicts) {
+		log.Infof("Not importing cluster %s with blocking conflicts", name)
+		return result, nil
+	}
+
+	cluster, err := b.registerClusterInternal(ctx, nil, installer.V2RegisterClusterParams{
+		NewClusterParams: bundleClusterCreateParams(bundle.Cluster, name, baseDNSDomain, pullSecret),
+	}, true)
+	if err != nil {
+		return nil, err
+	}
+	result.ClusterID = *cluster.ID
+
+	if vsphereEncryptedPassword != "" {
+		if err = b.db.Model(&common.Cluster{}).Where("id = ?", cluster.ID.String()).
+			Update("vsphere_encrypted_password", vsphereEncryptedPassword).Error; err != nil {
+			log.WithError(err).Errorf("failed to store the vCenter password of imported cluster %s, deregistering it", cluster.ID)
+			if deregisterErr := b.DeregisterClusterInternal(ctx, cluster); deregisterErr != nil {
+				log.WithError(deregisterErr).Errorf("failed to deregister imported cluster %s", cluster.ID)
+			}
+			return nil, common.NewApiError(http.StatusInternalServerError, err)
+		}
+	}
+
+	if withInfraEnv {
+		var infraEnv *common.InfraEnv
+		if infraEnv, err = b.registerBundleInfraEnv(ctx, cluster, bundle.InfraEnv, name, pullSecret); err != nil {
+			log.WithError(err).Errorf("failed to register the infra-env of imported cluster %s, deregistering it", cluster.ID)
+			if deregisterErr := b.DeregisterClusterInternal(ctx, cluster); deregisterErr != nil {
+				log.WithError(deregisterErr).Errorf("failed to deregister imported cluster %s", cluster.ID)
+			}
+			return nil, err
+		}
+		result.InfraEnvID = *infraEnv.ID
+	}
+
+	if bundle.Cluster.InstallConfigOverrides != "" {
+		if _, err = b.UpdateClusterInstallConfigInternal(ctx, installer.V2UpdateClusterInstallConfigParams{
+			ClusterID:           *cluster.ID,
+			InstallConfigParams: bundle.Cluster.InstallConfigOverrides,
+		}); err != nil {
+			result.Conflicts = append(result.Conflicts, bundleConflict("cluster.install_config_overrides", false,
+				"The install config overrides were not applied: %s", err.Error()))
+		}
+	}
+
+	for i, manifest := range bundle.Manifests {
+		if _, err = b.manifestsApi.CreateClusterManifestInternal(ctx, manifestsops.V2CreateClusterManifestParams{
+			ClusterID: *cluster.ID,
+			CreateManifestParams: &models.CreateManifestParams{
+				Folder:   manifest.Folder,
+				FileName: manifest.FileName,
+				Content:  manifest.Content,
+			},
+		}); err != nil {
+			result.Conflicts = append(result.Conflicts, bundleConflict(fmt.Sprintf("manifests.%d", i), false,
+				"Manifest %s was not imported: %s", swag.StringValue(manifest.FileName), err.Error()))
+		}
+	}
+
+	if len(bundle.Hosts) > 0 {
+		var importedHosts []byte
+		if importedHosts, err = json.Marshal(bundle.Hosts); err == nil {
+			err = b.db.Model(&common.Cluster{}).Where("id = ?", cluster.ID.String()).Update("imported_hosts", string(importedHosts)).Error
+		}
+		if err != nil {
+			log.WithError(err).Errorf("failed to store the imported hosts of cluster %s", cluster.ID)
+			result.Conflicts = append(result.Conflicts, bundleConflict("hosts", false,
+				"The roles and hostnames of the hosts were not imported: %s", err.Error()))
+		}
+	}
+
+	log.Infof("Imported cluster %s from a bundle of cluster %s", cluster.ID, bundle.SourceClusterID)
+	return result, nil
+}
+
+// cloneClusterInternal registers a new cluster with the settings of the source cluster of the clone parameters. Only the
+// name, base DNS domain, OpenShift version and pull secret are taken from the creation parameters. The VIPs of the source
+// cluster are not copied, the clone can't use them while the source cluster exists. The vCenter password is copied encrypted.
+func (b *bareMetalInventory) cloneClusterInternal(ctx context.Context, params installer.V2RegisterClusterParams) (*common.Cluster, error) {
+	log := logutil.FromContext(ctx, b.log)
+	newParams := params.NewClusterParams
+	sourceID := *newParams.Clone.SourceClusterID
+
+	source, err := b.getCluster(ctx, sourceID.String(), common.UseEagerLoading)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, common.NewApiError(http.StatusNotFound, err)
+		}
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if canRead, authErr := b.authzHandler.HasAccessTo(ctx, source, auth.ReadAction); !canRead {
+		log.WithError(authErr).Errorf("Unauthorized to clone cluster %s", sourceID)
+		return nil, common.NewApiError(http.StatusNotFound, errors.New("Object Not Found"))
+	}
+
+	bundle, err := b.exportClusterBundle(ctx, source)
+	if err != nil {
+		return nil, err
+	}
+	// The hosts and the VIPs remain in the source cluster, the VIPs of the clone are set once it has its own hosts
+	bundle.Hosts = nil
+	bundle.Cluster.APIVips = nil
+	bundle.Cluster.IngressVips = nil
+	bundle.Cluster.OpenshiftVersion = newParams.OpenshiftVersion
+	baseDNSDomain := bundle.Cluster.BaseDNSDomain
+	if newParams.BaseDNSDomain != "" {
+		baseDNSDomain = newParams.BaseDNSDomain
+	}
+
+	// The password isn't part of the bundle, the clone keeps the encrypted password of the source cluster
+	result, err := b.importClusterBundle(ctx, bundle, swag.StringValue(newParams.Name), baseDNSDomain,
+		swag.StringValue(newParams.PullSecret), source.VsphereEncryptedPassword, swag.BoolValue(newParams.Clone.WithInfraEnv))
+	if err != nil {
+		return nil, err
+	}
+	if hasBlockingConflicts(result.Conflicts) {
+		messages := make([]string, 0, len(result.Conflicts))
+		for _, c := range result.Conflicts {
+			if swag.BoolValue(c.Blocking) {
+				messages = append(messages, swag.StringValue(c.Message))
+			}
+		}
+		return nil, common.NewApiError(http.StatusBadRequest,
+			errors.Errorf("Cluster %s can't be cloned: %s", sourceID, strings.Join(messages, ", ")))
+	}
+	for _, c := range result.Conflicts {
+		eventgen.SendClusterCloneConflictEvent(ctx, b.eventsHandler, result.ClusterID, sourceID, swag.StringValue(c.Message))
+	}
+	if err = b.cloneFeatureUsage(source, result.ClusterID); err != nil {
+		log.WithError(err).Warnf("failed to copy the feature usage of cluster %s to cluster %s", sourceID, result.ClusterID)
+	}
+	eventgen.SendClusterClonedEvent(ctx, b.eventsHandler, result.ClusterID, sourceID)
+
+	cluster, err := common.GetClusterFromDB(b.db, result.ClusterID, common.UseEagerLoading)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return cluster, nil
+}
+
+// cloneFeatureUsage adds the features used by the source cluster that weren't already recorded when the clone was registered
+func (b *bareMetalInventory) cloneFeatureUsage(source *common.Cluster, clusterID strfmt.UUID) error {
+	sourceUsages, err := usage.Unmarshal(source.FeatureUsage)
+	if err != nil {
+		return err
+	}
+	cluster, err := common.GetClusterFromDB(b.db, clusterID, common.SkipEagerLoading)
+	if err != nil {
+		return err
+	}
+	usages, err := usage.Unmarshal(cluster.FeatureUsage)
+	if err != nil {
+		return err
+	}
+	for name, u := range sourceUsages {
+		if _, ok := usages[name]; !ok {
+			data := u.Data
+			b.usageApi.Add(usages, name, &data)
+		}
+	}
+	b.usageApi.Save(b.db, clusterID, usages)
+	return nil
+}
+
+func (b *bareMetalInventory) registerBundleInfraEnv(ctx context.Context, cluster *common.Cluster, bundleInfraEnv *models.ClusterBundleInfraEnv,
+	name, pullSecret string) (*common.InfraEnv, error) {
+	infraEnvParams := &models.InfraEnvCreateParams{
+		Name:             swag.String(fmt.Sprintf("%s_infra-env", name)),
+		ClusterID:        cluster.ID,
+		PullSecret:       swag.String(pullSecret),
+		OpenshiftVersion: cluster.OpenshiftVersion,
+		CPUArchitecture:  cluster.CPUArchitecture,
+	}
+	if bundleInfraEnv != nil {
+		infraEnvParams.ImageType = bundleInfraEnv.ImageType
+		infraEnvParams.AdditionalNtpSources = swag.String(bundleInfraEnv.AdditionalNtpSources)
+		infraEnvParams.SSHAuthorizedKey = swag.String(bundleInfraEnv.SSHAuthorizedKey)
+		infraEnvParams.IgnitionConfigOverride = bundleInfraEnv.IgnitionConfigOverride
+		infraEnvParams.Proxy = bundleInfraEnv.Proxy
+		infraEnvParams.StaticNetworkConfig = bundleInfraEnv.StaticNetworkConfig
+		infraEnvParams.KernelArguments = bundleInfraEnv.KernelArguments
+	}
+	return b.RegisterInfraEnvInternal(ctx, nil, installer.RegisterInfraEnvParams{InfraenvCreateParams: infraEnvParams})
+}
+
+// clusterBundleConflicts returns the conflicts of a bundle with the service and with the existing clusters
+func (b *bareMetalInventory) clusterBundleConflicts(bundle *models.ClusterBundle, name, baseDNSDomain string,
+	hasVspherePassword bool) ([]*models.ClusterBundleConflict, error) {
+	conflicts := make([]*models.ClusterBundleConflict, 0)
+
+	if swag.StringValue(bundle.Version) != models.ClusterBundleVersionV1 {
+		conflicts = append(conflicts, bundleConflict("version", true, "Bundle version %s is not supported", swag.StringValue(bundle.Version)))
+	}
+
+	cpuArchitecture := bundle.Cluster.CPUArchitecture
+	if cpuArchitecture == "" {
+		cpuArchitecture = common.DefaultCPUArchitecture
+	}
+	if _, err := b.versionsHandler.GetReleaseImage(swag.StringValue(bundle.Cluster.OpenshiftVersion), cpuArchitecture); err != nil {
+		conflicts = append(conflicts, bundleConflict("cluster.openshift_version", true,
+			"OpenShift version %s for CPU architecture %s is not supported: %s",
+			swag.StringValue(bundle.Cluster.OpenshiftVersion), cpuArchitecture, err.Error()))
+	}
+
+	for i, operator := range bundle.Cluster.OlmOperators {
+		if _, err := b.operatorManagerApi.GetOperatorByName(operator.Name); err != nil {
+			conflicts = append(conflicts, bundleConflict(fmt.Sprintf("cluster.olm_operators.%d", i), true,
+				"Operator %s is not supported", operator.Name))
+		}
+	}
+
+	if baseDNSDomain != "" {
+		existing, err := common.GetClustersFromDBWhere(b.db, common.SkipEagerLoading, common.SkipDeletedRecords,
+			"name = ? and base_dns_domain = ?", name, baseDNSDomain)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to find clusters with the same domain")
+		}
+		for _, c := range existing {
+			conflicts = append(conflicts, bundleConflict("cluster.name", false,
+				"Cluster %s already uses the domain %s.%s", c.ID, name, baseDNSDomain))
+		}
+	}
+
+	if !swag.BoolValue(bundle.Cluster.VipDhcpAllocation) {
+		for i, vip := range bundle.Cluster.APIVips {
+			conflict, err := b.bundleVipConflict(fmt.Sprintf("cluster.api_vips.%d", i), string(vip.IP))
+			if err != nil {
+				return nil, err
+			}
+			if conflict != nil {
+				conflicts = append(conflicts, conflict)
+			}
+		}
+		for i, vip := range bundle.Cluster.IngressVips {
+			conflict, err := b.bundleVipConflict(fmt.Sprintf("cluster.ingress_vips.%d", i), string(vip.IP))
+			if err != nil {
+				return nil, err
+			}
+			if conflict != nil {
+				conflicts = append(conflicts, conflict)
+			}
+		}
+	}
+
+	for i, h := range bundle.Hosts {
+		field := fmt.Sprintf("hosts.%d.mac_addresses", i)
+		for _, mac := range h.MacAddresses {
+			hwAddr, err := net.ParseMAC(mac)
+			if err != nil {
+				conflicts = append(conflicts, bundleConflict(field, true, "%s is not a valid MAC address", mac))
+				continue
+			}
+			// the inventory holds the MAC addresses in the canonical form of the agent, lower case and colon separated
+			macs, err := json.Marshal([]string{hwAddr.String()})
+			if err != nil {
+				return nil, err
+			}
+			hosts, err := common.GetHostsFromDBWhere(b.db, "cluster_id IS NOT NULL AND "+common.HostMacAddressesQuery+" @> ?::jsonb", string(macs))
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to find hosts with MAC address %s", mac)
+			}
+			for _, existing := range hosts {
+				conflicts = append(conflicts, bundleConflict(field, false,
+					"MAC address %s belongs to host %s of cluster %s", mac, existing.ID, existing.ClusterID))
+			}
+		}
+	}
+
+	if platform := bundle.Cluster.Platform; platform != nil && platform.Vsphere != nil && platform.Vsphere.Username != "" && !hasVspherePassword {
+		conflicts = append(conflicts, bundleConflict("cluster.platform.vsphere.password", false,
+			"The vCenter password is not part of the bundle and must be set on the imported cluster"))
+	}
+	return conflicts, nil
+}
+
+func (b *bareMetalInventory) bundleVipConflict(field, ip string) (*models.ClusterBundleConflict, error) {
+	var clusterIDs []string
+	err := b.db.Raw(`SELECT cluster_id FROM api_vips WHERE ip = ? UNION SELECT cluster_id FROM ingress_vips WHERE ip = ?`, ip, ip).
+		Scan(&clusterIDs).Error
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find clusters using VIP %s", ip)
+	}
+	clusters, err := common.GetClustersFromDBWhere(b.db, common.SkipEagerLoading, common.SkipDeletedRecords, "id in (?)", clusterIDs)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find clusters using VIP %s", ip)
+	}
+	if len(clusters) == 0 {
+		return nil, nil
+	}
+	return bundleConflict(field, false, "VIP %s is used by cluster %s", ip, clusters[0].ID), nil
+}
+
+func bundleClusterCreateParams(c *models.ClusterBundleCluster, name, baseDNSDomain, pullSecret string) *models.ClusterCreateParams {
+	params := &models.ClusterCreateParams{
+		Name:                  swag.String(name),
+		OpenshiftVersion:      c.OpenshiftVersion,
+		PullSecret:            swag.String(pullSecret),
+		CPUArchitecture:       c.CPUArchitecture,
+		BaseDNSDomain:         baseDNSDomain,
+		ClusterNetworks:       c.ClusterNetworks,
+		ServiceNetworks:       c.ServiceNetworks,
+		MachineNetworks:       c.MachineNetworks,
+		APIVips:               c.APIVips,
+		IngressVips:           c.IngressVips,
+		VipDhcpAllocation:     c.VipDhcpAllocation,
+		UserManagedNetworking: c.UserManagedNetworking,
+		Platform:              c.Platform,
+		OlmOperators:          c.OlmOperators,
+		DiskEncryption:        c.DiskEncryption,
+		SchedulableMasters:    c.SchedulableMasters,
+		SSHPublicKey:          c.SSHPublicKey,
+	}
+	if c.CPUArchitecture == "" {
+		params.CPUArchitecture = common.DefaultCPUArchitecture
+	}
+	if c.HighAvailabilityMode != "" {
+		params.HighAvailabilityMode = swag.String(c.HighAvailabilityMode)
+	}
+	if c.NetworkType != "" {
+		params.NetworkType = swag.String(c.NetworkType)
+	}
+	if c.Hyperthreading != "" {
+		params.Hyperthreading = swag.String(c.Hyperthreading)
+	}
+	if c.HTTPProxy != "" {
+		params.HTTPProxy = swag.String(c.HTTPProxy)
+	}
+	if c.HTTPSProxy != "" {
+		params.HTTPSProxy = swag.String(c.HTTPSProxy)
+	}
+	if c.NoProxy != "" {
+		params.NoProxy = swag.String(c.NoProxy)
+	}
+	if c.AdditionalNtpSource != "" {
+		params.AdditionalNtpSource = swag.String(c.AdditionalNtpSource)
+	}
+	if c.Tags != "" {
+		params.Tags = swag.String(c.Tags)
+	}
+	return params
+}
+
+func bundleConflict(field string, blocking bool, format string, args ...interface{}) *models.ClusterBundleConflict {
+	return &models.ClusterBundleConflict{
+		Field:    swag.String(field),
+		Message:  swag.String(fmt.Sprintf(format, args...)),
+		Blocking: swag.Bool(blocking),
+	}
+}
+
+func hasBlockingConflicts(conflicts []*models.ClusterBundleConflict) bool {
+	for _, c := range conflicts {
+		if swag.BoolValue(c.Blocking) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,188 @@
+package bminventory
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	eventgen "github.com/openshift/assisted-service/internal/common/events"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/pkg/requestid"
+	"github.com/openshift/assisted-service/restapi/operations/installer"
+	"github.com/pkg/errors"
+	jsonpatchdiff "gomodules.xyz/jsonpatch/v2"
+	"gorm.io/gorm"
+)
+
+// clusterRevisionConfiguration returns the configuration of a cluster that is recorded in its revisions, in JSON
+// format. The install config overrides have revisions of their own and aren't part of it.
+func clusterRevisionConfiguration(cluster *common.Cluster) ([]byte, error) {
+	configuration := exportBundleCluster(cluster)
+	configuration.InstallConfigOverrides = ""
+	return json.Marshal(configuration)
+}
+
+// addClusterRevision records the configuration of a cluster after an update, together with the JSON-Patch from the
+// configuration it had before. The first update of a cluster also records the configuration it had before the update,
+// so that the cluster can be rolled back to it. Updates that leave the configuration unchanged aren't recorded.
+// The cluster row is locked until the transaction ends, so that concurrent updates take consecutive revision numbers.
+func (b *bareMetalInventory) addClusterRevision(ctx context.Context, tx *gorm.DB, clusterID strfmt.UUID, previous []byte) error {
+	cluster, err := common.GetClusterFromDBForUpdate(tx, clusterID, common.UseEagerLoading)
+	if err != nil {
+		return err
+	}
+	current, err := clusterRevisionConfiguration(cluster)
+	if err != nil {
+		return err
+	}
+	operations, err := jsonpatchdiff.CreatePatch(previous, current)
+	if err != nil {
+		return errors.Wrapf(err, "failed to diff the configuration of cluster %s", clusterID)
+	}
+	if len(operations) == 0 {
+		return nil
+	}
+	diff, err := json.Marshal(operations)
+	if err != nil {
+		return err
+	}
+
+	var last int64
+	if err = tx.Unscoped().Model(&common.ClusterRevision{}).Where("cluster_id = ?", clusterID.String()).
+		Select("COALESCE(MAX(revision), 0)").Scan(&last).Error; err != nil {
+		return err
+	}
+	revisionTime := strfmt.DateTime(time.Now())
+	if last == 0 {
+		last++
+		if err = tx.Create(&common.ClusterRevision{ClusterRevision: models.ClusterRevision{
+			ClusterID:     &clusterID,
+			Revision:      swag.Int64(last),
+			RevisionTime:  &revisionTime,
+			Configuration: swag.String(string(previous)),
+		}}).Error; err != nil {
+			return err
+		}
+	}
+	return tx.Create(&common.ClusterRevision{ClusterRevision: models.ClusterRevision{
+		ClusterID:     &clusterID,
+		Revision:      swag.Int64(last + 1),
+		RevisionTime:  &revisionTime,
+		UserName:      ocm.UserNameFromContext(ctx),
+		RequestID:     strfmt.UUID(requestid.FromContext(ctx)),
+		Configuration: swag.String(string(current)),
+		Diff:          string(diff),
+	}}).Error
+}
+
+// ListClusterRevisionsInternal returns the revisions of the configuration of a cluster, oldest first
+func (b *bareMetalInventory) ListClusterRevisionsInternal(ctx context.Context, clusterID strfmt.UUID) (models.ClusterRevisionList, error) {
+	if _, err := b.getCluster(ctx, clusterID.String()); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, common.NewApiError(http.StatusNotFound, err)
+		}
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	var revisions []*common.ClusterRevision
+	if err := b.db.Where("cluster_id = ?", clusterID.String()).Order("revision").Find(&revisions).Error; err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	ret := make(models.ClusterRevisionList, 0, len(revisions))
+	for _, revision := range revisions {
+		ret = append(ret, &revision.ClusterRevision)
+	}
+	return ret, nil
+}
+
+// RollbackClusterInternal updates a cluster to the configuration of one of its revisions. The update goes through the
+// same validations as any other update, so only clusters that weren't installed yet can be rolled back.
+func (b *bareMetalInventory) RollbackClusterInternal(ctx context.Context, params installer.V2RollbackClusterParams) (*common.Cluster, error) {
+	log := logutil.FromContext(ctx, b.log)
+
+	var revision common.ClusterRevision
+	err := b.db.Where("cluster_id = ? and revision = ?", params.ClusterID.String(), params.Revision).Take(&revision).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, common.NewApiError(http.StatusNotFound, errors.Errorf("revision %d of cluster %s was not found", params.Revision, params.ClusterID))
+		}
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	var configuration models.ClusterBundleCluster
+	if err = json.Unmarshal([]byte(swag.StringValue(revision.Configuration)), &configuration); err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError,
+			errors.Wrapf(err, "failed to parse revision %d of cluster %s", params.Revision, params.ClusterID))
+	}
+
+	cluster, err := b.v2UpdateClusterInternal(ctx, installer.V2UpdateClusterParams{
+		ClusterID:           params.ClusterID,
+		ClusterUpdateParams: revisionClusterUpdateParams(&configuration),
+	}, Interactive)
+	if err != nil {
+		return nil, err
+	}
+	eventgen.SendClusterRolledBackEvent(ctx, b.eventsHandler, params.ClusterID, params.Revision)
+	log.Infof("Cluster %s was rolled back to revision %d", params.ClusterID, params.Revision)
+	return cluster, nil
+}
+
+// revisionClusterUpdateParams returns the update params that set the configuration of a cluster to the one recorded in
+// a revision. Lists are always set, so that items added after the revision are removed. Properties that can't be
+// updated, such as the OpenShift version, are ignored.
+func revisionClusterUpdateParams(c *models.ClusterBundleCluster) *models.V2ClusterUpdateParams {
+	params := &models.V2ClusterUpdateParams{
+		Name:                  c.Name,
+		BaseDNSDomain:         swag.String(c.BaseDNSDomain),
+		ClusterNetworks:       c.ClusterNetworks,
+		ServiceNetworks:       c.ServiceNetworks,
+		MachineNetworks:       c.MachineNetworks,
+		VipDhcpAllocation:     c.VipDhcpAllocation,
+		UserManagedNetworking: c.UserManagedNetworking,
+		HTTPProxy:             swag.String(c.HTTPProxy),
+		HTTPSProxy:            swag.String(c.HTTPSProxy),
+		NoProxy:               swag.String(c.NoProxy),
+		AdditionalNtpSource:   swag.String(c.AdditionalNtpSource),
+		SSHPublicKey:          swag.String(c.SSHPublicKey),
+		Tags:                  swag.String(c.Tags),
+		DiskEncryption:        c.DiskEncryption,
+		SchedulableMasters:    c.SchedulableMasters,
+		Platform:              c.Platform,
+		OlmOperators:          c.OlmOperators,
+	}
+	if params.ClusterNetworks == nil {
+		params.ClusterNetworks = []*models.ClusterNetwork{}
+	}
+	if params.ServiceNetworks == nil {
+		params.ServiceNetworks = []*models.ServiceNetwork{}
+	}
+	if params.MachineNetworks == nil {
+		params.MachineNetworks = []*models.MachineNetwork{}
+	}
+	if params.OlmOperators == nil {
+		params.OlmOperators = []*models.OperatorCreateParams{}
+	}
+	// VIPs allocated by DHCP aren't recorded
+	if !swag.BoolValue(c.VipDhcpAllocation) {
+		params.APIVips = c.APIVips
+		params.IngressVips = c.IngressVips
+		if params.APIVips == nil {
+			params.APIVips = []*models.APIVip{}
+		}
+		if params.IngressVips == nil {
+			params.IngressVips = []*models.IngressVip{}
+		}
+	}
+	if c.NetworkType != "" {
+		params.NetworkType = swag.String(c.NetworkType)
+	}
+	if c.Hyperthreading != "" {
+		params.Hyperthreading = swag.String(c.Hyperthreading)
+	}
+	return params
+}
@@ -0,0 +1,148 @@
+package bminventory
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	eventgen "github.com/openshift/assisted-service/internal/common/events"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/restapi/operations/installer"
+	"github.com/pkg/errors"
+)
+
+func (b *bareMetalInventory) V2BulkHostsAction(ctx context.Context, params installer.V2BulkHostsActionParams) middleware.Responder {
+	results, err := b.V2BulkHostsActionInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2BulkHostsActionOK().WithPayload(results)
+}
+
+// V2BulkHostsActionInternal applies an action to the hosts of an infra-env whose labels match a label selector. The
+// action is applied to each host separately, so a failure on one host doesn't prevent it from being applied to the
+// others, and the result of each host is returned.
+func (b *bareMetalInventory) V2BulkHostsActionInternal(ctx context.Context, params installer.V2BulkHostsActionParams) (models.HostBulkActionResultList, error) {
+	log := logutil.FromContext(ctx, b.log)
+	action := swag.StringValue(params.BulkActionParams.Action)
+
+	if err := validateBulkHostsActionParams(params.BulkActionParams); err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+	labelSelector, err := common.LabelSelectorScope(swag.StringValue(params.BulkActionParams.LabelSelector))
+	if err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+
+	if _, err = b.GetInfraEnvInternal(ctx, installer.GetInfraEnvParams{InfraEnvID: params.InfraEnvID}); err != nil {
+		return nil, err
+	}
+	hosts, err := common.GetInfraEnvHostsFromDB(b.db.Scopes(labelSelector), params.InfraEnvID)
+	if err != nil {
+		log.WithError(err).Errorf("failed to get the hosts of infra-env %s", params.InfraEnvID)
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	log.Infof("Applying action %s to %d hosts of infra-env %s", action, len(hosts), params.InfraEnvID)
+	results := models.HostBulkActionResultList{}
+	for _, host := range hosts {
+		result := &models.HostBulkActionResult{
+			HostID:   host.ID,
+			HostName: hostutil.GetHostnameForMsg(&host.Host),
+			Success:  swag.Bool(true),
+		}
+		if err = b.applyBulkHostAction(ctx, host, params.BulkActionParams); err != nil {
+			log.WithError(err).Warnf("failed to apply action %s to host %s", action, host.ID)
+			result.Success = swag.Bool(false)
+			result.Error = err.Error()
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+func validateBulkHostsActionParams(params *models.HostBulkActionParams) error {
+	action := swag.StringValue(params.Action)
+	switch {
+	// An empty selector matches every host of the infra-env
+	case strings.TrimSpace(swag.StringValue(params.LabelSelector)) == "":
+		return errors.New("a label selector is required to select the hosts")
+	case action == models.HostBulkActionParamsActionSetRole && params.Role == "":
+		return errors.Errorf("action %s requires a role", action)
+	case action == models.HostBulkActionParamsActionSetInstallationDisk && params.RootDeviceHints == nil:
+		return errors.Errorf("action %s requires root device hints", action)
+	case action == models.HostBulkActionParamsActionBind && params.ClusterID == nil:
+		return errors.Errorf("action %s requires a cluster ID", action)
+	}
+	return nil
+}
+
+func (b *bareMetalInventory) applyBulkHostAction(ctx context.Context, host *common.Host, params *models.HostBulkActionParams) error {
+	var err error
+	switch swag.StringValue(params.Action) {
+	case models.HostBulkActionParamsActionSetRole:
+		_, err = b.V2UpdateHostInternal(ctx, installer.V2UpdateHostParams{
+			InfraEnvID:       host.InfraEnvID,
+			HostID:           *host.ID,
+			HostUpdateParams: &models.HostUpdateParams{HostRole: swag.String(string(params.Role))},
+		}, Interactive)
+	case models.HostBulkActionParamsActionSetInstallationDisk:
+		var diskID string
+		if diskID, err = installationDiskByHints(host, params.RootDeviceHints); err != nil {
+			return err
+		}
+		_, err = b.V2UpdateHostInternal(ctx, installer.V2UpdateHostParams{
+			InfraEnvID: host.InfraEnvID,
+			HostID:     *host.ID,
+			HostUpdateParams: &models.HostUpdateParams{
+				DisksSelectedConfig: []*models.DiskConfigParams{{ID: &diskID, Role: models.DiskRoleInstall}},
+			},
+		}, Interactive)
+	case models.HostBulkActionParamsActionBind:
+		if _, err = b.BindHostInternal(ctx, installer.BindHostParams{
+			InfraEnvID:     host.InfraEnvID,
+			HostID:         *host.ID,
+			BindHostParams: &models.BindHostParams{ClusterID: params.ClusterID},
+		}); err != nil {
+			eventgen.SendHostBindFailedEvent(ctx, b.eventsHandler, *host.ID, host.InfraEnvID, params.ClusterID, err.Error())
+			return err
+		}
+		eventgen.SendHostBindSucceededEvent(ctx, b.eventsHandler, *host.ID, host.InfraEnvID, params.ClusterID, hostutil.GetHostnameForMsg(&host.Host))
+	case models.HostBulkActionParamsActionUnbind:
+		if _, err = b.UnbindHostInternal(ctx, installer.UnbindHostParams{
+			InfraEnvID: host.InfraEnvID,
+			HostID:     *host.ID,
+		}, false, Interactive); err != nil {
+			eventgen.SendHostUnbindFailedEvent(ctx, b.eventsHandler, *host.ID, host.InfraEnvID, err.Error())
+			return err
+		}
+		eventgen.SendHostUnbindSucceededEvent(ctx, b.eventsHandler, *host.ID, host.InfraEnvID, hostutil.GetHostnameForMsg(&host.Host))
+	case models.HostBulkActionParamsActionReset:
+		_, err = b.V2ResetHostInternal(ctx, installer.V2ResetHostParams{
+			InfraEnvID: host.InfraEnvID,
+			HostID:     *host.ID,
+		})
+	}
+	return err
+}
+
+// installationDiskByHints returns the ID of the first eligible disk of the host that matches the root device hints
+func installationDiskByHints(host *common.Host, hints *models.RootDeviceHints) (string, error) {
+	if host.Inventory == "" {
+		return "", errors.New("host inventory is not available yet")
+	}
+	inventory, err := common.UnmarshalInventory(host.Inventory)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to parse the host inventory")
+	}
+	disks := hostutil.GetAcceptableDisksWithHints(inventory.Disks, hostutil.RootDeviceHintsFromModel(hints))
+	if len(disks) == 0 {
+		return "", errors.New("no eligible disk matches the root device hints")
+	}
+	return disks[0].ID, nil
+}
@@ -0,0 +1,61 @@
+package bminventory
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	installcfg "github.com/openshift/assisted-service/internal/installcfg/builder"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/ocm"
+	"github.com/openshift/assisted-service/pkg/requestid"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// addInstallConfigOverridesRevision records a change of the install config overrides of a cluster, together with the
+// JSON-Patch from the previous overrides. Updates that leave the overrides unchanged aren't recorded.
+func (b *bareMetalInventory) addInstallConfigOverridesRevision(ctx context.Context, tx *gorm.DB, clusterID strfmt.UUID, previous, current string) error {
+	diff, err := installcfg.OverridesDiff(previous, current)
+	if err != nil {
+		return errors.Wrapf(err, "failed to diff the install config overrides of cluster %s", clusterID)
+	}
+	if diff == "" {
+		return nil
+	}
+	revision := common.InstallConfigOverridesRevision{
+		InstallConfigOverridesRevision: models.InstallConfigOverridesRevision{
+			ClusterID:    &clusterID,
+			RevisionTime: (*strfmt.DateTime)(swag.Time(time.Now())),
+			UserName:     ocm.UserNameFromContext(ctx),
+			RequestID:    strfmt.UUID(requestid.FromContext(ctx)),
+			Overrides:    swag.String(current),
+			Diff:         diff,
+		},
+	}
+	return tx.Create(&revision).Error
+}
+
+// ListClusterInstallConfigRevisionsInternal returns the changes made to the install config overrides of a cluster,
+// oldest first
+func (b *bareMetalInventory) ListClusterInstallConfigRevisionsInternal(ctx context.Context, clusterID strfmt.UUID) (models.InstallConfigOverridesRevisionList, error) {
+	if _, err := b.getCluster(ctx, clusterID.String()); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, common.NewApiError(http.StatusNotFound, err)
+		}
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	var revisions []*common.InstallConfigOverridesRevision
+	if err := b.db.Where("cluster_id = ?", clusterID.String()).Order("id").Find(&revisions).Error; err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	ret := make(models.InstallConfigOverridesRevisionList, 0, len(revisions))
+	for _, revision := range revisions {
+		ret = append(ret, &revision.InstallConfigOverridesRevision)
+	}
+	return ret, nil
+}
@@ -0,0 +1,32 @@
+package bminventory
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/timeline"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// GetClusterInstallationTimelineInternal builds the timeline of the last installation of a cluster from its events
+func (b *bareMetalInventory) GetClusterInstallationTimelineInternal(ctx context.Context, clusterID strfmt.UUID) (*models.InstallationTimeline, error) {
+	cluster, err := b.getCluster(ctx, clusterID.String())
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, common.NewApiError(http.StatusNotFound, err)
+		}
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	var events []*common.Event
+	if err = b.db.Where("cluster_id = ? and name in (?)", clusterID.String(), timeline.EventNames).
+		Order("event_time").Find(&events).Error; err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return timeline.Build(cluster, events, time.Now()), nil
+}
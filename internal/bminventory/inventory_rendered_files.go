@@ -0,0 +1,147 @@
+package bminventory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/gencrypto"
+	"github.com/openshift/assisted-service/internal/manifests"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	manifestsops "github.com/openshift/assisted-service/restapi/operations/manifests"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// customManifestsFile is the name of the rendered file holding the custom manifests of the operators
+const customManifestsFile = "custom_manifests.json"
+
+// redactedCredential replaces the credentials of the cluster in the rendered files
+const redactedCredential = "<redacted>"
+
+// RenderClusterFilesInternal renders the install config and the manifests that would be generated if the cluster was
+// installed now. The files are rendered in a temporary directory, where the provider hooks run as they do during the
+// installation, and nothing is stored.
+func (b *bareMetalInventory) RenderClusterFilesInternal(ctx context.Context, clusterID strfmt.UUID) (models.ClusterRenderedFiles, error) {
+	log := logutil.FromContext(ctx, b.log)
+
+	cluster, err := b.getCluster(ctx, clusterID.String(), common.UseEagerLoading)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, common.NewApiError(http.StatusNotFound, err)
+		}
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	workDir, err := os.MkdirTemp("", fmt.Sprintf("render-%s-", clusterID))
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	defer os.RemoveAll(workDir)
+
+	installConfig, err := b.installConfigBuilder.GetInstallConfig(cluster, false, "")
+	if err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, errors.Wrap(err, "failed to render the install config"))
+	}
+	if err = os.WriteFile(filepath.Join(workDir, "install-config.yaml"), installConfig, 0600); err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	var envVars []string
+	if err = b.providerRegistry.PreCreateManifestsHook(cluster, &envVars, workDir); err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, errors.Wrap(err, "failed to run the pre manifests creation hook"))
+	}
+	if err = b.providerRegistry.PostCreateManifestsHook(cluster, &envVars, workDir); err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, errors.Wrap(err, "failed to run the post manifests creation hook"))
+	}
+
+	// The stored manifests are rendered first, the generated ones replace those of previous installation attempts
+	directoryManifests := manifests.NewDirectoryManifests(workDir)
+	stored, err := b.exportBundleManifests(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+	for _, manifest := range stored {
+		if _, err = directoryManifests.CreateClusterManifestInternal(ctx, manifestsops.V2CreateClusterManifestParams{
+			ClusterID: clusterID,
+			CreateManifestParams: &models.CreateManifestParams{
+				Folder:   manifest.Folder,
+				FileName: manifest.FileName,
+				Content:  manifest.Content,
+			},
+		}); err != nil {
+			return nil, err
+		}
+	}
+	customManifests, err := b.clusterApi.RenderAdditionalManifests(ctx, cluster, directoryManifests)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, errors.Wrap(err, "failed to render the manifests"))
+	}
+	if customManifests != nil {
+		if err = os.WriteFile(filepath.Join(workDir, customManifestsFile), customManifests, 0600); err != nil {
+			return nil, common.NewApiError(http.StatusInternalServerError, err)
+		}
+	}
+
+	files := models.ClusterRenderedFiles{}
+	err = filepath.WalkDir(workDir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		name, err := filepath.Rel(workDir, path)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(name)] = string(content)
+		return nil
+	})
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, errors.Wrapf(err, "failed to read the rendered files of cluster %s", clusterID))
+	}
+	if err = redactRenderedFiles(cluster, files); err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	log.Infof("Rendered %d files for cluster %s", len(files), clusterID)
+	return files, nil
+}
+
+// redactRenderedFiles replaces the credentials of the cluster, the pull secret and the vCenter password, wherever they
+// appear in the rendered files. They are looked for both as is and escaped as a JSON string.
+func redactRenderedFiles(cluster *common.Cluster, files models.ClusterRenderedFiles) error {
+	var credentials []string
+	if cluster.PullSecret != "" {
+		credentials = append(credentials, cluster.PullSecret)
+	}
+	if cluster.VsphereEncryptedPassword != "" {
+		password, err := gencrypto.DecryptSecret(cluster.VsphereEncryptedPassword)
+		if err != nil {
+			return errors.Wrap(err, "failed to decrypt the vCenter password")
+		}
+		if password != "" {
+			credentials = append(credentials, password)
+		}
+	}
+	for _, credential := range credentials {
+		escaped, err := json.Marshal(credential)
+		if err != nil {
+			return err
+		}
+		for name, content := range files {
+			content = strings.ReplaceAll(content, credential, redactedCredential)
+			files[name] = strings.ReplaceAll(content, string(escaped[1:len(escaped)-1]), redactedCredential)
+		}
+	}
+	return nil
+}
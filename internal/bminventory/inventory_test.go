@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"compress/gzip"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -49,11 +50,15 @@ import (
 	"github.com/openshift/assisted-service/internal/infraenv"
 	installcfg "github.com/openshift/assisted-service/internal/installcfg"
 	installcfg_builder "github.com/openshift/assisted-service/internal/installcfg/builder"
+	"github.com/openshift/assisted-service/internal/manifests"
+	manifestsapi "github.com/openshift/assisted-service/internal/manifests/api"
 	"github.com/openshift/assisted-service/internal/metrics"
 	"github.com/openshift/assisted-service/internal/network"
 	"github.com/openshift/assisted-service/internal/operators"
 	"github.com/openshift/assisted-service/internal/provider/registry"
 	"github.com/openshift/assisted-service/internal/provider/vsphere"
+	"github.com/openshift/assisted-service/internal/reclaim"
+	"github.com/openshift/assisted-service/internal/spoke_k8s_client"
 	"github.com/openshift/assisted-service/internal/usage"
 	"github.com/openshift/assisted-service/internal/versions"
 	"github.com/openshift/assisted-service/models"
@@ -67,6 +72,7 @@ import (
 	"github.com/openshift/assisted-service/pkg/staticnetworkconfig"
 	"github.com/openshift/assisted-service/restapi"
 	"github.com/openshift/assisted-service/restapi/operations/installer"
+	manifestsops "github.com/openshift/assisted-service/restapi/operations/manifests"
 	"github.com/patrickmn/go-cache"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -236,7 +242,7 @@ func mockGenerateInstallConfigSuccess(mockGenerator *generator.MockISOInstallCon
 }
 
 func mockGetInstallConfigSuccess(mockInstallConfigBuilder *installcfg_builder.MockInstallConfigBuilder) {
-	mockInstallConfigBuilder.EXPECT().GetInstallConfig(gomock.Any(), gomock.Any(), gomock.Any()).Return([]byte("some string"), nil).Times(1)
+	mockInstallConfigBuilder.EXPECT().GetInstallConfigWithSecrets(gomock.Any(), gomock.Any(), gomock.Any()).Return([]byte("some string"), nil).Times(1)
 }
 
 func addVMToCluster(cluster *common.Cluster, db *gorm.DB) {
@@ -685,6 +691,7 @@ var _ = Describe("v2PostStepReply", func() {
 		Expect(envconfig.Process("test", &cfg)).ShouldNot(HaveOccurred())
 		db, dbName = common.PrepareTestDB()
 		bm = createInventory(db, cfg)
+		mockMetric.EXPECT().StepReplyDuration(gomock.Any(), gomock.Any()).AnyTimes()
 	})
 
 	AfterEach(func() {
@@ -1675,7 +1682,7 @@ var _ = Describe("cluster", func() {
 		})
 		It("happy flow", func() {
 			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
-				db, mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+				db, mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			mockClusterRegisterSuccess(true)
 			noneHaMode := models.ClusterHighAvailabilityModeNone
@@ -1697,7 +1704,7 @@ var _ = Describe("cluster", func() {
 				eventstest.WithMessageContainsMatcher("Invalid OCP version (4.7) for Single node, Single node OpenShift is supported for version 4.8 and above"),
 				eventstest.WithSeverityMatcher(models.EventSeverityError))).Times(1)
 			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
-				db, mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+				db, mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 			noneHaMode := models.ClusterHighAvailabilityModeNone
 			insufficientOpenShiftVersionForNoneHA := "4.7"
 			clusterParams.OpenshiftVersion = swag.String(insufficientOpenShiftVersionForNoneHA)
@@ -1713,7 +1720,7 @@ var _ = Describe("cluster", func() {
 				eventstest.WithMessageContainsMatcher("Invalid OCP version (4.7.0-fc.1) for Single node, Single node OpenShift is supported for version 4.8 and above"),
 				eventstest.WithSeverityMatcher(models.EventSeverityError))).Times(1)
 			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
-				db, mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+				db, mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 			noneHaMode := models.ClusterHighAvailabilityModeNone
 			insufficientOpenShiftVersionForNoneHA := "4.7.0-fc.1"
 			clusterParams.OpenshiftVersion = swag.String(insufficientOpenShiftVersionForNoneHA)
@@ -1725,7 +1732,7 @@ var _ = Describe("cluster", func() {
 		})
 		It("create non ha cluster success, release version is greater than minimal", func() {
 			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
-				db, mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+				db, mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			mockClusterRegisterSuccess(true)
 			noneHaMode := models.ClusterHighAvailabilityModeNone
@@ -1743,7 +1750,7 @@ var _ = Describe("cluster", func() {
 		})
 		It("create non ha cluster success, release version is pre-release and greater than minimal", func() {
 			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
-				db, mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+				db, mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			mockClusterRegisterSuccess(true)
 			noneHaMode := models.ClusterHighAvailabilityModeNone
@@ -1766,7 +1773,7 @@ var _ = Describe("cluster", func() {
 				eventstest.WithMessageContainsMatcher(errStr),
 				eventstest.WithSeverityMatcher(models.EventSeverityError))).Times(1)
 			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
-				db, mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+				db, mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 			noneHaMode := models.ClusterHighAvailabilityModeNone
 			openShiftVersionForNoneHA := "4.8.0-fc.2"
 			clusterParams.OpenshiftVersion = swag.String(openShiftVersionForNoneHA)
@@ -1783,7 +1790,7 @@ var _ = Describe("cluster", func() {
 				eventstest.WithMessageContainsMatcher("Failed to register cluster. Error: VIP DHCP Allocation cannot be enabled on single node OpenShift"),
 				eventstest.WithSeverityMatcher(models.EventSeverityError))).Times(1)
 			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
-				db, mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+				db, mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 			noneHaMode := models.ClusterHighAvailabilityModeNone
 			openShiftVersionForNoneHA := "4.8.0-fc.2"
 			clusterParams.OpenshiftVersion = swag.String(openShiftVersionForNoneHA)
@@ -1798,7 +1805,7 @@ var _ = Describe("cluster", func() {
 	})
 	It("create non ha cluster success, release version is ci-release and greater than minimal", func() {
 		bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
-			db, mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			db, mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		mockClusterRegisterSuccess(true)
 		noneHaMode := models.ClusterHighAvailabilityModeNone
@@ -1887,7 +1894,7 @@ var _ = Describe("cluster", func() {
 
 		It("update cluster day1 with APIVipDNSName failed", func() {
 			mockOperators := operators.NewMockAPI(ctrl)
-			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog(), db, mockEvents, nil, nil, nil, nil, mockOperators, nil, nil, nil, nil)
+			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog(), db, mockEvents, nil, nil, nil, nil, mockOperators, nil, nil, nil, nil, nil)
 
 			mockClusterRegisterSuccess(true)
 
@@ -1966,7 +1973,7 @@ var _ = Describe("cluster", func() {
 			Context("V2RegisterCluster", func() {
 				BeforeEach(func() {
 					bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
-						db, mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+						db, mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 				})
 				Context("RegisterCluster - Multiple-VIPs Support", func() {
 					var openshiftVersion = "4.12.0"
@@ -3702,6 +3709,43 @@ var _ = Describe("cluster", func() {
 			})
 		})
 
+		Context("Update Cluster Labels", func() {
+			BeforeEach(func() {
+				clusterID = strfmt.UUID(uuid.New().String())
+				err := db.Create(&common.Cluster{Cluster: models.Cluster{
+					ID:     &clusterID,
+					Kind:   swag.String(models.ClusterKindAddHostsCluster),
+					Labels: `{"env":"staging","team":"a"}`,
+				}}).Error
+				Expect(err).ShouldNot(HaveOccurred())
+				mockClusterApi.EXPECT().VerifyClusterUpdatability(gomock.Any()).Return(nil).Times(1)
+			})
+
+			It("Update labels success", func() {
+				mockSuccess()
+				reply := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
+					ClusterID: clusterID,
+					ClusterUpdateParams: &models.V2ClusterUpdateParams{
+						Labels: []*models.LabelParams{{Key: swag.String("env"), Value: swag.String("prod")}},
+					},
+				})
+				Expect(reply).To(BeAssignableToTypeOf(installer.NewV2UpdateClusterCreated()))
+				actual := reply.(*installer.V2UpdateClusterCreated)
+				Expect(actual.Payload.Labels).To(Equal(`{"env":"prod"}`))
+			})
+
+			It("Update cluster with invalid labels", func() {
+				reply := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
+					ClusterID: clusterID,
+					ClusterUpdateParams: &models.V2ClusterUpdateParams{
+						Labels: []*models.LabelParams{{Key: swag.String("env"), Value: swag.String("not a value")}},
+					},
+				})
+				Expect(reply).Should(BeAssignableToTypeOf(common.NewApiError(http.StatusBadRequest, errors.Errorf("error"))))
+				verifyApiErrorString(reply, http.StatusBadRequest, "labels: Invalid value")
+			})
+		})
+
 		Context("Update Network", func() {
 			BeforeEach(func() {
 				clusterID = strfmt.UUID(uuid.New().String())
@@ -5272,6 +5316,33 @@ var _ = Describe("cluster", func() {
 			verifyApiError(reply, http.StatusConflict)
 		})
 
+		It("vSphere credentials are rejected by vCenter", func() {
+			os.Setenv("CREDENTIALS_ENCRYPTION_KEY", "key")
+			defer os.Unsetenv("CREDENTIALS_ENCRYPTION_KEY")
+			encrypted, err := gencrypto.EncryptSecret("pass")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(db.Model(&common.Cluster{Cluster: models.Cluster{ID: &clusterID}}).Updates(map[string]interface{}{
+				"platform_type":              models.PlatformTypeVsphere,
+				"platform_vsphere_vcenter":   "vcenter.example.com",
+				"platform_vsphere_username":  "user",
+				"vsphere_encrypted_password": encrypted,
+			}).Error).ToNot(HaveOccurred())
+
+			mockVsphereValidator := vsphere.NewMockCredentialsValidator(ctrl)
+			bm.vsphereValidator = mockVsphereValidator
+			mockFalseAutoAssignSuccess(3)
+			setIsReadyForInstallationTrue(mockClusterApi)
+			mockVsphereValidator.EXPECT().ValidateCredentials(gomock.Any(), gomock.Any(), "pass").Return(errors.New("vCenter vcenter.example.com is not reachable"))
+			mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.VsphereCredentialsValidationFailedEventName),
+				eventstest.WithClusterIdMatcher(clusterID.String()))).Times(1)
+
+			reply := bm.V2InstallCluster(ctx, installer.V2InstallClusterParams{
+				ClusterID: clusterID,
+			})
+			verifyApiErrorString(reply, http.StatusBadRequest, "is not reachable")
+		})
+
 		It("list of masters for setting bootstrap return empty list", func() {
 			mockAutoAssignSuccess(3)
 			mockClusterRefreshStatusSuccess()
@@ -5787,6 +5858,77 @@ var _ = Describe("[V2ClusterUpdate] cluster", func() {
 					Expect(*actual2.Platform.Type).To(Equal(models.PlatformTypeVsphere))
 				})
 
+				Context("vCenter configuration", func() {
+					vsphereConfig := func() *models.VspherePlatform {
+						return &models.VspherePlatform{
+							Vcenter:          "vcenter.example.com",
+							Datacenter:       "dc",
+							Cluster:          "cluster",
+							DefaultDatastore: "datastore",
+							Network:          "VM Network",
+							Username:         "user",
+							Password:         "pass",
+						}
+					}
+
+					BeforeEach(func() {
+						os.Setenv("CREDENTIALS_ENCRYPTION_KEY", "key")
+					})
+
+					AfterEach(func() {
+						os.Unsetenv("CREDENTIALS_ENCRYPTION_KEY")
+					})
+
+					It("stores the configuration with an encrypted password", func() {
+						mockClusterUpdateSuccess(1, 0)
+						mockProviderRegistry.EXPECT().SetPlatformUsages(models.PlatformTypeVsphere, gomock.Any(), mockUsage)
+
+						reply := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
+							ClusterID: clusterID,
+							ClusterUpdateParams: &models.V2ClusterUpdateParams{
+								Platform: &models.Platform{Type: common.PlatformTypePtr(models.PlatformTypeVsphere), Vsphere: vsphereConfig()},
+							},
+						})
+						Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2UpdateClusterCreated()))
+						actual := reply.(*installer.V2UpdateClusterCreated).Payload
+						Expect(actual.Platform.Vsphere).ShouldNot(BeNil())
+						Expect(actual.Platform.Vsphere.Vcenter).To(Equal("vcenter.example.com"))
+						Expect(actual.Platform.Vsphere.Password).To(BeEmpty())
+
+						cluster, err := common.GetClusterFromDB(db, clusterID, common.SkipEagerLoading)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(cluster.VsphereEncryptedPassword).ToNot(BeEmpty())
+						Expect(cluster.VsphereEncryptedPassword).ToNot(ContainSubstring("pass"))
+						password, err := gencrypto.DecryptSecret(cluster.VsphereEncryptedPassword)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(password).To(Equal("pass"))
+					})
+
+					It("requires a password when none is stored", func() {
+						config := vsphereConfig()
+						config.Password = ""
+						reply := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
+							ClusterID: clusterID,
+							ClusterUpdateParams: &models.V2ClusterUpdateParams{
+								Platform: &models.Platform{Type: common.PlatformTypePtr(models.PlatformTypeVsphere), Vsphere: config},
+							},
+						})
+						verifyApiErrorString(reply, http.StatusBadRequest, "password")
+					})
+
+					It("rejects an incomplete configuration", func() {
+						config := vsphereConfig()
+						config.Datacenter = ""
+						reply := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
+							ClusterID: clusterID,
+							ClusterUpdateParams: &models.V2ClusterUpdateParams{
+								Platform: &models.Platform{Type: common.PlatformTypePtr(models.PlatformTypeVsphere), Vsphere: config},
+							},
+						})
+						verifyApiErrorString(reply, http.StatusBadRequest, "datacenter")
+					})
+				})
+
 				It("Update UMN=true and vphsere platform while cluster platform already set to none - success", func() {
 					mockClusterUpdateSuccess(2, 0)
 					mockProviderRegistry.EXPECT().SetPlatformUsages(models.PlatformTypeNone, gomock.Any(), mockUsage)
@@ -5879,6 +6021,11 @@ var _ = Describe("[V2ClusterUpdate] cluster", func() {
 					actual2 := reply2.(*installer.V2UpdateClusterCreated).Payload
 					Expect(swag.BoolValue(actual2.UserManagedNetworking)).To(Equal(false))
 					Expect(*actual2.Platform.Type).To(Equal(models.PlatformTypeBaremetal))
+
+					// An explicit baremetal choice is never replaced by an automatically selected platform
+					cluster, err := common.GetClusterFromDB(db, clusterID, common.SkipEagerLoading)
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(cluster.PlatformSelectedByUser).To(BeTrue())
 				})
 
 				It("Update UMN=nil and baremetal platform while cluster platform is set to vsphere and umn true- failure", func() {
@@ -6385,6 +6532,28 @@ var _ = Describe("infraEnvs", func() {
 			Expect(len(payload)).Should(Equal(1))
 			Expect(*payload[0].ID).To(Equal(infraEnvID2))
 		})
+
+		It("filters by label selector", func() {
+			Expect(db.Model(&common.InfraEnv{}).Where("id = ?", infraEnvID2).
+				Update("labels", `{"env":"prod"}`).Error).ShouldNot(HaveOccurred())
+
+			resp := bm.ListInfraEnvs(ctx, installer.ListInfraEnvsParams{LabelSelector: swag.String("env=prod")})
+			Expect(resp).Should(BeAssignableToTypeOf(installer.NewListInfraEnvsOK()))
+			payload := resp.(*installer.ListInfraEnvsOK).Payload
+			Expect(len(payload)).Should(Equal(1))
+			Expect(*payload[0].ID).To(Equal(infraEnvID2))
+
+			resp = bm.ListInfraEnvs(ctx, installer.ListInfraEnvsParams{LabelSelector: swag.String("!env")})
+			Expect(resp).Should(BeAssignableToTypeOf(installer.NewListInfraEnvsOK()))
+			payload = resp.(*installer.ListInfraEnvsOK).Payload
+			Expect(len(payload)).Should(Equal(1))
+			Expect(*payload[0].ID).To(Equal(infraEnvID))
+		})
+
+		It("rejects an invalid label selector", func() {
+			resp := bm.ListInfraEnvs(ctx, installer.ListInfraEnvsParams{LabelSelector: swag.String("env in prod")})
+			verifyApiErrorString(resp, http.StatusBadRequest, "invalid label selector env in prod")
+		})
 	})
 
 	Context("Filter based on organization ID", func() {
@@ -7279,6 +7448,31 @@ var _ = Describe("infraEnvs", func() {
 				Expect(i.AdditionalNtpSources).ToNot(Equal(nil))
 				Expect(i.AdditionalNtpSources).To(Equal("1.1.1.1"))
 			})
+			It("Update root device hints", func() {
+				mockInfraEnvUpdateSuccess()
+				reply := bm.UpdateInfraEnv(ctx, installer.UpdateInfraEnvParams{
+					InfraEnvID: *i.ID,
+					InfraEnvUpdateParams: &models.InfraEnvUpdateParams{
+						RootDeviceHints: &models.RootDeviceHints{MinSizeGigabytes: 100, Vendor: "ATA"},
+					},
+				})
+				Expect(reply).To(BeAssignableToTypeOf(installer.NewUpdateInfraEnvCreated()))
+				var err error
+				i, err = bm.GetInfraEnvInternal(ctx, installer.GetInfraEnvParams{InfraEnvID: *i.ID})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(i.RootDeviceHints).To(Equal(&models.RootDeviceHints{MinSizeGigabytes: 100, Vendor: "ATA"}))
+
+				By("clearing the hints")
+				mockInfraEnvUpdateSuccess()
+				reply = bm.UpdateInfraEnv(ctx, installer.UpdateInfraEnvParams{
+					InfraEnvID:           *i.ID,
+					InfraEnvUpdateParams: &models.InfraEnvUpdateParams{RootDeviceHints: &models.RootDeviceHints{}},
+				})
+				Expect(reply).To(BeAssignableToTypeOf(installer.NewUpdateInfraEnvCreated()))
+				i, err = bm.GetInfraEnvInternal(ctx, installer.GetInfraEnvParams{InfraEnvID: *i.ID})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(hostutil.RootDeviceHintsFromModel(i.RootDeviceHints)).To(BeNil())
+			})
 			It("Update Ignition", func() {
 				mockInfraEnvUpdateSuccess()
 				override := `{"ignition": {"version": "3.1.0"}, "storage": {"files": [{"path": "/tmp/example", "contents": {"source": "data:text/plain;base64,aGVscGltdHJhcHBlZGluYXN3YWdnZXJzcGVj"}}]}}`
@@ -8557,6 +8751,195 @@ var _ = Describe("infraEnvs host", func() {
 			Expect(resp).To(BeAssignableToTypeOf(&common.ApiErrorResponse{}))
 			Expect(resp.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusConflict)))
 		})
+
+		It("update host labels success", func() {
+			mockHostApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			mockClusterApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+			mockHostApi.EXPECT().GetStagesByRole(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			resp := bm.V2UpdateHost(ctx, installer.V2UpdateHostParams{
+				InfraEnvID: infraEnvID,
+				HostID:     hostID,
+				HostUpdateParams: &models.HostUpdateParams{
+					Labels: []*models.LabelParams{{Key: swag.String("rack"), Value: swag.String("r1")}},
+				},
+			})
+			Expect(resp).Should(BeAssignableToTypeOf(installer.NewV2UpdateHostCreated()))
+			Expect(resp.(*installer.V2UpdateHostCreated).Payload.Labels).To(Equal(`{"rack":"r1"}`))
+		})
+
+		It("update host with invalid labels", func() {
+			resp := bm.V2UpdateHost(ctx, installer.V2UpdateHostParams{
+				InfraEnvID: infraEnvID,
+				HostID:     hostID,
+				HostUpdateParams: &models.HostUpdateParams{
+					Labels: []*models.LabelParams{{Key: swag.String("rack"), Value: swag.String("not a value")}},
+				},
+			})
+			verifyApiErrorString(resp, http.StatusBadRequest, "labels: Invalid value")
+		})
+
+		It("update host root device hints success", func() {
+			mockHostApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			mockClusterApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+			mockHostApi.EXPECT().GetStagesByRole(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			resp := bm.V2UpdateHost(ctx, installer.V2UpdateHostParams{
+				InfraEnvID: infraEnvID,
+				HostID:     hostID,
+				HostUpdateParams: &models.HostUpdateParams{
+					RootDeviceHints: &models.RootDeviceHints{Model: "Disk B", Rotational: swag.Bool(false)},
+				},
+			})
+			Expect(resp).Should(BeAssignableToTypeOf(installer.NewV2UpdateHostCreated()))
+			hints := resp.(*installer.V2UpdateHostCreated).Payload.RootDeviceHints
+			Expect(hints.Model).To(Equal("Disk B"))
+			Expect(hints.Rotational).To(Equal(swag.Bool(false)))
+		})
+	})
+
+	Context("Bulk hosts action", func() {
+		var (
+			clusterID strfmt.UUID
+			hostID1   strfmt.UUID
+			hostID2   strfmt.UUID
+		)
+
+		BeforeEach(func() {
+			clusterID = strfmt.UUID(uuid.New().String())
+			Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID}}).Error).ShouldNot(HaveOccurred())
+
+			inventory := common.GenerateTestInventoryWithMutate(func(inventory *models.Inventory) {
+				inventory.Disks = []*models.Disk{
+					{ID: "/dev/sda", Path: "/dev/sda", InstallationEligibility: models.DiskInstallationEligibility{Eligible: true}},
+				}
+			})
+			hostID1 = strfmt.UUID(uuid.New().String())
+			hostID2 = strfmt.UUID(uuid.New().String())
+			for _, id := range []strfmt.UUID{hostID1, hostID2} {
+				hostID := id
+				Expect(db.Create(&models.Host{
+					ID:         &hostID,
+					InfraEnvID: infraEnvID,
+					ClusterID:  &clusterID,
+					Inventory:  inventory,
+					Labels:     `{"rack":"r1"}`,
+				}).Error).ToNot(HaveOccurred())
+			}
+			mockHostApi.EXPECT().RefreshInventory(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		})
+
+		bulkAction := func(selector, action string, mutate func(*models.HostBulkActionParams)) middleware.Responder {
+			params := &models.HostBulkActionParams{LabelSelector: swag.String(selector), Action: swag.String(action)}
+			if mutate != nil {
+				mutate(params)
+			}
+			return bm.V2BulkHostsAction(ctx, installer.V2BulkHostsActionParams{
+				InfraEnvID:       infraEnvID,
+				BulkActionParams: params,
+			})
+		}
+
+		It("sets the role of the matching hosts", func() {
+			mockHostApi.EXPECT().UpdateRole(gomock.Any(), gomock.Any(), models.HostRole("worker"), gomock.Any()).Return(nil).Times(2)
+			mockHostApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(2)
+			mockClusterApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(2)
+			mockHostApi.EXPECT().GetStagesByRole(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+			resp := bulkAction("rack=r1", models.HostBulkActionParamsActionSetRole, func(params *models.HostBulkActionParams) {
+				params.Role = models.HostRoleUpdateParamsWorker
+			})
+			Expect(resp).Should(BeAssignableToTypeOf(installer.NewV2BulkHostsActionOK()))
+			results := resp.(*installer.V2BulkHostsActionOK).Payload
+			Expect(results).To(HaveLen(2))
+			for _, result := range results {
+				Expect([]strfmt.UUID{hostID1, hostID2}).To(ContainElement(*result.HostID))
+				Expect(swag.BoolValue(result.Success)).To(BeTrue())
+				Expect(result.Error).To(BeEmpty())
+			}
+		})
+
+		It("reports the result of each host", func() {
+			mockHostApi.EXPECT().UpdateRole(gomock.Any(), gomock.Any(), models.HostRole("master"), gomock.Any()).Return(nil).Times(1)
+			mockHostApi.EXPECT().UpdateRole(gomock.Any(), gomock.Any(), models.HostRole("master"), gomock.Any()).Return(fmt.Errorf("some error")).Times(1)
+			mockHostApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			mockClusterApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+			mockHostApi.EXPECT().GetStagesByRole(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			resp := bulkAction("rack in (r1,r2)", models.HostBulkActionParamsActionSetRole, func(params *models.HostBulkActionParams) {
+				params.Role = models.HostRoleUpdateParamsMaster
+			})
+			Expect(resp).Should(BeAssignableToTypeOf(installer.NewV2BulkHostsActionOK()))
+			results := resp.(*installer.V2BulkHostsActionOK).Payload
+			Expect(results).To(HaveLen(2))
+			var failed []*models.HostBulkActionResult
+			for _, result := range results {
+				if !swag.BoolValue(result.Success) {
+					failed = append(failed, result)
+				}
+			}
+			Expect(failed).To(HaveLen(1))
+			Expect(failed[0].Error).To(ContainSubstring("some error"))
+		})
+
+		It("doesn't apply the action to hosts that don't match", func() {
+			resp := bulkAction("rack=r2", models.HostBulkActionParamsActionSetRole, func(params *models.HostBulkActionParams) {
+				params.Role = models.HostRoleUpdateParamsWorker
+			})
+			Expect(resp).Should(BeAssignableToTypeOf(installer.NewV2BulkHostsActionOK()))
+			Expect(resp.(*installer.V2BulkHostsActionOK).Payload).To(BeEmpty())
+		})
+
+		It("sets the installation disk matching the root device hints", func() {
+			mockHostApi.EXPECT().UpdateInstallationDisk(gomock.Any(), gomock.Any(), gomock.Any(), "/dev/sda").Return(nil).Times(2)
+			mockHostApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(2)
+			mockClusterApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(2)
+			mockHostApi.EXPECT().GetStagesByRole(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+			resp := bulkAction("rack=r1", models.HostBulkActionParamsActionSetInstallationDisk, func(params *models.HostBulkActionParams) {
+				params.RootDeviceHints = &models.RootDeviceHints{DeviceName: "/dev/sda"}
+			})
+			Expect(resp).Should(BeAssignableToTypeOf(installer.NewV2BulkHostsActionOK()))
+			for _, result := range resp.(*installer.V2BulkHostsActionOK).Payload {
+				Expect(swag.BoolValue(result.Success)).To(BeTrue())
+			}
+		})
+
+		It("fails hosts without a disk matching the root device hints", func() {
+			resp := bulkAction("rack=r1", models.HostBulkActionParamsActionSetInstallationDisk, func(params *models.HostBulkActionParams) {
+				params.RootDeviceHints = &models.RootDeviceHints{DeviceName: "/dev/nvme0n1"}
+			})
+			Expect(resp).Should(BeAssignableToTypeOf(installer.NewV2BulkHostsActionOK()))
+			results := resp.(*installer.V2BulkHostsActionOK).Payload
+			Expect(results).To(HaveLen(2))
+			for _, result := range results {
+				Expect(swag.BoolValue(result.Success)).To(BeFalse())
+				Expect(result.Error).To(Equal("no eligible disk matches the root device hints"))
+			}
+		})
+
+		It("rejects an action without its parameters", func() {
+			resp := bulkAction("rack=r1", models.HostBulkActionParamsActionSetRole, nil)
+			verifyApiErrorString(resp, http.StatusBadRequest, "action set-role requires a role")
+		})
+
+		It("rejects an invalid label selector", func() {
+			resp := bulkAction("rack in r1", models.HostBulkActionParamsActionReset, nil)
+			verifyApiErrorString(resp, http.StatusBadRequest, "invalid label selector")
+		})
+
+		It("rejects an empty label selector", func() {
+			for _, selector := range []string{"", "  "} {
+				resp := bulkAction(selector, models.HostBulkActionParamsActionReset, nil)
+				verifyApiErrorString(resp, http.StatusBadRequest, "a label selector is required")
+			}
+		})
+
+		It("fails for a missing infra-env", func() {
+			resp := bm.V2BulkHostsAction(ctx, installer.V2BulkHostsActionParams{
+				InfraEnvID: strfmt.UUID(uuid.New().String()),
+				BulkActionParams: &models.HostBulkActionParams{
+					LabelSelector: swag.String("rack=r1"),
+					Action:        swag.String(models.HostBulkActionParamsActionReset),
+				},
+			})
+			verifyApiError(resp, http.StatusNotFound)
+		})
 	})
 
 	AfterEach(func() {
@@ -8775,7 +9158,7 @@ var _ = Describe("V2UploadClusterIngressCert test", func() {
 		bm = createInventory(db, cfg)
 		mockOperators := operators.NewMockAPI(ctrl)
 		bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
-			db, nil, nil, nil, nil, nil, mockOperators, nil, nil, nil, nil)
+			db, nil, nil, nil, nil, nil, mockOperators, nil, nil, nil, nil, nil)
 		c = common.Cluster{Cluster: models.Cluster{
 			ID:               &clusterID,
 			OpenshiftVersion: common.TestDefaultConfig.OpenShiftVersion,
@@ -9217,6 +9600,37 @@ var _ = Describe("List clusters", func() {
 			Expect(len(payload)).Should(Equal(0))
 		})
 	})
+	Context("Filter by label selector", func() {
+		var prodClusterID strfmt.UUID
+
+		BeforeEach(func() {
+			prodClusterID = strfmt.UUID(uuid.New().String())
+			err := db.Create(&common.Cluster{Cluster: models.Cluster{
+				ID:     &prodClusterID,
+				Labels: `{"env":"prod","team":"a"}`,
+			}}).Error
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("returns the clusters that match the selector", func() {
+			resp := bm.V2ListClusters(ctx, installer.V2ListClustersParams{LabelSelector: swag.String("env in (prod,staging),team=a")})
+			payload := resp.(*installer.V2ListClustersOK).Payload
+			Expect(len(payload)).Should(Equal(1))
+			Expect(*payload[0].ID).Should(Equal(prodClusterID))
+		})
+
+		It("returns the clusters without the label", func() {
+			resp := bm.V2ListClusters(ctx, installer.V2ListClustersParams{LabelSelector: swag.String("env!=prod")})
+			payload := resp.(*installer.V2ListClustersOK).Payload
+			Expect(len(payload)).Should(Equal(1))
+			Expect(*payload[0].ID).Should(Equal(clusterID))
+		})
+
+		It("rejects unsupported operators", func() {
+			resp := bm.V2ListClusters(ctx, installer.V2ListClustersParams{LabelSelector: swag.String("replicas>3")})
+			verifyApiErrorString(resp, http.StatusBadRequest, "operator gt is not supported")
+		})
+	})
 })
 
 var _ = Describe("Upload and Download logs test", func() {
@@ -9800,6 +10214,78 @@ var _ = Describe("UpdateClusterInstallConfig", func() {
 		Expect(err).ShouldNot(HaveOccurred())
 		Expect(updated.Cluster.FeatureUsage).To(Equal(""))
 	})
+
+	It("applies a JSON-Patch to the current overrides", func() {
+		Expect(db.Model(&common.Cluster{}).Where("id = ?", clusterID).
+			Update("install_config_overrides", `{"controlPlane": {"hyperthreading": "Disabled"}}`).Error).ShouldNot(HaveOccurred())
+		params := installer.V2UpdateClusterInstallConfigParams{
+			ClusterID:           clusterID,
+			InstallConfigParams: `[{"op": "add", "path": "/fips", "value": true}]`,
+		}
+		mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+			eventstest.WithNameMatcher(eventgen.InstallConfigAppliedEventName),
+			eventstest.WithClusterIdMatcher(params.ClusterID.String())))
+		mockInstallConfigBuilder.EXPECT().ValidateInstallConfigPatch(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ *common.Cluster, overrides string) error {
+				Expect(overrides).To(MatchJSON(`{"controlPlane": {"hyperthreading": "Disabled"}, "fips": true}`))
+				return nil
+			}).Times(1)
+		mockUsageReports()
+		response := bm.V2UpdateClusterInstallConfig(ctx, params)
+		Expect(response).To(BeAssignableToTypeOf(&installer.V2UpdateClusterInstallConfigCreated{}))
+
+		var updated common.Cluster
+		Expect(db.First(&updated, "id = ?", clusterID).Error).ShouldNot(HaveOccurred())
+		Expect(updated.InstallConfigOverrides).To(MatchJSON(`{"controlPlane": {"hyperthreading": "Disabled"}, "fips": true}`))
+	})
+
+	It("returns bad request when the JSON-Patch doesn't apply", func() {
+		params := installer.V2UpdateClusterInstallConfigParams{
+			ClusterID:           clusterID,
+			InstallConfigParams: `[{"op": "remove", "path": "/fips"}]`,
+		}
+		mockInstallConfigBuilder.EXPECT().ValidateInstallConfigPatch(gomock.Any(), gomock.Any()).Times(0)
+		response := bm.V2UpdateClusterInstallConfig(ctx, params)
+		verifyApiErrorString(response, http.StatusBadRequest, "JSON-Patch")
+	})
+
+	Context("revisions", func() {
+		update := func(overrides string) {
+			mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.InstallConfigAppliedEventName),
+				eventstest.WithClusterIdMatcher(clusterID.String())))
+			mockInstallConfigBuilder.EXPECT().ValidateInstallConfigPatch(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			mockUsageReports()
+			response := bm.V2UpdateClusterInstallConfig(ctx, installer.V2UpdateClusterInstallConfigParams{
+				ClusterID:           clusterID,
+				InstallConfigParams: overrides,
+			})
+			Expect(response).To(BeAssignableToTypeOf(&installer.V2UpdateClusterInstallConfigCreated{}))
+		}
+
+		It("lists the changes of the overrides with their diffs", func() {
+			update(`{"fips": true}`)
+			update(`[{"op": "add", "path": "/sshKey", "value": "key"}]`)
+			update(`{"fips": true, "sshKey": "key"}`)
+
+			response := bm.V2ListClusterInstallConfigRevisions(ctx, installer.V2ListClusterInstallConfigRevisionsParams{ClusterID: clusterID})
+			Expect(response).To(BeAssignableToTypeOf(installer.NewV2ListClusterInstallConfigRevisionsOK()))
+			revisions := response.(*installer.V2ListClusterInstallConfigRevisionsOK).Payload
+			Expect(revisions).To(HaveLen(2))
+			Expect(*revisions[0].ClusterID).To(Equal(clusterID))
+			Expect(*revisions[0].Overrides).To(MatchJSON(`{"fips": true}`))
+			Expect(revisions[0].Diff).To(MatchJSON(`[{"op": "add", "path": "/fips", "value": true}]`))
+			Expect(*revisions[1].Overrides).To(MatchJSON(`{"fips": true, "sshKey": "key"}`))
+			Expect(revisions[1].Diff).To(MatchJSON(`[{"op": "add", "path": "/sshKey", "value": "key"}]`))
+		})
+
+		It("returns not found with a non-existant cluster", func() {
+			response := bm.V2ListClusterInstallConfigRevisions(ctx, installer.V2ListClusterInstallConfigRevisionsParams{
+				ClusterID: strfmt.UUID(uuid.New().String()),
+			})
+			verifyApiError(response, http.StatusNotFound)
+		})
+	})
 })
 
 var _ = Describe("V2DownloadInfraEnvFiles", func() {
@@ -10427,7 +10913,7 @@ var _ = Describe("GetSupportedPlatformsFromInventory", func() {
 		db, dbName = common.PrepareTestDB()
 		bm = createInventory(db, cfg)
 		bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
-			db, mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			db, mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 		mockUsageReports()
 		mockClusterRegisterSuccess(true)
 		mockAMSSubscription(ctx)
@@ -10517,18 +11003,55 @@ var _ = Describe("GetSupportedPlatformsFromInventory", func() {
 		platformReplay := bm.GetClusterSupportedPlatforms(ctx, installer.GetClusterSupportedPlatformsParams{ClusterID: clusterID})
 		Expect(platformReplay).Should(BeAssignableToTypeOf(installer.NewGetClusterSupportedPlatformsOK()))
 	})
-})
 
-func verifyApiError(responder middleware.Responder, expectedHttpStatus int32) {
-	ExpectWithOffset(1, responder).To(BeAssignableToTypeOf(common.NewApiError(expectedHttpStatus, nil)))
-	concreteError := responder.(*common.ApiErrorResponse)
-	ExpectWithOffset(1, concreteError.StatusCode()).To(Equal(expectedHttpStatus))
-}
+	Context("details", func() {
+		platformsSupport := func() []*models.PlatformSupport {
+			return []*models.PlatformSupport{
+				{PlatformType: common.PlatformTypePtr(models.PlatformTypeBaremetal), Supported: swag.Bool(true)},
+				{PlatformType: common.PlatformTypePtr(models.PlatformTypeNone), Supported: swag.Bool(true)},
+				{PlatformType: common.PlatformTypePtr(models.PlatformTypeVsphere), Supported: swag.Bool(false),
+					UnsupportedReasons: []string{"Host h1 is not supported by platform vsphere"}},
+			}
+		}
 
-func verifyApiErrorString(responder middleware.Responder, expectedHttpStatus int32, expectedSubstring string) {
-	ExpectWithOffset(1, responder).To(BeAssignableToTypeOf(common.NewApiError(expectedHttpStatus, nil)))
-	concreteError := responder.(*common.ApiErrorResponse)
-	ExpectWithOffset(1, concreteError.StatusCode()).To(Equal(expectedHttpStatus))
+		It("no hosts", func() {
+			mockProviderRegistry.EXPECT().GetProvidersSupportByHosts(gomock.Any()).Return(platformsSupport(), nil)
+			reply := bm.GetClusterSupportedPlatformsDetails(ctx, installer.GetClusterSupportedPlatformsDetailsParams{ClusterID: clusterID})
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewGetClusterSupportedPlatformsDetailsOK()))
+			for _, p := range reply.(*installer.GetClusterSupportedPlatformsDetailsOK).Payload {
+				Expect(swag.BoolValue(p.Supported)).Should(Equal(*p.PlatformType == models.PlatformTypeNone))
+				if *p.PlatformType != models.PlatformTypeNone {
+					Expect(p.UnsupportedReasons[0]).Should(ContainSubstring("no hosts"))
+				}
+			}
+		})
+
+		It("multi node cluster", func() {
+			addVsphereHost(clusterID, models.HostRoleMaster)
+			mockProviderRegistry.EXPECT().GetProvidersSupportByHosts(gomock.Any()).Return(platformsSupport(), nil)
+			reply := bm.GetClusterSupportedPlatformsDetails(ctx, installer.GetClusterSupportedPlatformsDetailsParams{ClusterID: clusterID})
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewGetClusterSupportedPlatformsDetailsOK()))
+			Expect(reply.(*installer.GetClusterSupportedPlatformsDetailsOK).Payload).Should(Equal(platformsSupport()))
+		})
+
+		It("registry failure", func() {
+			mockProviderRegistry.EXPECT().GetProvidersSupportByHosts(gomock.Any()).Return(nil, errors.New("some error"))
+			reply := bm.GetClusterSupportedPlatformsDetails(ctx, installer.GetClusterSupportedPlatformsDetailsParams{ClusterID: clusterID})
+			verifyApiError(reply, http.StatusInternalServerError)
+		})
+	})
+})
+
+func verifyApiError(responder middleware.Responder, expectedHttpStatus int32) {
+	ExpectWithOffset(1, responder).To(BeAssignableToTypeOf(common.NewApiError(expectedHttpStatus, nil)))
+	concreteError := responder.(*common.ApiErrorResponse)
+	ExpectWithOffset(1, concreteError.StatusCode()).To(Equal(expectedHttpStatus))
+}
+
+func verifyApiErrorString(responder middleware.Responder, expectedHttpStatus int32, expectedSubstring string) {
+	ExpectWithOffset(1, responder).To(BeAssignableToTypeOf(common.NewApiError(expectedHttpStatus, nil)))
+	concreteError := responder.(*common.ApiErrorResponse)
+	ExpectWithOffset(1, concreteError.StatusCode()).To(Equal(expectedHttpStatus))
 	ExpectWithOffset(1, concreteError.Error()).To(ContainSubstring(expectedSubstring))
 }
 
@@ -10667,6 +11190,60 @@ var _ = Describe("Register AddHostsCluster test", func() {
 		Expect(actual.Payload.OpenshiftClusterID).To(Equal(openshiftClusterID))
 		Expect(res).Should(BeAssignableToTypeOf(installer.NewV2ImportClusterCreated()))
 	})
+
+	Context("with a kubeconfig", func() {
+		kubeconfig := `apiVersion: v1
+kind: Config
+clusters:
+- cluster:
+    server: https://api.test-cluster.example.com:6443
+  name: test-cluster
+contexts:
+- context:
+    cluster: test-cluster
+    user: admin
+  name: admin
+current-context: admin
+users:
+- name: admin
+  user:
+    token: some-token
+`
+		var params installer.V2ImportClusterParams
+
+		BeforeEach(func() {
+			openshiftClusterID := strfmt.UUID(uuid.New().String())
+			params = installer.V2ImportClusterParams{
+				HTTPRequest: request,
+				NewImportClusterParams: &models.ImportClusterParams{
+					APIVipDnsname:      &apiVIPDnsname,
+					Name:               &clusterName,
+					OpenshiftClusterID: &openshiftClusterID,
+					Kubeconfig:         kubeconfig,
+				},
+			}
+		})
+
+		It("stores the kubeconfig of the imported cluster", func() {
+			mockClusterApi.EXPECT().RegisterAddHostsCluster(ctx, gomock.Any()).Return(nil).Times(1)
+			mockMetric.EXPECT().ClusterRegistered().Times(1)
+			var uploadedObjectName string
+			mockS3Client.EXPECT().Upload(ctx, []byte(kubeconfig), gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ []byte, objectName string) error {
+					uploadedObjectName = objectName
+					return nil
+				}).Times(1)
+			res := bm.V2ImportCluster(ctx, params)
+			actual := res.(*installer.V2ImportClusterCreated)
+			Expect(uploadedObjectName).To(Equal(fmt.Sprintf("%s/%s", actual.Payload.ID, constants.Kubeconfig)))
+		})
+
+		It("rejects an invalid kubeconfig", func() {
+			params.NewImportClusterParams.Kubeconfig = "not a kubeconfig"
+			res := bm.V2ImportCluster(ctx, params)
+			verifyApiError(res, http.StatusBadRequest)
+		})
+	})
 })
 
 var _ = Describe("Reset Host test", func() {
@@ -10989,7 +11566,7 @@ var _ = Describe("TestRegisterCluster", func() {
 		Expect(cfg.DiskEncryptionSupport).Should(BeTrue())
 		bm = createInventory(db, cfg)
 		bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
-			db, mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			db, mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 		mockUsageReports()
 	})
 
@@ -11802,7 +12379,7 @@ var _ = Describe("TestRegisterCluster", func() {
 			var c *models.Cluster
 			diskEncryptionBm := createInventory(db, cfg)
 			diskEncryptionBm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
-				db, mockEvents, nil, nil, nil, nil, mockOperatorManager, nil, nil, nil, nil)
+				db, mockEvents, nil, nil, nil, nil, mockOperatorManager, nil, nil, nil, nil, nil)
 
 			By("Register cluster", func() {
 
@@ -11882,7 +12459,7 @@ var _ = Describe("TestRegisterCluster", func() {
 			cfg.DiskEncryptionSupport = false
 			bm = createInventory(db, cfg)
 			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
-				db, mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+				db, mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 			mockUsageReports()
 		})
 
@@ -12127,7 +12704,7 @@ var _ = Describe("TestRegisterCluster", func() {
 			cfg.DiskEncryptionSupport = false
 			bm = createInventory(db, cfg)
 			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
-				db, mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+				db, mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 			mockUsageReports()
 		})
 
@@ -12541,6 +13118,37 @@ var _ = Describe("TestRegisterCluster", func() {
 		})
 	})
 
+	Context("Cluster Labels", func() {
+		It("Register cluster with labels", func() {
+			mockClusterRegisterSuccess(true)
+			mockAMSSubscription(ctx)
+
+			params := getDefaultClusterCreateParams()
+			params.Labels = []*models.LabelParams{{Key: swag.String("env"), Value: swag.String("prod")}}
+			reply := bm.V2RegisterCluster(ctx, installer.V2RegisterClusterParams{
+				NewClusterParams: params,
+			})
+			Expect(reflect.TypeOf(reply)).Should(Equal(reflect.TypeOf(installer.NewV2RegisterClusterCreated())))
+			actual := reply.(*installer.V2RegisterClusterCreated)
+			Expect(actual.Payload.Labels).To(Equal(`{"env":"prod"}`))
+		})
+
+		It("Register cluster with invalid labels", func() {
+			mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.ClusterRegistrationFailedEventName),
+				eventstest.WithMessageContainsMatcher("labels: Invalid value"),
+				eventstest.WithSeverityMatcher(models.EventSeverityError))).Times(1)
+
+			params := getDefaultClusterCreateParams()
+			params.Labels = []*models.LabelParams{{Key: swag.String("env"), Value: swag.String("not a value")}}
+			reply := bm.V2RegisterCluster(ctx, installer.V2RegisterClusterParams{
+				NewClusterParams: params,
+			})
+			Expect(reply).Should(BeAssignableToTypeOf(common.NewApiError(http.StatusBadRequest, errors.Errorf("error"))))
+			verifyApiErrorString(reply, http.StatusBadRequest, "labels: Invalid value")
+		})
+	})
+
 	Context("Networking", func() {
 		var (
 			clusterNetworks = []*models.ClusterNetwork{{Cidr: "1.1.1.0/24", HostPrefix: 24}, {Cidr: "2.2.2.0/24", HostPrefix: 24}}
@@ -12619,7 +13227,7 @@ var _ = Describe("TestRegisterCluster", func() {
 			db, dbName = common.PrepareTestDB()
 			bm = createInventory(db, Config{})
 			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
-				db, mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+				db, mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 		})
 
 		Context("with EnableOrgBasedFeatureGates true", func() {
@@ -12744,7 +13352,7 @@ var _ = Describe("AMS subscriptions", func() {
 		Expect(envconfig.Process("test", &cfg)).ShouldNot(HaveOccurred())
 		db, dbName = common.PrepareTestDB()
 		bm = createInventory(db, cfg)
-		bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog(), db, mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog(), db, mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 		mockUsageReports()
 	})
 
@@ -12813,7 +13421,7 @@ var _ = Describe("AMS subscriptions", func() {
 		It("deregister cluster that don't have 'Reserved' subscriptions", func() {
 			mockS3Client = s3wrapper.NewMockAPI(ctrl)
 			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
-				db, mockEvents, nil, nil, nil, nil, nil, nil, mockS3Client, nil, nil)
+				db, mockEvents, nil, nil, nil, nil, nil, nil, mockS3Client, nil, nil, nil)
 			mockClusterRegisterSuccess(true)
 			mockAMSSubscription(ctx)
 
@@ -12835,7 +13443,7 @@ var _ = Describe("AMS subscriptions", func() {
 
 		It("update cluster name happy flow", func() {
 			mockOperators := operators.NewMockAPI(ctrl)
-			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog(), db, mockEvents, nil, nil, nil, nil, mockOperators, nil, nil, nil, nil)
+			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog(), db, mockEvents, nil, nil, nil, nil, mockOperators, nil, nil, nil, nil, nil)
 
 			mockClusterRegisterSuccess(true)
 			mockAMSSubscription(ctx)
@@ -12868,7 +13476,7 @@ var _ = Describe("AMS subscriptions", func() {
 
 		It("update cluster name with same name", func() {
 			mockOperators := operators.NewMockAPI(ctrl)
-			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog(), db, mockEvents, nil, nil, nil, nil, mockOperators, nil, nil, nil, nil)
+			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog(), db, mockEvents, nil, nil, nil, nil, mockOperators, nil, nil, nil, nil, nil)
 
 			mockClusterRegisterSuccess(true)
 			mockAMSSubscription(ctx)
@@ -12898,7 +13506,7 @@ var _ = Describe("AMS subscriptions", func() {
 
 		It("update cluster without name field", func() {
 			mockOperators := operators.NewMockAPI(ctrl)
-			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog(), db, mockEvents, nil, nil, nil, nil, mockOperators, nil, nil, nil, nil)
+			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog(), db, mockEvents, nil, nil, nil, nil, mockOperators, nil, nil, nil, nil, nil)
 
 			mockClusterRegisterSuccess(true)
 			mockAMSSubscription(ctx)
@@ -13007,7 +13615,7 @@ var _ = Describe("AMS subscriptions", func() {
 		It("register and deregister cluster happy flow - nil OCM client", func() {
 			mockS3Client = s3wrapper.NewMockAPI(ctrl)
 			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
-				db, mockEvents, nil, nil, nil, nil, nil, nil, mockS3Client, nil, nil)
+				db, mockEvents, nil, nil, nil, nil, nil, nil, mockS3Client, nil, nil, nil)
 			bm.ocmClient = nil
 			mockClusterRegisterSuccess(true)
 
@@ -14179,6 +14787,888 @@ var _ = Describe("UnbindHost", func() {
 
 })
 
+var _ = Describe("V2ReclaimHost", func() {
+	var (
+		bm                     *bareMetalInventory
+		cfg                    Config
+		db                     *gorm.DB
+		ctx                    = context.Background()
+		clusterID              strfmt.UUID
+		hostID                 strfmt.UUID
+		infraEnvID             strfmt.UUID
+		dbName                 string
+		mockSpokeClientFactory *spoke_k8s_client.MockSpokeK8sClientFactory
+		mockSpokeClient        *spoke_k8s_client.MockSpokeK8sClient
+		mockReclaimer          *reclaim.MockReclaimer
+		params                 installer.V2ReclaimHostParams
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		clusterID = strfmt.UUID(uuid.New().String())
+		hostID = strfmt.UUID(uuid.New().String())
+		infraEnvID = strfmt.UUID(uuid.New().String())
+		bm = createInventory(db, cfg)
+		mockSpokeClientFactory = spoke_k8s_client.NewMockSpokeK8sClientFactory(ctrl)
+		mockSpokeClient = spoke_k8s_client.NewMockSpokeK8sClient(ctrl)
+		mockReclaimer = reclaim.NewMockReclaimer(ctrl)
+		bm.spokeClientFactory = mockSpokeClientFactory
+		bm.reclaimer = mockReclaimer
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &clusterID, Kind: swag.String(models.ClusterKindAddHostsCluster)}}).Error).ShouldNot(HaveOccurred())
+		Expect(db.Create(&common.InfraEnv{InfraEnv: models.InfraEnv{ID: &infraEnvID}}).Error).ShouldNot(HaveOccurred())
+		Expect(db.Create(&common.Host{Host: models.Host{ID: &hostID, InfraEnvID: infraEnvID, ClusterID: &clusterID,
+			Status: swag.String(models.HostStatusAddedToExistingCluster), RequestedHostname: "worker-0"}}).Error).ShouldNot(HaveOccurred())
+		params = installer.V2ReclaimHostParams{HostID: hostID, InfraEnvID: infraEnvID}
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	expectReclaimFailedEvent := func() {
+		mockEvents.EXPECT().SendHostEvent(gomock.Any(), eventstest.NewEventMatcher(
+			eventstest.WithNameMatcher(eventgen.HostReclaimStartFailedEventName),
+			eventstest.WithHostIdMatcher(hostID.String()),
+			eventstest.WithInfraEnvIdMatcher(infraEnvID.String()),
+			eventstest.WithSeverityMatcher(models.EventSeverityError)))
+	}
+
+	It("starts the reclaim agent and unbinds the host", func() {
+		mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), fmt.Sprintf("%s/%s", clusterID, constants.Kubeconfig)).Return(true, nil)
+		mockSpokeClientFactory.EXPECT().CreateFromStorageKubeconfig(gomock.Any(), &clusterID, mockS3Client).Return(mockSpokeClient, nil)
+		mockReclaimer.EXPECT().StartReclaimAgent(gomock.Any(), mockSpokeClient, gomock.Any(), "worker-0", infraEnvID.String(), hostID.String()).Return(nil)
+		mockHostApi.EXPECT().UnbindHost(gomock.Any(), gomock.Any(), gomock.Any(), true).Return(nil)
+		mockClusterApi.EXPECT().RefreshStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+		mockClusterApi.EXPECT().RefreshSchedulableMastersForcedTrue(gomock.Any(), clusterID).Return(nil)
+		mockEvents.EXPECT().SendHostEvent(gomock.Any(), eventstest.NewEventMatcher(
+			eventstest.WithNameMatcher(eventgen.HostReclaimStartedEventName),
+			eventstest.WithHostIdMatcher(hostID.String()),
+			eventstest.WithInfraEnvIdMatcher(infraEnvID.String()),
+			eventstest.WithClusterIdMatcher(clusterID.String()),
+			eventstest.WithSeverityMatcher(models.EventSeverityInfo)))
+		response := bm.V2ReclaimHost(ctx, params)
+		Expect(response).To(BeAssignableToTypeOf(&installer.V2ReclaimHostOK{}))
+	})
+
+	It("fails when the host is not installed", func() {
+		Expect(db.Model(&models.Host{}).Where("id = ?", hostID).Update("status", models.HostStatusKnown).Error).ShouldNot(HaveOccurred())
+		expectReclaimFailedEvent()
+		verifyApiError(bm.V2ReclaimHost(ctx, params), http.StatusConflict)
+	})
+
+	It("fails when the host is not bound", func() {
+		Expect(db.Model(&models.Host{}).Where("id = ?", hostID).Update("cluster_id", nil).Error).ShouldNot(HaveOccurred())
+		expectReclaimFailedEvent()
+		verifyApiError(bm.V2ReclaimHost(ctx, params), http.StatusConflict)
+	})
+
+	It("fails when the infra-env is bound to the cluster", func() {
+		Expect(db.Model(&models.InfraEnv{}).Where("id = ?", infraEnvID).Update("cluster_id", clusterID).Error).ShouldNot(HaveOccurred())
+		expectReclaimFailedEvent()
+		verifyApiError(bm.V2ReclaimHost(ctx, params), http.StatusConflict)
+	})
+
+	It("fails when the cluster is not a day-2 cluster", func() {
+		Expect(db.Model(&models.Cluster{}).Where("id = ?", clusterID).Update("kind", models.ClusterKindCluster).Error).ShouldNot(HaveOccurred())
+		expectReclaimFailedEvent()
+		verifyApiError(bm.V2ReclaimHost(ctx, params), http.StatusConflict)
+	})
+
+	It("fails without an event when the host doesn't exist", func() {
+		params.HostID = strfmt.UUID(uuid.New().String())
+		verifyApiError(bm.V2ReclaimHost(ctx, params), http.StatusNotFound)
+	})
+
+	It("fails when the service has no kubeconfig for the cluster", func() {
+		mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), fmt.Sprintf("%s/%s", clusterID, constants.Kubeconfig)).Return(false, nil)
+		expectReclaimFailedEvent()
+		verifyApiError(bm.V2ReclaimHost(ctx, params), http.StatusConflict)
+	})
+
+	It("fails when the cluster kubeconfig is not available", func() {
+		mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), fmt.Sprintf("%s/%s", clusterID, constants.Kubeconfig)).Return(true, nil)
+		mockSpokeClientFactory.EXPECT().CreateFromStorageKubeconfig(gomock.Any(), &clusterID, mockS3Client).Return(nil, errors.New("not found"))
+		expectReclaimFailedEvent()
+		verifyApiError(bm.V2ReclaimHost(ctx, params), http.StatusConflict)
+	})
+
+	It("does not unbind the host when the reclaim agent fails to start", func() {
+		mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), fmt.Sprintf("%s/%s", clusterID, constants.Kubeconfig)).Return(true, nil)
+		mockSpokeClientFactory.EXPECT().CreateFromStorageKubeconfig(gomock.Any(), &clusterID, mockS3Client).Return(mockSpokeClient, nil)
+		mockReclaimer.EXPECT().StartReclaimAgent(gomock.Any(), mockSpokeClient, gomock.Any(), "worker-0", infraEnvID.String(), hostID.String()).Return(errors.New("node not found"))
+		expectReclaimFailedEvent()
+		verifyApiError(bm.V2ReclaimHost(ctx, params), http.StatusInternalServerError)
+	})
+})
+
+var _ = Describe("Cluster bundles", func() {
+	var (
+		bm               *bareMetalInventory
+		cfg              Config
+		db               *gorm.DB
+		ctx              = context.Background()
+		clusterID        strfmt.UUID
+		dbName           string
+		mockManifestsApi *manifestsapi.MockClusterManifestsInternals
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		clusterID = strfmt.UUID(uuid.New().String())
+		bm = createInventory(db, cfg)
+		mockManifestsApi = manifestsapi.NewMockClusterManifestsInternals(ctrl)
+		bm.manifestsApi = mockManifestsApi
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	Context("V2ExportCluster", func() {
+		BeforeEach(func() {
+			c := &common.Cluster{
+				Cluster: models.Cluster{
+					ID:               &clusterID,
+					Name:             "exported",
+					OpenshiftVersion: common.TestDefaultConfig.OpenShiftVersion,
+					BaseDNSDomain:    "example.com",
+					ClusterNetworks:  []*models.ClusterNetwork{{ClusterID: clusterID, Cidr: "10.128.0.0/14", HostPrefix: 23}},
+					MachineNetworks:  []*models.MachineNetwork{{ClusterID: clusterID, Cidr: "1.2.3.0/24"}},
+					APIVips:          []*models.APIVip{{ClusterID: clusterID, IP: "1.2.3.5"}},
+					Platform: &models.Platform{
+						Type:    common.PlatformTypePtr(models.PlatformTypeVsphere),
+						Vsphere: &models.VspherePlatform{Vcenter: "vcenter.example.com", Username: "admin"},
+					},
+					MonitoredOperators: []*models.MonitoredOperator{
+						{ClusterID: clusterID, Name: "console", OperatorType: models.OperatorTypeBuiltin},
+						{ClusterID: clusterID, Name: "lso", OperatorType: models.OperatorTypeOlm},
+					},
+					InstallConfigOverrides: `{"fips":true}`,
+				},
+				PullSecret: fakePullSecret,
+			}
+			Expect(db.Create(c).Error).ShouldNot(HaveOccurred())
+			infraEnvID := strfmt.UUID(uuid.New().String())
+			Expect(db.Create(&common.InfraEnv{InfraEnv: models.InfraEnv{
+				ID:                  &infraEnvID,
+				ClusterID:           clusterID,
+				Type:                common.ImageTypePtr(models.ImageTypeMinimalIso),
+				StaticNetworkConfig: `[{"mac_interface_map":[{"logical_nic_name":"eth0","mac_address":"52:00:00:01:02:03"}],"network_yaml":"interfaces: []"}]`,
+			}}).Error).ShouldNot(HaveOccurred())
+			hostID := strfmt.UUID(uuid.New().String())
+			inventory, err := common.MarshalInventory(&models.Inventory{Interfaces: []*models.Interface{{MacAddress: "52:00:00:01:02:03"}}})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(db.Create(&common.Host{Host: models.Host{ID: &hostID, InfraEnvID: infraEnvID, ClusterID: &clusterID,
+				Status: swag.String(models.HostStatusKnown), Role: models.HostRoleBootstrap, RequestedHostname: "master-0",
+				Inventory: inventory}}).Error).ShouldNot(HaveOccurred())
+		})
+
+		It("exports the configuration without credentials", func() {
+			mockManifestsApi.EXPECT().ListClusterManifestsInternal(gomock.Any(), gomock.Any()).
+				Return(models.ListManifests{{Folder: "openshift", FileName: "custom.yaml"}}, nil)
+			mockS3Client.EXPECT().Download(gomock.Any(), fmt.Sprintf("%s/manifests/openshift/custom.yaml", clusterID)).
+				Return(io.NopCloser(strings.NewReader("kind: ConfigMap")), int64(15), nil)
+
+			response := bm.V2ExportCluster(ctx, installer.V2ExportClusterParams{ClusterID: clusterID})
+			Expect(response).To(BeAssignableToTypeOf(&installer.V2ExportClusterOK{}))
+			bundle := response.(*installer.V2ExportClusterOK).Payload
+			Expect(swag.StringValue(bundle.Version)).To(Equal(models.ClusterBundleVersionV1))
+			Expect(bundle.SourceClusterID).To(Equal(clusterID))
+			Expect(swag.StringValue(bundle.Cluster.Name)).To(Equal("exported"))
+			Expect(bundle.Cluster.ClusterNetworks).To(Equal([]*models.ClusterNetwork{{Cidr: "10.128.0.0/14", HostPrefix: 23}}))
+			Expect(bundle.Cluster.APIVips).To(Equal([]*models.APIVip{{IP: "1.2.3.5"}}))
+			Expect(bundle.Cluster.Platform.Vsphere.Username).To(Equal("admin"))
+			Expect(bundle.Cluster.OlmOperators).To(Equal([]*models.OperatorCreateParams{{Name: "lso"}}))
+			Expect(bundle.Cluster.InstallConfigOverrides).To(Equal(`{"fips":true}`))
+			Expect(bundle.InfraEnv.ImageType).To(Equal(models.ImageTypeMinimalIso))
+			Expect(bundle.InfraEnv.StaticNetworkConfig).To(HaveLen(1))
+			Expect(bundle.Hosts).To(Equal([]*models.ClusterBundleHost{{
+				MacAddresses: []string{"52:00:00:01:02:03"}, Hostname: "master-0", Role: models.HostRoleUpdateParamsMaster}}))
+			Expect(bundle.Manifests).To(Equal([]*models.ClusterBundleManifest{{Folder: swag.String("openshift"),
+				FileName: swag.String("custom.yaml"), Content: swag.String(base64.StdEncoding.EncodeToString([]byte("kind: ConfigMap")))}}))
+
+			serialized, err := json.Marshal(bundle)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(serialized)).ToNot(ContainSubstring("auths"))
+		})
+
+		It("fails for a missing cluster", func() {
+			response := bm.V2ExportCluster(ctx, installer.V2ExportClusterParams{ClusterID: strfmt.UUID(uuid.New().String())})
+			verifyApiError(response, http.StatusNotFound)
+		})
+	})
+
+	Context("V2ImportClusterBundle", func() {
+		var bundle *models.ClusterBundle
+
+		importParams := func() installer.V2ImportClusterBundleParams {
+			return installer.V2ImportClusterBundleParams{ImportClusterBundleParams: &models.ImportClusterBundleParams{
+				Bundle:     bundle,
+				PullSecret: swag.String(fakePullSecret),
+			}}
+		}
+
+		BeforeEach(func() {
+			bundle = &models.ClusterBundle{
+				Version: swag.String(models.ClusterBundleVersionV1),
+				Cluster: &models.ClusterBundleCluster{
+					Name:             swag.String("imported"),
+					OpenshiftVersion: swag.String(common.TestDefaultConfig.OpenShiftVersion),
+					BaseDNSDomain:    "example.com",
+					APIVips:          []*models.APIVip{{IP: "1.2.3.5"}},
+				},
+				InfraEnv: &models.ClusterBundleInfraEnv{ImageType: models.ImageTypeMinimalIso},
+				Manifests: []*models.ClusterBundleManifest{{Folder: swag.String("openshift"), FileName: swag.String("custom.yaml"),
+					Content: swag.String(base64.StdEncoding.EncodeToString([]byte("kind: ConfigMap")))}},
+				Hosts: []*models.ClusterBundleHost{{MacAddresses: []string{"52:00:00:01:02:03"}, Role: models.HostRoleUpdateParamsMaster}},
+			}
+		})
+
+		It("refuses bundles with blocking conflicts", func() {
+			mockVersions.EXPECT().GetReleaseImage(gomock.Any(), gomock.Any()).Return(nil, errors.New("unsupported"))
+			bundle.Cluster.OlmOperators = []*models.OperatorCreateParams{{Name: "unknown"}}
+			mockOperatorManager.EXPECT().GetOperatorByName("unknown").Return(nil, errors.New("not found"))
+
+			response := bm.V2ImportClusterBundle(ctx, importParams())
+			Expect(response).To(BeAssignableToTypeOf(&installer.V2ImportClusterBundleConflict{}))
+			result := response.(*installer.V2ImportClusterBundleConflict).Payload
+			Expect(result.ClusterID).To(BeEmpty())
+			fields := make([]string, 0)
+			for _, conflict := range result.Conflicts {
+				Expect(swag.BoolValue(conflict.Blocking)).To(BeTrue())
+				fields = append(fields, swag.StringValue(conflict.Field))
+			}
+			Expect(fields).To(ConsistOf("cluster.openshift_version", "cluster.olm_operators.0"))
+
+			var count int64
+			Expect(db.Model(&common.Cluster{}).Count(&count).Error).ShouldNot(HaveOccurred())
+			Expect(count).To(BeZero())
+		})
+
+		It("refuses invalid MAC addresses", func() {
+			mockVersions.EXPECT().GetReleaseImage(gomock.Any(), gomock.Any()).Return(common.TestDefaultConfig.ReleaseImage, nil)
+			bundle.Hosts[0].MacAddresses = []string{"%"}
+
+			response := bm.V2ImportClusterBundle(ctx, importParams())
+			Expect(response).To(BeAssignableToTypeOf(&installer.V2ImportClusterBundleConflict{}))
+			conflicts := response.(*installer.V2ImportClusterBundleConflict).Payload.Conflicts
+			Expect(conflicts).To(HaveLen(1))
+			Expect(swag.StringValue(conflicts[0].Field)).To(Equal("hosts.0.mac_addresses"))
+		})
+
+		It("registers the cluster and infra-env and reports the non-blocking conflicts", func() {
+			existingID := strfmt.UUID(uuid.New().String())
+			Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &existingID, Name: "imported", BaseDNSDomain: "example.com",
+				APIVips: []*models.APIVip{{ClusterID: existingID, IP: "1.2.3.5"}}}}).Error).ShouldNot(HaveOccurred())
+			existingHostID := strfmt.UUID(uuid.New().String())
+			Expect(db.Create(&common.Host{Host: models.Host{ID: &existingHostID, InfraEnvID: strfmt.UUID(uuid.New().String()), ClusterID: &existingID,
+				Inventory: `{"interfaces":[{"name":"eth0","mac_address":"52:00:00:0a:0b:0c"}]}`}}).Error).ShouldNot(HaveOccurred())
+			bundle.Hosts[0].MacAddresses = []string{"52:00:00:01:02:03", "52:00:00:0A:0B:0C"}
+
+			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
+				db, mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			mockVersions.EXPECT().GetReleaseImage(gomock.Any(), gomock.Any()).Return(common.TestDefaultConfig.ReleaseImage, nil)
+			mockClusterRegisterSuccess(true)
+			mockInfraEnvRegisterSuccess()
+			mockUsageReports()
+			mockEvents.EXPECT().SendInfraEnvEvent(gomock.Any(), eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.InfraEnvRegisteredEventName))).Times(1)
+			mockManifestsApi.EXPECT().CreateClusterManifestInternal(gomock.Any(), gomock.Any()).Return(&models.Manifest{}, nil)
+
+			response := bm.V2ImportClusterBundle(ctx, importParams())
+			Expect(response).To(BeAssignableToTypeOf(&installer.V2ImportClusterBundleCreated{}))
+			result := response.(*installer.V2ImportClusterBundleCreated).Payload
+			Expect(result.ClusterID).ToNot(BeEmpty())
+			Expect(result.InfraEnvID).ToNot(BeEmpty())
+			fields := make([]string, 0)
+			for _, conflict := range result.Conflicts {
+				Expect(swag.BoolValue(conflict.Blocking)).To(BeFalse())
+				fields = append(fields, swag.StringValue(conflict.Field))
+			}
+			Expect(fields).To(ConsistOf("cluster.name", "cluster.api_vips.0", "hosts.0.mac_addresses"))
+
+			c, err := common.GetClusterFromDB(db, result.ClusterID, common.SkipEagerLoading)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(c.ImportedHosts).To(ContainSubstring("52:00:00:01:02:03"))
+			infraEnv, err := common.GetInfraEnvFromDB(db, result.InfraEnvID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(infraEnv.ClusterID).To(Equal(result.ClusterID))
+		})
+
+		It("imports the export of a vSphere cluster without its password", func() {
+			os.Setenv("CREDENTIALS_ENCRYPTION_KEY", "key")
+			defer os.Unsetenv("CREDENTIALS_ENCRYPTION_KEY")
+			encrypted, err := gencrypto.EncryptSecret("vcenter-password")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(db.Create(&common.Cluster{
+				Cluster: models.Cluster{
+					ID:               &clusterID,
+					Name:             "vsphere",
+					OpenshiftVersion: common.TestDefaultConfig.OpenShiftVersion,
+					BaseDNSDomain:    "example.com",
+					Platform: &models.Platform{
+						Type: common.PlatformTypePtr(models.PlatformTypeVsphere),
+						Vsphere: &models.VspherePlatform{Vcenter: "vcenter.example.com", Datacenter: "dc", Cluster: "cluster",
+							DefaultDatastore: "datastore", Network: "VM Network", Username: "admin"},
+					},
+				},
+				PullSecret:               fakePullSecret,
+				VsphereEncryptedPassword: encrypted,
+			}).Error).ShouldNot(HaveOccurred())
+			mockManifestsApi.EXPECT().ListClusterManifestsInternal(gomock.Any(), gomock.Any()).Return(models.ListManifests{}, nil)
+			exported := bm.V2ExportCluster(ctx, installer.V2ExportClusterParams{ClusterID: clusterID})
+			Expect(exported).To(BeAssignableToTypeOf(&installer.V2ExportClusterOK{}))
+			bundle = exported.(*installer.V2ExportClusterOK).Payload
+			Expect(bundle.Cluster.Platform.Vsphere.Password).To(BeEmpty())
+
+			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
+				db, mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			mockVersions.EXPECT().GetReleaseImage(gomock.Any(), gomock.Any()).Return(common.TestDefaultConfig.ReleaseImage, nil)
+			mockClusterRegisterSuccess(true)
+			mockInfraEnvRegisterSuccess()
+			mockUsageReports()
+			mockEvents.EXPECT().SendInfraEnvEvent(gomock.Any(), eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.InfraEnvRegisteredEventName))).Times(1)
+
+			params := importParams()
+			params.ImportClusterBundleParams.Name = "vsphere-copy"
+			response := bm.V2ImportClusterBundle(ctx, params)
+			Expect(response).To(BeAssignableToTypeOf(&installer.V2ImportClusterBundleCreated{}))
+			result := response.(*installer.V2ImportClusterBundleCreated).Payload
+			Expect(result.Conflicts).To(HaveLen(1))
+			Expect(swag.StringValue(result.Conflicts[0].Field)).To(Equal("cluster.platform.vsphere.password"))
+			Expect(swag.BoolValue(result.Conflicts[0].Blocking)).To(BeFalse())
+
+			c, err := common.GetClusterFromDB(db, result.ClusterID, common.SkipEagerLoading)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(common.PlatformTypeValue(c.Platform.Type)).To(Equal(models.PlatformTypeVsphere))
+			Expect(c.Platform.Vsphere.Vcenter).To(Equal("vcenter.example.com"))
+			Expect(c.Platform.Vsphere.Username).To(Equal("admin"))
+			Expect(c.VsphereEncryptedPassword).To(BeEmpty())
+		})
+
+		It("still requires the vCenter password when registering a vSphere cluster", func() {
+			mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.ClusterRegistrationFailedEventName))).Times(1)
+			response := bm.V2RegisterCluster(ctx, installer.V2RegisterClusterParams{NewClusterParams: &models.ClusterCreateParams{
+				Name:             swag.String("vsphere"),
+				OpenshiftVersion: swag.String(common.TestDefaultConfig.OpenShiftVersion),
+				PullSecret:       swag.String(fakePullSecret),
+				BaseDNSDomain:    "example.com",
+				Platform: &models.Platform{
+					Type: common.PlatformTypePtr(models.PlatformTypeVsphere),
+					Vsphere: &models.VspherePlatform{Vcenter: "vcenter.example.com", Datacenter: "dc", Cluster: "cluster",
+						DefaultDatastore: "datastore", Network: "VM Network", Username: "admin"},
+				},
+			}})
+			verifyApiErrorString(response, http.StatusBadRequest, "missing the following fields: password")
+		})
+	})
+
+	Context("V2RegisterCluster with clone", func() {
+		var sourceID strfmt.UUID
+
+		cloneParams := func(withInfraEnv bool) installer.V2RegisterClusterParams {
+			return installer.V2RegisterClusterParams{NewClusterParams: &models.ClusterCreateParams{
+				Name:             swag.String("clone"),
+				OpenshiftVersion: swag.String(common.TestDefaultConfig.OpenShiftVersion),
+				PullSecret:       swag.String(fakePullSecret),
+				Clone: &models.ClusterCloneParams{
+					SourceClusterID: &sourceID,
+					WithInfraEnv:    swag.Bool(withInfraEnv),
+				},
+			}}
+		}
+
+		BeforeEach(func() {
+			sourceID = clusterID
+			Expect(db.Create(&common.Cluster{
+				Cluster: models.Cluster{
+					ID:               &sourceID,
+					Name:             "source",
+					OpenshiftVersion: common.TestDefaultConfig.OpenShiftVersion,
+					BaseDNSDomain:    "example.com",
+					ClusterNetworks:  []*models.ClusterNetwork{{ClusterID: sourceID, Cidr: "10.128.0.0/14", HostPrefix: 23}},
+					ServiceNetworks:  []*models.ServiceNetwork{{ClusterID: sourceID, Cidr: "172.30.0.0/16"}},
+					MachineNetworks:  []*models.MachineNetwork{{ClusterID: sourceID, Cidr: "1.2.3.0/24"}},
+					APIVips:          []*models.APIVip{{ClusterID: sourceID, IP: "1.2.3.5"}},
+					IngressVips:      []*models.IngressVip{{ClusterID: sourceID, IP: "1.2.3.6"}},
+					FeatureUsage:     `{"Static Network Config":{"id":"STATIC_NETWORK_CONFIG","name":"Static Network Config"}}`,
+					UserName:         "owner",
+					OrgID:            "owner-org",
+				},
+				PullSecret: fakePullSecret,
+			}).Error).ShouldNot(HaveOccurred())
+		})
+
+		It("registers a cluster with the settings of the source cluster", func() {
+			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
+				db, mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			mockManifestsApi.EXPECT().ListClusterManifestsInternal(gomock.Any(), gomock.Any()).Return(models.ListManifests{}, nil)
+			mockVersions.EXPECT().GetReleaseImage(gomock.Any(), gomock.Any()).Return(common.TestDefaultConfig.ReleaseImage, nil)
+			mockClusterRegisterSuccess(true)
+			mockUsage.EXPECT().Add(gomock.Any(), "Static Network Config", gomock.Any()).Times(1)
+			mockUsageReports()
+			mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.ClusterCloneConflictEventName))).Times(0)
+			mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.ClusterClonedEventName))).Times(1)
+
+			response := bm.V2RegisterCluster(ctx, cloneParams(false))
+			Expect(response).To(BeAssignableToTypeOf(&installer.V2RegisterClusterCreated{}))
+			c := response.(*installer.V2RegisterClusterCreated).Payload
+			Expect(*c.ID).ToNot(Equal(sourceID))
+			Expect(c.Name).To(Equal("clone"))
+			Expect(c.BaseDNSDomain).To(Equal("example.com"))
+			Expect(c.ClusterNetworks).To(HaveLen(1))
+			Expect(c.ClusterNetworks[0].Cidr).To(Equal(models.Subnet("10.128.0.0/14")))
+			Expect(c.MachineNetworks).To(HaveLen(1))
+			Expect(c.APIVips).To(BeEmpty())
+			Expect(c.IngressVips).To(BeEmpty())
+
+			infraEnvs, err := common.GetInfraEnvsFromDBWhere(db, "cluster_id = ?", c.ID.String())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(infraEnvs).To(BeEmpty())
+		})
+
+		It("copies the vCenter password of a vSphere cluster", func() {
+			os.Setenv("CREDENTIALS_ENCRYPTION_KEY", "key")
+			defer os.Unsetenv("CREDENTIALS_ENCRYPTION_KEY")
+			encrypted, err := gencrypto.EncryptSecret("vcenter-password")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(db.Model(&common.Cluster{}).Where("id = ?", sourceID.String()).Updates(map[string]interface{}{
+				"platform_type":                      models.PlatformTypeVsphere,
+				"platform_vsphere_vcenter":           "vcenter.example.com",
+				"platform_vsphere_datacenter":        "dc",
+				"platform_vsphere_cluster":           "cluster",
+				"platform_vsphere_default_datastore": "datastore",
+				"platform_vsphere_network":           "VM Network",
+				"platform_vsphere_username":          "admin",
+				"vsphere_encrypted_password":         encrypted,
+			}).Error).ShouldNot(HaveOccurred())
+
+			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
+				db, mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			mockManifestsApi.EXPECT().ListClusterManifestsInternal(gomock.Any(), gomock.Any()).Return(models.ListManifests{}, nil)
+			mockVersions.EXPECT().GetReleaseImage(gomock.Any(), gomock.Any()).Return(common.TestDefaultConfig.ReleaseImage, nil)
+			mockClusterRegisterSuccess(true)
+			mockUsage.EXPECT().Add(gomock.Any(), "Static Network Config", gomock.Any()).Times(1)
+			mockUsageReports()
+			mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.ClusterCloneConflictEventName))).Times(0)
+			mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.ClusterClonedEventName))).Times(1)
+
+			response := bm.V2RegisterCluster(ctx, cloneParams(false))
+			Expect(response).To(BeAssignableToTypeOf(&installer.V2RegisterClusterCreated{}))
+			c := response.(*installer.V2RegisterClusterCreated).Payload
+			Expect(common.PlatformTypeValue(c.Platform.Type)).To(Equal(models.PlatformTypeVsphere))
+			Expect(c.Platform.Vsphere.Password).To(BeEmpty())
+
+			clone, err := common.GetClusterFromDB(db, *c.ID, common.SkipEagerLoading)
+			Expect(err).ToNot(HaveOccurred())
+			password, err := gencrypto.DecryptSecret(clone.VsphereEncryptedPassword)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(password).To(Equal("vcenter-password"))
+		})
+
+		It("refuses to clone a cluster the user can't read", func() {
+			authCfg := auth.GetConfigRHSSO()
+			bm.authzHandler = auth.NewAuthzHandler(authCfg, nil, common.GetTestLog().WithField("pkg", "auth"), db)
+			payload := &ocm.AuthPayload{Role: ocm.UserRole}
+			payload.Username = "other"
+			payload.Organization = "other-org"
+			authCtx := context.WithValue(ctx, restapi.AuthKey, payload)
+
+			response := bm.V2RegisterCluster(authCtx, cloneParams(true))
+			verifyApiError(response, http.StatusNotFound)
+		})
+
+		It("fails for a missing source cluster", func() {
+			sourceID = strfmt.UUID(uuid.New().String())
+			response := bm.V2RegisterCluster(ctx, cloneParams(true))
+			verifyApiError(response, http.StatusNotFound)
+		})
+
+		It("fails when the source can't be recreated", func() {
+			mockManifestsApi.EXPECT().ListClusterManifestsInternal(gomock.Any(), gomock.Any()).Return(models.ListManifests{}, nil)
+			mockVersions.EXPECT().GetReleaseImage(gomock.Any(), gomock.Any()).Return(nil, errors.New("unsupported"))
+
+			response := bm.V2RegisterCluster(ctx, cloneParams(true))
+			verifyApiErrorString(response, http.StatusBadRequest, "can't be cloned")
+		})
+	})
+})
+
+var _ = Describe("V2UpdateCluster host refresh", func() {
+	var (
+		bm        *bareMetalInventory
+		cfg       Config
+		db        *gorm.DB
+		ctx       = context.Background()
+		clusterID strfmt.UUID
+		dbName    string
+		hostApi   *host.MockAPI
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		clusterID = strfmt.UUID(uuid.New().String())
+		bm = createInventory(db, cfg)
+		bm.ocmClient = nil
+		hostApi = host.NewMockAPI(ctrl)
+		bm.hostApi = hostApi
+		mockUsageReports()
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{
+			ID:               &clusterID,
+			Name:             "refresh",
+			OpenshiftVersion: common.TestDefaultConfig.OpenShiftVersion,
+			BaseDNSDomain:    "example.com",
+		}}).Error).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("queues a refresh of the hosts of the cluster", func() {
+		mockClusterApi.EXPECT().VerifyClusterUpdatability(gomock.Any()).Return(nil).Times(1)
+		mockClusterUpdateSuccess(1, 0)
+		hostApi.EXPECT().RequestClusterHostsRefresh(clusterID).Times(1)
+		response := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
+			ClusterID:           clusterID,
+			ClusterUpdateParams: &models.V2ClusterUpdateParams{Name: swag.String("renamed")},
+		})
+		Expect(response).To(BeAssignableToTypeOf(installer.NewV2UpdateClusterCreated()))
+	})
+
+	It("doesn't queue a refresh when the update fails", func() {
+		mockClusterApi.EXPECT().VerifyClusterUpdatability(gomock.Any()).Return(errors.New("not updatable")).Times(1)
+		response := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
+			ClusterID:           clusterID,
+			ClusterUpdateParams: &models.V2ClusterUpdateParams{Name: swag.String("renamed")},
+		})
+		Expect(response).NotTo(BeAssignableToTypeOf(installer.NewV2UpdateClusterCreated()))
+	})
+})
+
+var _ = Describe("Cluster revisions", func() {
+	var (
+		bm        *bareMetalInventory
+		cfg       Config
+		db        *gorm.DB
+		ctx       = context.Background()
+		clusterID strfmt.UUID
+		dbName    string
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		clusterID = strfmt.UUID(uuid.New().String())
+		bm = createInventory(db, cfg)
+		bm.ocmClient = nil
+		mockUsageReports()
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{
+			ID:               &clusterID,
+			Name:             "revisions",
+			OpenshiftVersion: common.TestDefaultConfig.OpenShiftVersion,
+			BaseDNSDomain:    "example.com",
+		}}).Error).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	update := func(params *models.V2ClusterUpdateParams) {
+		mockClusterApi.EXPECT().VerifyClusterUpdatability(gomock.Any()).Return(nil).Times(1)
+		mockClusterUpdateSuccess(1, 0)
+		response := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{ClusterID: clusterID, ClusterUpdateParams: params})
+		Expect(response).To(BeAssignableToTypeOf(installer.NewV2UpdateClusterCreated()))
+	}
+
+	listRevisions := func() models.ClusterRevisionList {
+		response := bm.V2ListClusterRevisions(ctx, installer.V2ListClusterRevisionsParams{ClusterID: clusterID})
+		Expect(response).To(BeAssignableToTypeOf(installer.NewV2ListClusterRevisionsOK()))
+		return response.(*installer.V2ListClusterRevisionsOK).Payload
+	}
+
+	Context("V2ListClusterRevisions", func() {
+		It("doesn't list revisions of a cluster that wasn't updated", func() {
+			Expect(listRevisions()).To(BeEmpty())
+		})
+
+		It("records the configuration before the first update and after every update", func() {
+			update(&models.V2ClusterUpdateParams{Name: swag.String("renamed")})
+			update(&models.V2ClusterUpdateParams{AdditionalNtpSource: swag.String("1.1.1.1")})
+
+			revisions := listRevisions()
+			Expect(revisions).To(HaveLen(3))
+			for i, revision := range revisions {
+				Expect(*revision.ClusterID).To(Equal(clusterID))
+				Expect(swag.Int64Value(revision.Revision)).To(Equal(int64(i + 1)))
+			}
+			Expect(revisions[0].Diff).To(BeEmpty())
+			Expect(*revisions[0].Configuration).To(ContainSubstring(`"name":"revisions"`))
+			Expect(revisions[1].Diff).To(MatchJSON(`[{"op": "replace", "path": "/name", "value": "renamed"}]`))
+			Expect(*revisions[1].Configuration).To(ContainSubstring(`"name":"renamed"`))
+			Expect(revisions[2].Diff).To(MatchJSON(`[{"op": "add", "path": "/additional_ntp_source", "value": "1.1.1.1"}]`))
+		})
+
+		It("numbers a revision after the last one", func() {
+			update(&models.V2ClusterUpdateParams{Name: swag.String("renamed")})
+			Expect(db.Where("cluster_id = ? AND revision = ?", clusterID.String(), 1).
+				Delete(&common.ClusterRevision{}).Error).ShouldNot(HaveOccurred())
+			update(&models.V2ClusterUpdateParams{AdditionalNtpSource: swag.String("1.1.1.1")})
+
+			revisions := listRevisions()
+			Expect(revisions).To(HaveLen(2))
+			Expect(swag.Int64Value(revisions[0].Revision)).To(Equal(int64(2)))
+			Expect(swag.Int64Value(revisions[1].Revision)).To(Equal(int64(3)))
+		})
+
+		It("doesn't record updates that leave the configuration unchanged", func() {
+			update(&models.V2ClusterUpdateParams{Name: swag.String("renamed")})
+			update(&models.V2ClusterUpdateParams{Name: swag.String("renamed")})
+			Expect(listRevisions()).To(HaveLen(2))
+		})
+
+		It("doesn't record the install config overrides", func() {
+			Expect(db.Model(&common.Cluster{}).Where("id = ?", clusterID).
+				Update("install_config_overrides", `{"fips":true}`).Error).ShouldNot(HaveOccurred())
+			update(&models.V2ClusterUpdateParams{Name: swag.String("renamed")})
+			for _, revision := range listRevisions() {
+				Expect(*revision.Configuration).ToNot(ContainSubstring("fips"))
+			}
+		})
+
+		It("returns not found with a non-existant cluster", func() {
+			response := bm.V2ListClusterRevisions(ctx, installer.V2ListClusterRevisionsParams{
+				ClusterID: strfmt.UUID(uuid.New().String()),
+			})
+			verifyApiError(response, http.StatusNotFound)
+		})
+	})
+
+	Context("V2RollbackCluster", func() {
+		BeforeEach(func() {
+			update(&models.V2ClusterUpdateParams{Name: swag.String("renamed")})
+			update(&models.V2ClusterUpdateParams{AdditionalNtpSource: swag.String("1.1.1.1")})
+		})
+
+		It("rolls the cluster back to the configuration of the revision", func() {
+			mockClusterApi.EXPECT().VerifyClusterUpdatability(gomock.Any()).Return(nil).Times(1)
+			mockClusterUpdateSuccess(1, 0)
+			mockOperatorManager.EXPECT().ResolveDependencies(gomock.Any(), gomock.Any()).Return([]*models.MonitoredOperator{}, nil).Times(1)
+			mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.ClusterRolledBackEventName),
+				eventstest.WithClusterIdMatcher(clusterID.String())))
+
+			response := bm.V2RollbackCluster(ctx, installer.V2RollbackClusterParams{ClusterID: clusterID, Revision: 1})
+			Expect(response).To(BeAssignableToTypeOf(installer.NewV2RollbackClusterAccepted()))
+			cluster := response.(*installer.V2RollbackClusterAccepted).Payload
+			Expect(cluster.Name).To(Equal("revisions"))
+			Expect(cluster.AdditionalNtpSource).To(BeEmpty())
+
+			revisions := listRevisions()
+			Expect(revisions).To(HaveLen(4))
+			Expect(*revisions[3].Configuration).To(MatchJSON(*revisions[0].Configuration))
+		})
+
+		It("fails for a missing revision", func() {
+			response := bm.V2RollbackCluster(ctx, installer.V2RollbackClusterParams{ClusterID: clusterID, Revision: 10})
+			verifyApiError(response, http.StatusNotFound)
+		})
+
+		It("fails when the cluster can't be updated", func() {
+			mockClusterApi.EXPECT().VerifyClusterUpdatability(gomock.Any()).Return(errors.New("installed")).Times(1)
+			response := bm.V2RollbackCluster(ctx, installer.V2RollbackClusterParams{ClusterID: clusterID, Revision: 1})
+			verifyApiError(response, http.StatusConflict)
+			Expect(listRevisions()).To(HaveLen(3))
+		})
+	})
+})
+
+var _ = Describe("V2GarbageCollectionDryRun", func() {
+	var (
+		bm     *bareMetalInventory
+		cfg    Config
+		db     *gorm.DB
+		ctx    = context.Background()
+		dbName string
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		bm = createInventory(db, cfg)
+		bm.gcConfig = garbagecollector.Config{
+			DeregisterInactiveAfter:     480 * time.Hour,
+			DeletedUnregisteredAfter:    72 * time.Hour,
+			InfraenvDeleteInactiveAfter: 480 * time.Hour,
+			MaxGCClustersPerInterval:    100,
+			MaxGCInfraEnvsPerInterval:   100,
+			Policies: garbagecollector.Policies{
+				{Name: "production", ClusterTag: "production", DeregisterInactiveAfter: &garbagecollector.Retention{Never: true}},
+				{Name: "lab", OrgID: "lab-org", DeregisterInactiveAfter: &garbagecollector.Retention{Duration: time.Hour}},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	createCluster := func(name, orgID, tags string, updatedAt time.Time) strfmt.UUID {
+		id := strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{ID: &id, Name: name, OrgID: orgID, Tags: tags}}).Error).ShouldNot(HaveOccurred())
+		Expect(db.Model(&common.Cluster{}).Where("id = ?", id.String()).UpdateColumn("updated_at", updatedAt).Error).ShouldNot(HaveOccurred())
+		return id
+	}
+
+	It("lists the records that the policies would collect", func() {
+		twoDaysAgo := time.Now().Add(-48 * time.Hour)
+		createCluster("production", "lab-org", "edge,production", twoDaysAgo)
+		labID := createCluster("lab", "lab-org", "", twoDaysAgo)
+		createCluster("other", "other-org", "", twoDaysAgo)
+		deletedID := createCluster("deleted", "other-org", "", twoDaysAgo)
+		Expect(db.Model(&common.Cluster{}).Where("id = ?", deletedID.String()).
+			UpdateColumn("deleted_at", time.Now().Add(-96*time.Hour)).Error).ShouldNot(HaveOccurred())
+
+		response := bm.V2GarbageCollectionDryRun(ctx, installer.V2GarbageCollectionDryRunParams{})
+		Expect(response).To(BeAssignableToTypeOf(installer.NewV2GarbageCollectionDryRunOK()))
+		candidates := response.(*installer.V2GarbageCollectionDryRunOK).Payload
+		Expect(candidates).To(HaveLen(2))
+
+		Expect(*candidates[0].ID).To(Equal(labID))
+		Expect(swag.StringValue(candidates[0].Kind)).To(Equal(models.GarbageCollectionCandidateKindCluster))
+		Expect(swag.StringValue(candidates[0].Action)).To(Equal(models.GarbageCollectionCandidateActionDeregister))
+		Expect(swag.StringValue(candidates[0].Policy)).To(Equal("lab"))
+		Expect(candidates[0].Name).To(Equal("lab"))
+
+		Expect(*candidates[1].ID).To(Equal(deletedID))
+		Expect(swag.StringValue(candidates[1].Action)).To(Equal(models.GarbageCollectionCandidateActionDelete))
+		Expect(swag.StringValue(candidates[1].Policy)).To(Equal("default"))
+
+		// Nothing was collected
+		var count int64
+		Expect(db.Model(&common.Cluster{}).Count(&count).Error).ShouldNot(HaveOccurred())
+		Expect(count).To(Equal(int64(3)))
+	})
+
+	It("is allowed only to admins", func() {
+		authCfg := auth.GetConfigRHSSO()
+		bm.authzHandler = auth.NewAuthzHandler(authCfg, nil, common.GetTestLog().WithField("pkg", "auth"), db)
+		payload := &ocm.AuthPayload{Role: ocm.UserRole}
+		authCtx := context.WithValue(ctx, restapi.AuthKey, payload)
+
+		response := bm.V2GarbageCollectionDryRun(authCtx, installer.V2GarbageCollectionDryRunParams{})
+		verifyApiErrorString(response, http.StatusForbidden, "only admin users are allowed to run garbage collection dry runs")
+	})
+})
+
+var _ = Describe("V2GetClusterRenderedFiles", func() {
+	var (
+		bm               *bareMetalInventory
+		cfg              Config
+		db               *gorm.DB
+		ctx              = context.Background()
+		clusterID        strfmt.UUID
+		dbName           string
+		mockManifestsApi *manifestsapi.MockClusterManifestsInternals
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		clusterID = strfmt.UUID(uuid.New().String())
+		bm = createInventory(db, cfg)
+		mockManifestsApi = manifestsapi.NewMockClusterManifestsInternals(ctrl)
+		bm.manifestsApi = mockManifestsApi
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{
+			ID:               &clusterID,
+			OpenshiftVersion: common.TestDefaultConfig.OpenShiftVersion,
+		}}).Error).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("renders the install config and the manifests", func() {
+		mockInstallConfigBuilder.EXPECT().GetInstallConfig(gomock.Any(), false, "").Return([]byte("apiVersion: v1"), nil).Times(1)
+		mockProviderRegistry.EXPECT().PreCreateManifestsHook(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		mockProviderRegistry.EXPECT().PostCreateManifestsHook(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		mockManifestsApi.EXPECT().ListClusterManifestsInternal(gomock.Any(), gomock.Any()).Return(models.ListManifests{
+			{Folder: models.ManifestFolderOpenshift, FileName: "user.yaml"},
+		}, nil).Times(1)
+		mockS3Client.EXPECT().Download(gomock.Any(), manifests.GetManifestObjectName(clusterID, "openshift/user.yaml")).
+			Return(io.NopCloser(strings.NewReader("kind: ConfigMap")), int64(0), nil).Times(1)
+		mockClusterApi.EXPECT().RenderAdditionalManifests(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, c *common.Cluster, manifestsAPI manifestsapi.ClusterManifestsInternals) ([]byte, error) {
+				_, err := manifestsAPI.CreateClusterManifestInternal(ctx, manifestsops.V2CreateClusterManifestParams{
+					ClusterID: clusterID,
+					CreateManifestParams: &models.CreateManifestParams{
+						Folder:   swag.String(models.ManifestFolderManifests),
+						FileName: swag.String("generated.yaml"),
+						Content:  swag.String(base64.StdEncoding.EncodeToString([]byte("kind: Secret"))),
+					},
+				})
+				return []byte(`[{"kind":"Namespace"}]`), err
+			}).Times(1)
+
+		response := bm.V2GetClusterRenderedFiles(ctx, installer.V2GetClusterRenderedFilesParams{ClusterID: clusterID})
+		Expect(response).To(BeAssignableToTypeOf(installer.NewV2GetClusterRenderedFilesOK()))
+		files := response.(*installer.V2GetClusterRenderedFilesOK).Payload
+		Expect(files).To(Equal(models.ClusterRenderedFiles{
+			"install-config.yaml":      "apiVersion: v1",
+			"openshift/user.yaml":      "kind: ConfigMap",
+			"manifests/generated.yaml": "kind: Secret",
+			customManifestsFile:        `[{"kind":"Namespace"}]`,
+		}))
+	})
+
+	It("redacts the credentials of the cluster", func() {
+		os.Setenv("CREDENTIALS_ENCRYPTION_KEY", "key")
+		defer os.Unsetenv("CREDENTIALS_ENCRYPTION_KEY")
+		pullSecret := `{"auths":{"cloud.openshift.com":{"auth":"dXNlcjpwYXNz"}}}`
+		encrypted, err := gencrypto.EncryptSecret("vcenter-password")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(db.Model(&common.Cluster{Cluster: models.Cluster{ID: &clusterID}}).Updates(map[string]interface{}{
+			"pull_secret":                pullSecret,
+			"vsphere_encrypted_password": encrypted,
+		}).Error).ShouldNot(HaveOccurred())
+
+		mockInstallConfigBuilder.EXPECT().GetInstallConfig(gomock.Any(), false, "").
+			Return([]byte("apiVersion: v1\npullSecret: '"+pullSecret+"'\n"), nil).Times(1)
+		mockProviderRegistry.EXPECT().PreCreateManifestsHook(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		mockProviderRegistry.EXPECT().PostCreateManifestsHook(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		mockManifestsApi.EXPECT().ListClusterManifestsInternal(gomock.Any(), gomock.Any()).Return(models.ListManifests{}, nil).Times(1)
+		mockClusterApi.EXPECT().RenderAdditionalManifests(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]byte(`[{"kind":"Secret","stringData":{"password":"vcenter-password","config":"{\"auths\":{\"cloud.openshift.com\":{\"auth\":\"dXNlcjpwYXNz\"}}}"}}]`), nil).Times(1)
+
+		response := bm.V2GetClusterRenderedFiles(ctx, installer.V2GetClusterRenderedFilesParams{ClusterID: clusterID})
+		Expect(response).To(BeAssignableToTypeOf(installer.NewV2GetClusterRenderedFilesOK()))
+		files := response.(*installer.V2GetClusterRenderedFilesOK).Payload
+		Expect(files["install-config.yaml"]).To(Equal("apiVersion: v1\npullSecret: '" + redactedCredential + "'\n"))
+		Expect(files[customManifestsFile]).To(Equal(`[{"kind":"Secret","stringData":{"password":"` + redactedCredential + `","config":"` + redactedCredential + `"}}]`))
+		for _, content := range files {
+			Expect(content).ToNot(ContainSubstring("vcenter-password"))
+			Expect(content).ToNot(ContainSubstring("dXNlcjpwYXNz"))
+		}
+	})
+
+	It("fails when the install config can't be rendered", func() {
+		mockInstallConfigBuilder.EXPECT().GetInstallConfig(gomock.Any(), false, "").Return(nil, errors.New("invalid overrides")).Times(1)
+
+		response := bm.V2GetClusterRenderedFiles(ctx, installer.V2GetClusterRenderedFilesParams{ClusterID: clusterID})
+		verifyApiErrorString(response, http.StatusBadRequest, "invalid overrides")
+	})
+
+	It("fails for a missing cluster", func() {
+		response := bm.V2GetClusterRenderedFiles(ctx, installer.V2GetClusterRenderedFilesParams{ClusterID: strfmt.UUID(uuid.New().String())})
+		verifyApiError(response, http.StatusNotFound)
+	})
+})
+
 var _ = Describe("V2UpdateHostInstallerArgs", func() {
 	var (
 		bm         *bareMetalInventory
@@ -14631,6 +16121,8 @@ func createInventory(db *gorm.DB, cfg Config) *bareMetalInventory {
 		getTestAuthHandler(), getTestAuthzHandler(), mockK8sClient, ocmClient, nil, mockSecretValidator, mockVersions,
 		mockCRDUtils, mockIgnitionBuilder, mockHwValidator, dnsApi, mockInstallConfigBuilder, mockStaticNetworkConfig,
 		gcConfig, mockProviderRegistry, true)
+	// Updates of clusters queue a refresh of their hosts, verified by the tests of the refresh
+	mockHostApi.EXPECT().RequestClusterHostsRefresh(gomock.Any()).AnyTimes()
 
 	bm.ImageServiceBaseURL = imageServiceBaseURL
 	return bm
@@ -15028,7 +16520,7 @@ var _ = Describe("AddReleaseImage", func() {
 	})
 
 	It("successfully added version", func() {
-		mockVersions.EXPECT().AddReleaseImage(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(common.TestDefaultConfig.ReleaseImage, nil).Times(1)
+		mockVersions.EXPECT().AddReleaseImage(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(common.TestDefaultConfig.ReleaseImage, nil).Times(1)
 
 		image, err := bm.AddReleaseImage(ctx, releaseImage, pullSecret, "", nil)
 		Expect(err).ShouldNot(HaveOccurred())
@@ -15036,7 +16528,7 @@ var _ = Describe("AddReleaseImage", func() {
 	})
 
 	It("failed to added version", func() {
-		mockVersions.EXPECT().AddReleaseImage(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("failed")).Times(1)
+		mockVersions.EXPECT().AddReleaseImage(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("failed")).Times(1)
 
 		_, err := bm.AddReleaseImage(ctx, releaseImage, pullSecret, "", nil)
 		Expect(err).Should(HaveOccurred())
@@ -15064,7 +16556,7 @@ var _ = Describe("Platform tests", func() {
 		db, dbName = common.PrepareTestDB()
 		bm = createInventory(db, cfg)
 		mockOperators := operators.NewMockAPI(ctrl)
-		bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog(), db, mockEvents, nil, nil, nil, nil, mockOperators, nil, nil, nil, nil)
+		bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog(), db, mockEvents, nil, nil, nil, nil, mockOperators, nil, nil, nil, nil, nil)
 		bm.ocmClient = nil
 		clusterParams := getDefaultClusterCreateParams()
 		clusterParams.Name = swag.String("cluster")
@@ -16120,3 +17612,71 @@ var _ = Describe("UpdateIgnitionEndpointIfHasMCSCert", func() {
 		Expect(ignitionEndpointUrl).To(Equal(httpsIgnitionEndpointUrl))
 	})
 })
+
+var _ = Describe("V2GetClusterInstallationTimeline", func() {
+	var (
+		bm        *bareMetalInventory
+		cfg       Config
+		db        *gorm.DB
+		ctx       = context.Background()
+		clusterID strfmt.UUID
+		hostID    strfmt.UUID
+		dbName    string
+		start     time.Time
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		clusterID = strfmt.UUID(uuid.New().String())
+		hostID = strfmt.UUID(uuid.New().String())
+		bm = createInventory(db, cfg)
+		start = time.Now().Add(-time.Hour)
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{
+			ID:     &clusterID,
+			Name:   "timeline",
+			Status: swag.String(models.ClusterStatusInstalled),
+		}}).Error).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	addEvent := func(name, message string, minutes int, hostID *strfmt.UUID) {
+		eventTime := strfmt.DateTime(start.Add(time.Duration(minutes) * time.Minute))
+		Expect(db.Create(&common.Event{Event: models.Event{
+			Name:      name,
+			Message:   swag.String(message),
+			EventTime: &eventTime,
+			ClusterID: &clusterID,
+			HostID:    hostID,
+			Category:  models.EventCategoryUser,
+			Severity:  swag.String(models.EventSeverityInfo),
+		}}).Error).ShouldNot(HaveOccurred())
+	}
+
+	It("builds the timeline from the events of the cluster", func() {
+		addEvent(eventgen.ClusterStatusUpdatedEventName, "Updated status of the cluster to preparing-for-installation", 0, nil)
+		addEvent(eventgen.ClusterStatusUpdatedEventName, "Updated status of the cluster to installing", 1, nil)
+		addEvent(eventgen.HostInstallProgressUpdatedEventName, "Host: master-0, reached installation stage Installing", 2, &hostID)
+		addEvent(eventgen.HostInstallProgressUpdatedEventName, "Host: master-0, reached installation stage Done", 20, &hostID)
+		addEvent(eventgen.HostRegistrationSucceededEventName, "Host master-0: Successfully registered", 21, &hostID)
+		addEvent(eventgen.ClusterStatusUpdatedEventName, "Updated status of the cluster to installed", 30, nil)
+
+		response := bm.V2GetClusterInstallationTimeline(ctx, installer.V2GetClusterInstallationTimelineParams{ClusterID: clusterID})
+		Expect(response).To(BeAssignableToTypeOf(installer.NewV2GetClusterInstallationTimelineOK()))
+		timeline := response.(*installer.V2GetClusterInstallationTimelineOK).Payload
+		Expect(*timeline.ClusterID).To(Equal(clusterID))
+		Expect(timeline.DurationSeconds).To(Equal(int64(30 * 60)))
+		Expect(timeline.Entries).To(HaveLen(5))
+		Expect(timeline.CriticalPath).To(HaveLen(1))
+		Expect(timeline.Summary).To(ContainSubstring("Host master-0: Done after 18m0s"))
+	})
+
+	It("fails for a cluster that doesn't exist", func() {
+		response := bm.V2GetClusterInstallationTimeline(ctx, installer.V2GetClusterInstallationTimelineParams{
+			ClusterID: strfmt.UUID(uuid.New().String()),
+		})
+		verifyApiError(response, http.StatusNotFound)
+	})
+})
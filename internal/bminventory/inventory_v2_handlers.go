@@ -19,6 +19,7 @@ import (
 	eventgen "github.com/openshift/assisted-service/internal/common/events"
 	"github.com/openshift/assisted-service/internal/constants"
 	"github.com/openshift/assisted-service/internal/featuresupport"
+	"github.com/openshift/assisted-service/internal/garbagecollector"
 	"github.com/openshift/assisted-service/internal/gencrypto"
 	"github.com/openshift/assisted-service/internal/host/hostutil"
 	"github.com/openshift/assisted-service/internal/imageservice"
@@ -26,6 +27,7 @@ import (
 	"github.com/openshift/assisted-service/pkg/auth"
 	"github.com/openshift/assisted-service/pkg/filemiddleware"
 	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/transaction"
 	"github.com/openshift/assisted-service/restapi/operations/installer"
 	"github.com/pkg/errors"
 	"gorm.io/gorm"
@@ -40,7 +42,13 @@ func (b *bareMetalInventory) V2UpdateHost(ctx context.Context, params installer.
 }
 
 func (b *bareMetalInventory) V2RegisterCluster(ctx context.Context, params installer.V2RegisterClusterParams) middleware.Responder {
-	c, err := b.RegisterClusterInternal(ctx, nil, params)
+	var c *common.Cluster
+	var err error
+	if params.NewClusterParams.Clone != nil {
+		c, err = b.cloneClusterInternal(ctx, params)
+	} else {
+		c, err = b.RegisterClusterInternal(ctx, nil, params)
+	}
 	if err != nil {
 		return common.GenerateErrorResponder(err)
 	}
@@ -106,6 +114,14 @@ func (b *bareMetalInventory) V2GetClusterInstallConfig(ctx context.Context, para
 	return installer.NewV2GetClusterInstallConfigOK().WithPayload(string(cfg))
 }
 
+func (b *bareMetalInventory) V2GetClusterRenderedFiles(ctx context.Context, params installer.V2GetClusterRenderedFilesParams) middleware.Responder {
+	files, err := b.RenderClusterFilesInternal(ctx, params.ClusterID)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2GetClusterRenderedFilesOK().WithPayload(files)
+}
+
 func (b *bareMetalInventory) V2UpdateClusterInstallConfig(ctx context.Context, params installer.V2UpdateClusterInstallConfigParams) middleware.Responder {
 	_, err := b.UpdateClusterInstallConfigInternal(ctx, params)
 	if err != nil {
@@ -114,6 +130,38 @@ func (b *bareMetalInventory) V2UpdateClusterInstallConfig(ctx context.Context, p
 	return installer.NewV2UpdateClusterInstallConfigCreated()
 }
 
+func (b *bareMetalInventory) V2GetClusterInstallationTimeline(ctx context.Context, params installer.V2GetClusterInstallationTimelineParams) middleware.Responder {
+	installationTimeline, err := b.GetClusterInstallationTimelineInternal(ctx, params.ClusterID)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2GetClusterInstallationTimelineOK().WithPayload(installationTimeline)
+}
+
+func (b *bareMetalInventory) V2ListClusterInstallConfigRevisions(ctx context.Context, params installer.V2ListClusterInstallConfigRevisionsParams) middleware.Responder {
+	revisions, err := b.ListClusterInstallConfigRevisionsInternal(ctx, params.ClusterID)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2ListClusterInstallConfigRevisionsOK().WithPayload(revisions)
+}
+
+func (b *bareMetalInventory) V2ListClusterRevisions(ctx context.Context, params installer.V2ListClusterRevisionsParams) middleware.Responder {
+	revisions, err := b.ListClusterRevisionsInternal(ctx, params.ClusterID)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2ListClusterRevisionsOK().WithPayload(revisions)
+}
+
+func (b *bareMetalInventory) V2RollbackCluster(ctx context.Context, params installer.V2RollbackClusterParams) middleware.Responder {
+	c, err := b.RollbackClusterInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2RollbackClusterAccepted().WithPayload(&c.Cluster)
+}
+
 func (b *bareMetalInventory) V2InstallCluster(ctx context.Context, params installer.V2InstallClusterParams) middleware.Responder {
 	c, err := b.InstallClusterInternal(ctx, params)
 	if err != nil {
@@ -145,7 +193,7 @@ func (b *bareMetalInventory) V2ResetCluster(ctx context.Context, params installe
 	var cluster *common.Cluster
 
 	txSuccess := false
-	tx := b.db.Begin()
+	tx := transaction.Begin(ctx, b.db, "reset cluster")
 	defer func() {
 		if !txSuccess {
 			log.Error("reset cluster failed")
@@ -357,6 +405,20 @@ func (b *bareMetalInventory) V2GetClusterDefaultConfig(_ context.Context, _ inst
 	return installer.NewV2GetClusterDefaultConfigOK().WithPayload(body)
 }
 
+func (b *bareMetalInventory) V2GarbageCollectionDryRun(ctx context.Context, _ installer.V2GarbageCollectionDryRunParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	if !b.authzHandler.IsAdmin(ctx) {
+		return common.GenerateErrorResponder(common.NewApiError(http.StatusForbidden,
+			errors.New("only admin users are allowed to run garbage collection dry runs")))
+	}
+	candidates, err := garbagecollector.DryRun(ctx, b.db, b.gcConfig, time.Now())
+	if err != nil {
+		log.WithError(err).Error("failed to run garbage collection dry run")
+		return common.GenerateErrorResponder(common.NewApiError(http.StatusInternalServerError, err))
+	}
+	return installer.NewV2GarbageCollectionDryRunOK().WithPayload(candidates)
+}
+
 func (b *bareMetalInventory) V2DownloadClusterLogs(ctx context.Context, params installer.V2DownloadClusterLogsParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
 	log.Infof("Downloading logs from cluster %s", params.ClusterID)
@@ -478,6 +540,25 @@ func (b *bareMetalInventory) V2ImportCluster(ctx context.Context, params install
 	return installer.NewV2ImportClusterCreated().WithPayload(&c.Cluster)
 }
 
+func (b *bareMetalInventory) V2ExportCluster(ctx context.Context, params installer.V2ExportClusterParams) middleware.Responder {
+	bundle, err := b.ExportClusterInternal(ctx, params.ClusterID)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2ExportClusterOK().WithPayload(bundle)
+}
+
+func (b *bareMetalInventory) V2ImportClusterBundle(ctx context.Context, params installer.V2ImportClusterBundleParams) middleware.Responder {
+	result, err := b.ImportClusterBundleInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	if hasBlockingConflicts(result.Conflicts) {
+		return installer.NewV2ImportClusterBundleConflict().WithPayload(result)
+	}
+	return installer.NewV2ImportClusterBundleCreated().WithPayload(result)
+}
+
 func (b *bareMetalInventory) RegenerateInfraEnvSigningKey(ctx context.Context, params installer.RegenerateInfraEnvSigningKeyParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
 
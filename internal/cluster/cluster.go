@@ -23,11 +23,16 @@ import (
 	"github.com/openshift/assisted-service/internal/constants"
 	"github.com/openshift/assisted-service/internal/dns"
 	eventsapi "github.com/openshift/assisted-service/internal/events/api"
+	"github.com/openshift/assisted-service/internal/featuresupport"
 	"github.com/openshift/assisted-service/internal/host"
 	"github.com/openshift/assisted-service/internal/host/hostutil"
+	manifestsapi "github.com/openshift/assisted-service/internal/manifests/api"
 	"github.com/openshift/assisted-service/internal/metrics"
 	"github.com/openshift/assisted-service/internal/network"
 	"github.com/openshift/assisted-service/internal/operators"
+	"github.com/openshift/assisted-service/internal/provider/registry"
+	"github.com/openshift/assisted-service/internal/usage"
+	"github.com/openshift/assisted-service/internal/validationcache"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/auth"
 	"github.com/openshift/assisted-service/pkg/commonutils"
@@ -36,6 +41,7 @@ import (
 	"github.com/openshift/assisted-service/pkg/ocm"
 	"github.com/openshift/assisted-service/pkg/requestid"
 	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/openshift/assisted-service/pkg/tracing"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/thoas/go-funk"
@@ -117,9 +123,10 @@ type API interface {
 	GetClusterByKubeKey(key types.NamespacedName) (*common.Cluster, error)
 	UpdateAmsSubscriptionID(ctx context.Context, clusterID, amsSubscriptionID strfmt.UUID) *common.ApiErrorResponse
 	GenerateAdditionalManifests(ctx context.Context, cluster *common.Cluster) error
+	RenderAdditionalManifests(ctx context.Context, cluster *common.Cluster, manifestsAPI manifestsapi.ClusterManifestsInternals) ([]byte, error)
 	CompleteInstallation(ctx context.Context, db *gorm.DB, cluster *common.Cluster, successfullyFinished bool, reason string) (*common.Cluster, error)
-	PermanentClustersDeletion(ctx context.Context, olderThan strfmt.DateTime, objectHandler s3wrapper.API) error
-	DeregisterInactiveCluster(ctx context.Context, maxDeregisterPerInterval int, inactiveSince strfmt.DateTime) error
+	PermanentClustersDeletion(ctx context.Context, olderThan strfmt.DateTime, objectHandler s3wrapper.API, scopes ...func(*gorm.DB) *gorm.DB) error
+	DeregisterInactiveCluster(ctx context.Context, maxDeregisterPerInterval int, inactiveSince strfmt.DateTime, scopes ...func(*gorm.DB) *gorm.DB) error
 	TransformClusterToDay2(ctx context.Context, cluster *common.Cluster, db *gorm.DB) error
 	RefreshSchedulableMastersForcedTrue(ctx context.Context, clusterID strfmt.UUID) error
 }
@@ -138,6 +145,9 @@ type Config struct {
 	InstallationTimeout time.Duration `envconfig:"INSTALLATION_TIMEOUT" default:"24h"`
 	FinalizingTimeout   time.Duration `envconfig:"FINALIZING_TIMEOUT" default:"5h"`
 	MonitorBatchSize    int           `envconfig:"CLUSTER_MONITOR_BATCH_SIZE" default:"100"`
+	// When enabled, baremetal clusters whose hosts all support a single other platform are switched to it
+	EnablePlatformAutoSelection bool `envconfig:"ENABLE_PLATFORM_AUTO_SELECTION" default:"false"`
+	ValidationCache             validationcache.Config
 }
 
 type Manager struct {
@@ -153,18 +163,21 @@ type Manager struct {
 	hostAPI               host.API
 	rp                    *refreshPreprocessor
 	leaderElector         leader.Leader
+	partitioner           leader.Partitioner
 	prevMonitorInvokedAt  time.Time
 	ocmClient             *ocm.Client
 	objectHandler         s3wrapper.API
 	dnsApi                dns.DNSApi
 	monitorQueryGenerator *common.MonitorClusterQueryGenerator
 	authHandler           auth.Authenticator
+	providerRegistry      registry.ProviderRegistry
+	usageAPI              usage.API
 }
 
 func NewManager(cfg Config, log logrus.FieldLogger, db *gorm.DB, eventsHandler eventsapi.Handler,
 	hostAPI host.API, metricApi metrics.API, manifestsGeneratorAPI network.ManifestsGeneratorAPI,
 	leaderElector leader.Leader, operatorsApi operators.API, ocmClient *ocm.Client, objectHandler s3wrapper.API,
-	dnsApi dns.DNSApi, authHandler auth.Authenticator) *Manager {
+	dnsApi dns.DNSApi, authHandler auth.Authenticator, providerRegistry registry.ProviderRegistry) *Manager {
 	th := &transitionHandler{
 		log:                 log,
 		db:                  db,
@@ -183,14 +196,17 @@ func NewManager(cfg Config, log logrus.FieldLogger, db *gorm.DB, eventsHandler e
 		sm:                    NewClusterStateMachine(th),
 		metricAPI:             metricApi,
 		manifestsGeneratorAPI: manifestsGeneratorAPI,
-		rp:                    newRefreshPreprocessor(log, hostAPI, operatorsApi),
+		rp:                    newRefreshPreprocessor(log, hostAPI, operatorsApi, cfg.ValidationCache),
 		hostAPI:               hostAPI,
 		leaderElector:         leaderElector,
+		partitioner:           leader.PartitionerFor(leaderElector),
 		prevMonitorInvokedAt:  time.Now(),
 		ocmClient:             ocmClient,
 		objectHandler:         objectHandler,
 		dnsApi:                dnsApi,
 		authHandler:           authHandler,
+		providerRegistry:      providerRegistry,
+		usageAPI:              usage.NewManager(log),
 	}
 }
 
@@ -373,7 +389,7 @@ func (m *Manager) refreshStatusInternal(ctx context.Context, c *common.Cluster,
 		dnsApi:            m.dnsApi,
 	}
 
-	err = m.sm.Run(TransitionTypeRefreshStatus, newStateCluster(vc.cluster), args)
+	err = tracing.RunTransition(ctx, m.sm, "cluster", TransitionTypeRefreshStatus, newStateCluster(vc.cluster), args)
 	if err != nil {
 		return nil, common.NewApiError(http.StatusConflict, err)
 	}
@@ -422,7 +438,7 @@ func (m *Manager) tryAssignMachineCidrNonDHCPMode(cluster *common.Cluster) error
 	return UpdateMachineCidr(m.db, cluster, machineCidr)
 }
 
-func (m *Manager) tryAssignMachineCidrSNO(cluster *common.Cluster) error {
+func (m *Manager) tryAssignMachineCidrSNO(ctx context.Context, cluster *common.Cluster) error {
 	if network.IsMachineCidrAvailable(cluster) {
 		return nil
 	}
@@ -448,12 +464,12 @@ func (m *Manager) tryAssignMachineCidrSNO(cluster *common.Cluster) error {
 			}
 			pendingCidrs = append(pendingCidrs, familyCidrs[0])
 		}
-		return updateMachineNetworks(m.db, cluster, pendingCidrs)
+		return updateMachineNetworks(ctx, m.db, cluster, pendingCidrs)
 	}
 	return nil
 }
 
-func (m *Manager) autoAssignMachineNetworkCidr(c *common.Cluster) error {
+func (m *Manager) autoAssignMachineNetworkCidr(ctx context.Context, c *common.Cluster) error {
 	if !funk.ContainsString([]string{models.ClusterStatusPendingForInput, models.ClusterStatusInsufficient}, swag.StringValue(c.Status)) {
 		return nil
 	}
@@ -469,7 +485,7 @@ func (m *Manager) autoAssignMachineNetworkCidr(c *common.Cluster) error {
 	if swag.BoolValue(c.VipDhcpAllocation) {
 		err = m.tryAssignMachineCidrDHCPMode(c)
 	} else if swag.StringValue(c.HighAvailabilityMode) == models.ClusterHighAvailabilityModeNone {
-		err = m.tryAssignMachineCidrSNO(c)
+		err = m.tryAssignMachineCidrSNO(ctx, c)
 	} else if !swag.BoolValue(c.UserManagedNetworking) {
 		err = m.tryAssignMachineCidrNonDHCPMode(c)
 	}
@@ -480,6 +496,72 @@ func (m *Manager) autoAssignMachineNetworkCidr(c *common.Cluster) error {
 	return err
 }
 
+func (m *Manager) autoSelectPlatform(ctx context.Context, c *common.Cluster) error {
+	if !m.EnablePlatformAutoSelection || m.providerRegistry == nil {
+		return nil
+	}
+	/*
+	 * Only baremetal multi-node clusters that are still being configured, and whose user didn't choose the
+	 * platform, are candidates. The platform is switched only when all the hosts have reported their inventory
+	 * and exactly one platform other than baremetal and none is supported by all of them.
+	 */
+	if !funk.ContainsString([]string{models.ClusterStatusInsufficient, models.ClusterStatusReady}, swag.StringValue(c.Status)) ||
+		swag.StringValue(c.Kind) != models.ClusterKindCluster ||
+		swag.StringValue(c.HighAvailabilityMode) == models.ClusterHighAvailabilityModeNone ||
+		swag.BoolValue(c.UserManagedNetworking) ||
+		c.PlatformSelectedByUser ||
+		c.Platform == nil || c.Platform.Type == nil || *c.Platform.Type != models.PlatformTypeBaremetal ||
+		len(c.Hosts) == 0 {
+		return nil
+	}
+	for _, h := range c.Hosts {
+		if h.Inventory == "" {
+			return nil
+		}
+	}
+	supported, err := m.providerRegistry.GetSupportedProvidersByHosts(c.Hosts)
+	if err != nil {
+		m.log.WithError(err).Warnf("failed to get the platforms supported by the hosts of cluster %s", c.ID.String())
+		return err
+	}
+	candidates := make([]models.PlatformType, 0)
+	for _, platform := range supported {
+		if platform == models.PlatformTypeBaremetal || platform == models.PlatformTypeNone {
+			continue
+		}
+		if platform == models.PlatformTypeNutanix && featuresupport.GetFeatureSupportLevel(c.OpenshiftVersion,
+			models.FeatureSupportLevelFeaturesItems0FeatureIDNUTANIXINTEGRATION) == models.FeatureSupportLevelFeaturesItems0SupportLevelUnsupported {
+			continue
+		}
+		candidates = append(candidates, platform)
+	}
+	if len(candidates) != 1 {
+		return nil
+	}
+	if _, err = UpdateCluster(m.log, m.db, *c.ID, swag.StringValue(c.Status), "platform_type", candidates[0]); err != nil {
+		m.log.WithError(err).Warnf("failed to set platform %s for cluster %s", candidates[0], c.ID.String())
+		return err
+	}
+	c.Platform.Type = common.PlatformTypePtr(candidates[0])
+	eventgen.SendClusterPlatformAutoSelectedEvent(ctx, m.eventsHandler, *c.ID, string(candidates[0]))
+	m.setAutoSelectedPlatformUsage(c, candidates[0])
+	return nil
+}
+
+// setAutoSelectedPlatformUsage records the feature usage of an automatically selected platform, the same as when
+// the platform is set by updating the cluster
+func (m *Manager) setAutoSelectedPlatformUsage(c *common.Cluster, platform models.PlatformType) {
+	usages, err := usage.Unmarshal(c.FeatureUsage)
+	if err == nil {
+		err = m.providerRegistry.SetPlatformUsages(platform, usages, m.usageAPI)
+	}
+	if err != nil {
+		m.log.WithError(err).Warnf("failed to set the feature usage of platform %s for cluster %s", platform, c.ID.String())
+		return
+	}
+	m.usageAPI.Save(m.db, *c.ID, usages)
+}
+
 func (m *Manager) shouldTriggerLeaseTimeoutEvent(c *common.Cluster, curMonitorInvokedAt time.Time) bool {
 	notAllowedStates := []string{models.ClusterStatusInstalled, models.ClusterStatusError, models.ClusterStatusCancelled}
 	if funk.Contains(notAllowedStates, *c.Status) {
@@ -522,12 +604,12 @@ func (m *Manager) initMonitorQueryGenerator() {
 }
 
 func (m *Manager) ClusterMonitoring() {
-	if !m.leaderElector.IsLeader() {
-		m.log.Debugf("Not a leader, exiting ClusterMonitoring")
+	if !m.partitioner.Participates() {
+		m.log.Debugf("Not participating in background work, exiting ClusterMonitoring")
 		return
 	}
 	m.log.Debugf("Running ClusterMonitoring")
-	defer commonutils.MeasureOperation("ClusterMonitoring", m.log, m.metricAPI)()
+	defer commonutils.MeasureMonitor("ClusterMonitoring", m.log, m.metricAPI)()
 	var (
 		offset              int
 		limit               = m.MonitorBatchSize
@@ -562,13 +644,17 @@ func (m *Manager) ClusterMonitoring() {
 		}
 		m.log.Debugf("We are going to monitor %d, query is: %+v", len(clusters), query)
 		for _, cluster := range clusters {
-			if !m.leaderElector.IsLeader() {
-				m.log.Debugf("Not a leader, exiting ClusterMonitoring")
+			if !m.partitioner.Participates() {
+				m.log.Debugf("Not participating in background work, exiting ClusterMonitoring")
 				return
 			}
+			if !m.partitioner.Owns(cluster.ID.String()) {
+				continue
+			}
 			if !m.SkipMonitoring(cluster) {
 				monitored += 1
-				_ = m.autoAssignMachineNetworkCidr(cluster)
+				_ = m.autoAssignMachineNetworkCidr(ctx, cluster)
+				_ = m.autoSelectPlatform(ctx, cluster)
 				if err = m.setConnectivityMajorityGroupsForClusterInternal(cluster, m.db); err != nil {
 					log.WithError(err).Error("failed to set majority group for clusters")
 				}
@@ -702,7 +788,7 @@ func (m *Manager) CancelInstallation(ctx context.Context, c *common.Cluster, rea
 		}
 	}()
 
-	err = m.sm.Run(TransitionTypeCancelInstallation, lastState, &TransitionArgsCancelInstallation{
+	err = tracing.RunTransition(ctx, m.sm, "cluster", TransitionTypeCancelInstallation, lastState, &TransitionArgsCancelInstallation{
 		ctx:    ctx,
 		reason: reason,
 		db:     db,
@@ -847,7 +933,7 @@ func (m *Manager) ResetCluster(ctx context.Context, c *common.Cluster, reason st
 
 	}()
 
-	err = m.sm.Run(TransitionTypeResetCluster, newStateCluster(c), &TransitionArgsResetCluster{
+	err = tracing.RunTransition(ctx, m.sm, "cluster", TransitionTypeResetCluster, newStateCluster(c), &TransitionArgsResetCluster{
 		ctx:    ctx,
 		reason: reason,
 		db:     db,
@@ -860,7 +946,7 @@ func (m *Manager) ResetCluster(ctx context.Context, c *common.Cluster, reason st
 }
 
 func (m *Manager) PrepareForInstallation(ctx context.Context, c *common.Cluster, db *gorm.DB) error {
-	err := m.sm.Run(TransitionTypePrepareForInstallation, newStateCluster(c),
+	err := tracing.RunTransition(ctx, m.sm, "cluster", TransitionTypePrepareForInstallation, newStateCluster(c),
 		&TransitionArgsPrepareForInstallation{
 			ctx:                ctx,
 			db:                 db,
@@ -1165,12 +1251,14 @@ func (m *Manager) DeleteClusterFiles(ctx context.Context, c *common.Cluster, obj
 	return m.deleteClusterFiles(ctx, c, objectHandler, "")
 }
 
-func (m Manager) DeregisterInactiveCluster(ctx context.Context, maxDeregisterPerInterval int, inactiveSince strfmt.DateTime) error {
+// DeregisterInactiveCluster deregisters the clusters that weren't updated since inactiveSince, the scopes narrow down the
+// clusters that are considered
+func (m Manager) DeregisterInactiveCluster(ctx context.Context, maxDeregisterPerInterval int, inactiveSince strfmt.DateTime, scopes ...func(*gorm.DB) *gorm.DB) error {
 	log := logutil.FromContext(ctx, m.log)
 
 	var clusters []*common.Cluster
 
-	if err := m.db.Limit(maxDeregisterPerInterval).Where("updated_at < ?", inactiveSince).Find(&clusters).Error; err != nil {
+	if err := m.db.Scopes(scopes...).Limit(maxDeregisterPerInterval).Where("updated_at < ?", inactiveSince).Find(&clusters).Error; err != nil {
 		return err
 	}
 	for _, c := range clusters {
@@ -1184,9 +1272,11 @@ func (m Manager) DeregisterInactiveCluster(ctx context.Context, maxDeregisterPer
 	return nil
 }
 
-func (m Manager) PermanentClustersDeletion(ctx context.Context, olderThan strfmt.DateTime, objectHandler s3wrapper.API) error {
+// PermanentClustersDeletion deletes the clusters that were deregistered before olderThan, the scopes narrow down the
+// clusters that are considered. An event is sent for each deleted cluster
+func (m Manager) PermanentClustersDeletion(ctx context.Context, olderThan strfmt.DateTime, objectHandler s3wrapper.API, scopes ...func(*gorm.DB) *gorm.DB) error {
 	var clusters []*common.Cluster
-	if reply := m.db.Unscoped().Where("deleted_at < ?", olderThan).Find(&clusters); reply.Error != nil {
+	if reply := m.db.Unscoped().Scopes(scopes...).Where("deleted_at < ?", olderThan).Find(&clusters); reply.Error != nil {
 		return reply.Error
 	}
 	for i := range clusters {
@@ -1215,6 +1305,8 @@ func (m Manager) PermanentClustersDeletion(ctx context.Context, olderThan strfmt
 		}
 		modelsToDelete := []interface{}{
 			&models.Event{},
+			&models.ClusterRevision{},
+			&models.InstallConfigOverridesRevision{},
 			&models.MonitoredOperator{},
 			&models.ClusterNetwork{},
 			&models.ServiceNetwork{},
@@ -1230,6 +1322,8 @@ func (m Manager) PermanentClustersDeletion(ctx context.Context, olderThan strfmt
 			m.log.WithError(reply.Error).Warnf("Failed deleting cluster from db %s", c.ID.String())
 		} else if reply.RowsAffected > 0 {
 			m.log.Debugf("Deleted %s cluster from db", reply.RowsAffected)
+			eventgen.SendClustersPermanentlyDeletedEvent(ctx, m.eventsHandler, *c.ID,
+				fmt.Sprintf("Permanently deleted cluster %s that was deregistered before %s", c.ID.String(), olderThan))
 		}
 	}
 	return nil
@@ -1245,35 +1339,53 @@ func (m *Manager) GetClusterByKubeKey(key types.NamespacedName) (*common.Cluster
 }
 
 func (m *Manager) GenerateAdditionalManifests(ctx context.Context, cluster *common.Cluster) error {
+	return m.addAdditionalManifests(ctx, cluster, m.manifestsGeneratorAPI, func() error {
+		return m.rp.operatorsAPI.GenerateManifests(ctx, cluster)
+	})
+}
+
+// RenderAdditionalManifests creates the manifests GenerateAdditionalManifests would create with the given manifests API,
+// and returns the custom manifests of the operators instead of uploading them
+func (m *Manager) RenderAdditionalManifests(ctx context.Context, cluster *common.Cluster, manifestsAPI manifestsapi.ClusterManifestsInternals) ([]byte, error) {
+	var customManifests []byte
+	err := m.addAdditionalManifests(ctx, cluster, m.manifestsGeneratorAPI.WithManifestsAPI(manifestsAPI), func() (err error) {
+		customManifests, err = m.rp.operatorsAPI.RenderManifests(ctx, cluster, manifestsAPI)
+		return err
+	})
+	return customManifests, err
+}
+
+func (m *Manager) addAdditionalManifests(ctx context.Context, cluster *common.Cluster, manifestsGenerator network.ManifestsGeneratorAPI,
+	addOperatorManifests func() error) error {
 	log := logutil.FromContext(ctx, m.log)
-	if err := m.manifestsGeneratorAPI.AddChronyManifest(ctx, log, cluster); err != nil {
+	if err := manifestsGenerator.AddChronyManifest(ctx, log, cluster); err != nil {
 		return errors.Wrap(err, "failed to add chrony manifest")
 	}
 
-	if common.IsSingleNodeCluster(cluster) && m.manifestsGeneratorAPI.IsSNODNSMasqEnabled() {
-		if err := m.manifestsGeneratorAPI.AddDnsmasqForSingleNode(ctx, log, cluster); err != nil {
+	if common.IsSingleNodeCluster(cluster) && manifestsGenerator.IsSNODNSMasqEnabled() {
+		if err := manifestsGenerator.AddDnsmasqForSingleNode(ctx, log, cluster); err != nil {
 			return errors.Wrap(err, "failed to add dnsmasq manifest")
 		}
 	}
 
-	if err := m.rp.operatorsAPI.GenerateManifests(ctx, cluster); err != nil {
+	if err := addOperatorManifests(); err != nil {
 		return errors.Wrap(err, "failed to add operator manifests")
 	}
-	if err := m.manifestsGeneratorAPI.AddTelemeterManifest(ctx, log, cluster); err != nil {
+	if err := manifestsGenerator.AddTelemeterManifest(ctx, log, cluster); err != nil {
 		return errors.Wrap(err, "failed to add telemeter manifest")
 	}
 
 	if common.AreMastersSchedulable(cluster) {
-		if err := m.manifestsGeneratorAPI.AddSchedulableMastersManifest(ctx, log, cluster); err != nil {
+		if err := manifestsGenerator.AddSchedulableMastersManifest(ctx, log, cluster); err != nil {
 			return errors.Wrap(err, "failed to add schedulable masters manifest")
 		}
 	}
 
-	if err := m.manifestsGeneratorAPI.AddDiskEncryptionManifest(ctx, log, cluster); err != nil {
+	if err := manifestsGenerator.AddDiskEncryptionManifest(ctx, log, cluster); err != nil {
 		return errors.Wrap(err, "failed to add disk encryption manifest")
 	}
 
-	if err := m.manifestsGeneratorAPI.AddNodeIpHint(ctx, log, cluster); err != nil {
+	if err := manifestsGenerator.AddNodeIpHint(ctx, log, cluster); err != nil {
 		return errors.Wrap(err, "failed to add node ip hint")
 	}
 
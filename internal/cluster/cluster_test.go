@@ -8,6 +8,7 @@ import (
 	"io"
 	"net/http"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/go-openapi/strfmt"
@@ -23,10 +24,13 @@ import (
 	eventsapi "github.com/openshift/assisted-service/internal/events/api"
 	"github.com/openshift/assisted-service/internal/events/eventstest"
 	"github.com/openshift/assisted-service/internal/host"
+	manifestsapi "github.com/openshift/assisted-service/internal/manifests/api"
 	"github.com/openshift/assisted-service/internal/metrics"
 	"github.com/openshift/assisted-service/internal/network"
 	"github.com/openshift/assisted-service/internal/operators"
 	"github.com/openshift/assisted-service/internal/operators/api"
+	"github.com/openshift/assisted-service/internal/provider/registry"
+	"github.com/openshift/assisted-service/internal/usage"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/auth"
 	"github.com/openshift/assisted-service/pkg/leader"
@@ -64,7 +68,7 @@ var _ = Describe("stateMachine", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mockOperators = operators.NewMockAPI(ctrl)
 		mockS3Client = s3wrapper.NewMockAPI(ctrl)
-		state = NewManager(getDefaultConfig(), common.GetTestLog(), db, nil, nil, nil, nil, dummy, mockOperators, nil, mockS3Client, nil, nil)
+		state = NewManager(getDefaultConfig(), common.GetTestLog(), db, nil, nil, nil, nil, dummy, mockOperators, nil, mockS3Client, nil, nil, nil)
 	})
 
 	AfterEach(func() {
@@ -138,11 +142,12 @@ var _ = Describe("TestClusterMonitoring", func() {
 		dummy := &leader.DummyElector{}
 		mockS3Client = s3wrapper.NewMockAPI(ctrl)
 		clusterApi = NewManager(getDefaultConfig(), common.GetTestLog().WithField("pkg", "cluster-monitor"), db,
-			mockEvents, mockHostAPI, mockMetric, nil, dummy, mockOperators, nil, mockS3Client, nil, nil)
+			mockEvents, mockHostAPI, mockMetric, nil, dummy, mockOperators, nil, mockS3Client, nil, nil, nil)
 		expectedState = ""
 		shouldHaveUpdated = false
 
 		mockMetric.EXPECT().Duration("ClusterMonitoring", gomock.Any()).AnyTimes()
+		mockMetric.EXPECT().MonitorDuration("ClusterMonitoring", gomock.Any()).AnyTimes()
 		mockMetric.EXPECT().MonitoredClusterCount(int64(1)).AnyTimes()
 		mockOperators.EXPECT().ValidateCluster(gomock.Any(), gomock.Any()).AnyTimes().Return([]api.ValidationResult{
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDOdfRequirementsSatisfied)},
@@ -722,10 +727,11 @@ var _ = Describe("lease timeout event", func() {
 		mockOperators := operators.NewMockAPI(ctrl)
 		dummy := &leader.DummyElector{}
 		clusterApi = NewManager(getDefaultConfig(), common.GetTestLog().WithField("pkg", "cluster-monitor"), db,
-			mockEvents, mockHostAPI, mockMetric, nil, dummy, mockOperators, nil, nil, nil, nil)
+			mockEvents, mockHostAPI, mockMetric, nil, dummy, mockOperators, nil, nil, nil, nil, nil)
 
 		mockMetric.EXPECT().MonitoredClusterCount(int64(1)).AnyTimes()
 		mockMetric.EXPECT().Duration("ClusterMonitoring", gomock.Any()).AnyTimes()
+		mockMetric.EXPECT().MonitorDuration("ClusterMonitoring", gomock.Any()).AnyTimes()
 		mockOperators.EXPECT().ValidateCluster(gomock.Any(), gomock.Any()).AnyTimes().Return([]api.ValidationResult{
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDCnvRequirementsSatisfied)},
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDOdfRequirementsSatisfied)},
@@ -838,10 +844,11 @@ var _ = Describe("Auto assign machine CIDR", func() {
 		mockOperators := operators.NewMockAPI(ctrl)
 		dummy := &leader.DummyElector{}
 		clusterApi = NewManager(getDefaultConfig(), common.GetTestLog().WithField("pkg", "cluster-monitor"), db,
-			mockEvents, mockHostAPI, mockMetric, nil, dummy, mockOperators, nil, nil, nil, nil)
+			mockEvents, mockHostAPI, mockMetric, nil, dummy, mockOperators, nil, nil, nil, nil, nil)
 
 		mockMetric.EXPECT().MonitoredClusterCount(int64(1)).AnyTimes()
 		mockMetric.EXPECT().Duration("ClusterMonitoring", gomock.Any()).AnyTimes()
+		mockMetric.EXPECT().MonitorDuration("ClusterMonitoring", gomock.Any()).AnyTimes()
 		mockOperators.EXPECT().ValidateCluster(gomock.Any(), gomock.Any()).AnyTimes().Return([]api.ValidationResult{
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDCnvRequirementsSatisfied)},
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDOdfRequirementsSatisfied)},
@@ -1331,6 +1338,131 @@ var _ = Describe("Auto assign machine CIDR", func() {
 	}
 })
 
+var _ = Describe("Auto select platform", func() {
+	var (
+		db               *gorm.DB
+		dbName           string
+		ctrl             *gomock.Controller
+		clusterApi       *Manager
+		mockEvents       *eventsapi.MockHandler
+		mockProviderRegs *registry.MockProviderRegistry
+		id               strfmt.UUID
+		ctx              = context.Background()
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		mockEvents = eventsapi.NewMockHandler(ctrl)
+		mockProviderRegs = registry.NewMockProviderRegistry(ctrl)
+		cfg := getDefaultConfig()
+		cfg.EnablePlatformAutoSelection = true
+		clusterApi = NewManager(cfg, common.GetTestLog(), db, mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, mockProviderRegs)
+		id = strfmt.UUID(uuid.New().String())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	createCluster := func(status string, highAvailabilityMode string, platform models.PlatformType) *common.Cluster {
+		c := common.Cluster{Cluster: models.Cluster{
+			ID:                   &id,
+			Kind:                 swag.String(models.ClusterKindCluster),
+			Status:               swag.String(status),
+			HighAvailabilityMode: swag.String(highAvailabilityMode),
+			Platform:             &models.Platform{Type: common.PlatformTypePtr(platform)},
+			OpenshiftVersion:     common.TestDefaultConfig.OpenShiftVersion,
+		}}
+		Expect(db.Create(&c).Error).ShouldNot(HaveOccurred())
+		for i := 0; i < 3; i++ {
+			hostID := strfmt.UUID(uuid.New().String())
+			h := models.Host{
+				ID:         &hostID,
+				InfraEnvID: id,
+				ClusterID:  &id,
+				Status:     swag.String(models.HostStatusKnown),
+				Inventory:  common.GenerateTestDefaultInventory(),
+			}
+			Expect(db.Create(&h).Error).ShouldNot(HaveOccurred())
+		}
+		cluster, err := common.GetClusterFromDBWithHosts(db, id)
+		Expect(err).ShouldNot(HaveOccurred())
+		return cluster
+	}
+
+	platformOf := func() models.PlatformType {
+		cluster, err := common.GetClusterFromDB(db, id, common.SkipEagerLoading)
+		Expect(err).ShouldNot(HaveOccurred())
+		return *cluster.Platform.Type
+	}
+
+	It("selects the single platform supported by all hosts", func() {
+		c := createCluster(models.ClusterStatusInsufficient, models.ClusterHighAvailabilityModeFull, models.PlatformTypeBaremetal)
+		mockProviderRegs.EXPECT().GetSupportedProvidersByHosts(gomock.Any()).Return(
+			[]models.PlatformType{models.PlatformTypeBaremetal, models.PlatformTypeVsphere, models.PlatformTypeNone}, nil)
+		mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+			eventstest.WithNameMatcher(eventgen.ClusterPlatformAutoSelectedEventName),
+			eventstest.WithClusterIdMatcher(id.String()))).Times(1)
+		mockProviderRegs.EXPECT().SetPlatformUsages(models.PlatformTypeVsphere, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(p models.PlatformType, usages map[string]models.Usage, usageApi usage.API) error {
+				usageApi.Add(usages, usage.PlatformSelectionUsage, &map[string]interface{}{"platform_type": p})
+				return nil
+			}).Times(1)
+		Expect(clusterApi.autoSelectPlatform(ctx, c)).ShouldNot(HaveOccurred())
+		Expect(platformOf()).To(Equal(models.PlatformTypeVsphere))
+		cluster, err := common.GetClusterFromDB(db, id, common.SkipEagerLoading)
+		Expect(err).ShouldNot(HaveOccurred())
+		usages, err := usage.Unmarshal(cluster.FeatureUsage)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(usages).To(HaveKey(usage.PlatformSelectionUsage))
+	})
+
+	It("keeps the platform chosen by the user", func() {
+		c := createCluster(models.ClusterStatusInsufficient, models.ClusterHighAvailabilityModeFull, models.PlatformTypeBaremetal)
+		Expect(db.Model(&common.Cluster{}).Where("id = ?", id.String()).Update("platform_selected_by_user", true).Error).ShouldNot(HaveOccurred())
+		c.PlatformSelectedByUser = true
+		Expect(clusterApi.autoSelectPlatform(ctx, c)).ShouldNot(HaveOccurred())
+		Expect(platformOf()).To(Equal(models.PlatformTypeBaremetal))
+	})
+
+	It("keeps baremetal when no other platform is supported", func() {
+		c := createCluster(models.ClusterStatusReady, models.ClusterHighAvailabilityModeFull, models.PlatformTypeBaremetal)
+		mockProviderRegs.EXPECT().GetSupportedProvidersByHosts(gomock.Any()).Return(
+			[]models.PlatformType{models.PlatformTypeBaremetal, models.PlatformTypeNone}, nil)
+		Expect(clusterApi.autoSelectPlatform(ctx, c)).ShouldNot(HaveOccurred())
+		Expect(platformOf()).To(Equal(models.PlatformTypeBaremetal))
+	})
+
+	It("keeps baremetal when more than one platform is supported", func() {
+		c := createCluster(models.ClusterStatusReady, models.ClusterHighAvailabilityModeFull, models.PlatformTypeBaremetal)
+		mockProviderRegs.EXPECT().GetSupportedProvidersByHosts(gomock.Any()).Return(
+			[]models.PlatformType{models.PlatformTypeBaremetal, models.PlatformTypeVsphere, models.PlatformTypeNutanix}, nil)
+		Expect(clusterApi.autoSelectPlatform(ctx, c)).ShouldNot(HaveOccurred())
+		Expect(platformOf()).To(Equal(models.PlatformTypeBaremetal))
+	})
+
+	It("ignores clusters that are pending for input", func() {
+		c := createCluster(models.ClusterStatusPendingForInput, models.ClusterHighAvailabilityModeFull, models.PlatformTypeBaremetal)
+		Expect(clusterApi.autoSelectPlatform(ctx, c)).ShouldNot(HaveOccurred())
+		Expect(platformOf()).To(Equal(models.PlatformTypeBaremetal))
+	})
+
+	It("ignores single node clusters", func() {
+		c := createCluster(models.ClusterStatusInsufficient, models.ClusterHighAvailabilityModeNone, models.PlatformTypeBaremetal)
+		Expect(clusterApi.autoSelectPlatform(ctx, c)).ShouldNot(HaveOccurred())
+		Expect(platformOf()).To(Equal(models.PlatformTypeBaremetal))
+	})
+
+	It("does nothing when disabled", func() {
+		clusterApi.EnablePlatformAutoSelection = false
+		c := createCluster(models.ClusterStatusInsufficient, models.ClusterHighAvailabilityModeFull, models.PlatformTypeBaremetal)
+		Expect(clusterApi.autoSelectPlatform(ctx, c)).ShouldNot(HaveOccurred())
+		Expect(platformOf()).To(Equal(models.PlatformTypeBaremetal))
+	})
+})
+
 var _ = Describe("VerifyRegisterHost", func() {
 	var (
 		db                     *gorm.DB
@@ -1350,7 +1482,7 @@ var _ = Describe("VerifyRegisterHost", func() {
 		mockOperators := operators.NewMockAPI(ctrl)
 		dummy := &leader.DummyElector{}
 		clusterApi = NewManager(getDefaultConfig(), common.GetTestLog().WithField("pkg", "cluster-monitor"), db,
-			nil, nil, nil, nil, dummy, mockOperators, nil, nil, nil, nil)
+			nil, nil, nil, nil, dummy, mockOperators, nil, nil, nil, nil, nil)
 	})
 
 	AfterEach(func() {
@@ -1413,7 +1545,7 @@ var _ = Describe("VerifyClusterUpdatability", func() {
 		mockOperators := operators.NewMockAPI(ctrl)
 		dummy := &leader.DummyElector{}
 		clusterApi = NewManager(getDefaultConfig(), common.GetTestLog().WithField("pkg", "cluster-monitor"), db,
-			nil, nil, nil, nil, dummy, mockOperators, nil, nil, nil, nil)
+			nil, nil, nil, nil, dummy, mockOperators, nil, nil, nil, nil, nil)
 	})
 
 	AfterEach(func() {
@@ -1468,7 +1600,7 @@ var _ = Describe("CancelInstallation", func() {
 		mockMetric = metrics.NewMockAPI(ctrl)
 		mockOperators := operators.NewMockAPI(ctrl)
 		dummy := &leader.DummyElector{}
-		state = NewManager(getDefaultConfig(), common.GetTestLog(), db, eventsHandler, nil, mockMetric, nil, dummy, mockOperators, nil, nil, nil, nil)
+		state = NewManager(getDefaultConfig(), common.GetTestLog(), db, eventsHandler, nil, mockMetric, nil, dummy, mockOperators, nil, nil, nil, nil, nil)
 		id := strfmt.UUID(uuid.New().String())
 		c = common.Cluster{Cluster: models.Cluster{
 			ID:         &id,
@@ -1544,7 +1676,7 @@ var _ = Describe("ResetCluster", func() {
 		dummy := &leader.DummyElector{}
 		ctrl = gomock.NewController(GinkgoT())
 		mockOperators := operators.NewMockAPI(ctrl)
-		state = NewManager(getDefaultConfig(), common.GetTestLog(), db, eventsHandler, nil, nil, nil, dummy, mockOperators, nil, nil, nil, nil)
+		state = NewManager(getDefaultConfig(), common.GetTestLog(), db, eventsHandler, nil, nil, nil, dummy, mockOperators, nil, nil, nil, nil, nil)
 	})
 
 	AfterEach(func() {
@@ -1772,7 +1904,7 @@ var _ = Describe("PrepareForInstallation", func() {
 		db, dbName = common.PrepareTestDB()
 		dummy := &leader.DummyElector{}
 		mockOperators := operators.NewMockAPI(ctrl)
-		capi = NewManager(getDefaultConfig(), common.GetTestLog(), db, mockEventsHandler, nil, mockMetric, nil, dummy, mockOperators, nil, nil, nil, nil)
+		capi = NewManager(getDefaultConfig(), common.GetTestLog(), db, mockEventsHandler, nil, mockMetric, nil, dummy, mockOperators, nil, nil, nil, nil, nil)
 		clusterId = strfmt.UUID(uuid.New().String())
 	})
 
@@ -1871,7 +2003,7 @@ var _ = Describe("HandlePreInstallationChanges", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mockOperators := operators.NewMockAPI(ctrl)
 		mockEvents = eventsapi.NewMockHandler(ctrl)
-		capi = NewManager(getDefaultConfig(), common.GetTestLog(), db, mockEvents, nil, nil, nil, dummy, mockOperators, nil, nil, nil, nil)
+		capi = NewManager(getDefaultConfig(), common.GetTestLog(), db, mockEvents, nil, nil, nil, dummy, mockOperators, nil, nil, nil, nil, nil)
 		clusterId = strfmt.UUID(uuid.New().String())
 		cluster := &common.Cluster{Cluster: models.Cluster{ID: &clusterId, Status: swag.String(models.ClusterStatusPreparingForInstallation)}}
 		Expect(db.Create(cluster).Error).ShouldNot(HaveOccurred())
@@ -1944,7 +2076,7 @@ var _ = Describe("SetVipsData", func() {
 		mockEvents = eventsapi.NewMockHandler(ctrl)
 		dummy := &leader.DummyElector{}
 		mockOperators := operators.NewMockAPI(ctrl)
-		capi = NewManager(getDefaultConfig(), common.GetTestLog(), db, mockEvents, nil, nil, nil, dummy, mockOperators, nil, nil, nil, nil)
+		capi = NewManager(getDefaultConfig(), common.GetTestLog(), db, mockEvents, nil, nil, nil, dummy, mockOperators, nil, nil, nil, nil, nil)
 		clusterId = strfmt.UUID(uuid.New().String())
 	})
 
@@ -2131,7 +2263,7 @@ var _ = Describe("Majority groups", func() {
 		mockMetricApi = metrics.NewMockAPI(ctrl)
 		dummy := &leader.DummyElector{}
 		clusterApi = NewManager(getDefaultConfig(), common.GetTestLog().WithField("pkg", "cluster-monitor"), db,
-			mockEvents, nil, mockMetricApi, nil, dummy, mockOperators, nil, nil, nil, nil)
+			mockEvents, nil, mockMetricApi, nil, dummy, mockOperators, nil, nil, nil, nil, nil)
 		id = strfmt.UUID(uuid.New().String())
 		apiVip := "1.2.3.5"
 		ingressVip := "1.2.3.6"
@@ -2153,6 +2285,7 @@ var _ = Describe("Majority groups", func() {
 
 		mockMetricApi.EXPECT().MonitoredClusterCount(int64(1)).AnyTimes()
 		mockMetricApi.EXPECT().Duration("ClusterMonitoring", gomock.Any()).AnyTimes()
+		mockMetricApi.EXPECT().MonitorDuration("ClusterMonitoring", gomock.Any()).AnyTimes()
 		mockOperators.EXPECT().ValidateCluster(gomock.Any(), gomock.Any()).AnyTimes().Return([]api.ValidationResult{
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDCnvRequirementsSatisfied)},
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDOdfRequirementsSatisfied)},
@@ -2246,7 +2379,7 @@ var _ = Describe("ready_state", func() {
 		dummy := &leader.DummyElector{}
 		mockOperators := operators.NewMockAPI(ctrl)
 		clusterApi = NewManager(getDefaultConfig(), common.GetTestLog().WithField("pkg", "cluster-monitor"), db,
-			mockEvents, nil, nil, nil, dummy, mockOperators, nil, nil, nil, nil)
+			mockEvents, nil, nil, nil, dummy, mockOperators, nil, nil, nil, nil, nil)
 		id = strfmt.UUID(uuid.New().String())
 		apiVip := "1.2.3.5"
 		ingressVip := "1.2.3.6"
@@ -2353,7 +2486,7 @@ var _ = Describe("insufficient_state", func() {
 		db, dbName = common.PrepareTestDB()
 		dummy := &leader.DummyElector{}
 		clusterApi = NewManager(getDefaultConfig(), common.GetTestLog().WithField("pkg", "cluster-monitor"), db,
-			mockEvents, mockHostAPI, nil, nil, dummy, mockOperators, nil, nil, nil, nil)
+			mockEvents, mockHostAPI, nil, nil, dummy, mockOperators, nil, nil, nil, nil, nil)
 
 		id = strfmt.UUID(uuid.New().String())
 		cluster = common.Cluster{Cluster: models.Cluster{
@@ -2408,7 +2541,7 @@ var _ = Describe("prepare-for-installation refresh status", func() {
 		mockOperators = operators.NewMockAPI(ctrl)
 		mockOperators.EXPECT().ValidateCluster(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
 		dummy := &leader.DummyElector{}
-		capi = NewManager(cfg, common.GetTestLog(), db, mockEvents, mockHostAPI, nil, nil, dummy, mockOperators, nil, nil, nil, nil)
+		capi = NewManager(cfg, common.GetTestLog(), db, mockEvents, mockHostAPI, nil, nil, dummy, mockOperators, nil, nil, nil, nil, nil)
 		clusterId = strfmt.UUID(uuid.New().String())
 		cl = common.Cluster{
 			Cluster: models.Cluster{
@@ -2485,7 +2618,7 @@ var _ = Describe("Cluster tarred files", func() {
 		mockEvents = eventsapi.NewMockHandler(ctrl)
 		dummy := &leader.DummyElector{}
 		mockOperators := operators.NewMockAPI(ctrl)
-		capi = NewManager(cfg, common.GetTestLog(), db, mockEvents, mockHostAPI, nil, nil, dummy, mockOperators, nil, nil, nil, nil)
+		capi = NewManager(cfg, common.GetTestLog(), db, mockEvents, mockHostAPI, nil, nil, dummy, mockOperators, nil, nil, nil, nil, nil)
 		clusterId = strfmt.UUID(uuid.New().String())
 		cl = common.Cluster{
 			Cluster: models.Cluster{
@@ -2607,7 +2740,7 @@ var _ = Describe("GenerateAdditionalManifests", func() {
 		dummy := &leader.DummyElector{}
 		mockOperatorMgr = operators.NewMockAPI(ctrl)
 		cfg := getDefaultConfig()
-		capi = NewManager(cfg, common.GetTestLog(), db, eventsHandler, nil, mockMetric, manifestsGenerator, dummy, mockOperatorMgr, nil, nil, nil, nil)
+		capi = NewManager(cfg, common.GetTestLog(), db, eventsHandler, nil, mockMetric, manifestsGenerator, dummy, mockOperatorMgr, nil, nil, nil, nil, nil)
 		id := strfmt.UUID(uuid.New().String())
 		c = common.Cluster{Cluster: models.Cluster{
 			ID:     &id,
@@ -2651,7 +2784,7 @@ var _ = Describe("GenerateAdditionalManifests", func() {
 
 	It("Single node manifests success with disabled dnsmasq", func() {
 		cfg2 := getDefaultConfig()
-		capi = NewManager(cfg2, common.GetTestLog(), db, eventsHandler, nil, mockMetric, manifestsGenerator, nil, mockOperatorMgr, nil, nil, nil, nil)
+		capi = NewManager(cfg2, common.GetTestLog(), db, eventsHandler, nil, mockMetric, manifestsGenerator, nil, mockOperatorMgr, nil, nil, nil, nil, nil)
 		manifestsGenerator.EXPECT().AddChronyManifest(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
 		manifestsGenerator.EXPECT().IsSNODNSMasqEnabled().Return(false).Times(1)
 		manifestsGenerator.EXPECT().AddNodeIpHint(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
@@ -2672,7 +2805,7 @@ var _ = Describe("GenerateAdditionalManifests", func() {
 
 		BeforeEach(func() {
 			telemeterCfg = getDefaultConfig()
-			capi = NewManager(telemeterCfg, common.GetTestLog(), db, eventsHandler, nil, mockMetric, manifestsGenerator, nil, mockOperatorMgr, nil, nil, nil, nil)
+			capi = NewManager(telemeterCfg, common.GetTestLog(), db, eventsHandler, nil, mockMetric, manifestsGenerator, nil, mockOperatorMgr, nil, nil, nil, nil, nil)
 		})
 
 		It("Happy flow", func() {
@@ -2697,6 +2830,39 @@ var _ = Describe("GenerateAdditionalManifests", func() {
 			Expect(err).To(HaveOccurred())
 		})
 	})
+
+	Context("RenderAdditionalManifests", func() {
+		var (
+			renderedManifests *manifestsapi.MockClusterManifestsInternals
+			renderGenerator   *network.MockManifestsGeneratorAPI
+		)
+
+		BeforeEach(func() {
+			renderedManifests = manifestsapi.NewMockClusterManifestsInternals(ctrl)
+			renderGenerator = network.NewMockManifestsGeneratorAPI(ctrl)
+			manifestsGenerator.EXPECT().WithManifestsAPI(renderedManifests).Return(renderGenerator)
+		})
+
+		It("creates the manifests with the given API", func() {
+			renderGenerator.EXPECT().AddChronyManifest(ctx, gomock.Any(), &c).Return(nil)
+			mockOperatorMgr.EXPECT().RenderManifests(ctx, &c, renderedManifests).Return([]byte("[]"), nil)
+			renderGenerator.EXPECT().AddTelemeterManifest(ctx, gomock.Any(), &c).Return(nil)
+			renderGenerator.EXPECT().AddNodeIpHint(ctx, gomock.Any(), &c).Return(nil)
+			renderGenerator.EXPECT().AddDiskEncryptionManifest(ctx, gomock.Any(), &c).Return(nil)
+
+			customManifests, err := capi.RenderAdditionalManifests(ctx, &c, renderedManifests)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(customManifests).To(Equal([]byte("[]")))
+		})
+
+		It("fails when an operator manifest can't be rendered", func() {
+			renderGenerator.EXPECT().AddChronyManifest(ctx, gomock.Any(), &c).Return(nil)
+			mockOperatorMgr.EXPECT().RenderManifests(ctx, &c, renderedManifests).Return(nil, errors.New("dummy"))
+
+			_, err := capi.RenderAdditionalManifests(ctx, &c, renderedManifests)
+			Expect(err).To(HaveOccurred())
+		})
+	})
 })
 
 var _ = Describe("Deregister inactive clusters", func() {
@@ -2737,7 +2903,7 @@ var _ = Describe("Deregister inactive clusters", func() {
 		eventsHandler = events.New(db, nil, logrus.New())
 		dummy := &leader.DummyElector{}
 		mockS3Client = s3wrapper.NewMockAPI(ctrl)
-		state = NewManager(getDefaultConfig(), common.GetTestLog(), db, eventsHandler, nil, mockMetric, nil, dummy, mockOperators, nil, mockS3Client, nil, nil)
+		state = NewManager(getDefaultConfig(), common.GetTestLog(), db, eventsHandler, nil, mockMetric, nil, dummy, mockOperators, nil, mockS3Client, nil, nil, nil)
 		c = registerCluster()
 	})
 
@@ -2842,9 +3008,11 @@ var _ = Describe("Permanently delete clusters", func() {
 	verifyClusterSubComponentsDeletion := func(clusterID strfmt.UUID, isDeleted bool) {
 		ExpectWithOffset(1, db.Unscoped().Where("id = ?", clusterID).Find(&common.Cluster{}).RowsAffected == 0).Should(Equal(isDeleted))
 
+		// The events of a deleted cluster are replaced by the event of its deletion
 		clusterEvents, err := eventsHandler.V2GetEvents(ctx, &clusterID, nil, nil)
 		Expect(err).NotTo(HaveOccurred())
-		Expect(len(clusterEvents) == 0).Should(Equal(isDeleted))
+		Expect(clusterEvents).To(HaveLen(1))
+		Expect(strings.HasPrefix(swag.StringValue(clusterEvents[0].Message), "Permanently deleted cluster")).Should(Equal(isDeleted))
 
 		var operators []*models.MonitoredOperator
 		Expect(db.Unscoped().Find(&operators, "cluster_id = ?", clusterID).Error).ShouldNot(HaveOccurred())
@@ -2871,7 +3039,7 @@ var _ = Describe("Permanently delete clusters", func() {
 		db, dbName = common.PrepareTestDB()
 		eventsHandler = events.New(db, nil, logrus.New())
 		dummy := &leader.DummyElector{}
-		state = NewManager(getDefaultConfig(), common.GetTestLog(), db, eventsHandler, nil, mockMetric, nil, dummy, mockOperators, nil, nil, nil, nil)
+		state = NewManager(getDefaultConfig(), common.GetTestLog(), db, eventsHandler, nil, mockMetric, nil, dummy, mockOperators, nil, nil, nil, nil, nil)
 		c1 = registerCluster()
 		c2 = registerCluster()
 		c3 = registerCluster()
@@ -2931,7 +3099,7 @@ var _ = Describe("Get cluster by Kube key", func() {
 		db, dbName = common.PrepareTestDB()
 		eventsHandler = events.New(db, nil, logrus.New())
 		dummy := &leader.DummyElector{}
-		state = NewManager(getDefaultConfig(), common.GetTestLog(), db, eventsHandler, nil, nil, nil, dummy, mockOperators, nil, nil, nil, nil)
+		state = NewManager(getDefaultConfig(), common.GetTestLog(), db, eventsHandler, nil, nil, nil, dummy, mockOperators, nil, nil, nil, nil, nil)
 		key = types.NamespacedName{
 			Namespace: kubeKeyNamespace,
 			Name:      kubeKeyName,
@@ -2979,7 +3147,7 @@ var _ = Describe("Transform day1 cluster to a day2 cluster", func() {
 	BeforeEach(func() {
 		db, dbName = common.PrepareTestDB()
 		ctrl = gomock.NewController(GinkgoT())
-		clusterApi = NewManager(getDefaultConfig(), common.GetTestLog(), db, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		clusterApi = NewManager(getDefaultConfig(), common.GetTestLog(), db, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 		Expect(envconfig.Process("test", &cfg)).ShouldNot(HaveOccurred())
 	})
 
@@ -3180,7 +3348,7 @@ var _ = Describe("Update AMS subscription ID", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		db, dbName = common.PrepareTestDB()
 		eventsHandler = events.New(db, nil, logrus.New())
-		api = NewManager(getDefaultConfig(), common.GetTestLog(), db, eventsHandler, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		api = NewManager(getDefaultConfig(), common.GetTestLog(), db, eventsHandler, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 	})
 
 	AfterEach(func() {
@@ -3270,7 +3438,7 @@ var _ = Describe("Validation metrics and events", func() {
 		mockHost = host.NewMockAPI(ctrl)
 		mockMetric = metrics.NewMockAPI(ctrl)
 		mockS3Client = s3wrapper.NewMockAPI(ctrl)
-		m = NewManager(getDefaultConfig(), common.GetTestLog(), db, mockEvents, mockHost, mockMetric, nil, nil, nil, nil, mockS3Client, nil, nil)
+		m = NewManager(getDefaultConfig(), common.GetTestLog(), db, mockEvents, mockHost, mockMetric, nil, nil, nil, nil, mockS3Client, nil, nil, nil)
 		c = registerTestClusterWithValidationsAndHost()
 	})
 
@@ -3349,7 +3517,7 @@ var _ = Describe("Console-operator's availability", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		db, dbName = common.PrepareTestDB()
 		mockEvents = eventsapi.NewMockHandler(ctrl)
-		clusterApi = NewManager(getDefaultConfig(), common.GetTestLog(), db, mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		clusterApi = NewManager(getDefaultConfig(), common.GetTestLog(), db, mockEvents, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 	})
 
 	AfterEach(func() {
@@ -3465,7 +3633,7 @@ var _ = Describe("Test RefreshSchedulableMastersForcedTrue", func() {
 	BeforeEach(func() {
 		ctrl = gomock.NewController(GinkgoT())
 		db, dbName = common.PrepareTestDB()
-		clusterApi = NewManager(getDefaultConfig(), common.GetTestLog(), db, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		clusterApi = NewManager(getDefaultConfig(), common.GetTestLog(), db, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 	})
 
 	AfterEach(func() {
@@ -11,6 +11,7 @@ import (
 	eventsapi "github.com/openshift/assisted-service/internal/events/api"
 	"github.com/openshift/assisted-service/internal/network"
 	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/transaction"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/thoas/go-funk"
@@ -267,11 +268,11 @@ func machineNetworksAlreadyExist(cluster *common.Cluster, machineNetworks []stri
 	return true
 }
 
-func updateMachineNetworks(db *gorm.DB, cluster *common.Cluster, machineNetworks []string) error {
+func updateMachineNetworks(ctx context.Context, db *gorm.DB, cluster *common.Cluster, machineNetworks []string) error {
 	if len(machineNetworks) == 0 || machineNetworksAlreadyExist(cluster, machineNetworks) {
 		return nil
 	}
-	return db.Transaction(func(tx *gorm.DB) error {
+	return transaction.Transaction(ctx, db, "update machine networks", func(tx *gorm.DB) error {
 		if err := tx.Delete(&models.MachineNetwork{}, "cluster_id = ?", cluster.ID.String()).Error; err != nil {
 			return err
 		}
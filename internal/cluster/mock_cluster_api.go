@@ -6,14 +6,14 @@ package cluster
 
 import (
 	context "context"
-	reflect "reflect"
-
 	strfmt "github.com/go-openapi/strfmt"
 	gomock "github.com/golang/mock/gomock"
 	common "github.com/openshift/assisted-service/internal/common"
+	api "github.com/openshift/assisted-service/internal/manifests/api"
 	s3wrapper "github.com/openshift/assisted-service/pkg/s3wrapper"
 	gorm "gorm.io/gorm"
 	types "k8s.io/apimachinery/pkg/types"
+	reflect "reflect"
 )
 
 // MockRegistrationAPI is a mock of RegistrationAPI interface.
@@ -305,17 +305,22 @@ func (mr *MockAPIMockRecorder) DeregisterCluster(ctx, c interface{}) *gomock.Cal
 }
 
 // DeregisterInactiveCluster mocks base method.
-func (m *MockAPI) DeregisterInactiveCluster(ctx context.Context, maxDeregisterPerInterval int, inactiveSince strfmt.DateTime) error {
+func (m *MockAPI) DeregisterInactiveCluster(ctx context.Context, maxDeregisterPerInterval int, inactiveSince strfmt.DateTime, scopes ...func(*gorm.DB) *gorm.DB) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeregisterInactiveCluster", ctx, maxDeregisterPerInterval, inactiveSince)
+	varargs := []interface{}{ctx, maxDeregisterPerInterval, inactiveSince}
+	for _, a := range scopes {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeregisterInactiveCluster", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeregisterInactiveCluster indicates an expected call of DeregisterInactiveCluster.
-func (mr *MockAPIMockRecorder) DeregisterInactiveCluster(ctx, maxDeregisterPerInterval, inactiveSince interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) DeregisterInactiveCluster(ctx, maxDeregisterPerInterval, inactiveSince interface{}, scopes ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterInactiveCluster", reflect.TypeOf((*MockAPI)(nil).DeregisterInactiveCluster), ctx, maxDeregisterPerInterval, inactiveSince)
+	varargs := append([]interface{}{ctx, maxDeregisterPerInterval, inactiveSince}, scopes...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterInactiveCluster", reflect.TypeOf((*MockAPI)(nil).DeregisterInactiveCluster), varargs...)
 }
 
 // GenerateAdditionalManifests mocks base method.
@@ -416,17 +421,22 @@ func (mr *MockAPIMockRecorder) IsReadyForInstallation(c interface{}) *gomock.Cal
 }
 
 // PermanentClustersDeletion mocks base method.
-func (m *MockAPI) PermanentClustersDeletion(ctx context.Context, olderThan strfmt.DateTime, objectHandler s3wrapper.API) error {
+func (m *MockAPI) PermanentClustersDeletion(ctx context.Context, olderThan strfmt.DateTime, objectHandler s3wrapper.API, scopes ...func(*gorm.DB) *gorm.DB) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PermanentClustersDeletion", ctx, olderThan, objectHandler)
+	varargs := []interface{}{ctx, olderThan, objectHandler}
+	for _, a := range scopes {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PermanentClustersDeletion", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // PermanentClustersDeletion indicates an expected call of PermanentClustersDeletion.
-func (mr *MockAPIMockRecorder) PermanentClustersDeletion(ctx, olderThan, objectHandler interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) PermanentClustersDeletion(ctx, olderThan, objectHandler interface{}, scopes ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PermanentClustersDeletion", reflect.TypeOf((*MockAPI)(nil).PermanentClustersDeletion), ctx, olderThan, objectHandler)
+	varargs := append([]interface{}{ctx, olderThan, objectHandler}, scopes...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PermanentClustersDeletion", reflect.TypeOf((*MockAPI)(nil).PermanentClustersDeletion), varargs...)
 }
 
 // PrepareClusterLogFile mocks base method.
@@ -529,6 +539,21 @@ func (mr *MockAPIMockRecorder) RegisterCluster(ctx, c interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterCluster", reflect.TypeOf((*MockAPI)(nil).RegisterCluster), ctx, c)
 }
 
+// RenderAdditionalManifests mocks base method.
+func (m *MockAPI) RenderAdditionalManifests(ctx context.Context, cluster *common.Cluster, manifestsAPI api.ClusterManifestsInternals) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenderAdditionalManifests", ctx, cluster, manifestsAPI)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenderAdditionalManifests indicates an expected call of RenderAdditionalManifests.
+func (mr *MockAPIMockRecorder) RenderAdditionalManifests(ctx, cluster, manifestsAPI interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderAdditionalManifests", reflect.TypeOf((*MockAPI)(nil).RenderAdditionalManifests), ctx, cluster, manifestsAPI)
+}
+
 // ResetCluster mocks base method.
 func (m *MockAPI) ResetCluster(ctx context.Context, c *common.Cluster, reason string, db *gorm.DB) *common.ApiErrorResponse {
 	m.ctrl.T.Helper()
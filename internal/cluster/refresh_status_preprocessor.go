@@ -9,6 +9,7 @@ import (
 	"github.com/openshift/assisted-service/internal/host"
 	"github.com/openshift/assisted-service/internal/operators"
 	"github.com/openshift/assisted-service/internal/operators/api"
+	"github.com/openshift/assisted-service/internal/validationcache"
 	"github.com/openshift/assisted-service/models"
 	"github.com/sirupsen/logrus"
 	"github.com/thoas/go-funk"
@@ -31,9 +32,10 @@ type refreshPreprocessor struct {
 	validations  []validation
 	conditions   []condition
 	operatorsAPI operators.API
+	cache        *validationcache.Cache
 }
 
-func newRefreshPreprocessor(log logrus.FieldLogger, hostAPI host.API, operatorsAPI operators.API) *refreshPreprocessor {
+func newRefreshPreprocessor(log logrus.FieldLogger, hostAPI host.API, operatorsAPI operators.API, validationCacheConfig validationcache.Config) *refreshPreprocessor {
 	v := clusterValidator{
 		log:     log,
 		hostAPI: hostAPI,
@@ -44,6 +46,7 @@ func newRefreshPreprocessor(log logrus.FieldLogger, hostAPI host.API, operatorsA
 		validations:  newValidations(&v),
 		conditions:   newConditions(&v),
 		operatorsAPI: operatorsAPI,
+		cache:        validationcache.New("cluster", validationCacheConfig),
 	}
 }
 
@@ -58,7 +61,7 @@ func (r *refreshPreprocessor) preprocess(ctx context.Context, c *clusterPreproce
 		return stateMachineInput, validationsOutput, nil
 	}
 	for _, v := range r.validations {
-		st, message := v.condition(c)
+		st, message := r.evaluate(c, v)
 		stateMachineInput[v.id.String()] = st == ValidationSuccess
 		category, err := v.id.Category()
 		if err != nil {
@@ -102,6 +105,20 @@ func (r *refreshPreprocessor) preprocess(ctx context.Context, c *clusterPreproce
 	return stateMachineInput, validationsOutput, nil
 }
 
+// evaluate returns the result of the validation, reusing the cached one if its inputs didn't change
+func (r *refreshPreprocessor) evaluate(c *clusterPreprocessContext, v validation) (ValidationStatus, string) {
+	fingerprint, err := c.fingerprint(v.inputs)
+	if err != nil {
+		r.log.WithError(err).Warnf("Failed to fingerprint the inputs of validation %s of cluster %s, not using the cache", v.id, c.clusterId)
+		fingerprint = nil
+	}
+	result := r.cache.Evaluate(c.clusterId.String(), v.id.String(), fingerprint, func() validationcache.Result {
+		st, message := v.condition(c)
+		return validationcache.Result{Status: string(st), Message: message}
+	})
+	return ValidationStatus(result.Status), result.Message
+}
+
 // sortByValidationResultID sorts results by models.ClusterValidationID
 func sortByValidationResultID(validationResults []ValidationResult) {
 	sort.SliceStable(validationResults, func(i, j int) bool {
@@ -118,6 +135,7 @@ func newValidations(v *clusterValidator) []validation {
 		{
 			id:        IsMachineCidrEqualsToCalculatedCidr,
 			condition: v.isMachineCidrEqualsToCalculatedCidr,
+			inputs:    []validationInput{inputClusterNetwork, inputHostsAddresses},
 		},
 		{
 			id:        AreApiVipsDefined,
@@ -126,6 +144,7 @@ func newValidations(v *clusterValidator) []validation {
 		{
 			id:        AreApiVipsValid,
 			condition: v.areApiVipsValid,
+			inputs:    []validationInput{inputClusterNetwork, inputHostsAddresses},
 		},
 		{
 			id:        isNetworkTypeValid,
@@ -138,6 +157,7 @@ func newValidations(v *clusterValidator) []validation {
 		{
 			id:        AreIngressVipsValid,
 			condition: v.areIngressVipsValid,
+			inputs:    []validationInput{inputClusterNetwork, inputHostsAddresses},
 		},
 		{
 			id:        AllHostsAreReadyToInstall,
@@ -8,6 +8,7 @@ import (
 	"github.com/go-openapi/swag"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/transaction"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
@@ -37,7 +38,7 @@ func (r *registrar) registerCluster(ctx context.Context, cluster *common.Cluster
 	cluster.Status = swag.String(status)
 	cluster.StatusInfo = swag.String(statusInfo)
 	cluster.StatusUpdatedAt = strfmt.DateTime(registerTime)
-	tx := r.db.Begin()
+	tx := transaction.Begin(ctx, r.db, "register cluster")
 	success := false
 	defer func() {
 		if rec := recover(); rec != nil || !success {
@@ -92,7 +93,7 @@ func (r *registrar) RegisterAddHostsOCPCluster(c *common.Cluster, db *gorm.DB) e
 
 func (r *registrar) DeregisterCluster(ctx context.Context, cluster *common.Cluster) error {
 	var txErr error
-	tx := r.db.Begin()
+	tx := transaction.Begin(ctx, r.db, "deregister cluster")
 
 	defer func() {
 		if txErr != nil {
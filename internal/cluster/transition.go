@@ -22,6 +22,7 @@ import (
 	logutil "github.com/openshift/assisted-service/pkg/log"
 	"github.com/openshift/assisted-service/pkg/ocm"
 	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/openshift/assisted-service/pkg/transaction"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/thoas/go-funk"
@@ -685,7 +686,7 @@ func isPendingUserResetRequired(hostAPI host.API, c *common.Cluster) bool {
 
 func setPendingUserReset(ctx context.Context, c *common.Cluster, db *gorm.DB, hostAPI host.API) error {
 	txSuccess := false
-	tx := db.Begin()
+	tx := transaction.Begin(ctx, db, "set pending user reset")
 	defer func() {
 		if !txSuccess {
 			tx.Rollback()
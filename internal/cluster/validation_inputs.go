@@ -0,0 +1,94 @@
+package cluster
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/openshift/assisted-service/internal/validationcache"
+	"github.com/openshift/assisted-service/models"
+)
+
+// validationInput is a part of the cluster state a validation reads. Validations that declare
+// their inputs are only recomputed when one of them changes, so the declaration must cover
+// everything the validation reads. Validations depending on the time, on the database or on
+// the host state machine must not declare inputs.
+type validationInput int
+
+const (
+	// The type and network configuration of the cluster
+	inputClusterNetwork validationInput = iota
+	// The addresses of the hosts of the cluster, as reported in their inventories and free addresses
+	inputHostsAddresses
+)
+
+type clusterNetworkInputs struct {
+	Kind                  *string
+	HighAvailabilityMode  *string
+	UserManagedNetworking *bool
+	VipDhcpAllocation     *bool
+	MachineNetworks       []*models.MachineNetwork
+	APIVips               []*models.APIVip
+	IngressVips           []*models.IngressVip
+}
+
+type hostAddressesInputs struct {
+	ID            *strfmt.UUID
+	Inventory     validationcache.Fingerprint
+	FreeAddresses validationcache.Fingerprint
+}
+
+func (c *clusterPreprocessContext) inputValue(input validationInput) (interface{}, error) {
+	switch input {
+	case inputClusterNetwork:
+		return clusterNetworkInputs{
+			Kind:                  c.cluster.Kind,
+			HighAvailabilityMode:  c.cluster.HighAvailabilityMode,
+			UserManagedNetworking: c.cluster.UserManagedNetworking,
+			VipDhcpAllocation:     c.cluster.VipDhcpAllocation,
+			MachineNetworks:       c.cluster.MachineNetworks,
+			APIVips:               c.cluster.APIVips,
+			IngressVips:           c.cluster.IngressVips,
+		}, nil
+	case inputHostsAddresses:
+		hosts := make([]hostAddressesInputs, 0, len(c.cluster.Hosts))
+		for _, h := range c.cluster.Hosts {
+			inventory, err := validationcache.NewFingerprint(h.Inventory)
+			if err != nil {
+				return nil, err
+			}
+			freeAddresses, err := validationcache.NewFingerprint(h.FreeAddresses)
+			if err != nil {
+				return nil, err
+			}
+			hosts = append(hosts, hostAddressesInputs{ID: h.ID, Inventory: inventory, FreeAddresses: freeAddresses})
+		}
+		return hosts, nil
+	}
+	return nil, nil
+}
+
+// fingerprint returns the fingerprint of the given inputs, or nil if there are none. The
+// fingerprint of each input is only calculated once per context.
+func (c *clusterPreprocessContext) fingerprint(inputs []validationInput) (*validationcache.Fingerprint, error) {
+	if len(inputs) == 0 {
+		return nil, nil
+	}
+	if c.inputFingerprints == nil {
+		c.inputFingerprints = make(map[validationInput]validationcache.Fingerprint)
+	}
+	fingerprints := make([]validationcache.Fingerprint, 0, len(inputs))
+	for _, input := range inputs {
+		fingerprint, ok := c.inputFingerprints[input]
+		if !ok {
+			value, err := c.inputValue(input)
+			if err != nil {
+				return nil, err
+			}
+			if fingerprint, err = validationcache.NewFingerprint(input, value); err != nil {
+				return nil, err
+			}
+			c.inputFingerprints[input] = fingerprint
+		}
+		fingerprints = append(fingerprints, fingerprint)
+	}
+	combined := validationcache.Combine(fingerprints...)
+	return &combined, nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/go-openapi/swag"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/network"
 	"github.com/openshift/assisted-service/models"
 	auth "github.com/openshift/assisted-service/pkg/auth"
@@ -632,6 +633,53 @@ var _ = Describe("Machine Network amount and order", func() {
 	}
 })
 
+var _ = Describe("vSphere platform validation", func() {
+	newPlatform := func() *models.Platform {
+		return &models.Platform{
+			Type: common.PlatformTypePtr(models.PlatformTypeVsphere),
+			Vsphere: &models.VspherePlatform{
+				Vcenter:          "vcenter.example.com",
+				Datacenter:       "dc",
+				Cluster:          "cluster",
+				DefaultDatastore: "datastore",
+				Network:          "VM Network",
+				Username:         "user",
+			},
+		}
+	}
+
+	It("accepts a platform without vSphere configuration", func() {
+		Expect(ValidateVspherePlatform(nil)).To(Succeed())
+		Expect(ValidateVspherePlatform(&models.Platform{Type: common.PlatformTypePtr(models.PlatformTypeVsphere)})).To(Succeed())
+	})
+
+	It("accepts a complete configuration", func() {
+		Expect(ValidateVspherePlatform(newPlatform())).To(Succeed())
+		platform := newPlatform()
+		platform.Vsphere.Vcenter = "192.168.1.10:8443"
+		Expect(ValidateVspherePlatform(platform)).To(Succeed())
+	})
+
+	It("rejects vSphere configuration with another platform", func() {
+		platform := newPlatform()
+		platform.Type = common.PlatformTypePtr(models.PlatformTypeBaremetal)
+		Expect(ValidateVspherePlatform(platform)).To(MatchError(ContainSubstring("supported only alongside vsphere platform")))
+	})
+
+	It("reports missing fields", func() {
+		platform := newPlatform()
+		platform.Vsphere.Datacenter = ""
+		platform.Vsphere.Username = " "
+		Expect(ValidateVspherePlatform(platform)).To(MatchError(ContainSubstring("datacenter, username")))
+	})
+
+	It("rejects a vCenter URL", func() {
+		platform := newPlatform()
+		platform.Vsphere.Vcenter = "https://vcenter.example.com/sdk"
+		Expect(ValidateVspherePlatform(platform)).To(HaveOccurred())
+	})
+})
+
 func TestCluster(t *testing.T) {
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "cluster validations tests")
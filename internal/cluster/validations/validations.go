@@ -869,3 +869,39 @@ func ValidateHighAvailabilityModeWithPlatform(highAvailabilityMode *string, plat
 
 	return nil
 }
+
+// ValidateVspherePlatform verifies that the vCenter configuration, when provided, is complete and
+// is only used with the vSphere platform. The password is validated by the caller since it may
+// have been provided in a previous request.
+func ValidateVspherePlatform(platform *models.Platform) error {
+	if platform == nil || platform.Vsphere == nil {
+		return nil
+	}
+	if common.PlatformTypeValue(platform.Type) != models.PlatformTypeVsphere {
+		return errors.Errorf("vSphere configuration is supported only alongside %s platform", models.PlatformTypeVsphere)
+	}
+	vsphere := platform.Vsphere
+	var missing []string
+	for _, field := range []struct {
+		name  string
+		value string
+	}{
+		{"vcenter", vsphere.Vcenter},
+		{"datacenter", vsphere.Datacenter},
+		{"cluster", vsphere.Cluster},
+		{"default_datastore", vsphere.DefaultDatastore},
+		{"network", vsphere.Network},
+		{"username", vsphere.Username},
+	} {
+		if strings.TrimSpace(field.value) == "" {
+			missing = append(missing, field.name)
+		}
+	}
+	if len(missing) > 0 {
+		return errors.Errorf("vSphere configuration is missing the following fields: %s", strings.Join(missing, ", "))
+	}
+	if strings.ContainsAny(vsphere.Vcenter, "/?#@") {
+		return errors.Errorf("vCenter %s must be a hostname or an IP address, optionally followed by a port", vsphere.Vcenter)
+	}
+	return nil
+}
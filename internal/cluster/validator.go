@@ -12,6 +12,7 @@ import (
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/host"
 	"github.com/openshift/assisted-service/internal/network"
+	"github.com/openshift/assisted-service/internal/validationcache"
 	"github.com/openshift/assisted-service/models"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -43,6 +44,7 @@ type clusterPreprocessContext struct {
 	db                      *gorm.DB
 	calculateCidr           string
 	hasHostsWithInventories bool
+	inputFingerprints       map[validationInput]validationcache.Fingerprint
 }
 
 type validationConditon func(context *clusterPreprocessContext) (ValidationStatus, string)
@@ -50,6 +52,8 @@ type validationConditon func(context *clusterPreprocessContext) (ValidationStatu
 type validation struct {
 	id        ValidationID
 	condition validationConditon
+	// The inputs of the condition, its result is cached while they don't change
+	inputs []validationInput
 }
 
 func hasHostsWithInventories(c *common.Cluster) bool {
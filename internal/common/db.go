@@ -63,6 +63,17 @@ type Cluster struct {
 
 	// StaticNetworkConfigured indicates if static network configuration was set for the ISO used by clusters' nodes
 	StaticNetworkConfigured bool `json:"static_network_configured"`
+
+	// The password of the vCenter user of clusters installed on the vSphere platform, encrypted by the service
+	VsphereEncryptedPassword string `json:"vsphere_encrypted_password" gorm:"type:TEXT"`
+
+	// The roles and hostnames of the hosts of the cluster bundle the cluster was imported from, as a JSON list
+	// of cluster-bundle-host. They are applied to the hosts with matching MAC addresses as they register
+	ImportedHosts string `json:"imported_hosts" gorm:"type:TEXT"`
+
+	// PlatformSelectedByUser indicates that the platform of the cluster was chosen by the user, either when the
+	// cluster was registered or updated. The platform of such a cluster is never selected automatically
+	PlatformSelectedByUser bool `json:"platform_selected_by_user"`
 }
 
 type Event struct {
@@ -70,6 +81,20 @@ type Event struct {
 	models.Event
 }
 
+type ClusterRevision struct {
+	gorm.Model
+	models.ClusterRevision
+}
+
+// ClusterRevisionsUniqueIndex is the name of the unique index of the revisions of a cluster, it is created by a
+// migration
+const ClusterRevisionsUniqueIndex = "idx_cluster_revisions_cluster_id_revision"
+
+type InstallConfigOverridesRevision struct {
+	gorm.Model
+	models.InstallConfigOverridesRevision
+}
+
 type Host struct {
 	models.Host
 	Approved bool `json:"approved"`
@@ -157,6 +182,8 @@ func AutoMigrate(db *gorm.DB) error {
 		&Host{},
 		&Cluster{},
 		&Event{},
+		&ClusterRevision{},
+		&InstallConfigOverridesRevision{},
 		&InfraEnv{},
 		&models.ClusterNetwork{},
 		&models.ServiceNetwork{},
@@ -302,6 +329,13 @@ func GetHostFromDBWhere(db *gorm.DB, where ...interface{}) (*Host, error) {
 	return &host, nil
 }
 
+// HostMacAddressesQuery is the JSON array of the MAC addresses of the interfaces in the inventory of a host, it is
+// indexed by the HostMacAddressesIndex GIN index so hosts can be looked up by MAC address with the @> operator
+const HostMacAddressesQuery = "jsonb_path_query_array(NULLIF(inventory, '')::jsonb, '$.interfaces[*].mac_address')"
+
+// HostMacAddressesIndex is the name of the index of HostMacAddressesQuery, it is created by a migration
+const HostMacAddressesIndex = "idx_hosts_inventory_mac_addresses"
+
 func GetHostsFromDBWhere(db *gorm.DB, where ...interface{}) ([]*Host, error) {
 	var hosts []*Host
 
@@ -469,6 +469,233 @@ func (e *ClusterRegistrationSucceededEvent) FormatMessage() string {
     return e.format(&s)
 }
 
+//
+// Event cluster_cloned
+//
+type ClusterClonedEvent struct {
+    eventName string
+    ClusterId strfmt.UUID
+    SourceClusterId strfmt.UUID
+}
+
+var ClusterClonedEventName string = "cluster_cloned"
+
+func NewClusterClonedEvent(
+    clusterId strfmt.UUID,
+    sourceClusterId strfmt.UUID,
+) *ClusterClonedEvent {
+    return &ClusterClonedEvent{
+        eventName: ClusterClonedEventName,
+        ClusterId: clusterId,
+        SourceClusterId: sourceClusterId,
+    }
+}
+
+func SendClusterClonedEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    sourceClusterId strfmt.UUID,) {
+    ev := NewClusterClonedEvent(
+        clusterId,
+        sourceClusterId,
+    )
+    eventsHandler.SendClusterEvent(ctx, ev)
+}
+
+func SendClusterClonedEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    sourceClusterId strfmt.UUID,
+    eventTime time.Time) {
+    ev := NewClusterClonedEvent(
+        clusterId,
+        sourceClusterId,
+    )
+    eventsHandler.SendClusterEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *ClusterClonedEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *ClusterClonedEvent) GetSeverity() string {
+    return "info"
+}
+func (e *ClusterClonedEvent) GetClusterId() strfmt.UUID {
+    return e.ClusterId
+}
+
+
+
+func (e *ClusterClonedEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{source_cluster_id}", fmt.Sprint(e.SourceClusterId),
+    )
+    return r.Replace(*message)
+}
+
+func (e *ClusterClonedEvent) FormatMessage() string {
+    s := "Cluster was cloned from cluster {source_cluster_id}"
+    return e.format(&s)
+}
+
+//
+// Event cluster_clone_conflict
+//
+type ClusterCloneConflictEvent struct {
+    eventName string
+    ClusterId strfmt.UUID
+    SourceClusterId strfmt.UUID
+    Message string
+}
+
+var ClusterCloneConflictEventName string = "cluster_clone_conflict"
+
+func NewClusterCloneConflictEvent(
+    clusterId strfmt.UUID,
+    sourceClusterId strfmt.UUID,
+    message string,
+) *ClusterCloneConflictEvent {
+    return &ClusterCloneConflictEvent{
+        eventName: ClusterCloneConflictEventName,
+        ClusterId: clusterId,
+        SourceClusterId: sourceClusterId,
+        Message: message,
+    }
+}
+
+func SendClusterCloneConflictEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    sourceClusterId strfmt.UUID,
+    message string,) {
+    ev := NewClusterCloneConflictEvent(
+        clusterId,
+        sourceClusterId,
+        message,
+    )
+    eventsHandler.SendClusterEvent(ctx, ev)
+}
+
+func SendClusterCloneConflictEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    sourceClusterId strfmt.UUID,
+    message string,
+    eventTime time.Time) {
+    ev := NewClusterCloneConflictEvent(
+        clusterId,
+        sourceClusterId,
+        message,
+    )
+    eventsHandler.SendClusterEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *ClusterCloneConflictEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *ClusterCloneConflictEvent) GetSeverity() string {
+    return "warning"
+}
+func (e *ClusterCloneConflictEvent) GetClusterId() strfmt.UUID {
+    return e.ClusterId
+}
+
+
+
+func (e *ClusterCloneConflictEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{source_cluster_id}", fmt.Sprint(e.SourceClusterId),
+        "{message}", fmt.Sprint(e.Message),
+    )
+    return r.Replace(*message)
+}
+
+func (e *ClusterCloneConflictEvent) FormatMessage() string {
+    s := "Cluster differs from its source cluster {source_cluster_id}: {message}"
+    return e.format(&s)
+}
+
+//
+// Event cluster_rolled_back
+//
+type ClusterRolledBackEvent struct {
+    eventName string
+    ClusterId strfmt.UUID
+    Revision int64
+}
+
+var ClusterRolledBackEventName string = "cluster_rolled_back"
+
+func NewClusterRolledBackEvent(
+    clusterId strfmt.UUID,
+    revision int64,
+) *ClusterRolledBackEvent {
+    return &ClusterRolledBackEvent{
+        eventName: ClusterRolledBackEventName,
+        ClusterId: clusterId,
+        Revision: revision,
+    }
+}
+
+func SendClusterRolledBackEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    revision int64,) {
+    ev := NewClusterRolledBackEvent(
+        clusterId,
+        revision,
+    )
+    eventsHandler.SendClusterEvent(ctx, ev)
+}
+
+func SendClusterRolledBackEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    revision int64,
+    eventTime time.Time) {
+    ev := NewClusterRolledBackEvent(
+        clusterId,
+        revision,
+    )
+    eventsHandler.SendClusterEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *ClusterRolledBackEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *ClusterRolledBackEvent) GetSeverity() string {
+    return "info"
+}
+func (e *ClusterRolledBackEvent) GetClusterId() strfmt.UUID {
+    return e.ClusterId
+}
+
+
+
+func (e *ClusterRolledBackEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{revision}", fmt.Sprint(e.Revision),
+    )
+    return r.Replace(*message)
+}
+
+func (e *ClusterRolledBackEvent) FormatMessage() string {
+    s := "Cluster configuration was rolled back to revision {revision}"
+    return e.format(&s)
+}
+
 //
 // Event cluster_deregister_failed
 //
@@ -1491,6 +1718,152 @@ func (e *ApiIngressVipTimedOutEvent) FormatMessage() string {
     return e.format(&s)
 }
 
+//
+// Event cluster_platform_auto_selected
+//
+type ClusterPlatformAutoSelectedEvent struct {
+    eventName string
+    ClusterId strfmt.UUID
+    PlatformType string
+}
+
+var ClusterPlatformAutoSelectedEventName string = "cluster_platform_auto_selected"
+
+func NewClusterPlatformAutoSelectedEvent(
+    clusterId strfmt.UUID,
+    platformType string,
+) *ClusterPlatformAutoSelectedEvent {
+    return &ClusterPlatformAutoSelectedEvent{
+        eventName: ClusterPlatformAutoSelectedEventName,
+        ClusterId: clusterId,
+        PlatformType: platformType,
+    }
+}
+
+func SendClusterPlatformAutoSelectedEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    platformType string,) {
+    ev := NewClusterPlatformAutoSelectedEvent(
+        clusterId,
+        platformType,
+    )
+    eventsHandler.SendClusterEvent(ctx, ev)
+}
+
+func SendClusterPlatformAutoSelectedEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    platformType string,
+    eventTime time.Time) {
+    ev := NewClusterPlatformAutoSelectedEvent(
+        clusterId,
+        platformType,
+    )
+    eventsHandler.SendClusterEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *ClusterPlatformAutoSelectedEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *ClusterPlatformAutoSelectedEvent) GetSeverity() string {
+    return "info"
+}
+func (e *ClusterPlatformAutoSelectedEvent) GetClusterId() strfmt.UUID {
+    return e.ClusterId
+}
+
+
+
+func (e *ClusterPlatformAutoSelectedEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{platform_type}", fmt.Sprint(e.PlatformType),
+    )
+    return r.Replace(*message)
+}
+
+func (e *ClusterPlatformAutoSelectedEvent) FormatMessage() string {
+    s := "Cluster platform was automatically set to {platform_type} since all the hosts of the cluster support it"
+    return e.format(&s)
+}
+
+//
+// Event vsphere_credentials_validation_failed
+//
+type VsphereCredentialsValidationFailedEvent struct {
+    eventName string
+    ClusterId strfmt.UUID
+    Error string
+}
+
+var VsphereCredentialsValidationFailedEventName string = "vsphere_credentials_validation_failed"
+
+func NewVsphereCredentialsValidationFailedEvent(
+    clusterId strfmt.UUID,
+    error string,
+) *VsphereCredentialsValidationFailedEvent {
+    return &VsphereCredentialsValidationFailedEvent{
+        eventName: VsphereCredentialsValidationFailedEventName,
+        ClusterId: clusterId,
+        Error: error,
+    }
+}
+
+func SendVsphereCredentialsValidationFailedEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    error string,) {
+    ev := NewVsphereCredentialsValidationFailedEvent(
+        clusterId,
+        error,
+    )
+    eventsHandler.SendClusterEvent(ctx, ev)
+}
+
+func SendVsphereCredentialsValidationFailedEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    error string,
+    eventTime time.Time) {
+    ev := NewVsphereCredentialsValidationFailedEvent(
+        clusterId,
+        error,
+    )
+    eventsHandler.SendClusterEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *VsphereCredentialsValidationFailedEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *VsphereCredentialsValidationFailedEvent) GetSeverity() string {
+    return "error"
+}
+func (e *VsphereCredentialsValidationFailedEvent) GetClusterId() strfmt.UUID {
+    return e.ClusterId
+}
+
+
+
+func (e *VsphereCredentialsValidationFailedEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{error}", fmt.Sprint(e.Error),
+    )
+    return r.Replace(*message)
+}
+
+func (e *VsphereCredentialsValidationFailedEvent) FormatMessage() string {
+    s := "Failed to validate the vSphere credentials: {error}"
+    return e.format(&s)
+}
+
 //
 // Event prepare_installation_failed
 //
@@ -2016,6 +2389,101 @@ func (e *HostDeregisteredEvent) FormatMessage() string {
     return e.format(&s)
 }
 
+//
+// Event host_permanently_deleted
+//
+type HostPermanentlyDeletedEvent struct {
+    eventName string
+    HostId strfmt.UUID
+    InfraEnvId strfmt.UUID
+    ClusterId *strfmt.UUID
+    HostName string
+}
+
+var HostPermanentlyDeletedEventName string = "host_permanently_deleted"
+
+func NewHostPermanentlyDeletedEvent(
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+) *HostPermanentlyDeletedEvent {
+    return &HostPermanentlyDeletedEvent{
+        eventName: HostPermanentlyDeletedEventName,
+        HostId: hostId,
+        InfraEnvId: infraEnvId,
+        ClusterId: clusterId,
+        HostName: hostName,
+    }
+}
+
+func SendHostPermanentlyDeletedEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,) {
+    ev := NewHostPermanentlyDeletedEvent(
+        hostId,
+        infraEnvId,
+        clusterId,
+        hostName,
+    )
+    eventsHandler.SendHostEvent(ctx, ev)
+}
+
+func SendHostPermanentlyDeletedEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+    eventTime time.Time) {
+    ev := NewHostPermanentlyDeletedEvent(
+        hostId,
+        infraEnvId,
+        clusterId,
+        hostName,
+    )
+    eventsHandler.SendHostEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *HostPermanentlyDeletedEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *HostPermanentlyDeletedEvent) GetSeverity() string {
+    return "info"
+}
+func (e *HostPermanentlyDeletedEvent) GetClusterId() *strfmt.UUID {
+    return e.ClusterId
+}
+func (e *HostPermanentlyDeletedEvent) GetHostId() strfmt.UUID {
+    return e.HostId
+}
+func (e *HostPermanentlyDeletedEvent) GetInfraEnvId() strfmt.UUID {
+    return e.InfraEnvId
+}
+
+
+
+func (e *HostPermanentlyDeletedEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{host_id}", fmt.Sprint(e.HostId),
+        "{infra_env_id}", fmt.Sprint(e.InfraEnvId),
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{host_name}", fmt.Sprint(e.HostName),
+    )
+    return r.Replace(*message)
+}
+
+func (e *HostPermanentlyDeletedEvent) FormatMessage() string {
+    s := "Host {host_name} was permanently deleted"
+    return e.format(&s)
+}
+
 //
 // Event host_installer_args_applied
 //
@@ -3862,105 +4330,173 @@ func SendInfraEnvDeregisterFailedEventAtTime(
     eventTime time.Time) {
     ev := NewInfraEnvDeregisterFailedEvent(
         infraEnvId,
-        error,
+        error,
+    )
+    eventsHandler.SendInfraEnvEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *InfraEnvDeregisterFailedEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *InfraEnvDeregisterFailedEvent) GetSeverity() string {
+    return "error"
+}
+func (e *InfraEnvDeregisterFailedEvent) GetClusterId() *strfmt.UUID {
+    return nil
+}
+func (e *InfraEnvDeregisterFailedEvent) GetInfraEnvId() strfmt.UUID {
+    return e.InfraEnvId
+}
+
+
+
+func (e *InfraEnvDeregisterFailedEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{infra_env_id}", fmt.Sprint(e.InfraEnvId),
+        "{error}", fmt.Sprint(e.Error),
+    )
+    return r.Replace(*message)
+}
+
+func (e *InfraEnvDeregisterFailedEvent) FormatMessage() string {
+    s := "Failed to deregister infra env. Error: {error}"
+    return e.format(&s)
+}
+
+//
+// Event infra_env_deregistered
+//
+type InfraEnvDeregisteredEvent struct {
+    eventName string
+    InfraEnvId strfmt.UUID
+}
+
+var InfraEnvDeregisteredEventName string = "infra_env_deregistered"
+
+func NewInfraEnvDeregisteredEvent(
+    infraEnvId strfmt.UUID,
+) *InfraEnvDeregisteredEvent {
+    return &InfraEnvDeregisteredEvent{
+        eventName: InfraEnvDeregisteredEventName,
+        InfraEnvId: infraEnvId,
+    }
+}
+
+func SendInfraEnvDeregisteredEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    infraEnvId strfmt.UUID,) {
+    ev := NewInfraEnvDeregisteredEvent(
+        infraEnvId,
+    )
+    eventsHandler.SendInfraEnvEvent(ctx, ev)
+}
+
+func SendInfraEnvDeregisteredEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    infraEnvId strfmt.UUID,
+    eventTime time.Time) {
+    ev := NewInfraEnvDeregisteredEvent(
+        infraEnvId,
     )
     eventsHandler.SendInfraEnvEventAtTime(ctx, ev, eventTime)
 }
 
-func (e *InfraEnvDeregisterFailedEvent) GetName() string {
+func (e *InfraEnvDeregisteredEvent) GetName() string {
     return e.eventName
 }
 
-func (e *InfraEnvDeregisterFailedEvent) GetSeverity() string {
-    return "error"
+func (e *InfraEnvDeregisteredEvent) GetSeverity() string {
+    return "info"
 }
-func (e *InfraEnvDeregisterFailedEvent) GetClusterId() *strfmt.UUID {
+func (e *InfraEnvDeregisteredEvent) GetClusterId() *strfmt.UUID {
     return nil
 }
-func (e *InfraEnvDeregisterFailedEvent) GetInfraEnvId() strfmt.UUID {
+func (e *InfraEnvDeregisteredEvent) GetInfraEnvId() strfmt.UUID {
     return e.InfraEnvId
 }
 
 
 
-func (e *InfraEnvDeregisterFailedEvent) format(message *string) string {
+func (e *InfraEnvDeregisteredEvent) format(message *string) string {
     r := strings.NewReplacer(
         "{infra_env_id}", fmt.Sprint(e.InfraEnvId),
-        "{error}", fmt.Sprint(e.Error),
     )
     return r.Replace(*message)
 }
 
-func (e *InfraEnvDeregisterFailedEvent) FormatMessage() string {
-    s := "Failed to deregister infra env. Error: {error}"
+func (e *InfraEnvDeregisteredEvent) FormatMessage() string {
+    s := "Deregistered infra env"
     return e.format(&s)
 }
 
 //
-// Event infra_env_deregistered
+// Event infra_env_deleted_after_inactivity
 //
-type InfraEnvDeregisteredEvent struct {
+type InfraEnvDeletedAfterInactivityEvent struct {
     eventName string
     InfraEnvId strfmt.UUID
 }
 
-var InfraEnvDeregisteredEventName string = "infra_env_deregistered"
+var InfraEnvDeletedAfterInactivityEventName string = "infra_env_deleted_after_inactivity"
 
-func NewInfraEnvDeregisteredEvent(
+func NewInfraEnvDeletedAfterInactivityEvent(
     infraEnvId strfmt.UUID,
-) *InfraEnvDeregisteredEvent {
-    return &InfraEnvDeregisteredEvent{
-        eventName: InfraEnvDeregisteredEventName,
+) *InfraEnvDeletedAfterInactivityEvent {
+    return &InfraEnvDeletedAfterInactivityEvent{
+        eventName: InfraEnvDeletedAfterInactivityEventName,
         InfraEnvId: infraEnvId,
     }
 }
 
-func SendInfraEnvDeregisteredEvent(
+func SendInfraEnvDeletedAfterInactivityEvent(
     ctx context.Context,
     eventsHandler eventsapi.Sender,
     infraEnvId strfmt.UUID,) {
-    ev := NewInfraEnvDeregisteredEvent(
+    ev := NewInfraEnvDeletedAfterInactivityEvent(
         infraEnvId,
     )
     eventsHandler.SendInfraEnvEvent(ctx, ev)
 }
 
-func SendInfraEnvDeregisteredEventAtTime(
+func SendInfraEnvDeletedAfterInactivityEventAtTime(
     ctx context.Context,
     eventsHandler eventsapi.Sender,
     infraEnvId strfmt.UUID,
     eventTime time.Time) {
-    ev := NewInfraEnvDeregisteredEvent(
+    ev := NewInfraEnvDeletedAfterInactivityEvent(
         infraEnvId,
     )
     eventsHandler.SendInfraEnvEventAtTime(ctx, ev, eventTime)
 }
 
-func (e *InfraEnvDeregisteredEvent) GetName() string {
+func (e *InfraEnvDeletedAfterInactivityEvent) GetName() string {
     return e.eventName
 }
 
-func (e *InfraEnvDeregisteredEvent) GetSeverity() string {
+func (e *InfraEnvDeletedAfterInactivityEvent) GetSeverity() string {
     return "info"
 }
-func (e *InfraEnvDeregisteredEvent) GetClusterId() *strfmt.UUID {
+func (e *InfraEnvDeletedAfterInactivityEvent) GetClusterId() *strfmt.UUID {
     return nil
 }
-func (e *InfraEnvDeregisteredEvent) GetInfraEnvId() strfmt.UUID {
+func (e *InfraEnvDeletedAfterInactivityEvent) GetInfraEnvId() strfmt.UUID {
     return e.InfraEnvId
 }
 
 
 
-func (e *InfraEnvDeregisteredEvent) format(message *string) string {
+func (e *InfraEnvDeletedAfterInactivityEvent) format(message *string) string {
     r := strings.NewReplacer(
         "{infra_env_id}", fmt.Sprint(e.InfraEnvId),
     )
     return r.Replace(*message)
 }
 
-func (e *InfraEnvDeregisteredEvent) FormatMessage() string {
-    s := "Deregistered infra env"
+func (e *InfraEnvDeletedAfterInactivityEvent) FormatMessage() string {
+    s := "Deleted infra env after inactivity"
     return e.format(&s)
 }
 
@@ -5707,6 +6243,299 @@ func (e *HostUnbindFailedEvent) FormatMessage() string {
     return e.format(&s)
 }
 
+//
+// Event host_reclaim_started
+//
+type HostReclaimStartedEvent struct {
+    eventName string
+    HostId strfmt.UUID
+    InfraEnvId strfmt.UUID
+    ClusterId *strfmt.UUID
+    HostName string
+}
+
+var HostReclaimStartedEventName string = "host_reclaim_started"
+
+func NewHostReclaimStartedEvent(
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+) *HostReclaimStartedEvent {
+    return &HostReclaimStartedEvent{
+        eventName: HostReclaimStartedEventName,
+        HostId: hostId,
+        InfraEnvId: infraEnvId,
+        ClusterId: clusterId,
+        HostName: hostName,
+    }
+}
+
+func SendHostReclaimStartedEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,) {
+    ev := NewHostReclaimStartedEvent(
+        hostId,
+        infraEnvId,
+        clusterId,
+        hostName,
+    )
+    eventsHandler.SendHostEvent(ctx, ev)
+}
+
+func SendHostReclaimStartedEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+    eventTime time.Time) {
+    ev := NewHostReclaimStartedEvent(
+        hostId,
+        infraEnvId,
+        clusterId,
+        hostName,
+    )
+    eventsHandler.SendHostEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *HostReclaimStartedEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *HostReclaimStartedEvent) GetSeverity() string {
+    return "info"
+}
+func (e *HostReclaimStartedEvent) GetClusterId() *strfmt.UUID {
+    return e.ClusterId
+}
+func (e *HostReclaimStartedEvent) GetHostId() strfmt.UUID {
+    return e.HostId
+}
+func (e *HostReclaimStartedEvent) GetInfraEnvId() strfmt.UUID {
+    return e.InfraEnvId
+}
+
+
+
+func (e *HostReclaimStartedEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{host_id}", fmt.Sprint(e.HostId),
+        "{infra_env_id}", fmt.Sprint(e.InfraEnvId),
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{host_name}", fmt.Sprint(e.HostName),
+    )
+    return r.Replace(*message)
+}
+
+func (e *HostReclaimStartedEvent) FormatMessage() string {
+    s := "Host {host_name}: Started reclaiming host from cluster {cluster_id}"
+    return e.format(&s)
+}
+
+//
+// Event host_reclaim_start_failed
+//
+type HostReclaimStartFailedEvent struct {
+    eventName string
+    HostId strfmt.UUID
+    InfraEnvId strfmt.UUID
+    Message string
+}
+
+var HostReclaimStartFailedEventName string = "host_reclaim_start_failed"
+
+func NewHostReclaimStartFailedEvent(
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    message string,
+) *HostReclaimStartFailedEvent {
+    return &HostReclaimStartFailedEvent{
+        eventName: HostReclaimStartFailedEventName,
+        HostId: hostId,
+        InfraEnvId: infraEnvId,
+        Message: message,
+    }
+}
+
+func SendHostReclaimStartFailedEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    message string,) {
+    ev := NewHostReclaimStartFailedEvent(
+        hostId,
+        infraEnvId,
+        message,
+    )
+    eventsHandler.SendHostEvent(ctx, ev)
+}
+
+func SendHostReclaimStartFailedEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    message string,
+    eventTime time.Time) {
+    ev := NewHostReclaimStartFailedEvent(
+        hostId,
+        infraEnvId,
+        message,
+    )
+    eventsHandler.SendHostEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *HostReclaimStartFailedEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *HostReclaimStartFailedEvent) GetSeverity() string {
+    return "error"
+}
+func (e *HostReclaimStartFailedEvent) GetClusterId() *strfmt.UUID {
+    return nil
+}
+func (e *HostReclaimStartFailedEvent) GetHostId() strfmt.UUID {
+    return e.HostId
+}
+func (e *HostReclaimStartFailedEvent) GetInfraEnvId() strfmt.UUID {
+    return e.InfraEnvId
+}
+
+
+
+func (e *HostReclaimStartFailedEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{host_id}", fmt.Sprint(e.HostId),
+        "{infra_env_id}", fmt.Sprint(e.InfraEnvId),
+        "{message}", fmt.Sprint(e.Message),
+    )
+    return r.Replace(*message)
+}
+
+func (e *HostReclaimStartFailedEvent) FormatMessage() string {
+    s := "Failed to reclaim host {host_id}: {message}"
+    return e.format(&s)
+}
+
+//
+// Event host_csr_approved
+//
+type HostCsrApprovedEvent struct {
+    eventName string
+    HostId strfmt.UUID
+    InfraEnvId strfmt.UUID
+    ClusterId *strfmt.UUID
+    HostName string
+    CsrType string
+    CsrName string
+}
+
+var HostCsrApprovedEventName string = "host_csr_approved"
+
+func NewHostCsrApprovedEvent(
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+    csrType string,
+    csrName string,
+) *HostCsrApprovedEvent {
+    return &HostCsrApprovedEvent{
+        eventName: HostCsrApprovedEventName,
+        HostId: hostId,
+        InfraEnvId: infraEnvId,
+        ClusterId: clusterId,
+        HostName: hostName,
+        CsrType: csrType,
+        CsrName: csrName,
+    }
+}
+
+func SendHostCsrApprovedEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+    csrType string,
+    csrName string,) {
+    ev := NewHostCsrApprovedEvent(
+        hostId,
+        infraEnvId,
+        clusterId,
+        hostName,
+        csrType,
+        csrName,
+    )
+    eventsHandler.SendHostEvent(ctx, ev)
+}
+
+func SendHostCsrApprovedEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    clusterId *strfmt.UUID,
+    hostName string,
+    csrType string,
+    csrName string,
+    eventTime time.Time) {
+    ev := NewHostCsrApprovedEvent(
+        hostId,
+        infraEnvId,
+        clusterId,
+        hostName,
+        csrType,
+        csrName,
+    )
+    eventsHandler.SendHostEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *HostCsrApprovedEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *HostCsrApprovedEvent) GetSeverity() string {
+    return "info"
+}
+func (e *HostCsrApprovedEvent) GetClusterId() *strfmt.UUID {
+    return e.ClusterId
+}
+func (e *HostCsrApprovedEvent) GetHostId() strfmt.UUID {
+    return e.HostId
+}
+func (e *HostCsrApprovedEvent) GetInfraEnvId() strfmt.UUID {
+    return e.InfraEnvId
+}
+
+
+
+func (e *HostCsrApprovedEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{host_id}", fmt.Sprint(e.HostId),
+        "{infra_env_id}", fmt.Sprint(e.InfraEnvId),
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{host_name}", fmt.Sprint(e.HostName),
+        "{csr_type}", fmt.Sprint(e.CsrType),
+        "{csr_name}", fmt.Sprint(e.CsrName),
+    )
+    return r.Replace(*message)
+}
+
+func (e *HostCsrApprovedEvent) FormatMessage() string {
+    s := "Host {host_name}: Approved {csr_type} certificate signing request {csr_name}"
+    return e.format(&s)
+}
+
 //
 // Event inactive_clusters_deregistered
 //
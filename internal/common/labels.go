@@ -0,0 +1,80 @@
+package common
+
+import (
+	"encoding/json"
+
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// labelValueQuery is the value of a label in the JSON labels column of clusters and infra-envs
+const labelValueQuery = "NULLIF(labels, '')::jsonb ->> ?"
+
+// MarshalLabels validates the user-defined labels of a cluster or an infra-env the same way Kubernetes validates
+// labels, and returns them as the JSON stored in the labels column
+func MarshalLabels(labelsList []*models.LabelParams) (string, error) {
+	labelsMap := make(map[string]string)
+	for _, l := range labelsList {
+		labelsMap[*l.Key] = *l.Value
+	}
+
+	errs := validation.ValidateLabels(labelsMap, field.NewPath("labels"))
+	if len(errs) != 0 {
+		return "", errors.Errorf("%s", errs.ToAggregate().Error())
+	}
+
+	labelsJson, err := json.Marshal(&labelsMap)
+	if err != nil {
+		return "", err
+	}
+	return string(labelsJson), nil
+}
+
+// LabelSelectorScope returns a scope that filters clusters or infra-envs by a Kubernetes label selector, e.g.
+// "env=prod,team in (a,b),!deprecated". The greater than and less than operators aren't supported.
+func LabelSelectorScope(selector string) (func(db *gorm.DB) *gorm.DB, error) {
+	parsed, err := labels.Parse(selector)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid label selector %s", selector)
+	}
+	requirements, _ := parsed.Requirements()
+
+	type condition struct {
+		query string
+		args  []interface{}
+	}
+	conditions := make([]condition, 0, len(requirements))
+	for _, r := range requirements {
+		key := r.Key()
+		switch r.Operator() {
+		case selection.Equals, selection.DoubleEquals, selection.In:
+			conditions = append(conditions, condition{
+				query: labelValueQuery + " IN ?",
+				args:  []interface{}{key, r.Values().List()},
+			})
+		case selection.NotEquals, selection.NotIn:
+			conditions = append(conditions, condition{
+				query: labelValueQuery + " IS NULL OR " + labelValueQuery + " NOT IN ?",
+				args:  []interface{}{key, key, r.Values().List()},
+			})
+		case selection.Exists:
+			conditions = append(conditions, condition{query: labelValueQuery + " IS NOT NULL", args: []interface{}{key}})
+		case selection.DoesNotExist:
+			conditions = append(conditions, condition{query: labelValueQuery + " IS NULL", args: []interface{}{key}})
+		default:
+			return nil, errors.Errorf("invalid label selector %s: operator %s is not supported", selector, r.Operator())
+		}
+	}
+
+	return func(db *gorm.DB) *gorm.DB {
+		for _, c := range conditions {
+			db = db.Where(c.query, c.args...)
+		}
+		return db
+	}, nil
+}
@@ -0,0 +1,74 @@
+package common
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/models"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("MarshalLabels", func() {
+	label := func(key, value string) *models.LabelParams {
+		return &models.LabelParams{Key: &key, Value: &value}
+	}
+
+	It("marshals the labels", func() {
+		labels, err := MarshalLabels([]*models.LabelParams{label("env", "prod"), label("example.com/team", "a")})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(labels).To(Equal(`{"env":"prod","example.com/team":"a"}`))
+	})
+
+	It("marshals no labels", func() {
+		labels, err := MarshalLabels([]*models.LabelParams{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(labels).To(Equal(`{}`))
+	})
+
+	It("rejects invalid labels", func() {
+		_, err := MarshalLabels([]*models.LabelParams{label("env", "not a value")})
+		Expect(err).To(MatchError(ContainSubstring("labels: Invalid value")))
+	})
+})
+
+var _ = Describe("LabelSelectorScope", func() {
+	var db *gorm.DB
+
+	BeforeEach(func() {
+		var err error
+		db, err = gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}), &gorm.Config{DryRun: true, DisableAutomaticPing: true})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	DescribeTable("translates selectors to SQL",
+		func(selector, expectedSQL string) {
+			scope, err := LabelSelectorScope(selector)
+			Expect(err).ToNot(HaveOccurred())
+			sql := db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+				return tx.Scopes(scope).Find(&[]*Cluster{})
+			})
+			Expect(sql).To(Equal(expectedSQL))
+		},
+		Entry("empty", "",
+			`SELECT * FROM "clusters" WHERE "clusters"."deleted_at" IS NULL`),
+		Entry("equals", "env=prod",
+			`SELECT * FROM "clusters" WHERE NULLIF(labels, '')::jsonb ->> 'env' IN ('prod') AND "clusters"."deleted_at" IS NULL`),
+		Entry("in and not equals", "env in (prod,staging),team!=a",
+			`SELECT * FROM "clusters" WHERE NULLIF(labels, '')::jsonb ->> 'env' IN ('prod','staging') AND `+
+				`(NULLIF(labels, '')::jsonb ->> 'team' IS NULL OR NULLIF(labels, '')::jsonb ->> 'team' NOT IN ('a')) AND `+
+				`"clusters"."deleted_at" IS NULL`),
+		Entry("exists and does not exist", "env,!deprecated",
+			`SELECT * FROM "clusters" WHERE NULLIF(labels, '')::jsonb ->> 'deprecated' IS NULL AND `+
+				`NULLIF(labels, '')::jsonb ->> 'env' IS NOT NULL AND "clusters"."deleted_at" IS NULL`),
+	)
+
+	DescribeTable("rejects invalid selectors",
+		func(selector, expectedError string) {
+			_, err := LabelSelectorScope(selector)
+			Expect(err).To(MatchError(ContainSubstring(expectedError)))
+		},
+		Entry("syntax error", "env in prod", "invalid label selector env in prod"),
+		Entry("greater than", "replicas>3", "operator gt is not supported"),
+	)
+})
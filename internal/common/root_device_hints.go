@@ -0,0 +1,18 @@
+package common
+
+import "github.com/openshift/assisted-service/models"
+
+// RootDeviceHintsUpdates returns the updates of the root device hints columns of a host or an infra-env. All the
+// columns are updated, so the hints replace the existing ones and hints without any field set clear them.
+func RootDeviceHintsUpdates(hints *models.RootDeviceHints) map[string]interface{} {
+	return map[string]interface{}{
+		"root_device_hints_device_name":        hints.DeviceName,
+		"root_device_hints_hctl":               hints.Hctl,
+		"root_device_hints_model":              hints.Model,
+		"root_device_hints_vendor":             hints.Vendor,
+		"root_device_hints_serial_number":      hints.SerialNumber,
+		"root_device_hints_min_size_gigabytes": hints.MinSizeGigabytes,
+		"root_device_hints_wwn":                hints.Wwn,
+		"root_device_hints_rotational":         hints.Rotational,
+	}
+}
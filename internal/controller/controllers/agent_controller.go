@@ -34,12 +34,15 @@ import (
 	aiv1beta1 "github.com/openshift/assisted-service/api/v1beta1"
 	"github.com/openshift/assisted-service/internal/bminventory"
 	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/csrapprover"
 	"github.com/openshift/assisted-service/internal/gencrypto"
 	"github.com/openshift/assisted-service/internal/host"
+	"github.com/openshift/assisted-service/internal/reclaim"
 	"github.com/openshift/assisted-service/internal/spoke_k8s_client"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/auth"
 	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/tracing"
 	"github.com/openshift/assisted-service/restapi/operations/installer"
 	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
 	hivev1 "github.com/openshift/hive/apis/hive/v1"
@@ -87,7 +90,7 @@ type AgentReconciler struct {
 	ApproveCsrsRequeueDuration time.Duration
 	AgentContainerImage        string
 	HostFSMountDir             string
-	reclaimer                  *agentReclaimer
+	reclaimer                  reclaim.Reclaimer
 }
 
 // +kubebuilder:rbac:groups=agent-install.openshift.io,resources=agents,verbs=get;list;watch;create;update;patch;delete
@@ -95,8 +98,12 @@ type AgentReconciler struct {
 // +kubebuilder:rbac:groups=agent-install.openshift.io,resources=agents/ai-deprovision,verbs=update
 // +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
 
-func (r *AgentReconciler) Reconcile(origCtx context.Context, req ctrl.Request) (ctrl.Result, error) {
+func (r *AgentReconciler) Reconcile(origCtx context.Context, req ctrl.Request) (_ ctrl.Result, err error) {
 	ctx := addRequestIdIfNeeded(origCtx)
+	ctx, span := startReconcileSpan(ctx, "Agent", req)
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
 	log := logutil.FromContext(ctx, r.Log).WithFields(
 		logrus.Fields{
 			"agent":           req.Name,
@@ -111,7 +118,7 @@ func (r *AgentReconciler) Reconcile(origCtx context.Context, req ctrl.Request) (
 
 	agent := &aiv1beta1.Agent{}
 
-	err := r.Get(ctx, req.NamespacedName, agent)
+	err = r.Get(ctx, req.NamespacedName, agent)
 	if err != nil {
 		log.WithError(err).Errorf("Failed to get resource %s", req.NamespacedName)
 		return ctrl.Result{}, client.IgnoreNotFound(err)
@@ -239,12 +246,12 @@ func (r *AgentReconciler) Reconcile(origCtx context.Context, req ctrl.Request) (
 }
 
 func (r *AgentReconciler) shouldApproveMoreCSRs(node *corev1.Node) bool {
-	return !isNodeReady(node)
+	return !csrapprover.IsNodeReady(node)
 }
 
 // Validate that the CSR can be approved
 func (r *AgentReconciler) shouldApproveCSR(csr *certificatesv1.CertificateSigningRequest, agent *aiv1beta1.Agent, validateNodeCsr nodeCsrValidator) (bool, error) {
-	x509CSR, err := getX509ParsedRequest(csr)
+	x509CSR, err := csrapprover.GetX509ParsedRequest(csr)
 	if err != nil {
 		return false, err
 	}
@@ -263,7 +270,7 @@ func (r *AgentReconciler) approveAIHostsCSRs(clients spoke_k8s_client.SpokeK8sCl
 	}
 	for i := range csrList.Items {
 		csr := &csrList.Items[i]
-		if !isCsrApproved(csr) {
+		if !csrapprover.IsCsrApproved(csr) {
 			shouldApprove, err := r.shouldApproveCSR(csr, agent, validateNodeCsr)
 			if err != nil || !shouldApprove {
 				if err != nil {
@@ -380,27 +387,7 @@ func (r *AgentReconciler) runReclaimAgent(ctx context.Context, log logrus.FieldL
 		return err
 	}
 
-	hostname := getAgentHostname(agent)
-	r.Log.Infof("Starting agent pod for reclaim on node %s", hostname)
-	if err := ensureSpokeNamespace(ctx, client, log); err != nil {
-		return err
-	}
-	if err := ensureSpokeServiceAccount(ctx, client, log); err != nil {
-		return err
-	}
-	if err := ensureSpokeRole(ctx, client, log); err != nil {
-		return err
-	}
-	if err := ensureSpokeRoleBinding(ctx, client, log); err != nil {
-		return err
-	}
-	if err := r.reclaimer.ensureSpokeAgentSecret(ctx, client, log, host.InfraEnvID.String()); err != nil {
-		return err
-	}
-	if err := r.reclaimer.ensureSpokeAgentCertCM(ctx, client, log); err != nil {
-		return err
-	}
-	return r.reclaimer.createNextStepRunnerDaemonSet(ctx, client, log, hostname, host.InfraEnvID.String(), host.ID.String())
+	return r.reclaimer.StartReclaimAgent(ctx, client, log, getAgentHostname(agent), host.InfraEnvID.String(), host.ID.String())
 }
 
 func (r *AgentReconciler) unbindHost(ctx context.Context, log logrus.FieldLogger, agent, origAgent *aiv1beta1.Agent, h *common.Host) (ctrl.Result, error) {
@@ -637,7 +624,7 @@ func (r *AgentReconciler) UpdateDay2InstallPogress(ctx context.Context, h *model
 		return ctrl.Result{}, err
 	}
 	ret := ctrl.Result{RequeueAfter: r.ApproveCsrsRequeueDuration}
-	if isNodeReady(node) {
+	if csrapprover.IsNodeReady(node) {
 		err = r.updateHostInstallProgress(ctx, h, models.HostStageDone)
 		agent.Status.Progress.CurrentStage = models.HostStageDone
 		// now that the node is done there is no need to requeue
@@ -1387,7 +1374,7 @@ func (r *AgentReconciler) setInfraEnvNameLabel(ctx context.Context, log logrus.F
 
 func (r *AgentReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	var err error
-	r.reclaimer, err = newAgentReclaimer(r.HostFSMountDir)
+	r.reclaimer, err = reclaim.NewReclaimerFromEnv(r.HostFSMountDir)
 	if err != nil {
 		return err
 	}
@@ -22,6 +22,7 @@ import (
 	"github.com/openshift/assisted-service/internal/bminventory"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/gencrypto"
+	"github.com/openshift/assisted-service/internal/reclaim"
 	"github.com/openshift/assisted-service/internal/spoke_k8s_client"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/auth"
@@ -682,13 +683,11 @@ var _ = Describe("agent reconcile", func() {
 		)
 
 		BeforeEach(func() {
-			hr.reclaimer = &agentReclaimer{
-				reclaimConfig: reclaimConfig{
-					AgentContainerImage: "quay.io/edge-infrastructure/assisted-installer-agent:latest",
-					AuthType:            auth.TypeNone,
-					ServiceBaseURL:      "https://assisted.example.com",
-				},
-			}
+			hr.reclaimer = reclaim.NewReclaimer(reclaim.Config{
+				AgentContainerImage: "quay.io/edge-infrastructure/assisted-installer-agent:latest",
+				AuthType:            auth.TypeNone,
+				ServiceBaseURL:      "https://assisted.example.com",
+			})
 			hostId := strfmt.UUID(uuid.New().String())
 			infraEnvID := strfmt.UUID(uuid.New().String())
 			commonHost = &common.Host{
@@ -1917,7 +1916,7 @@ VU1eS0RiS/Lz6HwRs2mATNY5FrpZOgdM3cI=
 							"system:authenticated",
 							"system:nodes",
 						},
-						Username: nodeUserPrefix + CommonHostname,
+						Username: "system:node:" + CommonHostname,
 					},
 				},
 			},
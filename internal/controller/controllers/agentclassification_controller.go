@@ -23,6 +23,7 @@ import (
 
 	aiv1beta1 "github.com/openshift/assisted-service/api/v1beta1"
 	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/tracing"
 	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
 	"github.com/sirupsen/logrus"
 	"github.com/thoas/go-funk"
@@ -52,8 +53,12 @@ type AgentClassificationReconciler struct {
 //+kubebuilder:rbac:groups=agent-install.openshift.io,resources=agentclassifications/finalizers,verbs=update
 //+kubebuilder:rbac:groups=agent-install.openshift.io,resources=agents,verbs=get;list;watch
 
-func (r *AgentClassificationReconciler) Reconcile(origCtx context.Context, req ctrl.Request) (ctrl.Result, error) {
+func (r *AgentClassificationReconciler) Reconcile(origCtx context.Context, req ctrl.Request) (_ ctrl.Result, err error) {
 	ctx := addRequestIdIfNeeded(origCtx)
+	ctx, span := startReconcileSpan(ctx, "AgentClassification", req)
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
 	log := r.Log.WithFields(
 		logrus.Fields{
 			"agent_classification":           req.Name,
@@ -24,6 +24,7 @@ import (
 	hiveext "github.com/openshift/assisted-service/api/hiveextension/v1beta1"
 	"github.com/openshift/assisted-service/internal/common"
 	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/tracing"
 	hivev1 "github.com/openshift/hive/apis/hive/v1"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -47,8 +48,12 @@ type AgentClusterInstallReconciler struct {
 // +kubebuilder:rbac:groups=extensions.hive.openshift.io,resources=agentclusterinstalls,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=extensions.hive.openshift.io,resources=agentclusterinstalls/status,verbs=get;update;patch
 
-func (r *AgentClusterInstallReconciler) Reconcile(origCtx context.Context, req ctrl.Request) (ctrl.Result, error) {
+func (r *AgentClusterInstallReconciler) Reconcile(origCtx context.Context, req ctrl.Request) (_ ctrl.Result, err error) {
 	ctx := addRequestIdIfNeeded(origCtx)
+	ctx, span := startReconcileSpan(ctx, "AgentClusterInstall", req)
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
 	log := logutil.FromContext(ctx, r.Log).WithFields(
 		logrus.Fields{
 			"agent_cluster_install":           req.Name,
@@ -24,6 +24,7 @@ import (
 	"github.com/itchyny/gojq"
 	aiv1beta1 "github.com/openshift/assisted-service/api/v1beta1"
 	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/tracing"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/types"
@@ -44,8 +45,12 @@ type AgentLabelReconciler struct {
 //+kubebuilder:rbac:groups=agent-install.openshift.io,resources=agents,verbs=get;list;watch
 //+kubebuilder:rbac:groups=agent-install.openshift.io,resources=agentclassifications,verbs=get;list;watch;create;update;patch;delete
 
-func (r *AgentLabelReconciler) Reconcile(origCtx context.Context, req ctrl.Request) (ctrl.Result, error) {
+func (r *AgentLabelReconciler) Reconcile(origCtx context.Context, req ctrl.Request) (_ ctrl.Result, err error) {
 	ctx := addRequestIdIfNeeded(origCtx)
+	ctx, span := startReconcileSpan(ctx, "AgentLabel", req)
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
 	log := r.Log.WithFields(
 		logrus.Fields{
 			"agent_label":           req.Name,
@@ -73,7 +78,7 @@ func (r *AgentLabelReconciler) Reconcile(origCtx context.Context, req ctrl.Reque
 	opts := &client.ListOptions{
 		Namespace: agent.Namespace,
 	}
-	err := r.List(ctx, &classifications, opts)
+	err = r.List(ctx, &classifications, opts)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
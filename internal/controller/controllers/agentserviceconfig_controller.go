@@ -24,6 +24,7 @@ import (
 	"net/url"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/go-openapi/swag"
 	"github.com/hashicorp/go-version"
@@ -34,6 +35,8 @@ import (
 	"github.com/openshift/assisted-service/internal/versions"
 	"github.com/openshift/assisted-service/models"
 	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/openshift/assisted-service/pkg/tracing"
 	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
 	toml "github.com/pelletier/go-toml"
 	pkgerror "github.com/pkg/errors"
@@ -51,6 +54,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/client-go/tools/record"
 	apiregv1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -76,6 +80,11 @@ const (
 	databasePasswordLength   int = 16
 	agentLocalAuthSecretName     = serviceName + "local-auth" // #nosec
 
+	// the CA certificates of an external database are mounted from the config map key in the service container
+	externalDatabaseCAKey       = "ca-bundle.crt"
+	externalDatabaseCAVolume    = "external-db-ca"
+	externalDatabaseCAMountPath = "/etc/assisted-db-ca"
+
 	defaultIngressCertCMName      string = "default-ingress-cert"
 	defaultIngressCertCMNamespace string = "openshift-config-managed"
 
@@ -104,6 +113,12 @@ var (
 	minDatabaseStorage   = resource.MustParse("1Gi")
 	minFilesystemStorage = resource.MustParse("1Gi")
 	minImageStorage      = resource.MustParse("10Gi")
+
+	externalDatabaseSecretKeys      = []string{"db.host", "db.port", "db.name", "db.user", "db.password"}
+	externalObjectStorageSecretKeys = []string{"endpoint", "bucket", "aws_region", "aws_access_key_id", "aws_secret_access_key"}
+
+	// interval for retrying the reconciliation when the external storage isn't available
+	externalStorageRetryInterval = time.Minute
 )
 
 type AgentServiceConfigReconcileContext struct {
@@ -116,6 +131,9 @@ type AgentServiceConfigReconcileContext struct {
 	Tolerations  []corev1.Toleration
 
 	Recorder record.EventRecorder
+
+	// verifies the connectivity of the external database and object storage, when configured
+	ExternalStorageChecker ExternalStorageChecker
 }
 
 // AgentServiceConfigReconciler reconciles a AgentServiceConfig object
@@ -186,9 +204,13 @@ type ComponentStatusFn func(context.Context, logrus.FieldLogger, string, appsv1.
 // +kubebuilder:rbac:groups="apiregistration.k8s.io",resources=apiservices,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=authorization.k8s.io,resources=subjectaccessreviews,verbs=create
 
-func (r *AgentServiceConfigReconciler) Reconcile(origCtx context.Context, req ctrl.Request) (ctrl.Result, error) {
+func (r *AgentServiceConfigReconciler) Reconcile(origCtx context.Context, req ctrl.Request) (_ ctrl.Result, err error) {
 	var asc ASC
 	ctx := addRequestIdIfNeeded(origCtx)
+	ctx, span := startReconcileSpan(ctx, "AgentServiceConfig", req)
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
 	log := logutil.FromContext(ctx, r.Log).WithFields(
 		logrus.Fields{
 			"agent_service_config":           req.Name,
@@ -245,6 +267,14 @@ func (r *AgentServiceConfigReconciler) Reconcile(origCtx context.Context, req ct
 		return ctrl.Result{}, nil
 	}
 
+	available, err := checkExternalStorage(ctx, log, asc)
+	if err != nil {
+		return ctrl.Result{Requeue: true}, err
+	}
+	if !available {
+		return ctrl.Result{RequeueAfter: externalStorageRetryInterval}, nil
+	}
+
 	// Remove IPXE HTTP routes if not needed
 	if err := cleanHTTPRoute(ctx, log, asc); err != nil {
 		return ctrl.Result{Requeue: true}, err
@@ -314,22 +344,30 @@ func updateConditions(ctx context.Context, log *logrus.Entry, asc ASC) error {
 }
 
 func getComponents(spec *aiv1beta1.AgentServiceConfigSpec) []component {
-	components := []component{
-		{"FilesystemStorage", aiv1beta1.ReasonStorageFailure, newFilesystemPVC},
-		{"DatabaseStorage", aiv1beta1.ReasonStorageFailure, newDatabasePVC},
+	components := []component{}
+	// The storage is not deployed when external storage is used
+	if spec.ExternalObjectStorage == nil {
+		components = append(components, component{"FilesystemStorage", aiv1beta1.ReasonStorageFailure, newFilesystemPVC})
+	}
+	if spec.ExternalDatabase == nil {
+		components = append(components,
+			component{"DatabaseStorage", aiv1beta1.ReasonStorageFailure, newDatabasePVC},
+			component{"DatabaseSecret", aiv1beta1.ReasonPostgresSecretFailure, newPostgresSecret},
+		)
+	}
+	components = append(components, []component{
 		{"ImageServiceService", aiv1beta1.ReasonImageHandlerServiceFailure, newImageServiceService},
 		{"AgentService", aiv1beta1.ReasonAgentServiceFailure, newAgentService},
 		{"ServiceMonitor", aiv1beta1.ReasonAgentServiceMonitorFailure, newServiceMonitor},
 		{"ImageServiceRoute", aiv1beta1.ReasonImageHandlerRouteFailure, newImageServiceRoute},
 		{"AgentRoute", aiv1beta1.ReasonAgentRouteFailure, newAgentRoute},
 		{"AgentLocalAuthSecret", aiv1beta1.ReasonAgentLocalAuthSecretFailure, newAgentLocalAuthSecret},
-		{"DatabaseSecret", aiv1beta1.ReasonPostgresSecretFailure, newPostgresSecret},
 		{"ImageServiceServiceAccount", aiv1beta1.ReasonImageHandlerServiceAccountFailure, newImageServiceServiceAccount},
 		{"IngressCertConfigMap", aiv1beta1.ReasonIngressCertFailure, newIngressCertCM},
 		{"ImageServiceConfigMap", aiv1beta1.ReasonConfigFailure, newImageServiceConfigMap},
 		{"AssistedServiceConfigMap", aiv1beta1.ReasonConfigFailure, newAssistedCM},
 		{"AssistedServiceDeployment", aiv1beta1.ReasonDeploymentFailure, newAssistedServiceDeployment},
-	}
+	}...)
 	// Additional routes need to be synced if HTTP iPXE routes are exposed
 	if exposeIPXEHTTPRoute(spec) {
 		components = append(components,
@@ -1093,7 +1131,7 @@ func newAssistedCM(ctx context.Context, log logrus.FieldLogger, asc ASC) (client
 			"INSTALL_RH_CA":          "false",
 			"REGISTRY_CREDS":         "",
 			"DEPLOY_TARGET":          "k8s",
-			"STORAGE":                "filesystem",
+			"STORAGE":                storageType(asc.spec),
 			"ISO_WORKSPACE_BASE_DIR": "/data",
 			"ISO_CACHE_DIR":          "/data/cache",
 
@@ -1121,6 +1159,11 @@ func newAssistedCM(ctx context.Context, log logrus.FieldLogger, asc ASC) (client
 			"SKIP_CERT_VERIFICATION": "False",
 		}
 
+		// The replicas share the monitoring and garbage collection instead of leaving it to the leader
+		if *getReplicas(asc.spec.AssistedServiceDeployment) > 1 {
+			cm.Data["ENABLE_WORK_PARTITIONING"] = "True"
+		}
+
 		copyEnv(cm.Data, "HTTP_PROXY")
 		copyEnv(cm.Data, "HTTPS_PROXY")
 		copyEnv(cm.Data, "NO_PROXY")
@@ -1188,12 +1231,12 @@ func newImageServiceStatefulSet(ctx context.Context, log logrus.FieldLogger, asc
 			{Name: "service-cabundle", MountPath: "/etc/image-service/ca-bundle"},
 			{Name: "image-service-data", MountPath: "/data"},
 		},
-		Resources: corev1.ResourceRequirements{
+		Resources: getResources(asc.spec.ImageServiceDeployment, corev1.ResourceRequirements{
 			Requests: corev1.ResourceList{
 				corev1.ResourceCPU:    resource.MustParse("100m"),
 				corev1.ResourceMemory: resource.MustParse("400Mi"),
 			},
-		},
+		}),
 		ReadinessProbe: &corev1.Probe{
 			ProbeHandler: corev1.ProbeHandler{
 				HTTPGet: &corev1.HTTPGetAction{
@@ -1246,8 +1289,7 @@ func newImageServiceStatefulSet(ctx context.Context, log logrus.FieldLogger, asc
 		}
 		controllerutil.AddFinalizer(statefulSet, imageServiceStatefulSetFinalizerName)
 
-		var replicas int32 = 1
-		statefulSet.Spec.Replicas = &replicas
+		statefulSet.Spec.Replicas = getReplicas(asc.spec.ImageServiceDeployment)
 
 		statefulSet.Spec.Template.Spec.Containers = []corev1.Container{container}
 		statefulSet.Spec.Template.Spec.ServiceAccountName = imageServiceName
@@ -1308,18 +1350,8 @@ func newImageServiceStatefulSet(ctx context.Context, log logrus.FieldLogger, asc
 		}
 
 		statefulSet.Spec.Template.Spec.Volumes = volumes
-
-		if asc.rec.NodeSelector != nil {
-			statefulSet.Spec.Template.Spec.NodeSelector = asc.rec.NodeSelector
-		} else {
-			statefulSet.Spec.Template.Spec.NodeSelector = map[string]string{}
-		}
-
-		if asc.rec.Tolerations != nil {
-			statefulSet.Spec.Template.Spec.Tolerations = asc.rec.Tolerations
-		} else {
-			statefulSet.Spec.Template.Spec.Tolerations = []corev1.Toleration{}
-		}
+		statefulSet.Spec.Template.Spec.NodeSelector = getNodeSelector(asc, asc.spec.ImageServiceDeployment)
+		statefulSet.Spec.Template.Spec.Tolerations = getTolerations(asc, asc.spec.ImageServiceDeployment)
 		return nil
 	}
 
@@ -1447,19 +1479,34 @@ func newAssistedServiceDeployment(ctx context.Context, log logrus.FieldLogger, a
 		return nil, nil, err
 	}
 
+	dbSecretName := databaseName
+	if asc.spec.ExternalDatabase != nil {
+		dbSecretName = asc.spec.ExternalDatabase.SecretRef.Name
+	}
 	envSecrets := []corev1.EnvVar{
 		// database
-		newSecretEnvVar("DB_HOST", "db.host", databaseName),
-		newSecretEnvVar("DB_NAME", "db.name", databaseName),
-		newSecretEnvVar("DB_PASS", "db.password", databaseName),
-		newSecretEnvVar("DB_PORT", "db.port", databaseName),
-		newSecretEnvVar("DB_USER", "db.user", databaseName),
+		newSecretEnvVar("DB_HOST", "db.host", dbSecretName),
+		newSecretEnvVar("DB_NAME", "db.name", dbSecretName),
+		newSecretEnvVar("DB_PASS", "db.password", dbSecretName),
+		newSecretEnvVar("DB_PORT", "db.port", dbSecretName),
+		newSecretEnvVar("DB_USER", "db.user", dbSecretName),
 
 		// local auth secret
 		newSecretEnvVar("EC_PUBLIC_KEY_PEM", "ec-public-key.pem", agentLocalAuthSecretName),
 		newSecretEnvVar("EC_PRIVATE_KEY_PEM", "ec-private-key.pem", agentLocalAuthSecretName),
 	}
 
+	if asc.spec.ExternalObjectStorage != nil {
+		s3SecretName := asc.spec.ExternalObjectStorage.SecretRef.Name
+		envSecrets = append(envSecrets,
+			newSecretEnvVar("S3_ENDPOINT_URL", "endpoint", s3SecretName),
+			newSecretEnvVar("S3_BUCKET", "bucket", s3SecretName),
+			newSecretEnvVar("S3_REGION", "aws_region", s3SecretName),
+			newSecretEnvVar("AWS_ACCESS_KEY_ID", "aws_access_key_id", s3SecretName),
+			newSecretEnvVar("AWS_SECRET_ACCESS_KEY", "aws_secret_access_key", s3SecretName),
+		)
+	}
+
 	if exposeIPXEHTTPRoute(asc.spec) {
 		envSecrets = append(envSecrets, corev1.EnvVar{Name: "HTTP_LISTEN_PORT", Value: serviceHTTPPort.String()})
 	}
@@ -1517,12 +1564,12 @@ func newAssistedServiceDeployment(ctx context.Context, log logrus.FieldLogger, a
 			{Name: "tls-certs", MountPath: "/etc/assisted-tls-config"},
 			{Name: "ingress-cert", MountPath: "/etc/assisted-ingress-cert"},
 		},
-		Resources: corev1.ResourceRequirements{
+		Resources: getResources(asc.spec.AssistedServiceDeployment, corev1.ResourceRequirements{
 			Requests: corev1.ResourceList{
 				corev1.ResourceCPU:    resource.MustParse("200m"),
 				corev1.ResourceMemory: resource.MustParse("512Mi"),
 			},
-		},
+		}),
 		LivenessProbe: &corev1.Probe{
 			InitialDelaySeconds: 30,
 			ProbeHandler: corev1.ProbeHandler{
@@ -1572,23 +1619,24 @@ func newAssistedServiceDeployment(ctx context.Context, log logrus.FieldLogger, a
 			},
 		},
 	}
+	if asc.spec.DatabaseResources != nil {
+		postgresContainer.Resources = *asc.spec.DatabaseResources
+	}
 
-	volumes := []corev1.Volume{
-		{
-			Name: "bucket-filesystem",
-			VolumeSource: corev1.VolumeSource{
-				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
-					ClaimName: serviceName,
-				},
-			},
+	// With external object storage the volume is only used as the ISO workspace
+	filesystemVolumeSource := corev1.VolumeSource{
+		PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+			ClaimName: serviceName,
 		},
+	}
+	if asc.spec.ExternalObjectStorage != nil {
+		filesystemVolumeSource = corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}
+	}
+
+	volumes := []corev1.Volume{
 		{
-			Name: "postgresdb",
-			VolumeSource: corev1.VolumeSource{
-				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
-					ClaimName: databaseName,
-				},
-			},
+			Name:         "bucket-filesystem",
+			VolumeSource: filesystemVolumeSource,
 		},
 		{
 			Name: "tls-certs",
@@ -1681,6 +1729,43 @@ func newAssistedServiceDeployment(ctx context.Context, log logrus.FieldLogger, a
 		volumes = append(volumes, volume)
 	}
 
+	if asc.spec.ExternalDatabase != nil {
+		if asc.spec.ExternalDatabase.SSLMode != "" {
+			serviceContainer.Env = append(serviceContainer.Env,
+				corev1.EnvVar{Name: "DB_SSL_MODE", Value: asc.spec.ExternalDatabase.SSLMode})
+		}
+		if asc.spec.ExternalDatabase.CACertificateRef != nil {
+			serviceContainer.Env = append(serviceContainer.Env, corev1.EnvVar{
+				Name:  "DB_SSL_ROOT_CERT",
+				Value: externalDatabaseCAMountPath + "/" + externalDatabaseCAKey,
+			})
+			serviceContainer.VolumeMounts = append(serviceContainer.VolumeMounts,
+				corev1.VolumeMount{Name: externalDatabaseCAVolume, MountPath: externalDatabaseCAMountPath})
+			volumes = append(volumes, corev1.Volume{
+				Name: externalDatabaseCAVolume,
+				VolumeSource: corev1.VolumeSource{
+					ConfigMap: &corev1.ConfigMapVolumeSource{
+						LocalObjectReference: *asc.spec.ExternalDatabase.CACertificateRef,
+						Items:                []corev1.KeyToPath{{Key: externalDatabaseCAKey, Path: externalDatabaseCAKey}},
+					},
+				},
+			})
+		}
+	}
+
+	containers := []corev1.Container{serviceContainer}
+	if asc.spec.ExternalDatabase == nil {
+		containers = append(containers, postgresContainer)
+		volumes = append(volumes, corev1.Volume{
+			Name: "postgresdb",
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: databaseName,
+				},
+			},
+		})
+	}
+
 	deploymentLabels := map[string]string{
 		"app": serviceName,
 	}
@@ -1713,8 +1798,7 @@ func newAssistedServiceDeployment(ctx context.Context, log logrus.FieldLogger, a
 		if err := controllerutil.SetControllerReference(asc.Object, deployment, asc.rec.Scheme); err != nil {
 			return err
 		}
-		var replicas int32 = 1
-		deployment.Spec.Replicas = &replicas
+		deployment.Spec.Replicas = getReplicas(asc.spec.AssistedServiceDeployment)
 		deployment.Spec.Strategy = deploymentStrategy
 
 		// Handle our hashed configMap(s)
@@ -1725,21 +1809,11 @@ func newAssistedServiceDeployment(ctx context.Context, log logrus.FieldLogger, a
 		deployment.Spec.Template.Annotations[mirrorConfigHashAnnotation] = mirrorConfigHash
 		deployment.Spec.Template.Annotations[userConfigHashAnnotation] = userConfigHash
 
-		deployment.Spec.Template.Spec.Containers = []corev1.Container{serviceContainer, postgresContainer}
+		deployment.Spec.Template.Spec.Containers = containers
 		deployment.Spec.Template.Spec.Volumes = volumes
 		deployment.Spec.Template.Spec.ServiceAccountName = serviceAccountName
-
-		if asc.rec.NodeSelector != nil {
-			deployment.Spec.Template.Spec.NodeSelector = asc.rec.NodeSelector
-		} else {
-			deployment.Spec.Template.Spec.NodeSelector = map[string]string{}
-		}
-
-		if asc.rec.Tolerations != nil {
-			deployment.Spec.Template.Spec.Tolerations = asc.rec.Tolerations
-		} else {
-			deployment.Spec.Template.Spec.Tolerations = []corev1.Toleration{}
-		}
+		deployment.Spec.Template.Spec.NodeSelector = getNodeSelector(asc, asc.spec.AssistedServiceDeployment)
+		deployment.Spec.Template.Spec.Tolerations = getTolerations(asc, asc.spec.AssistedServiceDeployment)
 
 		return nil
 	}
@@ -2387,29 +2461,23 @@ func validate(ctx context.Context, log logrus.FieldLogger, asc ASC) (bool, error
 	}
 	if len(failures) > 0 {
 		log.Error("Storage configuration isn't valid")
-		conditionsv1.SetStatusConditionNoHeartbeat(
-			asc.conditions, conditionsv1.Condition{
-				Type:    aiv1beta1.ConditionReconcileCompleted,
-				Status:  corev1.ConditionFalse,
-				Reason:  aiv1beta1.ReasonStorageFailure,
-				Message: fmt.Sprintf("%s.", strings.Join(failures, ". ")),
-			},
-		)
-		err = asc.rec.Status().Update(ctx, asc.Object)
-		if err != nil {
-			log.WithError(err).Error("Failed to update status")
-			return false, err
-		}
-		return false, nil
+		return false, setValidationFailure(ctx, log, asc, aiv1beta1.ReasonStorageFailure, failures)
 	}
 
-	// If we are here then the storage configuration validation succeeded, so we may need to
+	// Validate the resources, replicas and scheduling of the components
+	if failures = validateDeploymentConfigs(asc.spec); len(failures) > 0 {
+		log.Error("Deployment configuration isn't valid")
+		return false, setValidationFailure(ctx, log, asc, aiv1beta1.ReasonDeploymentConfigFailure, failures)
+	}
+
+	// If we are here then the configuration validation succeeded, so we may need to
 	// remove a previous failure condition:
 	condition := conditionsv1.FindStatusCondition(
 		*asc.conditions,
 		aiv1beta1.ConditionReconcileCompleted,
 	)
-	if condition != nil && condition.Reason == aiv1beta1.ReasonStorageFailure {
+	if condition != nil && (condition.Reason == aiv1beta1.ReasonStorageFailure ||
+		condition.Reason == aiv1beta1.ReasonDeploymentConfigFailure) {
 		conditionsv1.RemoveStatusCondition(
 			asc.conditions,
 			aiv1beta1.ConditionReconcileCompleted,
@@ -2419,8 +2487,102 @@ func validate(ctx context.Context, log logrus.FieldLogger, asc ASC) (bool, error
 	return true, nil
 }
 
+func setValidationFailure(ctx context.Context, log logrus.FieldLogger, asc ASC, reason string, failures []string) error {
+	conditionsv1.SetStatusConditionNoHeartbeat(
+		asc.conditions, conditionsv1.Condition{
+			Type:    aiv1beta1.ConditionReconcileCompleted,
+			Status:  corev1.ConditionFalse,
+			Reason:  reason,
+			Message: fmt.Sprintf("%s.", strings.Join(failures, ". ")),
+		},
+	)
+	if err := asc.rec.Status().Update(ctx, asc.Object); err != nil {
+		log.WithError(err).Error("Failed to update status")
+		return err
+	}
+	return nil
+}
+
+// validateDeploymentConfigs checks the resources, replicas and scheduling configured for the
+// components. Returns the failures, intended for reporting in conditions.
+func validateDeploymentConfigs(spec *aiv1beta1.AgentServiceConfigSpec) (failures []string) {
+	components := []struct {
+		name   string
+		config *aiv1beta1.DeploymentConfig
+	}{
+		{serviceName, spec.AssistedServiceDeployment},
+		{imageServiceName, spec.ImageServiceDeployment},
+	}
+	for _, component := range components {
+		if component.config == nil {
+			continue
+		}
+		if component.config.Replicas != nil && *component.config.Replicas < 1 {
+			failures = append(failures, fmt.Sprintf("Replicas of %s must be at least 1", component.name))
+		}
+		if component.config.Resources != nil {
+			failures = append(failures, validateResources(component.name, component.config.Resources)...)
+		}
+		for key, value := range component.config.NodeSelector {
+			for _, msg := range validation.IsQualifiedName(key) {
+				failures = append(failures, fmt.Sprintf("Node selector key %s of %s is invalid: %s", key, component.name, msg))
+			}
+			for _, msg := range validation.IsValidLabelValue(value) {
+				failures = append(failures, fmt.Sprintf("Node selector value %s of %s is invalid: %s", value, component.name, msg))
+			}
+		}
+		for i, toleration := range component.config.Tolerations {
+			if msg := validateToleration(toleration); msg != "" {
+				failures = append(failures, fmt.Sprintf("Toleration %d of %s is invalid: %s", i, component.name, msg))
+			}
+		}
+	}
+
+	if spec.AssistedServiceDeployment != nil && spec.AssistedServiceDeployment.Replicas != nil &&
+		*spec.AssistedServiceDeployment.Replicas > 1 && (spec.ExternalDatabase == nil || spec.ExternalObjectStorage == nil) {
+		failures = append(failures, fmt.Sprintf("Running more than one replica of %s requires external database and object storage", serviceName))
+	}
+
+	if spec.DatabaseResources != nil {
+		failures = append(failures, validateResources(databaseName, spec.DatabaseResources)...)
+	}
+
+	return failures
+}
+
+func validateResources(name string, resources *corev1.ResourceRequirements) (failures []string) {
+	for resourceName, request := range resources.Requests {
+		if limit, ok := resources.Limits[resourceName]; ok && request.Cmp(limit) > 0 {
+			failures = append(failures, fmt.Sprintf("The %s request %s of %s is larger than its limit %s",
+				resourceName, &request, name, &limit))
+		}
+	}
+	return failures
+}
+
+func validateToleration(toleration corev1.Toleration) string {
+	switch toleration.Operator {
+	case corev1.TolerationOpExists:
+		if toleration.Value != "" {
+			return "value must be empty when operator is Exists"
+		}
+	case corev1.TolerationOpEqual, "":
+		if toleration.Key == "" {
+			return "operator must be Exists when key is empty"
+		}
+	default:
+		return fmt.Sprintf("unsupported operator %s", toleration.Operator)
+	}
+	switch toleration.Effect {
+	case "", corev1.TaintEffectNoSchedule, corev1.TaintEffectPreferNoSchedule, corev1.TaintEffectNoExecute:
+	default:
+		return fmt.Sprintf("unsupported effect %s", toleration.Effect)
+	}
+	return ""
+}
+
 // validateStorage checks that the sizes of the storage volumes for the database, the file system
-// and the images are acceptable.
+// and the images are acceptable. Volumes replaced by external storage are not checked.
 //
 // Returns two slices of strings containing warnings and failures. Warnings are intended for
 // creation of events. Failures are intended for reporting in conditions, and for stopping the
@@ -2436,7 +2598,7 @@ func validateStorage(ctx context.Context, log logrus.FieldLogger, asc ASC) (warn
 
 	// Check the size of the database storage:
 	databaseStorage := asc.spec.DatabaseStorage.Resources.Requests.Storage()
-	if databaseStorage.Cmp(minDatabaseStorage) < 0 {
+	if asc.spec.ExternalDatabase == nil && databaseStorage.Cmp(minDatabaseStorage) < 0 {
 		message := fmt.Sprintf(
 			"Database storage %s is too small, it must be at least %s",
 			databaseStorage, &minDatabaseStorage,
@@ -2459,7 +2621,7 @@ func validateStorage(ctx context.Context, log logrus.FieldLogger, asc ASC) (warn
 
 	// Check the size of the filesystem storage:
 	filesystemStorage := asc.spec.FileSystemStorage.Resources.Requests.Storage()
-	if filesystemStorage.Cmp(minFilesystemStorage) < 0 {
+	if asc.spec.ExternalObjectStorage == nil && filesystemStorage.Cmp(minFilesystemStorage) < 0 {
 		message := fmt.Sprintf(
 			"Filesystem storage %s is too small, it must be at least %s",
 			filesystemStorage, &minFilesystemStorage,
@@ -2507,3 +2669,175 @@ func validateStorage(ctx context.Context, log logrus.FieldLogger, asc ASC) (warn
 
 	return
 }
+
+// checkExternalStorage verifies that the external database and object storage, if configured, are
+// reachable and reports it in the ExternalStorageAvailable condition. Returns false if they aren't,
+// in which case the reconciliation should not continue.
+func checkExternalStorage(ctx context.Context, log logrus.FieldLogger, asc ASC) (bool, error) {
+	if asc.spec.ExternalDatabase == nil && asc.spec.ExternalObjectStorage == nil {
+		conditionsv1.RemoveStatusCondition(asc.conditions, aiv1beta1.ConditionExternalStorageAvailable)
+		return true, nil
+	}
+
+	if reason, err := verifyExternalStorage(ctx, asc); err != nil {
+		log.WithError(err).Error("External storage isn't available")
+		asc.rec.Recorder.Event(asc.Object, "Warning", reason, err.Error())
+		conditionsv1.SetStatusConditionNoHeartbeat(asc.conditions, conditionsv1.Condition{
+			Type:    aiv1beta1.ConditionExternalStorageAvailable,
+			Status:  corev1.ConditionFalse,
+			Reason:  reason,
+			Message: err.Error(),
+		})
+		if updateErr := asc.rec.Status().Update(ctx, asc.Object); updateErr != nil {
+			log.WithError(updateErr).Error("Failed to update status")
+			return false, updateErr
+		}
+		return false, nil
+	}
+
+	conditionsv1.SetStatusConditionNoHeartbeat(asc.conditions, conditionsv1.Condition{
+		Type:    aiv1beta1.ConditionExternalStorageAvailable,
+		Status:  corev1.ConditionTrue,
+		Reason:  aiv1beta1.ReasonExternalStorageAvailable,
+		Message: "The external storage is available.",
+	})
+	return true, nil
+}
+
+// verifyExternalStorage returns the failure reason along with the error
+func verifyExternalStorage(ctx context.Context, asc ASC) (string, error) {
+	if asc.spec.ExternalDatabase != nil {
+		data, err := getExternalStorageSecretData(ctx, asc, asc.spec.ExternalDatabase.SecretRef.Name, externalDatabaseSecretKeys)
+		if err != nil {
+			return aiv1beta1.ReasonExternalDatabaseFailure, err
+		}
+		conn := ExternalDatabaseConnection{
+			Host:     data["db.host"],
+			Port:     data["db.port"],
+			Name:     data["db.name"],
+			User:     data["db.user"],
+			Password: data["db.password"],
+			SSLMode:  asc.spec.ExternalDatabase.SSLMode,
+		}
+		if ref := asc.spec.ExternalDatabase.CACertificateRef; ref != nil {
+			if conn.CACertificate, err = getExternalDatabaseCA(ctx, asc, ref.Name); err != nil {
+				return aiv1beta1.ReasonExternalDatabaseFailure, err
+			}
+		}
+		if err = asc.rec.ExternalStorageChecker.CheckDatabase(ctx, conn); err != nil {
+			return aiv1beta1.ReasonExternalDatabaseFailure, pkgerror.Wrap(err, "External database is not reachable")
+		}
+	}
+
+	if asc.spec.ExternalObjectStorage != nil {
+		data, err := getExternalStorageSecretData(ctx, asc, asc.spec.ExternalObjectStorage.SecretRef.Name, externalObjectStorageSecretKeys)
+		if err != nil {
+			return aiv1beta1.ReasonExternalObjectStorageFailure, err
+		}
+		cfg := &s3wrapper.Config{
+			S3EndpointURL:      data["endpoint"],
+			S3Bucket:           data["bucket"],
+			Region:             data["aws_region"],
+			AwsAccessKeyID:     data["aws_access_key_id"],
+			AwsSecretAccessKey: data["aws_secret_access_key"],
+		}
+		if err = asc.rec.ExternalStorageChecker.CheckObjectStorage(ctx, cfg); err != nil {
+			return aiv1beta1.ReasonExternalObjectStorageFailure, pkgerror.Wrap(err, "External object storage is not reachable")
+		}
+	}
+
+	return "", nil
+}
+
+func getExternalStorageSecretData(ctx context.Context, asc ASC, name string, requiredKeys []string) (map[string]string, error) {
+	secret := &corev1.Secret{}
+	key := types.NamespacedName{Name: name, Namespace: asc.namespace}
+	if err := asc.rec.Get(ctx, key, secret); err != nil {
+		return nil, pkgerror.Wrapf(err, "Failed to get secret %s", name)
+	}
+
+	// make sure the secret is being backed up
+	if err := ensureSecretIsLabelled(ctx, asc.rec.Client, secret, key); err != nil {
+		return nil, pkgerror.Wrapf(err, "Unable to mark secret %s for backup", name)
+	}
+
+	data := make(map[string]string)
+	var missing []string
+	for _, k := range requiredKeys {
+		value, ok := secret.Data[k]
+		if !ok || len(value) == 0 {
+			missing = append(missing, k)
+			continue
+		}
+		data[k] = string(value)
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("Secret %s is missing the keys %s", name, strings.Join(missing, ", "))
+	}
+	return data, nil
+}
+
+func getExternalDatabaseCA(ctx context.Context, asc ASC, name string) (string, error) {
+	cm := &corev1.ConfigMap{}
+	key := types.NamespacedName{Name: name, Namespace: asc.namespace}
+	if err := asc.rec.Get(ctx, key, cm); err != nil {
+		return "", pkgerror.Wrapf(err, "Failed to get config map %s", name)
+	}
+
+	// make sure the config map is being backed up
+	if err := ensureConfigMapIsLabelled(ctx, asc.rec.Client, cm, key); err != nil {
+		return "", pkgerror.Wrapf(err, "Unable to mark config map %s for backup", name)
+	}
+
+	ca := cm.Data[externalDatabaseCAKey]
+	if ca == "" {
+		return "", fmt.Errorf("ConfigMap %s is missing the key %s", name, externalDatabaseCAKey)
+	}
+	return ca, nil
+}
+
+func storageType(spec *aiv1beta1.AgentServiceConfigSpec) string {
+	if spec.ExternalObjectStorage != nil {
+		return "s3"
+	}
+	return "filesystem"
+}
+
+// getReplicas returns the replicas configured for the component, 1 by default
+func getReplicas(config *aiv1beta1.DeploymentConfig) *int32 {
+	var replicas int32 = 1
+	if config != nil && config.Replicas != nil {
+		replicas = *config.Replicas
+	}
+	return &replicas
+}
+
+// getResources returns the resources configured for the component, or the given defaults
+func getResources(config *aiv1beta1.DeploymentConfig, defaults corev1.ResourceRequirements) corev1.ResourceRequirements {
+	if config != nil && config.Resources != nil {
+		return *config.Resources
+	}
+	return defaults
+}
+
+// getNodeSelector returns the node selector configured for the component, or the one the operator runs with
+func getNodeSelector(asc ASC, config *aiv1beta1.DeploymentConfig) map[string]string {
+	if config != nil && config.NodeSelector != nil {
+		return config.NodeSelector
+	}
+	if asc.rec.NodeSelector != nil {
+		return asc.rec.NodeSelector
+	}
+	return map[string]string{}
+}
+
+// getTolerations returns the tolerations configured for the component, or the ones the operator runs with
+func getTolerations(asc ASC, config *aiv1beta1.DeploymentConfig) []corev1.Toleration {
+	if config != nil && config.Tolerations != nil {
+		return config.Tolerations
+	}
+	if asc.rec.Tolerations != nil {
+		return asc.rec.Tolerations
+	}
+	return []corev1.Toleration{}
+}
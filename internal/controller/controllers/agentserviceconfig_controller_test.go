@@ -7,6 +7,7 @@ import (
 	"os"
 
 	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	routev1 "github.com/openshift/api/route/v1"
@@ -14,12 +15,14 @@ import (
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/versions"
 	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
 	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
 	"github.com/sirupsen/logrus"
 	"github.com/thoas/go-funk"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -513,6 +516,219 @@ var _ = Describe("agentserviceconfig_controller reconcile", func() {
 		})
 	})
 
+	Context("external storage", func() {
+		var (
+			mockCtrl                *gomock.Controller
+			mockChecker             *MockExternalStorageChecker
+			dbSecret, s3Secret      *corev1.Secret
+			imageServiceStatefulSet *appsv1.StatefulSet
+		)
+
+		BeforeEach(func() {
+			mockCtrl = gomock.NewController(GinkgoT())
+			mockChecker = NewMockExternalStorageChecker(mockCtrl)
+
+			asc.Spec.DatabaseStorage = corev1.PersistentVolumeClaimSpec{}
+			asc.Spec.FileSystemStorage = corev1.PersistentVolumeClaimSpec{}
+			asc.Spec.ExternalDatabase = &aiv1beta1.ExternalDatabase{
+				SecretRef: corev1.LocalObjectReference{Name: "external-db"},
+			}
+			asc.Spec.ExternalObjectStorage = &aiv1beta1.ExternalObjectStorage{
+				SecretRef: corev1.LocalObjectReference{Name: "external-s3"},
+			}
+			dbSecret = &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "external-db", Namespace: testNamespace},
+				Data: map[string][]byte{
+					"db.host":     []byte("db.example.com"),
+					"db.port":     []byte("5432"),
+					"db.name":     []byte("installer"),
+					"db.user":     []byte("admin"),
+					"db.password": []byte("secret"),
+				},
+			}
+			s3Secret = &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "external-s3", Namespace: testNamespace},
+				Data: map[string][]byte{
+					"endpoint":              []byte("https://s3.example.com"),
+					"bucket":                []byte("assisted"),
+					"aws_region":            []byte("us-east-1"),
+					"aws_access_key_id":     []byte("key"),
+					"aws_secret_access_key": []byte("secret"),
+				},
+			}
+
+			var replicas int32 = 1
+			imageServiceStatefulSet = &appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      imageServiceName,
+					Namespace: testNamespace,
+				},
+				Spec: appsv1.StatefulSetSpec{
+					Replicas: &replicas,
+					VolumeClaimTemplates: []corev1.PersistentVolumeClaim{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "image-service-data",
+							},
+							Spec: *asc.Spec.ImageStorage,
+						},
+					},
+				},
+				Status: appsv1.StatefulSetStatus{
+					Replicas:        1,
+					ReadyReplicas:   1,
+					CurrentReplicas: 1,
+					UpdatedReplicas: 1,
+				},
+			}
+		})
+
+		AfterEach(func() {
+			mockCtrl.Finish()
+		})
+
+		reconcile := func(objs ...runtime.Object) ctrl.Result {
+			objs = append(objs, asc, ingressCM, route, imageRoute, imageServiceStatefulSet,
+				&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: webhookServiceName, Namespace: testNamespace}},
+				&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: serviceName, Namespace: testNamespace}})
+			ascr = newTestReconciler(objs...)
+			ascr.ExternalStorageChecker = mockChecker
+			result, err := ascr.Reconcile(ctx, newAgentServiceConfigRequest(asc))
+			Expect(err).To(Succeed())
+			return result
+		}
+
+		getCondition := func() *conditionsv1.Condition {
+			instance := &aiv1beta1.AgentServiceConfig{}
+			Expect(ascr.Get(ctx, types.NamespacedName{Name: testName}, instance)).To(Succeed())
+			return conditionsv1.FindStatusCondition(instance.Status.Conditions, aiv1beta1.ConditionExternalStorageAvailable)
+		}
+
+		It("deploys the service without local storage when the external storage is available", func() {
+			mockChecker.EXPECT().CheckDatabase(gomock.Any(), ExternalDatabaseConnection{
+				Host: "db.example.com", Port: "5432", Name: "installer", User: "admin", Password: "secret",
+			}).Return(nil)
+			mockChecker.EXPECT().CheckObjectStorage(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, cfg *s3wrapper.Config) error {
+					Expect(cfg.S3EndpointURL).To(Equal("https://s3.example.com"))
+					Expect(cfg.S3Bucket).To(Equal("assisted"))
+					return nil
+				})
+			Expect(reconcile(dbSecret, s3Secret)).To(Equal(ctrl.Result{}))
+
+			condition := getCondition()
+			Expect(condition).ToNot(BeNil())
+			Expect(condition.Status).To(Equal(corev1.ConditionTrue))
+			Expect(condition.Reason).To(Equal(aiv1beta1.ReasonExternalStorageAvailable))
+
+			By("ensure the local storage is not deployed")
+			pvc := &corev1.PersistentVolumeClaim{}
+			Expect(errors.IsNotFound(ascr.Get(ctx, types.NamespacedName{Name: databaseName, Namespace: testNamespace}, pvc))).To(BeTrue())
+			Expect(errors.IsNotFound(ascr.Get(ctx, types.NamespacedName{Name: serviceName, Namespace: testNamespace}, pvc))).To(BeTrue())
+			Expect(errors.IsNotFound(ascr.Get(ctx, types.NamespacedName{Name: databaseName, Namespace: testNamespace}, &corev1.Secret{}))).To(BeTrue())
+
+			By("ensure the deployment uses the external storage")
+			deployment := &appsv1.Deployment{}
+			Expect(ascr.Get(ctx, types.NamespacedName{Name: serviceName, Namespace: testNamespace}, deployment)).To(Succeed())
+			Expect(deployment.Spec.Template.Spec.Containers).To(HaveLen(1))
+			env := deployment.Spec.Template.Spec.Containers[0].Env
+			Expect(env).To(ContainElement(newSecretEnvVar("DB_HOST", "db.host", "external-db")))
+			Expect(env).To(ContainElement(newSecretEnvVar("S3_BUCKET", "bucket", "external-s3")))
+			for _, volume := range deployment.Spec.Template.Spec.Volumes {
+				Expect(volume.PersistentVolumeClaim).To(BeNil())
+			}
+
+			cm := &corev1.ConfigMap{}
+			Expect(ascr.Get(ctx, types.NamespacedName{Name: serviceName, Namespace: testNamespace}, cm)).To(Succeed())
+			Expect(cm.Data["STORAGE"]).To(Equal("s3"))
+
+			By("ensure the secrets are labelled for backup")
+			secret := &corev1.Secret{}
+			Expect(ascr.Get(ctx, types.NamespacedName{Name: "external-db", Namespace: testNamespace}, secret)).To(Succeed())
+			Expect(secret.Labels[BackupLabel]).To(Equal(BackupLabelValue))
+		})
+
+		It("connects to the external database with SSL", func() {
+			asc.Spec.ExternalDatabase.SSLMode = "verify-full"
+			asc.Spec.ExternalDatabase.CACertificateRef = &corev1.LocalObjectReference{Name: "external-db-ca"}
+			caCM := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "external-db-ca", Namespace: testNamespace},
+				Data:       map[string]string{"ca-bundle.crt": "CA"},
+			}
+			mockChecker.EXPECT().CheckDatabase(gomock.Any(), ExternalDatabaseConnection{
+				Host: "db.example.com", Port: "5432", Name: "installer", User: "admin", Password: "secret",
+				SSLMode: "verify-full", CACertificate: "CA",
+			}).Return(nil)
+			mockChecker.EXPECT().CheckObjectStorage(gomock.Any(), gomock.Any()).Return(nil)
+			Expect(reconcile(dbSecret, s3Secret, caCM)).To(Equal(ctrl.Result{}))
+
+			deployment := &appsv1.Deployment{}
+			Expect(ascr.Get(ctx, types.NamespacedName{Name: serviceName, Namespace: testNamespace}, deployment)).To(Succeed())
+			container := deployment.Spec.Template.Spec.Containers[0]
+			Expect(container.Env).To(ContainElement(corev1.EnvVar{Name: "DB_SSL_MODE", Value: "verify-full"}))
+			Expect(container.Env).To(ContainElement(corev1.EnvVar{Name: "DB_SSL_ROOT_CERT", Value: "/etc/assisted-db-ca/ca-bundle.crt"}))
+			Expect(container.VolumeMounts).To(ContainElement(corev1.VolumeMount{Name: externalDatabaseCAVolume, MountPath: "/etc/assisted-db-ca"}))
+			var caVolume *corev1.Volume
+			for i := range deployment.Spec.Template.Spec.Volumes {
+				if deployment.Spec.Template.Spec.Volumes[i].Name == externalDatabaseCAVolume {
+					caVolume = &deployment.Spec.Template.Spec.Volumes[i]
+				}
+			}
+			Expect(caVolume).ToNot(BeNil())
+			Expect(caVolume.ConfigMap.Name).To(Equal("external-db-ca"))
+		})
+
+		It("reports a missing CA certificate of the external database", func() {
+			asc.Spec.ExternalDatabase.CACertificateRef = &corev1.LocalObjectReference{Name: "external-db-ca"}
+			caCM := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "external-db-ca", Namespace: testNamespace}}
+			Expect(reconcile(dbSecret, s3Secret, caCM)).To(Equal(ctrl.Result{RequeueAfter: externalStorageRetryInterval}))
+
+			condition := getCondition()
+			Expect(condition).ToNot(BeNil())
+			Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(aiv1beta1.ReasonExternalDatabaseFailure))
+			Expect(condition.Message).To(ContainSubstring("ca-bundle.crt"))
+		})
+
+		It("keeps the local storage that wasn't replaced", func() {
+			asc.Spec.ExternalObjectStorage = nil
+			asc.Spec.FileSystemStorage = newASCDefault().Spec.FileSystemStorage
+			mockChecker.EXPECT().CheckDatabase(gomock.Any(), gomock.Any()).Return(nil)
+			Expect(reconcile(dbSecret)).To(Equal(ctrl.Result{}))
+
+			Expect(ascr.Get(ctx, types.NamespacedName{Name: serviceName, Namespace: testNamespace}, &corev1.PersistentVolumeClaim{})).To(Succeed())
+			cm := &corev1.ConfigMap{}
+			Expect(ascr.Get(ctx, types.NamespacedName{Name: serviceName, Namespace: testNamespace}, cm)).To(Succeed())
+			Expect(cm.Data["STORAGE"]).To(Equal("filesystem"))
+		})
+
+		It("reports a missing secret key without deploying the service", func() {
+			delete(dbSecret.Data, "db.password")
+			Expect(reconcile(dbSecret, s3Secret)).To(Equal(ctrl.Result{RequeueAfter: externalStorageRetryInterval}))
+
+			condition := getCondition()
+			Expect(condition).ToNot(BeNil())
+			Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(aiv1beta1.ReasonExternalDatabaseFailure))
+			Expect(condition.Message).To(ContainSubstring("db.password"))
+
+			deployment := &appsv1.Deployment{}
+			Expect(ascr.Get(ctx, types.NamespacedName{Name: serviceName, Namespace: testNamespace}, deployment)).To(Succeed())
+			Expect(deployment.Spec.Template.Spec.Containers).To(BeEmpty())
+		})
+
+		It("reports an unreachable object storage", func() {
+			mockChecker.EXPECT().CheckDatabase(gomock.Any(), gomock.Any()).Return(nil)
+			mockChecker.EXPECT().CheckObjectStorage(gomock.Any(), gomock.Any()).Return(fmt.Errorf("access denied"))
+			Expect(reconcile(dbSecret, s3Secret)).To(Equal(ctrl.Result{RequeueAfter: externalStorageRetryInterval}))
+
+			condition := getCondition()
+			Expect(condition).ToNot(BeNil())
+			Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(aiv1beta1.ReasonExternalObjectStorageFailure))
+			Expect(condition.Message).To(ContainSubstring("access denied"))
+		})
+	})
 })
 
 var _ = Describe("newImageServiceService", func() {
@@ -1404,6 +1620,180 @@ var _ = Describe("ensureAssistedServiceDeployment", func() {
 	})
 })
 
+var _ = Describe("deployment configuration", func() {
+	var (
+		asc  *aiv1beta1.AgentServiceConfig
+		ascr *AgentServiceConfigReconciler
+		ASCC ASC
+		ctx  = context.Background()
+		log  = logrus.New()
+
+		config = &aiv1beta1.DeploymentConfig{
+			Replicas: swag.Int32(3),
+			Resources: &corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("1"),
+					corev1.ResourceMemory: resource.MustParse("1Gi"),
+				},
+				Limits: corev1.ResourceList{
+					corev1.ResourceMemory: resource.MustParse("2Gi"),
+				},
+			},
+			NodeSelector: map[string]string{"node-role.kubernetes.io/infra": ""},
+			Tolerations: []corev1.Toleration{
+				{Key: "node-role.kubernetes.io/infra", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+			},
+		}
+	)
+
+	BeforeEach(func() {
+		asc = newASCDefault()
+	})
+
+	Context("assisted-service", func() {
+		route := &routev1.Route{
+			ObjectMeta: metav1.ObjectMeta{Name: serviceName, Namespace: testNamespace},
+			Spec:       routev1.RouteSpec{Host: testHost},
+		}
+		assistedCM := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: serviceName, Namespace: testNamespace},
+		}
+
+		getDeployment := func() *appsv1.Deployment {
+			ascr = newTestReconciler(asc, route, assistedCM)
+			ASCC.init(ascr, asc)
+			AssertReconcileSuccess(ctx, log, ASCC, newAssistedServiceDeployment)
+			found := &appsv1.Deployment{}
+			Expect(ascr.Client.Get(ctx, types.NamespacedName{Name: serviceName, Namespace: testNamespace}, found)).To(Succeed())
+			return found
+		}
+
+		It("uses the defaults when not configured", func() {
+			found := getDeployment()
+			Expect(*found.Spec.Replicas).To(BeEquivalentTo(1))
+			Expect(found.Spec.Template.Spec.Containers[0].Resources.Requests.Cpu().String()).To(Equal("200m"))
+			Expect(found.Spec.Template.Spec.Containers[1].Resources.Requests.Memory().String()).To(Equal("400Mi"))
+			Expect(found.Spec.Template.Spec.NodeSelector).To(BeEmpty())
+			Expect(found.Spec.Template.Spec.Tolerations).To(BeEmpty())
+		})
+
+		It("applies the configured replicas, resources and scheduling", func() {
+			asc.Spec.AssistedServiceDeployment = config
+			asc.Spec.DatabaseResources = &corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+			}
+			found := getDeployment()
+			Expect(*found.Spec.Replicas).To(BeEquivalentTo(3))
+			Expect(found.Spec.Template.Spec.Containers[0].Resources).To(Equal(*config.Resources))
+			Expect(found.Spec.Template.Spec.Containers[1].Resources).To(Equal(*asc.Spec.DatabaseResources))
+			Expect(found.Spec.Template.Spec.NodeSelector).To(Equal(config.NodeSelector))
+			Expect(found.Spec.Template.Spec.Tolerations).To(Equal(config.Tolerations))
+		})
+
+		It("prefers the configured scheduling over the one of the operator", func() {
+			asc.Spec.AssistedServiceDeployment = &aiv1beta1.DeploymentConfig{
+				NodeSelector: map[string]string{"disk": "ssd"},
+			}
+			ascr = newTestReconciler(asc, route, assistedCM)
+			ascr.NodeSelector = map[string]string{"operator": "node"}
+			ascr.Tolerations = []corev1.Toleration{{Key: "operator", Operator: corev1.TolerationOpExists}}
+			ASCC.init(ascr, asc)
+			AssertReconcileSuccess(ctx, log, ASCC, newAssistedServiceDeployment)
+			found := &appsv1.Deployment{}
+			Expect(ascr.Client.Get(ctx, types.NamespacedName{Name: serviceName, Namespace: testNamespace}, found)).To(Succeed())
+			Expect(found.Spec.Template.Spec.NodeSelector).To(Equal(map[string]string{"disk": "ssd"}))
+			Expect(found.Spec.Template.Spec.Tolerations).To(Equal(ascr.Tolerations))
+		})
+	})
+
+	Context("image service", func() {
+		imageRoute := &routev1.Route{
+			ObjectMeta: metav1.ObjectMeta{Name: imageServiceName, Namespace: testNamespace},
+			Spec:       routev1.RouteSpec{Host: fmt.Sprintf("%s.images", testHost)},
+		}
+
+		It("applies the configured replicas, resources and scheduling", func() {
+			asc.Spec.ImageServiceDeployment = config
+			ascr = newTestReconciler(asc, imageRoute)
+			ASCC.init(ascr, asc)
+			Expect(reconcileImageServiceStatefulSet(ctx, log, ASCC)).To(Succeed())
+
+			found := &appsv1.StatefulSet{}
+			Expect(ascr.Client.Get(ctx, types.NamespacedName{Name: imageServiceName, Namespace: testNamespace}, found)).To(Succeed())
+			Expect(*found.Spec.Replicas).To(BeEquivalentTo(3))
+			Expect(found.Spec.Template.Spec.Containers[0].Resources).To(Equal(*config.Resources))
+			Expect(found.Spec.Template.Spec.NodeSelector).To(Equal(config.NodeSelector))
+			Expect(found.Spec.Template.Spec.Tolerations).To(Equal(config.Tolerations))
+		})
+	})
+
+	Context("validation", func() {
+		externalStorage := func(spec *aiv1beta1.AgentServiceConfigSpec) {
+			spec.ExternalDatabase = &aiv1beta1.ExternalDatabase{SecretRef: corev1.LocalObjectReference{Name: "db"}}
+			spec.ExternalObjectStorage = &aiv1beta1.ExternalObjectStorage{SecretRef: corev1.LocalObjectReference{Name: "s3"}}
+		}
+
+		It("accepts a valid configuration", func() {
+			asc.Spec.AssistedServiceDeployment = config
+			asc.Spec.ImageServiceDeployment = config
+			externalStorage(&asc.Spec)
+			Expect(validateDeploymentConfigs(&asc.Spec)).To(BeEmpty())
+		})
+
+		It("requires external storage for more than one assisted-service replica", func() {
+			asc.Spec.AssistedServiceDeployment = &aiv1beta1.DeploymentConfig{Replicas: swag.Int32(2)}
+			Expect(validateDeploymentConfigs(&asc.Spec)).To(ConsistOf(ContainSubstring("requires external database and object storage")))
+
+			externalStorage(&asc.Spec)
+			Expect(validateDeploymentConfigs(&asc.Spec)).To(BeEmpty())
+		})
+
+		It("allows more than one image service replica with local storage", func() {
+			asc.Spec.ImageServiceDeployment = &aiv1beta1.DeploymentConfig{Replicas: swag.Int32(2)}
+			Expect(validateDeploymentConfigs(&asc.Spec)).To(BeEmpty())
+		})
+
+		It("rejects invalid settings", func() {
+			asc.Spec.ImageServiceDeployment = &aiv1beta1.DeploymentConfig{
+				Replicas: swag.Int32(0),
+				Resources: &corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("2Gi")},
+					Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+				},
+				NodeSelector: map[string]string{"bad key!": "value"},
+				Tolerations:  []corev1.Toleration{{Key: "a", Operator: corev1.TolerationOpExists, Value: "b"}},
+			}
+			asc.Spec.DatabaseResources = &corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+				Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+			}
+			Expect(validateDeploymentConfigs(&asc.Spec)).To(ConsistOf(
+				"Replicas of assisted-image-service must be at least 1",
+				"The memory request 2Gi of assisted-image-service is larger than its limit 1Gi",
+				ContainSubstring("Node selector key bad key! of assisted-image-service is invalid"),
+				"Toleration 0 of assisted-image-service is invalid: value must be empty when operator is Exists",
+				"The cpu request 2 of postgres is larger than its limit 1",
+			))
+		})
+
+		It("reports the failure in the conditions", func() {
+			asc.Spec.AssistedServiceDeployment = &aiv1beta1.DeploymentConfig{Replicas: swag.Int32(2)}
+			ascr = newTestReconciler(asc)
+			_, err := ascr.Reconcile(ctx, newAgentServiceConfigRequest(asc))
+			Expect(err).To(Succeed())
+
+			instance := &aiv1beta1.AgentServiceConfig{}
+			Expect(ascr.Get(ctx, types.NamespacedName{Name: testName}, instance)).To(Succeed())
+			condition := conditionsv1.FindStatusCondition(instance.Status.Conditions, aiv1beta1.ConditionReconcileCompleted)
+			Expect(condition).ToNot(BeNil())
+			Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(aiv1beta1.ReasonDeploymentConfigFailure))
+			Expect(condition.Message).To(ContainSubstring("requires external database and object storage"))
+			Expect(ascr.Get(ctx, types.NamespacedName{Name: serviceName, Namespace: testNamespace}, &appsv1.Deployment{})).ToNot(Succeed())
+		})
+	})
+})
+
 var _ = Describe("getMustGatherImages", func() {
 	const MUST_GATHER_IMAGES_ENVVAR string = "MUST_GATHER_IMAGES"
 	var defaultSpecMustGatherImages = []aiv1beta1.MustGatherImage{
@@ -1741,6 +2131,13 @@ var _ = Describe("newAssistedCM", func() {
 		ASCC.rec = &ascr.AgentServiceConfigReconcileContext
 		ensureNewAssistedConfigmapValue(ctx, log, ASCC, "PUBLIC_CONTAINER_REGISTRIES", "quay.io,registry.svc.ci.openshift.org,registry.access.redhat.com,docker.io,example.com")
 	})
+	It("doesn't partition the work of a single replica", func() {
+		ensureNewAssistedConfigmapValue(ctx, log, ASCC, "ENABLE_WORK_PARTITIONING", "")
+	})
+	It("partitions the work between multiple replicas", func() {
+		asc.Spec.AssistedServiceDeployment = &aiv1beta1.DeploymentConfig{Replicas: swag.Int32(3)}
+		ensureNewAssistedConfigmapValue(ctx, log, ASCC, "ENABLE_WORK_PARTITIONING", "True")
+	})
 })
 
 func ensureNewAssistedConfigmapValue(ctx context.Context, log logrus.FieldLogger, ASCC ASC, key, value string) {
@@ -0,0 +1,111 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/openshift/assisted-service/internal/common"
+	dbPkg "github.com/openshift/assisted-service/pkg/db"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+)
+
+const externalStorageCheckTimeout = 10 * time.Second
+
+// ExternalDatabaseConnection holds the connection details read from the external database secret
+type ExternalDatabaseConnection struct {
+	Host     string
+	Port     string
+	Name     string
+	User     string
+	Password string
+	SSLMode  string
+	// CACertificate holds the PEM encoded CA certificates used to verify the database server
+	CACertificate string
+}
+
+//go:generate mockgen --build_flags=--mod=mod -package=controllers -destination=mock_external_storage_checker.go . ExternalStorageChecker
+type ExternalStorageChecker interface {
+	// CheckDatabase verifies that the database accepts connections with the given details
+	CheckDatabase(ctx context.Context, conn ExternalDatabaseConnection) error
+	// CheckObjectStorage verifies that the bucket is accessible with the given details
+	CheckObjectStorage(ctx context.Context, cfg *s3wrapper.Config) error
+}
+
+type externalStorageChecker struct {
+	log logrus.FieldLogger
+}
+
+func NewExternalStorageChecker(log logrus.FieldLogger) ExternalStorageChecker {
+	return &externalStorageChecker{log: log}
+}
+
+func (c *externalStorageChecker) CheckDatabase(ctx context.Context, conn ExternalDatabaseConnection) error {
+	ctx, cancel := context.WithTimeout(ctx, externalStorageCheckTimeout)
+	defer cancel()
+
+	cfg := dbPkg.Config{
+		Host:    conn.Host,
+		Port:    conn.Port,
+		User:    conn.User,
+		Pass:    conn.Password,
+		Name:    conn.Name,
+		SSLMode: conn.SSLMode,
+	}
+	if conn.CACertificate != "" {
+		caFile, err := writeTempCACertificate(conn.CACertificate)
+		if err != nil {
+			return err
+		}
+		defer os.Remove(caFile)
+		cfg.SSLRootCert = caFile
+	}
+
+	dsn := fmt.Sprintf("%s connect_timeout=%d", cfg.DSN(), int(externalStorageCheckTimeout.Seconds()))
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{Logger: gormlogger.Default.LogMode(gormlogger.Silent)})
+	if err != nil {
+		return errors.Wrapf(err, "failed to connect to database %s at %s:%s", conn.Name, conn.Host, conn.Port)
+	}
+	defer common.CloseDB(db)
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+	if err = sqlDB.PingContext(ctx); err != nil {
+		return errors.Wrapf(err, "failed to ping database %s at %s:%s", conn.Name, conn.Host, conn.Port)
+	}
+	return nil
+}
+
+// writeTempCACertificate writes the CA certificates to a temporary file, as the database driver only reads
+// them from a file, and returns its path
+func writeTempCACertificate(ca string) (string, error) {
+	f, err := os.CreateTemp("", "external-db-ca-*.crt")
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create the CA certificate file of the database")
+	}
+	defer f.Close()
+	if _, err = f.WriteString(ca); err != nil {
+		os.Remove(f.Name())
+		return "", errors.Wrap(err, "failed to write the CA certificate file of the database")
+	}
+	return f.Name(), nil
+}
+
+func (c *externalStorageChecker) CheckObjectStorage(ctx context.Context, cfg *s3wrapper.Config) error {
+	ctx, cancel := context.WithTimeout(ctx, externalStorageCheckTimeout)
+	defer cancel()
+
+	client := s3wrapper.NewS3Client(cfg, c.log)
+	if client == nil {
+		return errors.Errorf("failed to create S3 client for endpoint %s", cfg.S3EndpointURL)
+	}
+	return client.CheckBucket(ctx)
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/openshift/assisted-service/config"
 	"github.com/openshift/assisted-service/internal/spoke_k8s_client"
 	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/tracing"
 	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
 	pkgerror "github.com/pkg/errors"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
@@ -86,6 +87,8 @@ func (asc *ASC) initHASC(r *HypershiftAgentServiceConfigReconciler, instance *ai
 		NodeSelector: context.NodeSelector,
 		Tolerations:  context.Tolerations,
 		Recorder:     context.Recorder,
+
+		ExternalStorageChecker: context.ExternalStorageChecker,
 	}
 }
 
@@ -140,13 +143,16 @@ func (hr *HypershiftAgentServiceConfigReconciler) getWebhookComponents_hub() []c
 // +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=roles,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=rolebindings,verbs=get;list;watch;create;update;patch;delete
 
-func (hr *HypershiftAgentServiceConfigReconciler) Reconcile(origCtx context.Context, req ctrl.Request) (ctrl.Result, error) {
+func (hr *HypershiftAgentServiceConfigReconciler) Reconcile(origCtx context.Context, req ctrl.Request) (_ ctrl.Result, err error) {
 	var asc ASC
-	var err error
 	var result reconcile.Result
 	var valid bool
 
 	ctx := addRequestIdIfNeeded(origCtx)
+	ctx, span := startReconcileSpan(ctx, "HypershiftAgentServiceConfig", req)
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
 	log := logutil.FromContext(ctx, hr.Log).WithFields(
 		logrus.Fields{
 			"hypershift_service_config":    req.Name,
@@ -199,6 +205,14 @@ func (hr *HypershiftAgentServiceConfigReconciler) Reconcile(origCtx context.Cont
 		return ctrl.Result{}, nil
 	}
 
+	available, err := checkExternalStorage(ctx, log, asc)
+	if err != nil {
+		return ctrl.Result{Requeue: true}, err
+	}
+	if !available {
+		return ctrl.Result{RequeueAfter: externalStorageRetryInterval}, nil
+	}
+
 	// Remove IPXE HTTP routes if not needed
 	log.Infof("cleaning ipxe http route")
 	if err = cleanHTTPRoute(ctx, log, asc); err != nil {
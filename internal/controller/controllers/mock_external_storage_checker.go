@@ -0,0 +1,63 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/openshift/assisted-service/internal/controller/controllers (interfaces: ExternalStorageChecker)
+
+// Package controllers is a generated GoMock package.
+package controllers
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	s3wrapper "github.com/openshift/assisted-service/pkg/s3wrapper"
+	reflect "reflect"
+)
+
+// MockExternalStorageChecker is a mock of ExternalStorageChecker interface.
+type MockExternalStorageChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockExternalStorageCheckerMockRecorder
+}
+
+// MockExternalStorageCheckerMockRecorder is the mock recorder for MockExternalStorageChecker.
+type MockExternalStorageCheckerMockRecorder struct {
+	mock *MockExternalStorageChecker
+}
+
+// NewMockExternalStorageChecker creates a new mock instance.
+func NewMockExternalStorageChecker(ctrl *gomock.Controller) *MockExternalStorageChecker {
+	mock := &MockExternalStorageChecker{ctrl: ctrl}
+	mock.recorder = &MockExternalStorageCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExternalStorageChecker) EXPECT() *MockExternalStorageCheckerMockRecorder {
+	return m.recorder
+}
+
+// CheckDatabase mocks base method.
+func (m *MockExternalStorageChecker) CheckDatabase(arg0 context.Context, arg1 ExternalDatabaseConnection) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckDatabase", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckDatabase indicates an expected call of CheckDatabase.
+func (mr *MockExternalStorageCheckerMockRecorder) CheckDatabase(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckDatabase", reflect.TypeOf((*MockExternalStorageChecker)(nil).CheckDatabase), arg0, arg1)
+}
+
+// CheckObjectStorage mocks base method.
+func (m *MockExternalStorageChecker) CheckObjectStorage(arg0 context.Context, arg1 *s3wrapper.Config) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckObjectStorage", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckObjectStorage indicates an expected call of CheckObjectStorage.
+func (mr *MockExternalStorageCheckerMockRecorder) CheckObjectStorage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckObjectStorage", reflect.TypeOf((*MockExternalStorageChecker)(nil).CheckObjectStorage), arg0, arg1)
+}
@@ -32,6 +32,7 @@ import (
 	"github.com/openshift/assisted-service/internal/versions"
 	"github.com/openshift/assisted-service/models"
 	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/tracing"
 	"github.com/openshift/assisted-service/restapi/operations/installer"
 	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
 	"github.com/pkg/errors"
@@ -71,8 +72,12 @@ type PreprovisioningImageReconciler struct {
 // +kubebuilder:rbac:groups=agent-install.openshift.io,resources=infraenvs,verbs=get;list;watch
 // +kubebuilder:rbac:groups=agent-install.openshift.io,resources=infraenvs/status,verbs=get
 
-func (r *PreprovisioningImageReconciler) Reconcile(origCtx context.Context, req ctrl.Request) (ctrl.Result, error) {
+func (r *PreprovisioningImageReconciler) Reconcile(origCtx context.Context, req ctrl.Request) (_ ctrl.Result, err error) {
 	ctx := addRequestIdIfNeeded(origCtx)
+	ctx, span := startReconcileSpan(ctx, "PreprovisioningImage", req)
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
 	log := logutil.FromContext(ctx, r.Log).WithFields(
 		logrus.Fields{
 			"preprovisioning_image":           req.Name,
@@ -87,7 +92,7 @@ func (r *PreprovisioningImageReconciler) Reconcile(origCtx context.Context, req
 
 	// Retrieve PreprovisioningImage
 	image := &metal3_v1alpha1.PreprovisioningImage{}
-	err := r.Get(ctx, req.NamespacedName, image)
+	err = r.Get(ctx, req.NamespacedName, image)
 	if err != nil {
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
@@ -369,7 +374,7 @@ func (r *PreprovisioningImageReconciler) AddIronicAgentToInfraEnv(ctx context.Co
 	}
 	ironicAgentImage := ""
 	if infraEnvInternal.OpenshiftVersion != "" {
-		ironicAgentImage, err = r.getIronicAgentImage(log, *infraEnvInternal)
+		ironicAgentImage, err = r.getIronicAgentImage(ctx, log, *infraEnvInternal)
 		if err != nil {
 			log.WithError(err).Warningf("Failed to get ironicAgentImage for infraEnv: %s", infraEnv.Name)
 		}
@@ -400,7 +405,7 @@ func (r *PreprovisioningImageReconciler) AddIronicAgentToInfraEnv(ctx context.Co
 	return ctrl.Result{}, err
 }
 
-func (r *PreprovisioningImageReconciler) getIronicAgentImage(log logrus.FieldLogger, infraEnv common.InfraEnv) (string, error) {
+func (r *PreprovisioningImageReconciler) getIronicAgentImage(ctx context.Context, log logrus.FieldLogger, infraEnv common.InfraEnv) (string, error) {
 	SupportConvergedFlow, _ := common.VersionGreaterOrEqual(infraEnv.OpenshiftVersion, MinimalVersionForConvergedFlow)
 	// Get the ironic agent image from the release only if the openshift version is higher then the MinimalVersionForConvergedFlow
 	if !SupportConvergedFlow {
@@ -413,7 +418,7 @@ func (r *PreprovisioningImageReconciler) getIronicAgentImage(log logrus.FieldLog
 	if err != nil {
 		return "", err
 	}
-	ironicAgentImage, err := r.OcRelease.GetIronicAgentImage(log, *releaseImage.URL, r.ReleaseImageMirror, infraEnv.PullSecret)
+	ironicAgentImage, err := r.OcRelease.GetIronicAgentImage(ctx, log, *releaseImage.URL, r.ReleaseImageMirror, infraEnv.PullSecret)
 	if err != nil {
 		return "", err
 	}
@@ -1,68 +1,255 @@
 package controllers
 
 import (
+	"container/list"
+	"context"
 	"crypto/sha256"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/openshift/assisted-service/internal/spoke_k8s_client"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 )
 
+const (
+	spokeClientEvictionReasonSize    = "size"
+	spokeClientEvictionReasonExpired = "expired"
+	spokeClientEvictionReasonHealth  = "health_check"
+
+	spokeClientFailureReasonCreate      = "create"
+	spokeClientFailureReasonHealthCheck = "health_check"
+
+	spokeClientHealthCheckTimeout = 10 * time.Second
+)
+
+var (
+	spokeClientCacheHits = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "assisted_spoke_client_cache_hits_total",
+		Help: "Number of spoke client requests served from the cache",
+	})
+	spokeClientCacheMisses = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "assisted_spoke_client_cache_misses_total",
+		Help: "Number of spoke client requests that required creating a new client",
+	})
+	spokeClientCacheEvictions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "assisted_spoke_client_cache_evictions_total",
+		Help: "Number of spoke clients evicted from the cache",
+	}, []string{"reason"})
+	spokeClientConnectionFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "assisted_spoke_client_connection_failures_total",
+		Help: "Number of failures to create or reach a spoke client",
+	}, []string{"reason"})
+	spokeClientCacheSize = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "assisted_spoke_client_cache_size",
+		Help: "Number of spoke clients in the cache",
+	})
+)
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(
+		spokeClientCacheHits,
+		spokeClientCacheMisses,
+		spokeClientCacheEvictions,
+		spokeClientConnectionFailures,
+		spokeClientCacheSize,
+	)
+}
+
+type SpokeClientCacheConfig struct {
+	// Maximum number of cached clients, the least recently used client is evicted when exceeded. 0 means unlimited.
+	MaxSize int `envconfig:"SPOKE_CLIENT_CACHE_MAX_SIZE" default:"1000"`
+	// Clients that were not used for this duration are evicted. 0 means never.
+	TTL time.Duration `envconfig:"SPOKE_CLIENT_CACHE_TTL" default:"1h"`
+	// Interval of the health probes of the cached clients. 0 disables the probes.
+	HealthCheckInterval time.Duration `envconfig:"SPOKE_CLIENT_CACHE_HEALTH_CHECK_INTERVAL" default:"5m"`
+}
+
 //go:generate mockgen --build_flags=--mod=mod -package=controllers -destination=mock_spoke_client_cache.go . SpokeClientCache
 type SpokeClientCache interface {
 	Get(secret *corev1.Secret) (spoke_k8s_client.SpokeK8sClient, error)
+	// Start runs the periodic health probes of the cached clients until the context is done
+	Start(ctx context.Context) error
 }
 
 type spokeClientCache struct {
 	sync.Mutex
+	config        SpokeClientCacheConfig
+	log           logrus.FieldLogger
 	clientFactory spoke_k8s_client.SpokeK8sClientFactory
-	clientMap     map[string]*spokeClient
+	// clientMap holds the elements of lruList, which is ordered from the most to the least recently used client
+	clientMap map[string]*list.Element
+	lruList   *list.List
+	now       func() time.Time
 }
 
 type spokeClient struct {
-	spokeK8sClient *spoke_k8s_client.SpokeK8sClient
-	kubeconfigHash string
+	key             string
+	spokeK8sClient  spoke_k8s_client.SpokeK8sClient
+	kubeconfigHash  string
+	resourceVersion string
+	lastUsed        time.Time
 }
 
-func NewSpokeClientCache(clientFactory spoke_k8s_client.SpokeK8sClientFactory) SpokeClientCache {
+func NewSpokeClientCache(clientFactory spoke_k8s_client.SpokeK8sClientFactory, config SpokeClientCacheConfig, log logrus.FieldLogger) SpokeClientCache {
 	return &spokeClientCache{
+		config:        config,
+		log:           log,
 		clientFactory: clientFactory,
-		clientMap:     make(map[string]*spokeClient),
+		clientMap:     make(map[string]*list.Element),
+		lruList:       list.New(),
+		now:           time.Now,
 	}
 }
 
 // Get returns a SpokeK8sClient for the given secret.
 // The client is returned from cache, or, a new client is created if not available.
+// A cached client is reused as long as the secret resource version or its kubeconfig did not change.
 func (c *spokeClientCache) Get(secret *corev1.Secret) (spoke_k8s_client.SpokeK8sClient, error) {
 	c.Lock()
 	defer c.Unlock()
 
+	now := c.now()
+	key := types.NamespacedName{Name: secret.Name, Namespace: secret.Namespace}.String()
+	elem, present := c.clientMap[key]
+	if present && c.isExpired(elem.Value.(*spokeClient), now) {
+		c.remove(elem, spokeClientEvictionReasonExpired)
+		present = false
+	}
+	if present {
+		client := elem.Value.(*spokeClient)
+		if secret.ResourceVersion != "" && client.resourceVersion == secret.ResourceVersion {
+			return c.hit(elem, now), nil
+		}
+	}
+
 	// Get kubeconfig data and compute hash
 	kubeconfigData, err := c.getKubeconfigFromSecret(secret)
 	if err != nil {
 		return nil, err
 	}
-	kubeconfigHash := fmt.Sprintf("%x", sha256.New().Sum(kubeconfigData))
+	kubeconfigHash := fmt.Sprintf("%x", sha256.Sum256(kubeconfigData))
 
-	// Get client from cache or create a new one if not available
-	key := types.NamespacedName{Name: secret.Name, Namespace: secret.Namespace}
-	client, present := c.clientMap[key.String()]
-	if !present || client.kubeconfigHash != kubeconfigHash {
-		spokeK8sClient, err := c.clientFactory.CreateFromRawKubeconfig(kubeconfigData)
-		if err != nil {
-			return nil, errors.Wrapf(err, "Failed to create client using secret '%s'", secret.Name)
+	if present {
+		client := elem.Value.(*spokeClient)
+		if client.kubeconfigHash == kubeconfigHash {
+			client.resourceVersion = secret.ResourceVersion
+			return c.hit(elem, now), nil
 		}
-		client = &spokeClient{
-			spokeK8sClient: &spokeK8sClient,
-			kubeconfigHash: kubeconfigHash,
+	}
+
+	spokeClientCacheMisses.Inc()
+	spokeK8sClient, err := c.clientFactory.CreateFromRawKubeconfig(kubeconfigData)
+	if err != nil {
+		spokeClientConnectionFailures.WithLabelValues(spokeClientFailureReasonCreate).Inc()
+		return nil, errors.Wrapf(err, "Failed to create client using secret '%s'", secret.Name)
+	}
+	client := &spokeClient{
+		key:             key,
+		spokeK8sClient:  spokeK8sClient,
+		kubeconfigHash:  kubeconfigHash,
+		resourceVersion: secret.ResourceVersion,
+		lastUsed:        now,
+	}
+	if present {
+		elem.Value = client
+		c.lruList.MoveToFront(elem)
+	} else {
+		c.clientMap[key] = c.lruList.PushFront(client)
+	}
+
+	for c.config.MaxSize > 0 && c.lruList.Len() > c.config.MaxSize {
+		c.remove(c.lruList.Back(), spokeClientEvictionReasonSize)
+	}
+	spokeClientCacheSize.Set(float64(c.lruList.Len()))
+
+	return spokeK8sClient, nil
+}
+
+// Start probes the cached clients every HealthCheckInterval and evicts the ones that can't reach their cluster,
+// so that the next Get creates a new client. Expired clients are evicted as well.
+func (c *spokeClientCache) Start(ctx context.Context) error {
+	if c.config.HealthCheckInterval <= 0 {
+		<-ctx.Done()
+		return nil
+	}
+
+	ticker := time.NewTicker(c.config.HealthCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			c.checkHealth(ctx)
+		}
+	}
+}
+
+func (c *spokeClientCache) checkHealth(ctx context.Context) {
+	c.Lock()
+	now := c.now()
+	var clients []*spokeClient
+	for elem := c.lruList.Front(); elem != nil; {
+		next := elem.Next()
+		if c.isExpired(elem.Value.(*spokeClient), now) {
+			c.remove(elem, spokeClientEvictionReasonExpired)
+		} else {
+			clients = append(clients, elem.Value.(*spokeClient))
+		}
+		elem = next
+	}
+	spokeClientCacheSize.Set(float64(c.lruList.Len()))
+	c.Unlock()
+
+	// Probe without holding the lock as unreachable clusters may take long to respond
+	for _, sc := range clients {
+		if err := c.probe(ctx, sc.spokeK8sClient); err != nil {
+			c.log.WithError(err).Warnf("Spoke client for secret %s failed health check, evicting it", sc.key)
+			spokeClientConnectionFailures.WithLabelValues(spokeClientFailureReasonHealthCheck).Inc()
+			c.evictIfCurrent(sc)
 		}
-		c.clientMap[key.String()] = client
 	}
+}
+
+func (c *spokeClientCache) probe(ctx context.Context, spokeK8sClient spoke_k8s_client.SpokeK8sClient) error {
+	ctx, cancel := context.WithTimeout(ctx, spokeClientHealthCheckTimeout)
+	defer cancel()
+	return spokeK8sClient.List(ctx, &corev1.NamespaceList{}, client.Limit(1))
+}
+
+// evictIfCurrent evicts the client unless it was already replaced by a newer client for the same secret
+func (c *spokeClientCache) evictIfCurrent(sc *spokeClient) {
+	c.Lock()
+	defer c.Unlock()
+	if elem, present := c.clientMap[sc.key]; present && elem.Value.(*spokeClient) == sc {
+		c.remove(elem, spokeClientEvictionReasonHealth)
+		spokeClientCacheSize.Set(float64(c.lruList.Len()))
+	}
+}
+
+func (c *spokeClientCache) hit(elem *list.Element, now time.Time) spoke_k8s_client.SpokeK8sClient {
+	spokeClientCacheHits.Inc()
+	client := elem.Value.(*spokeClient)
+	client.lastUsed = now
+	c.lruList.MoveToFront(elem)
+	return client.spokeK8sClient
+}
+
+func (c *spokeClientCache) isExpired(client *spokeClient, now time.Time) bool {
+	return c.config.TTL > 0 && now.Sub(client.lastUsed) > c.config.TTL
+}
 
-	return *client.spokeK8sClient, nil
+func (c *spokeClientCache) remove(elem *list.Element, reason string) {
+	c.lruList.Remove(elem)
+	delete(c.clientMap, elem.Value.(*spokeClient).key)
+	spokeClientCacheEvictions.WithLabelValues(reason).Inc()
 }
 
 func (c *spokeClientCache) getKubeconfigFromSecret(secret *corev1.Secret) ([]byte, error) {
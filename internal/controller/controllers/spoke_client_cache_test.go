@@ -1,12 +1,16 @@
 package controllers
 
 import (
+	"context"
 	"errors"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/spoke_k8s_client"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -23,6 +27,7 @@ var _ = Context("with kubeconfig test secret", func() {
 		mockSpokeFactory *spoke_k8s_client.MockSpokeK8sClientFactory
 		clientCache      SpokeClientCache
 		kubeconfigSecret *corev1.Secret
+		now              time.Time
 	)
 
 	newKubeconfigSecret := func() *corev1.Secret {
@@ -42,7 +47,9 @@ var _ = Context("with kubeconfig test secret", func() {
 		mockCtrl = gomock.NewController(GinkgoT())
 		mockSpokeClient = spoke_k8s_client.NewMockSpokeK8sClient(mockCtrl)
 		mockSpokeFactory = spoke_k8s_client.NewMockSpokeK8sClientFactory(mockCtrl)
-		clientCache = NewSpokeClientCache(mockSpokeFactory)
+		now = time.Now()
+		clientCache = NewSpokeClientCache(mockSpokeFactory, SpokeClientCacheConfig{MaxSize: 2, TTL: time.Hour}, common.GetTestLog())
+		clientCache.(*spokeClientCache).now = func() time.Time { return now }
 		kubeconfigSecret = newKubeconfigSecret()
 	})
 
@@ -110,5 +117,117 @@ var _ = Context("with kubeconfig test secret", func() {
 			Expect(err).Should(HaveOccurred())
 			Expect(err.Error()).Should(ContainSubstring("does not contain kubeconfig"))
 		})
+
+		It("reuses the client without reading the kubeconfig when the resource version did not change", func() {
+			kubeconfigSecret.ResourceVersion = "1"
+			mockSpokeFactory.EXPECT().CreateFromRawKubeconfig([]byte(BASIC_KUBECONFIG)).Return(mockSpokeClient, nil)
+			_, err := clientCache.Get(kubeconfigSecret)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			hits := testutil.ToFloat64(spokeClientCacheHits)
+			kubeconfigSecret.Data = nil
+			client, err := clientCache.Get(kubeconfigSecret)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(client).To(Equal(mockSpokeClient))
+			Expect(testutil.ToFloat64(spokeClientCacheHits)).To(Equal(hits + 1))
+		})
+
+		It("creates a new client when the resource version and the kubeconfig changed", func() {
+			kubeconfigSecret.ResourceVersion = "1"
+			mockSpokeFactory.EXPECT().CreateFromRawKubeconfig([]byte(BASIC_KUBECONFIG)).Return(mockSpokeClient, nil)
+			_, err := clientCache.Get(kubeconfigSecret)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			misses := testutil.ToFloat64(spokeClientCacheMisses)
+			kubeconfigSecret.ResourceVersion = "2"
+			kubeconfigSecret.Data["kubeconfig"] = []byte("rotated")
+			mockSpokeFactory.EXPECT().CreateFromRawKubeconfig([]byte("rotated")).Return(mockSpokeClient, nil)
+			_, err = clientCache.Get(kubeconfigSecret)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(testutil.ToFloat64(spokeClientCacheMisses)).To(Equal(misses + 1))
+		})
+
+		It("evicts the least recently used client when the cache is full", func() {
+			secrets := []*corev1.Secret{newKubeconfigSecret(), newKubeconfigSecret(), newKubeconfigSecret()}
+			secrets[1].Name = "second-secret"
+			secrets[2].Name = "third-secret"
+			mockSpokeFactory.EXPECT().CreateFromRawKubeconfig([]byte(BASIC_KUBECONFIG)).Return(mockSpokeClient, nil).Times(4)
+
+			_, err := clientCache.Get(secrets[0])
+			Expect(err).ShouldNot(HaveOccurred())
+			_, err = clientCache.Get(secrets[1])
+			Expect(err).ShouldNot(HaveOccurred())
+			// use the first client so that the second one is the least recently used
+			_, err = clientCache.Get(secrets[0])
+			Expect(err).ShouldNot(HaveOccurred())
+
+			evictions := testutil.ToFloat64(spokeClientCacheEvictions.WithLabelValues(spokeClientEvictionReasonSize))
+			_, err = clientCache.Get(secrets[2])
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(testutil.ToFloat64(spokeClientCacheEvictions.WithLabelValues(spokeClientEvictionReasonSize))).To(Equal(evictions + 1))
+			Expect(testutil.ToFloat64(spokeClientCacheSize)).To(Equal(float64(2)))
+
+			// the first client is still cached, the second one is created again
+			_, err = clientCache.Get(secrets[0])
+			Expect(err).ShouldNot(HaveOccurred())
+			_, err = clientCache.Get(secrets[1])
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("creates a new client when the cached one expired", func() {
+			mockSpokeFactory.EXPECT().CreateFromRawKubeconfig([]byte(BASIC_KUBECONFIG)).Return(mockSpokeClient, nil).Times(2)
+			_, err := clientCache.Get(kubeconfigSecret)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			now = now.Add(2 * time.Hour)
+			_, err = clientCache.Get(kubeconfigSecret)
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("counts failures to create a client", func() {
+			failures := testutil.ToFloat64(spokeClientConnectionFailures.WithLabelValues(spokeClientFailureReasonCreate))
+			mockSpokeFactory.EXPECT().CreateFromRawKubeconfig(gomock.Any()).Return(nil, errors.New("error"))
+			_, err := clientCache.Get(kubeconfigSecret)
+			Expect(err).Should(HaveOccurred())
+			Expect(testutil.ToFloat64(spokeClientConnectionFailures.WithLabelValues(spokeClientFailureReasonCreate))).To(Equal(failures + 1))
+		})
+	})
+
+	Describe("checkHealth", func() {
+		It("keeps healthy clients", func() {
+			mockSpokeFactory.EXPECT().CreateFromRawKubeconfig([]byte(BASIC_KUBECONFIG)).Return(mockSpokeClient, nil).Times(1)
+			_, err := clientCache.Get(kubeconfigSecret)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			mockSpokeClient.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			clientCache.(*spokeClientCache).checkHealth(context.Background())
+
+			_, err = clientCache.Get(kubeconfigSecret)
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("evicts clients that fail the health probe", func() {
+			mockSpokeFactory.EXPECT().CreateFromRawKubeconfig([]byte(BASIC_KUBECONFIG)).Return(mockSpokeClient, nil).Times(2)
+			_, err := clientCache.Get(kubeconfigSecret)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			failures := testutil.ToFloat64(spokeClientConnectionFailures.WithLabelValues(spokeClientFailureReasonHealthCheck))
+			mockSpokeClient.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("connection refused"))
+			clientCache.(*spokeClientCache).checkHealth(context.Background())
+			Expect(testutil.ToFloat64(spokeClientConnectionFailures.WithLabelValues(spokeClientFailureReasonHealthCheck))).To(Equal(failures + 1))
+
+			_, err = clientCache.Get(kubeconfigSecret)
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("evicts expired clients without probing them", func() {
+			mockSpokeFactory.EXPECT().CreateFromRawKubeconfig([]byte(BASIC_KUBECONFIG)).Return(mockSpokeClient, nil).Times(1)
+			_, err := clientCache.Get(kubeconfigSecret)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			now = now.Add(2 * time.Hour)
+			clientCache.(*spokeClientCache).checkHealth(context.Background())
+			Expect(clientCache.(*spokeClientCache).lruList.Len()).To(Equal(0))
+		})
 	})
 })
@@ -0,0 +1,212 @@
+package csrapprover
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	eventgen "github.com/openshift/assisted-service/internal/common/events"
+	"github.com/openshift/assisted-service/internal/constants"
+	eventsapi "github.com/openshift/assisted-service/internal/events/api"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/internal/spoke_k8s_client"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/leader"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+	"gorm.io/gorm"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+const (
+	csrTypeClient = "client"
+	csrTypeServer = "server"
+)
+
+type Config struct {
+	EnableDay2CSRApproval bool          `envconfig:"ENABLE_DAY2_CSR_APPROVAL" default:"false"`
+	Interval              time.Duration `envconfig:"DAY2_CSR_APPROVAL_INTERVAL" default:"1m"`
+	// CSRs are approved only for hosts that were added to the cluster within this period
+	ApprovalWindow time.Duration `envconfig:"DAY2_CSR_APPROVAL_WINDOW" default:"2h"`
+}
+
+// Approver approves the node CSRs of day-2 hosts that were installed through the REST API.
+// The kube-API flow approves them in the agent controller.
+// Only clusters that have a kubeconfig stored by the service are handled.
+type Approver struct {
+	Config
+	db                 *gorm.DB
+	log                logrus.FieldLogger
+	eventsHandler      eventsapi.Handler
+	objectHandler      s3wrapper.API
+	spokeClientFactory spoke_k8s_client.SpokeK8sClientFactory
+	leaderElector      leader.Leader
+}
+
+func NewApprover(
+	config Config,
+	db *gorm.DB,
+	log logrus.FieldLogger,
+	eventsHandler eventsapi.Handler,
+	objectHandler s3wrapper.API,
+	spokeClientFactory spoke_k8s_client.SpokeK8sClientFactory,
+	leaderElector leader.Leader,
+) *Approver {
+	return &Approver{
+		Config:             config,
+		db:                 db,
+		log:                log,
+		eventsHandler:      eventsHandler,
+		objectHandler:      objectHandler,
+		spokeClientFactory: spokeClientFactory,
+		leaderElector:      leaderElector,
+	}
+}
+
+func (a *Approver) ApproveDay2HostsCSRs() {
+	if !a.leaderElector.IsLeader() {
+		return
+	}
+
+	var hosts []*models.Host
+	addedAfter := time.Now().Add(-a.ApprovalWindow)
+	if err := a.db.Where("kind = ? and status = ? and status_updated_at > ?",
+		models.HostKindAddToExistingClusterHost, models.HostStatusAddedToExistingCluster, addedAfter).Find(&hosts).Error; err != nil {
+		a.log.WithError(err).Error("Failed to get day2 hosts for CSR approval")
+		return
+	}
+
+	hostsByCluster := make(map[strfmt.UUID][]*models.Host)
+	for _, h := range hosts {
+		if h.ClusterID != nil {
+			hostsByCluster[*h.ClusterID] = append(hostsByCluster[*h.ClusterID], h)
+		}
+	}
+
+	ctx := context.Background()
+	for clusterID, clusterHosts := range hostsByCluster {
+		a.approveClusterCSRs(ctx, clusterID, clusterHosts)
+	}
+}
+
+func (a *Approver) approveClusterCSRs(ctx context.Context, clusterID strfmt.UUID, hosts []*models.Host) {
+	log := a.log.WithField("cluster_id", clusterID)
+	exists, err := a.objectHandler.DoesObjectExist(ctx, fmt.Sprintf("%s/%s", clusterID, constants.Kubeconfig))
+	if err != nil || !exists {
+		if err != nil {
+			log.WithError(err).Warn("Failed to check if cluster kubeconfig exists")
+		}
+		return
+	}
+
+	client, err := a.spokeClientFactory.CreateFromStorageKubeconfig(ctx, &clusterID, a.objectHandler)
+	if err != nil {
+		log.WithError(err).Warn("Failed to create spoke client for CSR approval")
+		return
+	}
+
+	csrList, err := client.ListCsrs()
+	if err != nil {
+		log.WithError(err).Warn("Failed to list CSRs")
+		return
+	}
+	var pending []*certificatesv1.CertificateSigningRequest
+	for i := range csrList.Items {
+		if !IsCsrApproved(&csrList.Items[i]) {
+			pending = append(pending, &csrList.Items[i])
+		}
+	}
+	if len(pending) == 0 {
+		return
+	}
+
+	for _, h := range hosts {
+		a.approveHostCSRs(ctx, log, client, h, pending)
+	}
+}
+
+func (a *Approver) approveHostCSRs(ctx context.Context, log logrus.FieldLogger, client spoke_k8s_client.SpokeK8sClient, h *models.Host,
+	csrs []*certificatesv1.CertificateSigningRequest) {
+	hostname, err := hostutil.GetCurrentHostName(h)
+	if err != nil || hostname == "" {
+		log.WithError(err).Warnf("Failed to get hostname of host %s, skipping CSR approval", h.ID)
+		return
+	}
+	owner := fmt.Sprintf("host %s", h.ID)
+
+	csrType := csrTypeClient
+	validate := func(csr *certificatesv1.CertificateSigningRequest, x509CSR *x509.CertificateRequest) (bool, error) {
+		return ValidateNodeClientCSR(owner, csr, x509CSR)
+	}
+	node, err := client.GetNode(hostname)
+	if err != nil {
+		if !k8serrors.IsNotFound(err) {
+			log.WithError(err).Warnf("Failed to get node %s of host %s", hostname, h.ID)
+			return
+		}
+	} else {
+		csrType = csrTypeServer
+		validate = func(csr *certificatesv1.CertificateSigningRequest, x509CSR *x509.CertificateRequest) (bool, error) {
+			if ok, validateErr := ValidateNodeServerCSR(owner, node, csr, x509CSR); !ok {
+				return ok, validateErr
+			}
+			return validateHostInventoryIPs(owner, h, csr, x509CSR)
+		}
+	}
+
+	for _, csr := range csrs {
+		x509CSR, err := GetX509ParsedRequest(csr)
+		if err != nil {
+			log.WithError(err).Debugf("Failed to parse CSR %s", csr.Name)
+			continue
+		}
+		if !IsCsrAssociatedWithHostname(x509CSR, hostname) {
+			continue
+		}
+		if ok, err := validate(csr, x509CSR); !ok {
+			log.WithError(err).Warnf("Not approving CSR %s for host %s", csr.Name, h.ID)
+			continue
+		}
+		if err = client.ApproveCsr(csr); err != nil {
+			log.WithError(err).Errorf("Failed to approve CSR %s for host %s", csr.Name, h.ID)
+			continue
+		}
+		log.Infof("Approved %s CSR %s for host %s", csrType, csr.Name, h.ID)
+		eventgen.SendHostCsrApprovedEvent(ctx, a.eventsHandler, *h.ID, h.InfraEnvID, h.ClusterID, hostname, csrType, csr.Name)
+	}
+}
+
+// Any IP address in a server CSR must also be reported in the inventory of the host
+func validateHostInventoryIPs(owner string, h *models.Host, csr *certificatesv1.CertificateSigningRequest, x509CSR *x509.CertificateRequest) (bool, error) {
+	if len(x509CSR.IPAddresses) == 0 {
+		return true, nil
+	}
+	var inventory models.Inventory
+	if err := json.Unmarshal([]byte(h.Inventory), &inventory); err != nil {
+		return false, errors.Wrapf(err, "CSR %s %s: failed to parse host inventory", csr.Name, owner)
+	}
+	var hostIPs []string
+	for _, intf := range inventory.Interfaces {
+		for _, addresses := range [][]string{intf.IPV4Addresses, intf.IPV6Addresses} {
+			for _, cidr := range addresses {
+				if ip, _, err := net.ParseCIDR(cidr); err == nil {
+					hostIPs = append(hostIPs, ip.String())
+				}
+			}
+		}
+	}
+	for _, ip := range x509CSR.IPAddresses {
+		if !funk.ContainsString(hostIPs, ip.String()) {
+			return false, errors.Errorf("CSR %s %s: IP address %s missing from host inventory IPs %v", csr.Name, owner,
+				ip.String(), hostIPs)
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,231 @@
+package csrapprover
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"net"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	eventgen "github.com/openshift/assisted-service/internal/common/events"
+	eventsapi "github.com/openshift/assisted-service/internal/events/api"
+	"github.com/openshift/assisted-service/internal/events/eventstest"
+	"github.com/openshift/assisted-service/internal/spoke_k8s_client"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/leader"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"gorm.io/gorm"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	testHostname = "worker-0.example.com"
+	testHostIP   = "192.168.111.30"
+)
+
+func generateCSR(name string, template *x509.CertificateRequest, username string, groups []string, usages []certificatesv1.KeyUsage) certificatesv1.CertificateSigningRequest {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).ToNot(HaveOccurred())
+	der, err := x509.CreateCertificateRequest(rand.Reader, template, key)
+	Expect(err).ToNot(HaveOccurred())
+	return certificatesv1.CertificateSigningRequest{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: certificatesv1.CertificateSigningRequestSpec{
+			Request:  pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der}),
+			Username: username,
+			Groups:   groups,
+			Usages:   usages,
+		},
+	}
+}
+
+func generateClientCSR(name, hostname string) certificatesv1.CertificateSigningRequest {
+	return generateCSR(name,
+		&x509.CertificateRequest{Subject: pkix.Name{CommonName: "system:node:" + hostname, Organization: []string{"system:nodes"}}},
+		"system:serviceaccount:openshift-machine-config-operator:node-bootstrapper",
+		[]string{"system:serviceaccounts:openshift-machine-config-operator", "system:serviceaccounts", "system:authenticated"},
+		[]certificatesv1.KeyUsage{certificatesv1.UsageKeyEncipherment, certificatesv1.UsageDigitalSignature, certificatesv1.UsageClientAuth})
+}
+
+func generateServerCSR(name, hostname string, ip string) certificatesv1.CertificateSigningRequest {
+	return generateCSR(name,
+		&x509.CertificateRequest{
+			Subject:     pkix.Name{CommonName: "system:node:" + hostname, Organization: []string{"system:nodes"}},
+			DNSNames:    []string{hostname},
+			IPAddresses: []net.IP{net.ParseIP(ip)},
+		},
+		"system:node:"+hostname,
+		[]string{"system:nodes", "system:authenticated"},
+		[]certificatesv1.KeyUsage{certificatesv1.UsageDigitalSignature, certificatesv1.UsageKeyEncipherment, certificatesv1.UsageServerAuth})
+}
+
+var _ = Describe("ApproveDay2HostsCSRs", func() {
+	var (
+		db                     *gorm.DB
+		dbName                 string
+		ctrl                   *gomock.Controller
+		mockEvents             *eventsapi.MockHandler
+		mockS3Client           *s3wrapper.MockAPI
+		mockSpokeClientFactory *spoke_k8s_client.MockSpokeK8sClientFactory
+		mockSpokeClient        *spoke_k8s_client.MockSpokeK8sClient
+		approver               *Approver
+		clusterID              strfmt.UUID
+		hostID                 strfmt.UUID
+		infraEnvID             strfmt.UUID
+	)
+
+	createHost := func(status string, statusUpdatedAt time.Time) {
+		inventory, err := json.Marshal(&models.Inventory{
+			Hostname:   testHostname,
+			Interfaces: []*models.Interface{{IPV4Addresses: []string{testHostIP + "/24"}}},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(db.Create(&common.Host{Host: models.Host{
+			ID:              &hostID,
+			InfraEnvID:      infraEnvID,
+			ClusterID:       &clusterID,
+			Kind:            swag.String(models.HostKindAddToExistingClusterHost),
+			Status:          swag.String(status),
+			StatusUpdatedAt: strfmt.DateTime(statusUpdatedAt),
+			Inventory:       string(inventory),
+		}}).Error).ToNot(HaveOccurred())
+	}
+
+	nodeNotFound := k8serrors.NewNotFound(schema.GroupResource{Resource: "nodes"}, testHostname)
+
+	readyNode := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: testHostname},
+		Status: corev1.NodeStatus{
+			Addresses: []corev1.NodeAddress{
+				{Type: corev1.NodeHostName, Address: testHostname},
+				{Type: corev1.NodeInternalIP, Address: testHostIP},
+			},
+		},
+	}
+
+	expectSpokeClient := func() {
+		mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), clusterID.String()+"/kubeconfig").Return(true, nil)
+		mockSpokeClientFactory.EXPECT().CreateFromStorageKubeconfig(gomock.Any(), &clusterID, mockS3Client).Return(mockSpokeClient, nil)
+	}
+
+	expectApprovedEvent := func(csrName string) {
+		mockEvents.EXPECT().SendHostEvent(gomock.Any(), eventstest.NewEventMatcher(
+			eventstest.WithNameMatcher(eventgen.HostCsrApprovedEventName),
+			eventstest.WithHostIdMatcher(hostID.String()),
+			eventstest.WithClusterIdMatcher(clusterID.String()),
+			eventstest.WithMessageContainsMatcher(csrName)))
+	}
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		mockEvents = eventsapi.NewMockHandler(ctrl)
+		mockS3Client = s3wrapper.NewMockAPI(ctrl)
+		mockSpokeClientFactory = spoke_k8s_client.NewMockSpokeK8sClientFactory(ctrl)
+		mockSpokeClient = spoke_k8s_client.NewMockSpokeK8sClient(ctrl)
+		approver = NewApprover(Config{ApprovalWindow: time.Hour}, db, common.GetTestLog(), mockEvents, mockS3Client,
+			mockSpokeClientFactory, &leader.DummyElector{})
+		clusterID = strfmt.UUID(uuid.New().String())
+		hostID = strfmt.UUID(uuid.New().String())
+		infraEnvID = strfmt.UUID(uuid.New().String())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("approves the client CSR of a host whose node did not join yet", func() {
+		createHost(models.HostStatusAddedToExistingCluster, time.Now())
+		csr := generateClientCSR("csr-client", testHostname)
+		expectSpokeClient()
+		mockSpokeClient.EXPECT().ListCsrs().Return(&certificatesv1.CertificateSigningRequestList{
+			Items: []certificatesv1.CertificateSigningRequest{csr, generateClientCSR("csr-other", "other-host")},
+		}, nil)
+		mockSpokeClient.EXPECT().GetNode(testHostname).Return(nil, nodeNotFound)
+		mockSpokeClient.EXPECT().ApproveCsr(gomock.Any()).DoAndReturn(func(c *certificatesv1.CertificateSigningRequest) error {
+			Expect(c.Name).To(Equal("csr-client"))
+			return nil
+		})
+		expectApprovedEvent("csr-client")
+		approver.ApproveDay2HostsCSRs()
+	})
+
+	It("approves the server CSR of a host once its node joined", func() {
+		createHost(models.HostStatusAddedToExistingCluster, time.Now())
+		expectSpokeClient()
+		mockSpokeClient.EXPECT().ListCsrs().Return(&certificatesv1.CertificateSigningRequestList{
+			Items: []certificatesv1.CertificateSigningRequest{generateServerCSR("csr-server", testHostname, testHostIP)},
+		}, nil)
+		mockSpokeClient.EXPECT().GetNode(testHostname).Return(readyNode, nil)
+		mockSpokeClient.EXPECT().ApproveCsr(gomock.Any()).Return(nil)
+		expectApprovedEvent("csr-server")
+		approver.ApproveDay2HostsCSRs()
+	})
+
+	It("does not approve a server CSR with an IP that is not in the host inventory", func() {
+		createHost(models.HostStatusAddedToExistingCluster, time.Now())
+		node := readyNode.DeepCopy()
+		node.Status.Addresses = append(node.Status.Addresses, corev1.NodeAddress{Type: corev1.NodeInternalIP, Address: "10.0.0.5"})
+		expectSpokeClient()
+		mockSpokeClient.EXPECT().ListCsrs().Return(&certificatesv1.CertificateSigningRequestList{
+			Items: []certificatesv1.CertificateSigningRequest{generateServerCSR("csr-server", testHostname, "10.0.0.5")},
+		}, nil)
+		mockSpokeClient.EXPECT().GetNode(testHostname).Return(node, nil)
+		approver.ApproveDay2HostsCSRs()
+	})
+
+	It("does not approve a client CSR that was not sent by the node bootstrapper", func() {
+		createHost(models.HostStatusAddedToExistingCluster, time.Now())
+		csr := generateClientCSR("csr-client", testHostname)
+		csr.Spec.Username = "system:admin"
+		expectSpokeClient()
+		mockSpokeClient.EXPECT().ListCsrs().Return(&certificatesv1.CertificateSigningRequestList{
+			Items: []certificatesv1.CertificateSigningRequest{csr},
+		}, nil)
+		mockSpokeClient.EXPECT().GetNode(testHostname).Return(nil, nodeNotFound)
+		approver.ApproveDay2HostsCSRs()
+	})
+
+	It("skips already approved CSRs", func() {
+		createHost(models.HostStatusAddedToExistingCluster, time.Now())
+		csr := generateClientCSR("csr-client", testHostname)
+		csr.Status.Conditions = []certificatesv1.CertificateSigningRequestCondition{{Type: certificatesv1.CertificateApproved}}
+		expectSpokeClient()
+		mockSpokeClient.EXPECT().ListCsrs().Return(&certificatesv1.CertificateSigningRequestList{
+			Items: []certificatesv1.CertificateSigningRequest{csr},
+		}, nil)
+		approver.ApproveDay2HostsCSRs()
+	})
+
+	It("skips clusters without a stored kubeconfig", func() {
+		createHost(models.HostStatusAddedToExistingCluster, time.Now())
+		mockS3Client.EXPECT().DoesObjectExist(gomock.Any(), clusterID.String()+"/kubeconfig").Return(false, nil)
+		approver.ApproveDay2HostsCSRs()
+	})
+
+	It("skips hosts that were added before the approval window", func() {
+		createHost(models.HostStatusAddedToExistingCluster, time.Now().Add(-2*time.Hour))
+		approver.ApproveDay2HostsCSRs()
+	})
+
+	It("skips hosts that are still installing", func() {
+		createHost(models.HostStatusInstallingInProgress, time.Now())
+		approver.ApproveDay2HostsCSRs()
+	})
+})
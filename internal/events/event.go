@@ -14,6 +14,7 @@ import (
 	"github.com/openshift/assisted-service/pkg/auth"
 	logutil "github.com/openshift/assisted-service/pkg/log"
 	"github.com/openshift/assisted-service/pkg/requestid"
+	"github.com/openshift/assisted-service/pkg/transaction"
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
 )
@@ -63,7 +64,7 @@ func (e *Events) saveEvent(ctx context.Context, clusterID strfmt.UUID, hostID *s
 
 	//each event is saved in its own embedded transaction
 	var dberr error
-	tx := e.db.Begin()
+	tx := transaction.Begin(ctx, e.db, "save event")
 	defer func() {
 		if dberr != nil {
 			log.Warnf("Rolling back transaction on event=%s", message)
@@ -115,7 +116,7 @@ func (e *Events) v2SaveEvent(ctx context.Context, clusterID *strfmt.UUID, hostID
 
 	//each event is saved in its own embedded transaction
 	var dberr error
-	tx := e.db.Begin()
+	tx := transaction.Begin(ctx, e.db, "save event")
 	defer func() {
 		if dberr != nil {
 			log.WithError(err).Errorf("failed to add event. Rolling back transaction on event=%s resources: %s",
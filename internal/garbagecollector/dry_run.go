@@ -0,0 +1,110 @@
+package garbagecollector
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// DryRun returns the clusters, hosts and infra-envs that garbage collection would deregister or delete at the given
+// time, using the same selection as the garbage collection jobs
+func DryRun(ctx context.Context, db *gorm.DB, config Config, now time.Time) (models.GarbageCollectionCandidateList, error) {
+	candidates := models.GarbageCollectionCandidateList{}
+	db = db.WithContext(ctx)
+
+	for _, scope := range config.deregisterInactiveScopes() {
+		if scope.retention.Never {
+			continue
+		}
+		var clusters []*common.Cluster
+		if err := db.Scopes(scope.where).Limit(config.MaxGCClustersPerInterval).
+			Where("updated_at < ?", now.Add(-scope.retention.Duration)).Find(&clusters).Error; err != nil {
+			return nil, errors.Wrap(err, "failed to list inactive clusters")
+		}
+		for _, c := range clusters {
+			candidates = append(candidates, newCandidate(models.GarbageCollectionCandidateKindCluster, *c.ID,
+				models.GarbageCollectionCandidateActionDeregister, scope, c.Name, c.OrgID, c.UserName,
+				strfmt.DateTime(c.UpdatedAt)))
+		}
+	}
+
+	for _, scope := range config.deleteUnregisteredClustersScopes() {
+		if scope.retention.Never {
+			continue
+		}
+		var clusters []*common.Cluster
+		if err := db.Unscoped().Scopes(scope.where).
+			Where("deleted_at < ?", now.Add(-scope.retention.Duration)).Find(&clusters).Error; err != nil {
+			return nil, errors.Wrap(err, "failed to list de-registered clusters")
+		}
+		for _, c := range clusters {
+			candidates = append(candidates, newCandidate(models.GarbageCollectionCandidateKindCluster, *c.ID,
+				models.GarbageCollectionCandidateActionDelete, scope, c.Name, c.OrgID, c.UserName,
+				strfmt.DateTime(c.DeletedAt.Time)))
+		}
+	}
+
+	for _, scope := range config.deleteUnregisteredHostsScopes() {
+		if scope.retention.Never {
+			continue
+		}
+		var hosts []*common.Host
+		if err := db.Unscoped().Scopes(scope.where).
+			Where("deleted_at < ?", now.Add(-scope.retention.Duration)).Find(&hosts).Error; err != nil {
+			return nil, errors.Wrap(err, "failed to list soft-deleted hosts")
+		}
+		for _, h := range hosts {
+			candidates = append(candidates, newCandidate(models.GarbageCollectionCandidateKindHost, *h.ID,
+				models.GarbageCollectionCandidateActionDelete, scope, h.RequestedHostname, "", "",
+				strfmt.DateTime(h.DeletedAt.Time)))
+		}
+	}
+
+	for _, scope := range config.deleteOrphanInfraEnvsScopes() {
+		if scope.retention.Never {
+			continue
+		}
+		var infraEnvs []*common.InfraEnv
+		if err := db.Scopes(scope.where).Limit(config.MaxGCInfraEnvsPerInterval).
+			Where("updated_at < ?", now.Add(-scope.retention.Duration)).Find(&infraEnvs).Error; err != nil {
+			return nil, errors.Wrap(err, "failed to list inactive infraenvs")
+		}
+		for _, infraEnv := range infraEnvs {
+			if infraEnv.ClusterID != "" {
+				// Infraenvs are deleted only when their cluster was deleted
+				_, err := common.GetClusterFromDBWhere(db, common.SkipEagerLoading, common.SkipDeletedRecords, "id = ?", infraEnv.ClusterID)
+				if err == nil {
+					continue
+				}
+				if !errors.Is(err, gorm.ErrRecordNotFound) {
+					return nil, errors.Wrapf(err, "failed to get the cluster of infraenv %s", infraEnv.ID)
+				}
+			}
+			candidates = append(candidates, newCandidate(models.GarbageCollectionCandidateKindInfraEnv, *infraEnv.ID,
+				models.GarbageCollectionCandidateActionDelete, scope, swag.StringValue(infraEnv.Name), infraEnv.OrgID,
+				infraEnv.UserName, strfmt.DateTime(swag.TimeValue(infraEnv.UpdatedAt))))
+		}
+	}
+
+	return candidates, nil
+}
+
+func newCandidate(kind string, id strfmt.UUID, action string, scope retentionScope, name, orgID, userName string,
+	since strfmt.DateTime) *models.GarbageCollectionCandidate {
+	return &models.GarbageCollectionCandidate{
+		Kind:     swag.String(kind),
+		ID:       &id,
+		Action:   swag.String(action),
+		Policy:   swag.String(scope.policyName()),
+		Name:     name,
+		OrgID:    orgID,
+		UserName: userName,
+		Since:    since,
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/go-openapi/strfmt"
 	clusterPkg "github.com/openshift/assisted-service/internal/cluster"
+	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/host"
 	"github.com/openshift/assisted-service/internal/infraenv"
 	"github.com/openshift/assisted-service/pkg/leader"
@@ -14,12 +15,42 @@ import (
 	"gorm.io/gorm"
 )
 
+// The records are partitioned between the replicas by cluster id, like the monitoring, the records
+// that don't belong to a cluster are partitioned by infra-env id
+const (
+	clusterPartitionKey  = "id"
+	hostPartitionKey     = "COALESCE(cluster_id, infra_env_id)"
+	infraEnvPartitionKey = "COALESCE(NULLIF(cluster_id, ''), id)"
+)
+
 type Config struct {
 	DeletedUnregisteredAfter    time.Duration `envconfig:"DELETED_UNREGISTERED_AFTER" default:"72h"`       // 3d
 	DeregisterInactiveAfter     time.Duration `envconfig:"DELETED_INACTIVE_AFTER" default:"480h"`          // 20d
 	InfraenvDeleteInactiveAfter time.Duration `envconfig:"INFRAENV_DELETED_INACTIVE_AFTER" default:"480h"` // 20d
 	MaxGCClustersPerInterval    int           `envconfig:"MAX_GC_CLUSTERS_PER_INTERVAL" default:"100"`
 	MaxGCInfraEnvsPerInterval   int           `envconfig:"MAX_GC_INFRAENVS_PER_INTERVAL" default:"100"`
+	// Policies override the durations above for the clusters and infra-envs they match, in JSON format
+	Policies Policies `envconfig:"GC_POLICIES" default:""`
+}
+
+func (c Config) deregisterInactiveScopes() []retentionScope {
+	return retentionScopes(c.Policies, c.DeregisterInactiveAfter,
+		func(p Policy) *Retention { return p.DeregisterInactiveAfter }, Policy.clustersCondition)
+}
+
+func (c Config) deleteUnregisteredClustersScopes() []retentionScope {
+	return retentionScopes(c.Policies, c.DeletedUnregisteredAfter,
+		func(p Policy) *Retention { return p.DeletedUnregisteredAfter }, Policy.clustersCondition)
+}
+
+func (c Config) deleteUnregisteredHostsScopes() []retentionScope {
+	return retentionScopes(c.Policies, c.DeletedUnregisteredAfter,
+		func(p Policy) *Retention { return p.DeletedUnregisteredAfter }, Policy.hostsCondition)
+}
+
+func (c Config) deleteOrphanInfraEnvsScopes() []retentionScope {
+	return retentionScopes(c.Policies, c.InfraenvDeleteInactiveAfter,
+		func(p Policy) *Retention { return p.InfraenvDeleteInactiveAfter }, Policy.infraEnvsCondition)
 }
 
 func NewGarbageCollectors(
@@ -41,7 +72,7 @@ func NewGarbageCollectors(
 		clusterApi:    clusterApi,
 		infraEnvApi:   infraEnvApi,
 		objectHandler: objectHandler,
-		leaderElector: leaderElector,
+		partitioner:   leader.PartitionerFor(leaderElector),
 	}
 }
 
@@ -53,51 +84,143 @@ type garbageCollector struct {
 	clusterApi    clusterPkg.API
 	infraEnvApi   infraenv.API
 	objectHandler s3wrapper.API
-	leaderElector leader.Leader
+	partitioner   leader.Partitioner
+}
+
+type partitionedRecord struct {
+	ID           strfmt.UUID
+	PartitionKey string
+}
+
+// ownedPageSize is the number of candidate records read at once, the owned records of each page are
+// processed in one batch
+const ownedPageSize = 500
+
+// forEachOwnedBatch pages through the records selected by query, in id order, and calls fn with a scope
+// selecting the records of each page that are owned by the replica. It stops after limit owned records
+// when limit is positive. The query must select from a table with an id column and the partition key.
+func (g garbageCollector) forEachOwnedBatch(query func() *gorm.DB, partitionKey string, limit int,
+	fn func(owned func(*gorm.DB) *gorm.DB) error) error {
+	lastID := ""
+	processed := 0
+	for {
+		var records []partitionedRecord
+		if err := query().Select("id, "+partitionKey+" AS partition_key").Where("id > ?", lastID).
+			Order("id").Limit(ownedPageSize).Scan(&records).Error; err != nil {
+			return err
+		}
+		if len(records) == 0 {
+			return nil
+		}
+		lastID = records[len(records)-1].ID.String()
+
+		ids := make([]strfmt.UUID, 0, len(records))
+		for _, r := range records {
+			if limit > 0 && processed+len(ids) == limit {
+				break
+			}
+			if g.partitioner.Owns(r.PartitionKey) {
+				ids = append(ids, r.ID)
+			}
+		}
+		if len(ids) > 0 {
+			if err := fn(func(db *gorm.DB) *gorm.DB { return db.Where("id IN ?", ids) }); err != nil {
+				return err
+			}
+			processed += len(ids)
+		}
+		if len(records) < ownedPageSize || (limit > 0 && processed >= limit) {
+			return nil
+		}
+	}
 }
 
 func (g garbageCollector) DeregisterInactiveClusters() {
-	if !g.leaderElector.IsLeader() {
+	if !g.partitioner.Participates() {
 		return
 	}
 
-	olderThan := strfmt.DateTime(time.Now().Add(-g.Config.DeregisterInactiveAfter))
-	if err := g.clusterApi.DeregisterInactiveCluster(context.Background(), g.MaxGCClustersPerInterval, olderThan); err != nil {
-		g.log.WithError(err).Errorf("Failed deregister inactive clusters")
-		return
+	for _, scope := range g.Config.deregisterInactiveScopes() {
+		if scope.retention.Never {
+			continue
+		}
+		olderThan := strfmt.DateTime(time.Now().Add(-scope.retention.Duration))
+		g.log.Debugf("Deregistering clusters of garbage collection policy %s that are inactive since %s", scope.policyName(), olderThan)
+		query := func() *gorm.DB {
+			return g.db.Model(&common.Cluster{}).Scopes(scope.where).Where("updated_at < ?", olderThan)
+		}
+		err := g.forEachOwnedBatch(query, clusterPartitionKey, g.MaxGCClustersPerInterval, func(owned func(*gorm.DB) *gorm.DB) error {
+			return g.clusterApi.DeregisterInactiveCluster(context.Background(), g.MaxGCClustersPerInterval, olderThan, scope.where, owned)
+		})
+		if err != nil {
+			g.log.WithError(err).Errorf("Failed deregister inactive clusters of garbage collection policy %s", scope.policyName())
+		}
 	}
 }
 
 func (g garbageCollector) PermanentlyDeleteUnregisteredClustersAndHosts() {
-	if !g.leaderElector.IsLeader() {
+	if !g.partitioner.Participates() {
 		return
 	}
 
-	olderThan := strfmt.DateTime(time.Now().Add(-g.Config.DeletedUnregisteredAfter))
-	if err := g.clusterApi.PermanentClustersDeletion(context.Background(), olderThan, g.objectHandler); err != nil {
-		g.log.WithError(err).Errorf("Failed deleting de-registered clusters")
-		return
+	for _, scope := range g.Config.deleteUnregisteredClustersScopes() {
+		if scope.retention.Never {
+			continue
+		}
+		olderThan := strfmt.DateTime(time.Now().Add(-scope.retention.Duration))
+		g.log.Debugf("Permanently deleting clusters of garbage collection policy %s that were de-registered before %s", scope.policyName(), olderThan)
+		query := func() *gorm.DB {
+			return g.db.Unscoped().Model(&common.Cluster{}).Scopes(scope.where).Where("deleted_at < ?", olderThan)
+		}
+		err := g.forEachOwnedBatch(query, clusterPartitionKey, 0, func(owned func(*gorm.DB) *gorm.DB) error {
+			return g.clusterApi.PermanentClustersDeletion(context.Background(), olderThan, g.objectHandler, scope.where, owned)
+		})
+		if err != nil {
+			g.log.WithError(err).Errorf("Failed deleting de-registered clusters of garbage collection policy %s", scope.policyName())
+		}
 	}
 
-	g.log.Debugf(
-		"Permanently deleting all hosts that were soft-deleted before %s",
-		olderThan)
-	if err := g.hostApi.PermanentHostsDeletion(olderThan); err != nil {
-		g.log.WithError(err).Errorf("Failed deleting soft-deleted hosts")
-		return
+	for _, scope := range g.Config.deleteUnregisteredHostsScopes() {
+		if scope.retention.Never {
+			continue
+		}
+		olderThan := strfmt.DateTime(time.Now().Add(-scope.retention.Duration))
+		g.log.Debugf(
+			"Permanently deleting all hosts of garbage collection policy %s that were soft-deleted before %s",
+			scope.policyName(), olderThan)
+		query := func() *gorm.DB {
+			return g.db.Unscoped().Model(&common.Host{}).Scopes(scope.where).Where("deleted_at < ?", olderThan)
+		}
+		err := g.forEachOwnedBatch(query, hostPartitionKey, 0, func(owned func(*gorm.DB) *gorm.DB) error {
+			return g.hostApi.PermanentHostsDeletion(context.Background(), olderThan, scope.where, owned)
+		})
+		if err != nil {
+			g.log.WithError(err).Errorf("Failed deleting soft-deleted hosts of garbage collection policy %s", scope.policyName())
+		}
 	}
 }
 
 func (g garbageCollector) DeleteOrphanInfraEnvs() {
-	if !g.leaderElector.IsLeader() {
+	if !g.partitioner.Participates() {
 		return
 	}
-	olderThan := strfmt.DateTime(time.Now().Add(-g.Config.InfraenvDeleteInactiveAfter))
-	g.log.Debugf(
-		"Permanently deleting all infraenv that were not updated before %s",
-		olderThan)
-	if err := g.infraEnvApi.DeleteOrphanInfraEnvs(context.Background(), g.MaxGCInfraEnvsPerInterval, olderThan); err != nil {
-		g.log.WithError(err).Errorf("Failed deleting infraenvs")
-		return
+
+	for _, scope := range g.Config.deleteOrphanInfraEnvsScopes() {
+		if scope.retention.Never {
+			continue
+		}
+		olderThan := strfmt.DateTime(time.Now().Add(-scope.retention.Duration))
+		g.log.Debugf(
+			"Permanently deleting all infraenv of garbage collection policy %s that were not updated before %s",
+			scope.policyName(), olderThan)
+		query := func() *gorm.DB {
+			return g.db.Model(&common.InfraEnv{}).Scopes(scope.where).Where("updated_at < ?", olderThan)
+		}
+		err := g.forEachOwnedBatch(query, infraEnvPartitionKey, g.MaxGCInfraEnvsPerInterval, func(owned func(*gorm.DB) *gorm.DB) error {
+			return g.infraEnvApi.DeleteOrphanInfraEnvs(context.Background(), g.MaxGCInfraEnvsPerInterval, olderThan, scope.where, owned)
+		})
+		if err != nil {
+			g.log.WithError(err).Errorf("Failed deleting infraenvs of garbage collection policy %s", scope.policyName())
+		}
 	}
 }
@@ -0,0 +1,206 @@
+package garbagecollector
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+const retentionNever = "never"
+
+// Retention is how long a record is kept, either a duration such as "72h" or "never"
+type Retention struct {
+	Duration time.Duration
+	Never    bool
+}
+
+func (r Retention) String() string {
+	if r.Never {
+		return retentionNever
+	}
+	return r.Duration.String()
+}
+
+func (r Retention) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.String())
+}
+
+func (r *Retention) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	if value == retentionNever {
+		*r = Retention{Never: true}
+		return nil
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return err
+	}
+	if duration < 0 {
+		return errors.Errorf("retention %s is negative", value)
+	}
+	*r = Retention{Duration: duration}
+	return nil
+}
+
+// Policy overrides the global retention of the clusters and infra-envs it matches. A policy matches the records that
+// have all of its org ID, user name and cluster tag; retentions it doesn't set are taken from the global configuration.
+type Policy struct {
+	Name       string `json:"name"`
+	OrgID      string `json:"org_id,omitempty"`
+	UserName   string `json:"user_name,omitempty"`
+	ClusterTag string `json:"cluster_tag,omitempty"`
+
+	DeregisterInactiveAfter     *Retention `json:"deregister_inactive_after,omitempty"`
+	DeletedUnregisteredAfter    *Retention `json:"deleted_unregistered_after,omitempty"`
+	InfraenvDeleteInactiveAfter *Retention `json:"infraenv_deleted_inactive_after,omitempty"`
+}
+
+// Policies are the garbage collection policies, a record is collected according to the first policy that matches it
+type Policies []Policy
+
+func (p *Policies) Decode(value string) error {
+	*p = nil
+	if strings.TrimSpace(value) == "" {
+		return nil
+	}
+	var policies Policies
+	if err := json.Unmarshal([]byte(value), &policies); err != nil {
+		return errors.Wrap(err, "failed to parse garbage collection policies")
+	}
+	names := map[string]bool{}
+	for _, policy := range policies {
+		if policy.Name == "" {
+			return errors.New("garbage collection policies must have a name")
+		}
+		if names[policy.Name] {
+			return errors.Errorf("garbage collection policy %s is defined more than once", policy.Name)
+		}
+		names[policy.Name] = true
+		if policy.OrgID == "" && policy.UserName == "" && policy.ClusterTag == "" {
+			return errors.Errorf("garbage collection policy %s must match an org ID, a user name or a cluster tag", policy.Name)
+		}
+	}
+	*p = policies
+	return nil
+}
+
+// condition is an SQL condition and its arguments
+type condition struct {
+	query string
+	args  []interface{}
+}
+
+func (c condition) not() condition {
+	return condition{query: fmt.Sprintf("NOT (%s)", c.query), args: c.args}
+}
+
+func and(conditions []condition) condition {
+	queries := make([]string, 0, len(conditions))
+	args := []interface{}{}
+	for _, c := range conditions {
+		queries = append(queries, c.query)
+		args = append(args, c.args...)
+	}
+	return condition{query: "(" + strings.Join(queries, " AND ") + ")", args: args}
+}
+
+func clusterTagCondition(tag string) condition {
+	return condition{query: "? = ANY(string_to_array(COALESCE(tags, ''), ','))", args: []interface{}{tag}}
+}
+
+// clustersCondition matches the clusters of the policy
+func (p Policy) clustersCondition() condition {
+	conditions := []condition{}
+	if p.OrgID != "" {
+		conditions = append(conditions, condition{query: "COALESCE(org_id, '') = ?", args: []interface{}{p.OrgID}})
+	}
+	if p.UserName != "" {
+		conditions = append(conditions, condition{query: "COALESCE(user_name, '') = ?", args: []interface{}{p.UserName}})
+	}
+	if p.ClusterTag != "" {
+		conditions = append(conditions, clusterTagCondition(p.ClusterTag))
+	}
+	return and(conditions)
+}
+
+// hostsCondition matches the hosts that belong to the clusters of the policy
+func (p Policy) hostsCondition() condition {
+	clusters := p.clustersCondition()
+	return condition{
+		query: fmt.Sprintf("(cluster_id IS NOT NULL AND cluster_id IN (SELECT id FROM clusters WHERE %s))", clusters.query),
+		args:  clusters.args,
+	}
+}
+
+// infraEnvsCondition matches the infra-envs of the policy, the cluster tag is matched against the cluster of the
+// infra-env
+func (p Policy) infraEnvsCondition() condition {
+	conditions := []condition{}
+	if p.OrgID != "" {
+		conditions = append(conditions, condition{query: "COALESCE(org_id, '') = ?", args: []interface{}{p.OrgID}})
+	}
+	if p.UserName != "" {
+		conditions = append(conditions, condition{query: "COALESCE(user_name, '') = ?", args: []interface{}{p.UserName}})
+	}
+	if p.ClusterTag != "" {
+		tag := clusterTagCondition(p.ClusterTag)
+		conditions = append(conditions, condition{
+			query: fmt.Sprintf("(cluster_id IS NOT NULL AND cluster_id IN (SELECT id FROM clusters WHERE %s))", tag.query),
+			args:  tag.args,
+		})
+	}
+	return and(conditions)
+}
+
+// retentionScope is a group of records that are kept for the same time, either because they match the same policy or
+// because they don't match any policy
+type retentionScope struct {
+	// policy is the name of the policy, empty for the records that don't match any policy
+	policy    string
+	retention Retention
+	where     func(db *gorm.DB) *gorm.DB
+}
+
+func (s retentionScope) policyName() string {
+	if s.policy == "" {
+		return "default"
+	}
+	return s.policy
+}
+
+// retentionScopes splits the records into scopes according to the policies. A record belongs to the scope of the first
+// policy it matches, and the records that don't match any policy belong to the last scope, which has the global
+// retention.
+func retentionScopes(policies Policies, global time.Duration, retention func(Policy) *Retention,
+	matches func(Policy) condition) []retentionScope {
+
+	scopes := make([]retentionScope, 0, len(policies)+1)
+	previous := []condition{}
+	for _, policy := range policies {
+		current := append([]condition{matches(policy)}, previous...)
+		previous = append(previous, matches(policy).not())
+		policyRetention := Retention{Duration: global}
+		if r := retention(policy); r != nil {
+			policyRetention = *r
+		}
+		scopes = append(scopes, retentionScope{policy: policy.Name, retention: policyRetention, where: whereScope(and(current))})
+	}
+	defaultScope := retentionScope{retention: Retention{Duration: global}, where: func(db *gorm.DB) *gorm.DB { return db }}
+	if len(previous) > 0 {
+		defaultScope.where = whereScope(and(previous))
+	}
+	return append(scopes, defaultScope)
+}
+
+func whereScope(c condition) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where(c.query, c.args...)
+	}
+}
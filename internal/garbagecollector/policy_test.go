@@ -0,0 +1,124 @@
+package garbagecollector
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("Policies", func() {
+	It("decodes policies", func() {
+		var policies Policies
+		Expect(policies.Decode(`[
+			{"name": "production", "cluster_tag": "production", "deregister_inactive_after": "never", "deleted_unregistered_after": "never"},
+			{"name": "lab", "org_id": "lab-org", "user_name": "tester", "deregister_inactive_after": "72h"}
+		]`)).To(Succeed())
+		Expect(policies).To(Equal(Policies{
+			{
+				Name:                     "production",
+				ClusterTag:               "production",
+				DeregisterInactiveAfter:  &Retention{Never: true},
+				DeletedUnregisteredAfter: &Retention{Never: true},
+			},
+			{
+				Name:                    "lab",
+				OrgID:                   "lab-org",
+				UserName:                "tester",
+				DeregisterInactiveAfter: &Retention{Duration: 72 * time.Hour},
+			},
+		}))
+	})
+
+	It("decodes no policies", func() {
+		policies := Policies{{Name: "previous"}}
+		Expect(policies.Decode("")).To(Succeed())
+		Expect(policies).To(BeNil())
+	})
+
+	DescribeTable("rejects invalid policies",
+		func(value, expectedError string) {
+			var policies Policies
+			Expect(policies.Decode(value)).To(MatchError(ContainSubstring(expectedError)))
+		},
+		Entry("not JSON", `production`, "failed to parse garbage collection policies"),
+		Entry("no name", `[{"org_id": "org"}]`, "must have a name"),
+		Entry("duplicate name", `[{"name": "a", "org_id": "org"}, {"name": "a", "user_name": "user"}]`, "policy a is defined more than once"),
+		Entry("no match", `[{"name": "a", "deregister_inactive_after": "1h"}]`, "policy a must match an org ID, a user name or a cluster tag"),
+		Entry("invalid retention", `[{"name": "a", "org_id": "org", "deregister_inactive_after": "forever"}]`, "invalid duration"),
+		Entry("negative retention", `[{"name": "a", "org_id": "org", "deregister_inactive_after": "-1h"}]`, "retention -1h is negative"),
+	)
+
+	Context("retention scopes", func() {
+		var db *gorm.DB
+
+		BeforeEach(func() {
+			var err error
+			db, err = gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}), &gorm.Config{DryRun: true, DisableAutomaticPing: true})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		toSQL := func(scope retentionScope) string {
+			return db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+				return tx.Unscoped().Scopes(scope.where).Find(&[]*common.Cluster{})
+			})
+		}
+
+		config := Config{
+			DeregisterInactiveAfter:  480 * time.Hour,
+			DeletedUnregisteredAfter: 72 * time.Hour,
+			Policies: Policies{
+				{Name: "production", ClusterTag: "production", DeregisterInactiveAfter: &Retention{Never: true}},
+				{Name: "lab", OrgID: "lab-org", DeregisterInactiveAfter: &Retention{Duration: 72 * time.Hour}},
+			},
+		}
+
+		It("assigns records to the first policy that matches them", func() {
+			scopes := config.deregisterInactiveScopes()
+			Expect(scopes).To(HaveLen(3))
+
+			Expect(scopes[0].policyName()).To(Equal("production"))
+			Expect(scopes[0].retention).To(Equal(Retention{Never: true}))
+			Expect(toSQL(scopes[0])).To(Equal(
+				`SELECT * FROM "clusters" WHERE (('production' = ANY(string_to_array(COALESCE(tags, ''), ','))))`))
+
+			Expect(scopes[1].policyName()).To(Equal("lab"))
+			Expect(scopes[1].retention).To(Equal(Retention{Duration: 72 * time.Hour}))
+			Expect(toSQL(scopes[1])).To(Equal(
+				`SELECT * FROM "clusters" WHERE ((COALESCE(org_id, '') = 'lab-org') AND NOT (('production' = ANY(string_to_array(COALESCE(tags, ''), ',')))))`))
+
+			Expect(scopes[2].policyName()).To(Equal("default"))
+			Expect(scopes[2].retention).To(Equal(Retention{Duration: 480 * time.Hour}))
+			Expect(toSQL(scopes[2])).To(Equal(
+				`SELECT * FROM "clusters" WHERE (NOT (('production' = ANY(string_to_array(COALESCE(tags, ''), ',')))) AND NOT ((COALESCE(org_id, '') = 'lab-org')))`))
+		})
+
+		It("uses the global retention when a policy doesn't set one", func() {
+			scopes := config.deleteUnregisteredClustersScopes()
+			Expect(scopes).To(HaveLen(3))
+			for _, scope := range scopes {
+				Expect(scope.retention).To(Equal(Retention{Duration: 72 * time.Hour}))
+			}
+		})
+
+		It("matches hosts and infra-envs by their cluster", func() {
+			scopes := config.deleteUnregisteredHostsScopes()
+			Expect(toSQL(scopes[0])).To(ContainSubstring(
+				`(cluster_id IS NOT NULL AND cluster_id IN (SELECT id FROM clusters WHERE ('production' = ANY(string_to_array(COALESCE(tags, ''), ',')))))`))
+
+			scopes = config.deleteOrphanInfraEnvsScopes()
+			Expect(toSQL(scopes[1])).To(Equal(`SELECT * FROM "clusters" WHERE ((COALESCE(org_id, '') = 'lab-org') AND NOT (((cluster_id IS NOT NULL AND cluster_id IN ` +
+				`(SELECT id FROM clusters WHERE 'production' = ANY(string_to_array(COALESCE(tags, ''), ',')))))))`))
+		})
+
+		It("doesn't narrow down the records without policies", func() {
+			scopes := Config{DeregisterInactiveAfter: time.Hour}.deregisterInactiveScopes()
+			Expect(scopes).To(HaveLen(1))
+			Expect(toSQL(scopes[0])).To(Equal(`SELECT * FROM "clusters"`))
+		})
+	})
+})
@@ -0,0 +1,86 @@
+package gencrypto
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"os"
+
+	"github.com/pkg/errors"
+)
+
+// EncryptSecret encrypts a secret that should be stored by the service, such as platform credentials.
+//
+// The key is taken from CREDENTIALS_ENCRYPTION_KEY, falling back to EC_PRIVATE_KEY_PEM so that
+// existing deployments can store secrets without additional configuration.
+func EncryptSecret(plaintext string) (string, error) {
+	key, err := secretKey()
+	if err != nil {
+		return "", err
+	}
+	return EncryptSecretWithKey(key, plaintext)
+}
+
+// DecryptSecret decrypts a secret that was encrypted with EncryptSecret.
+func DecryptSecret(ciphertext string) (string, error) {
+	key, err := secretKey()
+	if err != nil {
+		return "", err
+	}
+	return DecryptSecretWithKey(key, ciphertext)
+}
+
+func secretKey() (string, error) {
+	for _, env := range []string{"CREDENTIALS_ENCRYPTION_KEY", "EC_PRIVATE_KEY_PEM"} {
+		if key, ok := os.LookupEnv(env); ok && key != "" {
+			return key, nil
+		}
+	}
+	return "", errors.Errorf("neither CREDENTIALS_ENCRYPTION_KEY nor EC_PRIVATE_KEY_PEM were found")
+}
+
+func newGCM(key string) (cipher.AEAD, error) {
+	// Derive a 256 bit key so that keys of any length can be used
+	derived := sha256.Sum256([]byte(key))
+	block, err := aes.NewCipher(derived[:])
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// EncryptSecretWithKey encrypts plaintext with AES-GCM using a key derived from the given key.
+// The result is the base64 encoding of the nonce followed by the sealed data.
+func EncryptSecretWithKey(key string, plaintext string) (string, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(gcm.Seal(nonce, nonce, []byte(plaintext), nil)), nil
+}
+
+// DecryptSecretWithKey decrypts a value that was encrypted by EncryptSecretWithKey with the same key.
+func DecryptSecretWithKey(key string, ciphertext string) (string, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return "", err
+	}
+	data, err := base64.StdEncoding.DecodeString(ciphertext)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to decode secret")
+	}
+	if len(data) < gcm.NonceSize() {
+		return "", errors.New("secret is too short")
+	}
+	plaintext, err := gcm.Open(nil, data[:gcm.NonceSize()], data[gcm.NonceSize():], nil)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to decrypt secret")
+	}
+	return string(plaintext), nil
+}
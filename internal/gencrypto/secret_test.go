@@ -0,0 +1,56 @@
+package gencrypto
+
+import (
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Secret encryption", func() {
+	It("decrypts what was encrypted with the same key", func() {
+		encrypted, err := EncryptSecretWithKey("key", "my-password")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(encrypted).ToNot(ContainSubstring("my-password"))
+		decrypted, err := DecryptSecretWithKey("key", encrypted)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decrypted).To(Equal("my-password"))
+	})
+
+	It("uses a random nonce", func() {
+		first, err := EncryptSecretWithKey("key", "my-password")
+		Expect(err).ToNot(HaveOccurred())
+		second, err := EncryptSecretWithKey("key", "my-password")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(first).ToNot(Equal(second))
+	})
+
+	It("fails to decrypt with a different key", func() {
+		encrypted, err := EncryptSecretWithKey("key", "my-password")
+		Expect(err).ToNot(HaveOccurred())
+		_, err = DecryptSecretWithKey("other-key", encrypted)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("fails to decrypt invalid data", func() {
+		_, err := DecryptSecretWithKey("key", "not base64!")
+		Expect(err).To(HaveOccurred())
+		_, err = DecryptSecretWithKey("key", "YQ==")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("reads the key from the environment", func() {
+		os.Unsetenv("CREDENTIALS_ENCRYPTION_KEY")
+		os.Unsetenv("EC_PRIVATE_KEY_PEM")
+		_, err := EncryptSecret("my-password")
+		Expect(err).To(HaveOccurred())
+
+		os.Setenv("CREDENTIALS_ENCRYPTION_KEY", "key")
+		defer os.Unsetenv("CREDENTIALS_ENCRYPTION_KEY")
+		encrypted, err := EncryptSecret("my-password")
+		Expect(err).ToNot(HaveOccurred())
+		decrypted, err := DecryptSecretWithKey("key", encrypted)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decrypted).To(Equal("my-password"))
+	})
+})
@@ -27,11 +27,14 @@ import (
 	"github.com/openshift/assisted-service/pkg/leader"
 	logutil "github.com/openshift/assisted-service/pkg/log"
 	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/openshift/assisted-service/pkg/tracing"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/thoas/go-funk"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
 )
 
 const singleNodeRebootTimeout = 80 * time.Minute
@@ -94,7 +97,9 @@ type API interface {
 	IsValidMasterCandidate(h *models.Host, c *common.Cluster, db *gorm.DB, log logrus.FieldLogger) (bool, error)
 	SetUploadLogsAt(ctx context.Context, h *models.Host, db *gorm.DB) error
 	UpdateLogsProgress(ctx context.Context, h *models.Host, progress string) error
-	PermanentHostsDeletion(olderThan strfmt.DateTime) error
+	PermanentHostsDeletion(ctx context.Context, olderThan strfmt.DateTime, scopes ...func(*gorm.DB) *gorm.DB) error
+	// Queue a refresh of the hosts of the cluster, when event-driven monitoring is enabled
+	RequestClusterHostsRefresh(clusterID strfmt.UUID)
 	ReportValidationFailedMetrics(ctx context.Context, h *models.Host, ocpVersion, emailDomain string) error
 
 	UpdateRole(ctx context.Context, h *models.Host, role models.HostRole, db *gorm.DB) error
@@ -133,10 +138,12 @@ type Manager struct {
 	metricApi                     metrics.API
 	Config                        Config
 	leaderElector                 leader.Leader
+	partitioner                   leader.Partitioner
 	monitorClusterQueryGenerator  *common.MonitorClusterQueryGenerator
 	monitorInfraEnvQueryGenerator *common.MonitorInfraEnvQueryGenerator
 	kubeApiEnabled                bool
 	objectHandler                 s3wrapper.API
+	refreshQueue                  workqueue.RateLimitingInterface
 }
 
 func NewManager(log logrus.FieldLogger, db *gorm.DB, eventsHandler eventsapi.Handler, hwValidator hardware.Validator, instructionApi hostcommands.InstructionApi,
@@ -156,10 +163,11 @@ func NewManager(log logrus.FieldLogger, db *gorm.DB, eventsHandler eventsapi.Han
 		hwValidator:    hwValidator,
 		eventsHandler:  eventsHandler,
 		sm:             sm,
-		rp:             newRefreshPreprocessor(log, hwValidatorCfg, hwValidator, operatorsApi, config.DisabledHostvalidations, providerRegistry),
+		rp:             newRefreshPreprocessor(log, hwValidatorCfg, hwValidator, operatorsApi, config.DisabledHostvalidations, providerRegistry, config.ValidationCache),
 		metricApi:      metricApi,
 		Config:         *config,
 		leaderElector:  leaderElector,
+		partitioner:    leader.PartitionerFor(leaderElector),
 		kubeApiEnabled: kubeApiEnabled,
 		objectHandler:  objectHandler,
 	}
@@ -200,16 +208,20 @@ func (m *Manager) RegisterHost(ctx context.Context, h *models.Host, db *gorm.DB)
 		}
 	}
 
-	return m.sm.Run(TransitionTypeRegisterHost, newStateHost(host), &TransitionArgsRegisterHost{
+	err = tracing.RunTransition(ctx, m.sm, "host", TransitionTypeRegisterHost, newStateHost(host), &TransitionArgsRegisterHost{
 		ctx:                   ctx,
 		discoveryAgentVersion: h.DiscoveryAgentVersion,
 		db:                    db,
 	})
+	if err == nil {
+		m.requestHostAndClusterRefresh(host, host.ClusterID)
+	}
+	return err
 }
 
 func (m *Manager) HandleInstallationFailure(ctx context.Context, h *models.Host) error {
 	lastStatusUpdateTime := h.StatusUpdatedAt
-	err := m.sm.Run(TransitionTypeHostInstallationFailed, newStateHost(h), &TransitionArgsHostInstallationFailed{
+	err := tracing.RunTransition(ctx, m.sm, "host", TransitionTypeHostInstallationFailed, newStateHost(h), &TransitionArgsHostInstallationFailed{
 		ctx:    ctx,
 		reason: "installation command failed",
 	})
@@ -221,7 +233,7 @@ func (m *Manager) HandleInstallationFailure(ctx context.Context, h *models.Host)
 }
 
 func (m *Manager) HandleMediaDisconnected(ctx context.Context, h *models.Host) error {
-	return m.sm.Run(TransitionTypeMediaDisconnect, newStateHost(h), &TransitionArgsMediaDisconnected{ctx: ctx, db: m.db})
+	return tracing.RunTransition(ctx, m.sm, "host", TransitionTypeMediaDisconnect, newStateHost(h), &TransitionArgsMediaDisconnected{ctx: ctx, db: m.db})
 }
 
 // populateDisksEligibility updates a given inventory object by updating the
@@ -264,7 +276,7 @@ func (m *Manager) populateDisksId(inventory *models.Inventory) {
 func (m *Manager) HandlePrepareInstallationFailure(ctx context.Context, h *models.Host, reason string) error {
 
 	lastStatusUpdateTime := h.StatusUpdatedAt
-	err := m.sm.Run(TransitionTypeHostInstallationFailed, newStateHost(h), &TransitionArgsHostInstallationFailed{
+	err := tracing.RunTransition(ctx, m.sm, "host", TransitionTypeHostInstallationFailed, newStateHost(h), &TransitionArgsHostInstallationFailed{
 		ctx:    ctx,
 		reason: reason,
 	})
@@ -331,6 +343,13 @@ func (m *Manager) updateInventory(ctx context.Context, cluster *common.Cluster,
 				}
 			}
 		}
+
+		if h.Inventory == "" {
+			if err = m.applyImportedHost(log, cluster, h, inventory, db); err != nil {
+				log.WithError(err).Errorf("failed to apply the imported configuration of host %s", h.ID)
+				return err
+			}
+		}
 	}
 
 	err = m.populateDisksEligibility(ctx, inventory, infraEnv, cluster, h)
@@ -375,6 +394,11 @@ func (m *Manager) updateInventory(ctx context.Context, cluster *common.Cluster,
 	}
 
 	validDisks := m.hwValidator.ListEligibleDisks(inventory)
+	if hints := hostutil.GetRootDeviceHints(h, infraEnv); hints != nil {
+		// Only a disk that matches the root device hints can be selected. When none of them matches no disk is
+		// selected rather than falling back to a disk the user may want to preserve, and the validation fails.
+		validDisks = hostutil.GetAcceptableDisksWithHints(validDisks, hints)
+	}
 	installationDisk := hostutil.DetermineInstallationDisk(validDisks, hostutil.GetHostInstallationPath(h))
 
 	var (
@@ -394,18 +418,29 @@ func (m *Manager) updateInventory(ctx context.Context, cluster *common.Cluster,
 	// If there is substantial change in the inventory that might cause the state machine to move to a new status
 	// or one of the validations to change, then the updated_at field has to be modified.  Otherwise, we just
 	// perform update with touching the updated_at field
-	return db.Model(h).Updates(map[string]interface{}{
+	err = db.Model(h).Updates(map[string]interface{}{
 		"inventory":              inventoryStr,
 		"installation_disk_path": installationDiskPath,
 		"installation_disk_id":   installationDiskID,
 		"disks_to_be_formatted":  disksToBeFormatted,
 	}).Error
+	if err != nil {
+		return err
+	}
+
+	// The validations of the other hosts of the cluster may depend on the inventory of this one
+	m.requestHostAndClusterRefresh(h, h.ClusterID)
+	return nil
 }
 
 func (m *Manager) UpdateMediaConnected(_ context.Context, h *models.Host) error {
-	return m.db.Model(h).Updates(map[string]interface{}{
+	err := m.db.Model(h).Updates(map[string]interface{}{
 		"media_status": models.HostMediaStatusConnected,
 	}).Error
+	if err == nil {
+		m.requestRefresh(h)
+	}
+	return err
 }
 
 func (m *Manager) refreshRoleInternal(ctx context.Context, h *models.Host, db *gorm.DB, forceRefresh bool) error {
@@ -471,7 +506,7 @@ func (m *Manager) refreshStatusInternal(ctx context.Context, h *models.Host, c *
 		}
 	}
 
-	err = m.sm.Run(TransitionTypeRefresh, newStateHost(h), &TransitionArgsRefreshHost{
+	err = tracing.RunTransition(ctx, m.sm, "host", TransitionTypeRefresh, newStateHost(h), &TransitionArgsRefreshHost{
 		ctx:               ctx,
 		db:                db,
 		eventHandler:      m.eventsHandler,
@@ -503,18 +538,22 @@ func (m *Manager) Install(ctx context.Context, h *models.Host, db *gorm.DB) erro
 	if db != nil {
 		cdb = db
 	}
-	return m.sm.Run(TransitionTypeInstallHost, newStateHost(h), &TransitionArgsInstallHost{
+	return tracing.RunTransition(ctx, m.sm, "host", TransitionTypeInstallHost, newStateHost(h), &TransitionArgsInstallHost{
 		ctx: ctx,
 		db:  cdb,
 	})
 }
 
 func (m *Manager) BindHost(ctx context.Context, h *models.Host, clusterID strfmt.UUID, db *gorm.DB) error {
-	return m.sm.Run(TransitionTypeBindHost, newStateHost(h), &TransitionArgsBindHost{
+	err := tracing.RunTransition(ctx, m.sm, "host", TransitionTypeBindHost, newStateHost(h), &TransitionArgsBindHost{
 		ctx:       ctx,
 		db:        db,
 		clusterID: clusterID,
 	})
+	if err == nil {
+		m.requestHostAndClusterRefresh(h, &clusterID)
+	}
+	return err
 }
 
 func (m *Manager) UnbindHost(ctx context.Context, h *models.Host, db *gorm.DB, reclaim bool) error {
@@ -522,13 +561,22 @@ func (m *Manager) UnbindHost(ctx context.Context, h *models.Host, db *gorm.DB, r
 	if reclaim {
 		transition = TransitionTypeReclaimHost
 	}
-	return m.sm.Run(stateswitch.TransitionType(transition), newStateHost(h), &TransitionArgsUnbindHost{
+	clusterID := h.ClusterID
+	err := tracing.RunTransition(ctx, m.sm, "host", stateswitch.TransitionType(transition), newStateHost(h), &TransitionArgsUnbindHost{
 		ctx: ctx,
 		db:  db,
 	})
+	if err == nil {
+		m.requestHostAndClusterRefresh(h, clusterID)
+	}
+	return err
 }
 
 func (m *Manager) GetNextSteps(ctx context.Context, host *models.Host) (models.Steps, error) {
+	// A disconnected host checking in again is connected now
+	if swag.StringValue(host.Status) == models.HostStatusDisconnected {
+		m.requestRefresh(host)
+	}
 	return m.instructionApi.GetNextSteps(ctx, host)
 }
 
@@ -659,6 +707,7 @@ func (m *Manager) UpdateConnectivityReport(ctx context.Context, h *models.Host,
 		if err := m.db.Model(h).Update("connectivity", connectivityReport).Error; err != nil {
 			return errors.Wrapf(err, "failed to set connectivity to host %s", h.ID.String())
 		}
+		m.requestRefresh(h)
 	}
 	return nil
 }
@@ -668,6 +717,7 @@ func (m *Manager) UpdateApiVipConnectivityReport(ctx context.Context, h *models.
 		if err := m.db.Model(h).Update("api_vip_connectivity", apiVipConnectivityReport).Error; err != nil {
 			return errors.Wrapf(err, "failed to set api_vip_connectivity to host %s", h.ID.String())
 		}
+		m.requestRefresh(h)
 	}
 	return nil
 }
@@ -677,6 +727,7 @@ func (m *Manager) UpdateTangConnectivityReport(ctx context.Context, h *models.Ho
 		if err := m.db.Model(h).Update("tang_connectivity", tangConnectivityReport).Error; err != nil {
 			return errors.Wrapf(err, "failed to set tang_connectivity to host %s", h.ID.String())
 		}
+		m.requestRefresh(h)
 	}
 	return nil
 }
@@ -772,7 +823,11 @@ func (m *Manager) UpdateNTP(ctx context.Context, h *models.Host, ntpSources []*m
 	}
 
 	m.log.Infof("Updating ntp source of host %s to %s", h.ID, string(bytes))
-	return db.Model(h).Update("ntp_sources", string(bytes)).Error
+	if err = db.Model(h).Update("ntp_sources", string(bytes)).Error; err != nil {
+		return err
+	}
+	m.requestRefresh(h)
+	return nil
 }
 
 func (m *Manager) UpdateDomainNameResolution(ctx context.Context, h *models.Host, domainResolutionResponse models.DomainResolutionResponse, db *gorm.DB) error {
@@ -791,6 +846,7 @@ func (m *Manager) UpdateDomainNameResolution(ctx context.Context, h *models.Host
 		if err := db.Model(h).Updates(updates).Error; err != nil {
 			return errors.Wrapf(err, "failed to update api_domain_name_resolution to host %s", h.ID.String())
 		}
+		m.requestRefresh(h)
 	}
 	return nil
 }
@@ -825,7 +881,60 @@ func (m *Manager) UpdateImageStatus(ctx context.Context, h *models.Host, newImag
 		return errors.Wrapf(err, "Failed to marshal image statuses for host %s", h.ID.String())
 	}
 
-	return db.Model(h).Update("images_status", marshalledStatuses).Error
+	if err = db.Model(h).Update("images_status", marshalledStatuses).Error; err != nil {
+		return err
+	}
+	m.requestRefresh(h)
+	return nil
+}
+
+// applyImportedHost sets the role and the hostname that the bundle the cluster was imported from holds for the
+// host, matching the host by the MAC addresses of its interfaces. It is only applied when the host reports its first
+// inventory, and doesn't override a role or a hostname the user already chose.
+func (m *Manager) applyImportedHost(log logrus.FieldLogger, cluster *common.Cluster, h *models.Host, inventory *models.Inventory, db *gorm.DB) error {
+	if cluster.ImportedHosts == "" {
+		return nil
+	}
+	var importedHosts []*models.ClusterBundleHost
+	if err := json.Unmarshal([]byte(cluster.ImportedHosts), &importedHosts); err != nil {
+		log.WithError(err).Warnf("ignoring the invalid imported hosts of cluster %s", cluster.ID)
+		return nil
+	}
+	imported := findImportedHost(importedHosts, inventory)
+	if imported == nil {
+		return nil
+	}
+
+	role := models.HostRole(imported.Role)
+	if role != "" && role != models.HostRoleAutoAssign && h.Role == models.HostRoleAutoAssign {
+		log.Infof("setting the imported role %s of host %s", role, h.ID)
+		if err := updateRole(log, h, role, role, db, string(h.Role)); err != nil {
+			return err
+		}
+		h.Role = role
+		h.SuggestedRole = role
+	}
+	if imported.Hostname != "" && h.RequestedHostname == "" {
+		log.Infof("setting the imported hostname %s of host %s", imported.Hostname, h.ID)
+		if err := db.Model(h).Update("requested_hostname", imported.Hostname).Error; err != nil {
+			return err
+		}
+		h.RequestedHostname = imported.Hostname
+	}
+	return nil
+}
+
+func findImportedHost(importedHosts []*models.ClusterBundleHost, inventory *models.Inventory) *models.ClusterBundleHost {
+	for _, imported := range importedHosts {
+		for _, iface := range inventory.Interfaces {
+			for _, mac := range imported.MacAddresses {
+				if strings.EqualFold(iface.MacAddress, mac) {
+					return imported
+				}
+			}
+		}
+	}
+	return nil
 }
 
 func (m *Manager) UpdateHostname(ctx context.Context, h *models.Host, hostname string, db *gorm.DB) error {
@@ -897,7 +1006,7 @@ func (m *Manager) CancelInstallation(ctx context.Context, h *models.Host, reason
 		}
 	}()
 
-	err = m.sm.Run(TransitionTypeCancelInstallation, newStateHost(h), &TransitionArgsCancelInstallation{
+	err = tracing.RunTransition(ctx, m.sm, "host", TransitionTypeCancelInstallation, newStateHost(h), &TransitionArgsCancelInstallation{
 		ctx:    ctx,
 		reason: reason,
 		db:     db,
@@ -943,7 +1052,7 @@ func (m *Manager) ResetHost(ctx context.Context, h *models.Host, reason string,
 		}
 	}()
 
-	if err = m.sm.Run(TransitionTypeResettingPendingUserAction, newStateHost(h), &TransitionResettingPendingUserAction{
+	if err = tracing.RunTransition(ctx, m.sm, "host", TransitionTypeResettingPendingUserAction, newStateHost(h), &TransitionResettingPendingUserAction{
 		ctx: ctx,
 		db:  db,
 	}); err != nil {
@@ -969,7 +1078,7 @@ func (m *Manager) ResetPendingUserAction(ctx context.Context, h *models.Host, db
 		}
 	}()
 
-	err = m.sm.Run(TransitionTypeResettingPendingUserAction, newStateHost(h), &TransitionResettingPendingUserAction{
+	err = tracing.RunTransition(ctx, m.sm, "host", TransitionTypeResettingPendingUserAction, newStateHost(h), &TransitionResettingPendingUserAction{
 		ctx: ctx,
 		db:  db,
 	})
@@ -1233,6 +1342,7 @@ func (m *Manager) SetDiskSpeed(ctx context.Context, h *models.Host, path string,
 			log.WithError(err).Error("Disks info")
 			return err
 		}
+		m.requestRefresh(h)
 	}
 	return nil
 }
@@ -1289,13 +1399,19 @@ func (m *Manager) ResetHostValidation(ctx context.Context, hostID, infraEnvID st
 	}
 }
 
-func (m Manager) PermanentHostsDeletion(olderThan strfmt.DateTime) error {
+// PermanentHostsDeletion deletes the hosts that were deregistered before olderThan, the scopes narrow down the hosts
+// that are considered. An event is sent for each deleted host
+func (m Manager) PermanentHostsDeletion(ctx context.Context, olderThan strfmt.DateTime, scopes ...func(*gorm.DB) *gorm.DB) error {
 	var hosts []*models.Host
-	db := m.db.Unscoped()
-	if reply := db.Where("deleted_at < ?", olderThan).Delete(&hosts); reply.Error != nil {
+	db := m.db.Unscoped().Scopes(scopes...)
+	if reply := db.Clauses(clause.Returning{}).Where("deleted_at < ?", olderThan).Delete(&hosts); reply.Error != nil {
 		return reply.Error
 	} else if reply.RowsAffected > 0 {
-		m.log.Debugf("Deleted %s hosts from db", reply.RowsAffected)
+		m.log.Infof("Permanently deleted %d hosts that were soft-deleted before %s", reply.RowsAffected, olderThan)
+	}
+	for _, h := range hosts {
+		eventgen.SendHostPermanentlyDeletedEvent(ctx, m.eventsHandler, *h.ID, h.InfraEnvID, h.ClusterID,
+			hostutil.GetHostnameForMsg(h))
 	}
 	return nil
 }
@@ -1379,9 +1495,9 @@ func (m *Manager) GetKnownApprovedHosts(clusterId strfmt.UUID) (hosts []*common.
 }
 
 func (m *Manager) HandleReclaimBootArtifactDownload(ctx context.Context, h *models.Host) error {
-	return m.sm.Run(TransitionTypeRebootingForReclaim, newStateHost(h), &TransitionArgsReclaimHost{ctx: ctx, db: m.db})
+	return tracing.RunTransition(ctx, m.sm, "host", TransitionTypeRebootingForReclaim, newStateHost(h), &TransitionArgsReclaimHost{ctx: ctx, db: m.db})
 }
 
 func (m *Manager) HandleReclaimFailure(ctx context.Context, h *models.Host) error {
-	return m.sm.Run(TransitionTypeReclaimFailed, newStateHost(h), &TransitionArgsUnbindHost{ctx: ctx, db: m.db})
+	return tracing.RunTransition(ctx, m.sm, "host", TransitionTypeReclaimFailed, newStateHost(h), &TransitionArgsUnbindHost{ctx: ctx, db: m.db})
 }
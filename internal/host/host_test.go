@@ -806,6 +806,70 @@ var _ = Describe("cancel installation", func() {
 	})
 })
 
+var _ = Describe("Permanently delete hosts", func() {
+	var (
+		ctx           = context.Background()
+		db            *gorm.DB
+		state         API
+		eventsHandler eventsapi.Handler
+		dbName        string
+		clusterID     strfmt.UUID
+		infraEnvID    strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		eventsHandler = events.New(db, nil, logrus.New())
+		dummy := &leader.DummyElector{}
+		state = NewManager(common.GetTestLog(), db, eventsHandler, nil, nil, nil, nil, defaultConfig, dummy, nil, nil, false, nil)
+		clusterID = strfmt.UUID(uuid.New().String())
+		infraEnvID = strfmt.UUID(uuid.New().String())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	createHost := func() models.Host {
+		h := hostutil.GenerateTestHost(strfmt.UUID(uuid.New().String()), infraEnvID, clusterID, models.HostStatusKnown)
+		Expect(db.Create(&h).Error).ShouldNot(HaveOccurred())
+		return h
+	}
+
+	hostEvents := func(h *models.Host) []*common.Event {
+		hostEvents, err := eventsHandler.V2GetEvents(ctx, h.ClusterID, h.ID, nil)
+		Expect(err).ShouldNot(HaveOccurred())
+		return hostEvents
+	}
+
+	It("sends an event for each deleted host", func() {
+		deleted := createHost()
+		kept := createHost()
+		Expect(db.Delete(&deleted).RowsAffected).Should(Equal(int64(1)))
+
+		Expect(state.PermanentHostsDeletion(ctx, strfmt.DateTime(time.Now().Add(time.Minute)))).ShouldNot(HaveOccurred())
+
+		Expect(db.Unscoped().Where("id = ?", deleted.ID.String()).Find(&models.Host{}).RowsAffected).Should(Equal(int64(0)))
+		deletedEvents := hostEvents(&deleted)
+		Expect(deletedEvents).To(HaveLen(1))
+		Expect(deletedEvents[0].Name).To(Equal(eventgen.HostPermanentlyDeletedEventName))
+		Expect(*deletedEvents[0].Message).To(Equal(fmt.Sprintf("Host %s was permanently deleted", hostutil.GetHostnameForMsg(&deleted))))
+
+		Expect(db.Where("id = ?", kept.ID.String()).Find(&models.Host{}).RowsAffected).Should(Equal(int64(1)))
+		Expect(hostEvents(&kept)).To(BeEmpty())
+	})
+
+	It("doesn't send events when no host is deleted", func() {
+		h := createHost()
+		Expect(db.Delete(&h).RowsAffected).Should(Equal(int64(1)))
+
+		Expect(state.PermanentHostsDeletion(ctx, strfmt.DateTime(time.Now().Add(-time.Hour)))).ShouldNot(HaveOccurred())
+
+		Expect(db.Unscoped().Where("id = ?", h.ID.String()).Find(&models.Host{}).RowsAffected).Should(Equal(int64(1)))
+		Expect(hostEvents(&h)).To(BeEmpty())
+	})
+})
+
 var _ = Describe("reset host", func() {
 	var (
 		ctx           = context.Background()
@@ -1294,6 +1358,49 @@ var _ = Describe("UpdateInventory", func() {
 		}
 	})
 
+	Context("Apply imported hosts", func() {
+		var inventoryStr string
+
+		BeforeEach(func() {
+			importedHosts, err := json.Marshal([]*models.ClusterBundleHost{
+				{MacAddresses: []string{"50:00:00:0A:0B:0C"}, Hostname: "imported-worker", Role: models.HostRoleUpdateParamsWorker},
+				{MacAddresses: []string{"50:00:00:01:02:03"}, Hostname: "imported-master", Role: models.HostRoleUpdateParamsMaster},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(db.Model(&common.Cluster{}).Where("id = ?", clusterId.String()).Update("imported_hosts", string(importedHosts)).Error).ShouldNot(HaveOccurred())
+
+			host = hostutil.GenerateTestHostByKind(hostId, infraEnvId, &clusterId, models.HostStatusDiscovering, models.HostKindHost, models.HostRoleAutoAssign)
+			host.Inventory = ""
+			inventory := models.Inventory{Interfaces: []*models.Interface{{MacAddress: "50:00:00:01:02:03"}}}
+			inventoryStr, err = common.MarshalInventory(&inventory)
+			Expect(err).ToNot(HaveOccurred())
+
+			mockEvents.EXPECT().AddMetricsEvent(ctx, clusterId, &hostId, models.EventSeverityInfo, "nic.virtual_interfaces", gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any())
+			mockEvents.EXPECT().AddMetricsEvent(ctx, clusterId, &hostId, models.EventSeverityInfo, "nic.physical_interfaces", gomock.Any(), gomock.Any(), gomock.Any())
+			mockValidator.EXPECT().ListEligibleDisks(gomock.Any()).Return([]*models.Disk{})
+		})
+
+		It("sets the role and hostname of the host with a matching MAC address", func() {
+			Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+			Expect(hapi.UpdateInventory(ctx, &host, inventoryStr)).To(Succeed())
+
+			h := hostutil.GetHostFromDB(hostId, infraEnvId, db)
+			Expect(h.Role).To(Equal(models.HostRoleMaster))
+			Expect(h.RequestedHostname).To(Equal("imported-master"))
+		})
+
+		It("keeps the role and hostname chosen by the user", func() {
+			host.Role = models.HostRoleWorker
+			host.RequestedHostname = "chosen"
+			Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+			Expect(hapi.UpdateInventory(ctx, &host, inventoryStr)).To(Succeed())
+
+			h := hostutil.GetHostFromDB(hostId, infraEnvId, db)
+			Expect(h.Role).To(Equal(models.HostRoleWorker))
+			Expect(h.RequestedHostname).To(Equal("chosen"))
+		})
+	})
+
 	Context("Update inventory - no cluster", func() {
 		const (
 			diskName = "FirstDisk"
@@ -1400,6 +1507,40 @@ var _ = Describe("UpdateInventory", func() {
 			Expect(h.InstallationDiskPath).To(Equal(diskPath))
 			Expect(h.InstallationDiskID).To(Equal(diskId))
 		})
+
+		Context("Root device hints", func() {
+			eligible := models.DiskInstallationEligibility{Eligible: true}
+			disks := []*models.Disk{
+				{ID: diskId, Name: diskName, Model: "ModelA", InstallationEligibility: eligible},
+				{ID: "/dev/disk/by-id/SecondDisk", Name: "SecondDisk", Model: "ModelB", InstallationEligibility: eligible},
+			}
+
+			It("selects the disk matching the hints of the host", func() {
+				host.RootDeviceHints = &models.RootDeviceHints{Model: "ModelB"}
+				mockValidator.EXPECT().ListEligibleDisks(gomock.Any()).Return(disks)
+				Expect(hapi.UpdateInventory(ctx, &host, host.Inventory)).ToNot(HaveOccurred())
+				h := hostutil.GetHostFromDB(hostId, infraEnvId, db)
+				Expect(h.InstallationDiskID).To(Equal("/dev/disk/by-id/SecondDisk"))
+			})
+
+			It("selects the disk matching the hints of the infra-env", func() {
+				Expect(db.Model(&common.InfraEnv{}).Where("id = ?", infraEnvId).
+					Updates(common.RootDeviceHintsUpdates(&models.RootDeviceHints{Model: "ModelB"})).Error).ShouldNot(HaveOccurred())
+				mockValidator.EXPECT().ListEligibleDisks(gomock.Any()).Return(disks)
+				Expect(hapi.UpdateInventory(ctx, &host, host.Inventory)).ToNot(HaveOccurred())
+				h := hostutil.GetHostFromDB(hostId, infraEnvId, db)
+				Expect(h.InstallationDiskID).To(Equal("/dev/disk/by-id/SecondDisk"))
+			})
+
+			It("selects no disk when no disk matches the hints", func() {
+				host.RootDeviceHints = &models.RootDeviceHints{Model: "ModelC"}
+				mockValidator.EXPECT().ListEligibleDisks(gomock.Any()).Return(disks)
+				Expect(hapi.UpdateInventory(ctx, &host, host.Inventory)).ToNot(HaveOccurred())
+				h := hostutil.GetHostFromDB(hostId, infraEnvId, db)
+				Expect(h.InstallationDiskID).To(Equal(""))
+				Expect(h.InstallationDiskPath).To(Equal(""))
+			})
+		})
 	})
 	Context("Interfaces", func() {
 		const (
@@ -201,7 +201,7 @@ func GetAcceptableDisksWithHints(disks []*models.Disk, hints *bmh_v1alpha1.RootD
 				continue
 			}
 
-			if hints.Vendor != "" && !strings.Contains(disk.Vendor, hints.Model) {
+			if hints.Vendor != "" && !strings.Contains(disk.Vendor, hints.Vendor) {
 				continue
 			}
 
@@ -241,6 +241,36 @@ func GetAcceptableDisksWithHints(disks []*models.Disk, hints *bmh_v1alpha1.RootD
 	return acceptable
 }
 
+// RootDeviceHintsFromModel converts the root device hints of the REST API to the ones of a BareMetalHost, hints
+// without any field set are the same as no hints
+func RootDeviceHintsFromModel(hints *models.RootDeviceHints) *bmh_v1alpha1.RootDeviceHints {
+	if hints == nil || *hints == (models.RootDeviceHints{}) {
+		return nil
+	}
+	return &bmh_v1alpha1.RootDeviceHints{
+		DeviceName:       hints.DeviceName,
+		HCTL:             hints.Hctl,
+		Model:            hints.Model,
+		Vendor:           hints.Vendor,
+		SerialNumber:     hints.SerialNumber,
+		MinSizeGigabytes: int(hints.MinSizeGigabytes),
+		WWN:              hints.Wwn,
+		Rotational:       hints.Rotational,
+	}
+}
+
+// GetRootDeviceHints returns the root device hints that apply to the host, the ones of the host take precedence over
+// the ones of its infra-env
+func GetRootDeviceHints(host *models.Host, infraEnv *common.InfraEnv) *bmh_v1alpha1.RootDeviceHints {
+	if hints := RootDeviceHintsFromModel(host.RootDeviceHints); hints != nil {
+		return hints
+	}
+	if infraEnv == nil {
+		return nil
+	}
+	return RootDeviceHintsFromModel(infraEnv.RootDeviceHints)
+}
+
 func IgnitionFileName(host *models.Host) string {
 	return fmt.Sprintf("%s-%s.ign", common.GetEffectiveRole(host), host.ID)
 }
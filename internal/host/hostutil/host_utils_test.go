@@ -73,6 +73,43 @@ var _ = Describe("Installation Disk selection", func() {
 	}
 })
 
+var _ = Describe("Root device hints", func() {
+	eligible := models.DiskInstallationEligibility{Eligible: true}
+	disks := []*models.Disk{
+		{ID: "/dev/sda", Path: "/dev/sda", Vendor: "ATA", Model: "Disk A", InstallationEligibility: eligible},
+		{ID: "/dev/sdb", Path: "/dev/sdb", Vendor: "NVMe", Model: "Disk B", InstallationEligibility: eligible},
+		{ID: "/dev/sdc", Path: "/dev/sdc", Vendor: "NVMe", Model: "Disk C"},
+	}
+
+	It("matches the vendor", func() {
+		acceptable := GetAcceptableDisksWithHints(disks, RootDeviceHintsFromModel(&models.RootDeviceHints{Vendor: "NVM"}))
+		Expect(acceptable).To(HaveLen(1))
+		Expect(acceptable[0].ID).To(Equal("/dev/sdb"))
+	})
+
+	It("doesn't match the vendor hint against the model", func() {
+		Expect(GetAcceptableDisksWithHints(disks, RootDeviceHintsFromModel(&models.RootDeviceHints{Vendor: "Disk"}))).To(BeEmpty())
+
+		acceptable := GetAcceptableDisksWithHints(disks, RootDeviceHintsFromModel(&models.RootDeviceHints{Vendor: "ATA", Model: "Disk"}))
+		Expect(acceptable).To(HaveLen(1))
+		Expect(acceptable[0].ID).To(Equal("/dev/sda"))
+	})
+
+	It("treats hints without any field set as no hints", func() {
+		Expect(RootDeviceHintsFromModel(&models.RootDeviceHints{})).To(BeNil())
+	})
+
+	It("prefers the hints of the host over the ones of its infra-env", func() {
+		infraEnv := &common.InfraEnv{InfraEnv: models.InfraEnv{RootDeviceHints: &models.RootDeviceHints{Model: "Disk A"}}}
+		host := &models.Host{RootDeviceHints: &models.RootDeviceHints{Model: "Disk B"}}
+		Expect(GetRootDeviceHints(host, infraEnv).Model).To(Equal("Disk B"))
+
+		host.RootDeviceHints = &models.RootDeviceHints{}
+		Expect(GetRootDeviceHints(host, infraEnv).Model).To(Equal("Disk A"))
+		Expect(GetRootDeviceHints(host, nil)).To(BeNil())
+	})
+})
+
 var _ = Describe("Validation", func() {
 	It("Should not allow forbidden hostnames", func() {
 		for _, hostName := range []string{
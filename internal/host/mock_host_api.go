@@ -6,8 +6,6 @@ package host
 
 import (
 	context "context"
-	reflect "reflect"
-
 	strfmt "github.com/go-openapi/strfmt"
 	gomock "github.com/golang/mock/gomock"
 	common "github.com/openshift/assisted-service/internal/common"
@@ -15,6 +13,7 @@ import (
 	logrus "github.com/sirupsen/logrus"
 	gorm "gorm.io/gorm"
 	types "k8s.io/apimachinery/pkg/types"
+	reflect "reflect"
 )
 
 // MockAPI is a mock of API interface.
@@ -328,17 +327,22 @@ func (mr *MockAPIMockRecorder) IsValidMasterCandidate(arg0, arg1, arg2, arg3 int
 }
 
 // PermanentHostsDeletion mocks base method.
-func (m *MockAPI) PermanentHostsDeletion(arg0 strfmt.DateTime) error {
+func (m *MockAPI) PermanentHostsDeletion(arg0 context.Context, arg1 strfmt.DateTime, arg2 ...func(*gorm.DB) *gorm.DB) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PermanentHostsDeletion", arg0)
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PermanentHostsDeletion", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // PermanentHostsDeletion indicates an expected call of PermanentHostsDeletion.
-func (mr *MockAPIMockRecorder) PermanentHostsDeletion(arg0 interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) PermanentHostsDeletion(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PermanentHostsDeletion", reflect.TypeOf((*MockAPI)(nil).PermanentHostsDeletion), arg0)
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PermanentHostsDeletion", reflect.TypeOf((*MockAPI)(nil).PermanentHostsDeletion), varargs...)
 }
 
 // RefreshInventory mocks base method.
@@ -411,6 +415,18 @@ func (mr *MockAPIMockRecorder) ReportValidationFailedMetrics(arg0, arg1, arg2, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportValidationFailedMetrics", reflect.TypeOf((*MockAPI)(nil).ReportValidationFailedMetrics), arg0, arg1, arg2, arg3)
 }
 
+// RequestClusterHostsRefresh mocks base method.
+func (m *MockAPI) RequestClusterHostsRefresh(arg0 strfmt.UUID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RequestClusterHostsRefresh", arg0)
+}
+
+// RequestClusterHostsRefresh indicates an expected call of RequestClusterHostsRefresh.
+func (mr *MockAPIMockRecorder) RequestClusterHostsRefresh(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestClusterHostsRefresh", reflect.TypeOf((*MockAPI)(nil).RequestClusterHostsRefresh), arg0)
+}
+
 // ResetHost mocks base method.
 func (m *MockAPI) ResetHost(arg0 context.Context, arg1 *models.Host, arg2 string, arg3 *gorm.DB) *common.ApiErrorResponse {
 	m.ctrl.T.Helper()
@@ -148,15 +148,18 @@ func (m *Manager) clusterHostMonitoring() int64 {
 		}
 
 		for _, c := range clusters {
+			if !m.partitioner.Participates() {
+				m.log.Debugf("Not participating in background work, exiting cluster HostMonitoring")
+				return monitored
+			}
+			if !m.partitioner.Owns(c.ID.String()) {
+				continue
+			}
 			inventoryCache := make(InventoryCache)
 			sortedHosts, canRefreshRoles := SortHosts(c.Hosts)
 			c.Cluster.OpenshiftVersion = "4.12.0-0.0"
 
 			for _, host := range sortedHosts {
-				if !m.leaderElector.IsLeader() {
-					m.log.Debugf("Not a leader, exiting cluster HostMonitoring")
-					return monitored
-				}
 				if !m.SkipMonitoring(host) {
 					monitored += 1
 					err = m.refreshStatusInternal(ctx, host, c, nil, inventoryCache, m.db)
@@ -211,12 +214,15 @@ func (m *Manager) infraEnvHostMonitoring() int64 {
 		}
 
 		for _, i := range infraEnvs {
+			if !m.partitioner.Participates() {
+				m.log.Debugf("Not participating in background work, exiting infra-env HostMonitoring")
+				return monitored
+			}
+			if !m.partitioner.Owns(i.ID.String()) {
+				continue
+			}
 			inventoryCache := make(InventoryCache)
 			for _, host := range i.Hosts {
-				if !m.leaderElector.IsLeader() {
-					m.log.Debugf("Not a leader, exiting infra-env HostMonitoring")
-					return monitored
-				}
 				if funk.ContainsString(monitorStates, swag.StringValue(host.Status)) {
 					monitored += 1
 					err = m.refreshStatusInternal(ctx, &host.Host, nil, i, inventoryCache, m.db)
@@ -232,12 +238,12 @@ func (m *Manager) infraEnvHostMonitoring() int64 {
 
 func (m *Manager) HostMonitoring() {
 	var monitored int64
-	if !m.leaderElector.IsLeader() {
-		m.log.Debugf("Not a leader, exiting HostMonitoring")
+	if !m.partitioner.Participates() {
+		m.log.Debugf("Not participating in background work, exiting HostMonitoring")
 		return
 	}
 	m.log.Debugf("Running HostMonitoring")
-	defer commonutils.MeasureOperation("HostMonitoring", m.log, m.metricApi)()
+	defer commonutils.MeasureMonitor("HostMonitoring", m.log, m.metricApi)()
 	m.initMonitoringQueryGenerator()
 	monitored += m.clusterHostMonitoring()
 	monitored += m.infraEnvHostMonitoring()
@@ -76,6 +76,7 @@ var _ = Describe("monitor_disconnection", func() {
 		db.First(&host, "id = ? and cluster_id = ?", host.ID, host.ClusterID)
 
 		mockMetricApi.EXPECT().Duration("HostMonitoring", gomock.Any()).Times(1)
+		mockMetricApi.EXPECT().MonitorDuration("HostMonitoring", gomock.Any()).Times(1)
 		mockMetricApi.EXPECT().MonitoredHostsCount(gomock.Any()).Times(1)
 		mockOperators.EXPECT().ValidateHost(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return([]api.ValidationResult{
 			{Status: api.Success, ValidationId: string(models.HostValidationIDOdfRequirementsSatisfied)},
@@ -192,6 +193,7 @@ var _ = Describe("TestHostMonitoring - with cluster", func() {
 			mockMetricApi, &cfg, &leader.DummyElector{}, mockOperators, pr, false, nil)
 
 		mockMetricApi.EXPECT().Duration("HostMonitoring", gomock.Any()).Times(1)
+		mockMetricApi.EXPECT().MonitorDuration("HostMonitoring", gomock.Any()).Times(1)
 		mockOperators.EXPECT().ValidateHost(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return([]api.ValidationResult{
 			{Status: api.Success, ValidationId: string(models.HostValidationIDOdfRequirementsSatisfied)},
 			{Status: api.Success, ValidationId: string(models.HostValidationIDLsoRequirementsSatisfied)},
@@ -241,8 +243,49 @@ var _ = Describe("TestHostMonitoring - with cluster", func() {
 			registerAndValidateDisconnected(765)
 		})
 	})
+
+	It("monitors only the hosts of the owned clusters", func() {
+		var clusterIDs []strfmt.UUID
+		for i := 0; i < 2; i++ {
+			clusterID = strfmt.UUID(uuid.New().String())
+			clusterIDs = append(clusterIDs, clusterID)
+			cluster := hostutil.GenerateTestCluster(clusterID)
+			Expect(db.Save(&cluster).Error).ToNot(HaveOccurred())
+			for j := 0; j < 3; j++ {
+				host = hostutil.GenerateTestHost(strfmt.UUID(uuid.New().String()), infraEnvID, clusterID, models.HostStatusDiscovering)
+				host.Inventory = workerInventory()
+				Expect(state.RegisterHost(ctx, &host, db)).ShouldNot(HaveOccurred())
+				host.CheckedInAt = strfmt.DateTime(time.Now().Add(-4 * time.Minute))
+				db.Save(&host)
+			}
+		}
+		state.(*Manager).partitioner = &partitionedElector{owned: map[string]bool{clusterIDs[0].String(): true}}
+
+		mockMetricApi.EXPECT().MonitoredHostsCount(int64(3)).Times(1)
+		state.HostMonitoring()
+		for i, status := range []string{models.HostStatusDisconnected, models.HostStatusDiscovering} {
+			var count int64
+			Expect(db.Model(&models.Host{}).Where("cluster_id = ? and status = ?", clusterIDs[i], status).Count(&count).Error).
+				ShouldNot(HaveOccurred())
+			Expect(count).Should(Equal(int64(3)))
+		}
+	})
 })
 
+// partitionedElector owns only the given keys of the background work
+type partitionedElector struct {
+	leader.DummyElector
+	owned map[string]bool
+}
+
+func (e *partitionedElector) Participates() bool {
+	return true
+}
+
+func (e *partitionedElector) Owns(key string) bool {
+	return e.owned[key]
+}
+
 var _ = Describe("HostMonitoring - with infra-env", func() {
 	var (
 		ctx           = context.Background()
@@ -285,6 +328,7 @@ var _ = Describe("HostMonitoring - with infra-env", func() {
 			mockMetricApi, &cfg, &leader.DummyElector{}, mockOperators, nil, false, nil)
 
 		mockMetricApi.EXPECT().Duration("HostMonitoring", gomock.Any()).Times(1)
+		mockMetricApi.EXPECT().MonitorDuration("HostMonitoring", gomock.Any()).Times(1)
 		mockOperators.EXPECT().ValidateHost(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return([]api.ValidationResult{
 			{Status: api.Success, ValidationId: string(models.HostValidationIDOdfRequirementsSatisfied)},
 			{Status: api.Success, ValidationId: string(models.HostValidationIDLsoRequirementsSatisfied)},
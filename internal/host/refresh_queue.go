@@ -0,0 +1,254 @@
+package host
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/requestid"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"k8s.io/client-go/util/workqueue"
+)
+
+// Deadline refreshes are scheduled slightly after the deadline, so that the refresh sees it expired
+const refreshDeadlineMargin = time.Second
+
+// Refreshes of hosts owned by another replica are retried with a growing delay, in case the ownership moves
+// to this replica, before leaving the hosts to the monitor of their owner
+const (
+	unownedRefreshRetries = 10
+	maxRefreshRetryDelay  = 5 * time.Minute
+)
+
+var errHostNotOwned = errors.New("host is refreshed by another replica")
+
+type RefreshQueueConfig struct {
+	// Refresh hosts when they report changes or reach a deadline, instead of relying on the periodic monitor only
+	EnableEventDrivenMonitoring bool `envconfig:"HOST_EVENT_DRIVEN_MONITORING" default:"false"`
+	// Number of workers refreshing the queued hosts
+	RefreshWorkers int `envconfig:"HOST_REFRESH_WORKERS" default:"4"`
+	// Delay before refreshing a host after a change, changes reported during the delay are refreshed together
+	RefreshDelay time.Duration `envconfig:"HOST_REFRESH_DELAY" default:"1s"`
+}
+
+type refreshKind int
+
+const (
+	refreshHost refreshKind = iota
+	refreshClusterHosts
+)
+
+// refreshKey identifies a queued refresh, a key is queued only once until it is processed
+type refreshKey struct {
+	kind       refreshKind
+	id         strfmt.UUID
+	infraEnvID strfmt.UUID
+}
+
+// StartRefreshWorkers creates the refresh queue and runs the workers processing it until the
+// context is cancelled. Until it is called changes are only picked up by the periodic monitor.
+func (m *Manager) StartRefreshWorkers(ctx context.Context) {
+	if !m.Config.EnableEventDrivenMonitoring {
+		return
+	}
+	queue := workqueue.NewNamedRateLimitingQueue(newRefreshRateLimiter(m.Config.RefreshDelay), "host-refresh")
+	m.refreshQueue = queue
+	for i := 0; i < m.Config.RefreshWorkers; i++ {
+		go func() {
+			for m.processNextRefresh() {
+			}
+		}()
+	}
+	go func() {
+		<-ctx.Done()
+		queue.ShutDown()
+	}()
+}
+
+// newRefreshRateLimiter retries refreshes with an exponential delay starting at the refresh delay
+func newRefreshRateLimiter(refreshDelay time.Duration) workqueue.RateLimiter {
+	return workqueue.NewItemExponentialFailureRateLimiter(refreshDelay, maxRefreshRetryDelay)
+}
+
+// RequestClusterHostsRefresh queues a refresh of all the hosts of the cluster, called when the
+// configuration of the cluster changes as the validations of the hosts depend on it
+func (m *Manager) RequestClusterHostsRefresh(clusterID strfmt.UUID) {
+	m.requestClusterHostsRefresh(clusterID)
+}
+
+// requestRefresh queues a refresh of the host, called when the host reports a change
+func (m *Manager) requestRefresh(h *models.Host) {
+	if m.refreshQueue == nil || h.ID == nil {
+		return
+	}
+	m.refreshQueue.AddAfter(refreshKey{kind: refreshHost, id: *h.ID, infraEnvID: h.InfraEnvID}, m.Config.RefreshDelay)
+}
+
+// requestClusterHostsRefresh queues a refresh of all the hosts of the cluster, as their
+// validations may depend on each other
+func (m *Manager) requestClusterHostsRefresh(clusterID strfmt.UUID) {
+	if m.refreshQueue == nil {
+		return
+	}
+	m.refreshQueue.AddAfter(refreshKey{kind: refreshClusterHosts, id: clusterID}, m.Config.RefreshDelay)
+}
+
+// requestHostAndClusterRefresh queues a refresh of the host and of the hosts of the cluster it
+// joined or left
+func (m *Manager) requestHostAndClusterRefresh(h *models.Host, clusterID *strfmt.UUID) {
+	m.requestRefresh(h)
+	if clusterID != nil {
+		m.requestClusterHostsRefresh(*clusterID)
+	}
+}
+
+func (m *Manager) processNextRefresh() bool {
+	item, shutdown := m.refreshQueue.Get()
+	if shutdown {
+		return false
+	}
+	defer m.refreshQueue.Done(item)
+
+	key := item.(refreshKey)
+	requestID := requestid.NewID()
+	ctx := requestid.ToContext(context.Background(), requestID)
+
+	var err error
+	switch key.kind {
+	case refreshHost:
+		err = m.refreshQueuedHost(ctx, key.infraEnvID, key.id)
+	case refreshClusterHosts:
+		err = m.queueClusterHosts(key.id)
+	}
+	if errors.Is(err, errHostNotOwned) {
+		m.requeueUnownedRefresh(item)
+		return true
+	}
+	if err != nil {
+		requestid.RequestIDLogger(m.log, requestID).WithError(err).Warnf("Failed to refresh queued %v, retrying", key)
+		m.refreshQueue.AddRateLimited(item)
+		return true
+	}
+	m.refreshQueue.Forget(item)
+	return true
+}
+
+// requeueUnownedRefresh retries the refresh of a host owned by another replica until the retries are
+// exhausted, the host is then refreshed by the monitor of its owner
+func (m *Manager) requeueUnownedRefresh(item interface{}) {
+	if m.refreshQueue.NumRequeues(item) < unownedRefreshRetries {
+		m.refreshQueue.AddRateLimited(item)
+		return
+	}
+	m.log.Debugf("Leaving the refresh of %v to the replica owning it", item)
+	m.refreshQueue.Forget(item)
+}
+
+func (m *Manager) queueClusterHosts(clusterID strfmt.UUID) error {
+	var hosts []*models.Host
+	if err := m.db.Select("id", "infra_env_id").Where("cluster_id = ?", clusterID.String()).Find(&hosts).Error; err != nil {
+		return errors.Wrapf(err, "failed to list hosts of cluster %s", clusterID)
+	}
+	for _, h := range hosts {
+		m.refreshQueue.Add(refreshKey{kind: refreshHost, id: *h.ID, infraEnvID: h.InfraEnvID})
+	}
+	return nil
+}
+
+func (m *Manager) refreshQueuedHost(ctx context.Context, infraEnvID, hostID strfmt.UUID) error {
+	h, err := common.GetHostFromDB(m.db, infraEnvID.String(), hostID.String())
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if m.SkipMonitoring(&h.Host) {
+		return nil
+	}
+	if !m.ownsHost(&h.Host) {
+		return errHostNotOwned
+	}
+
+	statusBefore := swag.StringValue(h.Status)
+	if h.ClusterID == nil {
+		var infraEnv *common.InfraEnv
+		if infraEnv, err = common.GetInfraEnvFromDB(m.db, infraEnvID); err != nil {
+			return err
+		}
+		err = m.refreshStatusInternal(ctx, &h.Host, nil, infraEnv, make(InventoryCache), m.db)
+	} else {
+		var cluster *common.Cluster
+		if cluster, err = common.GetClusterFromDBWithHosts(m.db, *h.ClusterID); err != nil {
+			return err
+		}
+		err = m.refreshStatusInternal(ctx, &h.Host, cluster, nil, make(InventoryCache), m.db)
+		// Same as the monitor, roles are only calculated once all the hosts of the cluster have an inventory
+		if _, canRefreshRoles := SortHosts(cluster.Hosts); err == nil && canRefreshRoles {
+			err = m.refreshRoleInternal(ctx, &h.Host, m.db, false)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	if h, err = common.GetHostFromDB(m.db, infraEnvID.String(), hostID.String()); err != nil {
+		return err
+	}
+	if h.ClusterID != nil && swag.StringValue(h.Status) != statusBefore {
+		m.requestClusterHostsRefresh(*h.ClusterID)
+	}
+	if deadline, ok := m.refreshDeadline(&h.Host); ok {
+		m.refreshQueue.AddAfter(refreshKey{kind: refreshHost, id: hostID, infraEnvID: infraEnvID}, time.Until(deadline))
+	}
+	return nil
+}
+
+// ownsHost returns true if this replica refreshes the host. Same as the monitor, hosts are refreshed by the
+// replica owning their cluster, or their infra-env when they aren't bound to a cluster. The hosts owned by
+// other replicas are retried in case the ownership moves, and are otherwise picked up by the monitor of their owner.
+func (m *Manager) ownsHost(h *models.Host) bool {
+	if !m.partitioner.Participates() {
+		return false
+	}
+	if h.ClusterID != nil {
+		return m.partitioner.Owns(h.ClusterID.String())
+	}
+	return m.partitioner.Owns(h.InfraEnvID.String())
+}
+
+// refreshDeadline returns the next time the status of the host may change without the host
+// reporting anything, like disconnection or a stage timeout
+func (m *Manager) refreshDeadline(h *models.Host) (time.Time, bool) {
+	var deadlines []time.Time
+	if checkedInAt := time.Time(h.CheckedInAt); !checkedInAt.IsZero() {
+		timeout := m.Config.MaxHostDisconnectionTime
+		if h.Bootstrap {
+			// Same extension as the connectivity validation
+			timeout += 2 * time.Minute
+		}
+		deadlines = append(deadlines, checkedInAt.Add(timeout))
+	}
+	if swag.StringValue(h.Status) == models.HostStatusInstallingInProgress && h.Progress != nil {
+		if stageUpdatedAt := time.Time(h.Progress.StageUpdatedAt); !stageUpdatedAt.IsZero() {
+			deadlines = append(deadlines, stageUpdatedAt.Add(m.Config.HostStageTimeout(h.Progress.CurrentStage)))
+		}
+	}
+
+	var next time.Time
+	now := time.Now()
+	for _, deadline := range deadlines {
+		// Expired deadlines were handled by the refresh that got us here
+		if deadline.After(now) && (next.IsZero() || deadline.Before(next)) {
+			next = deadline
+		}
+	}
+	if next.IsZero() {
+		return next, false
+	}
+	return next.Add(refreshDeadlineMargin), true
+}
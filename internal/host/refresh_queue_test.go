@@ -0,0 +1,179 @@
+package host
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"k8s.io/client-go/util/workqueue"
+)
+
+var _ = Describe("Host refresh deadline", func() {
+	var m *Manager
+
+	BeforeEach(func() {
+		m = &Manager{Config: Config{MaxHostDisconnectionTime: 3 * time.Minute}}
+	})
+
+	It("is the disconnection time of a connected host", func() {
+		checkedInAt := time.Now()
+		deadline, ok := m.refreshDeadline(&models.Host{
+			Status:      swag.String(models.HostStatusKnown),
+			CheckedInAt: strfmt.DateTime(checkedInAt),
+		})
+		Expect(ok).To(BeTrue())
+		Expect(deadline).To(BeTemporally("==", checkedInAt.Add(3*time.Minute+refreshDeadlineMargin)))
+	})
+
+	It("gives the bootstrap host more time to check in", func() {
+		checkedInAt := time.Now()
+		deadline, ok := m.refreshDeadline(&models.Host{
+			Status:      swag.String(models.HostStatusKnown),
+			CheckedInAt: strfmt.DateTime(checkedInAt),
+			Bootstrap:   true,
+		})
+		Expect(ok).To(BeTrue())
+		Expect(deadline).To(BeTemporally("==", checkedInAt.Add(5*time.Minute+refreshDeadlineMargin)))
+	})
+
+	It("is the stage timeout when it comes first", func() {
+		m.Config.MaxHostDisconnectionTime = time.Hour
+		stageUpdatedAt := time.Now()
+		deadline, ok := m.refreshDeadline(&models.Host{
+			Status:      swag.String(models.HostStatusInstallingInProgress),
+			CheckedInAt: strfmt.DateTime(time.Now()),
+			Progress: &models.HostProgressInfo{
+				CurrentStage:   models.HostStageRebooting,
+				StageUpdatedAt: strfmt.DateTime(stageUpdatedAt),
+			},
+		})
+		Expect(ok).To(BeTrue())
+		Expect(deadline).To(BeTemporally("==", stageUpdatedAt.Add(hostStageTimeoutDefault+refreshDeadlineMargin)))
+	})
+
+	It("ignores expired deadlines", func() {
+		_, ok := m.refreshDeadline(&models.Host{
+			Status:      swag.String(models.HostStatusDisconnected),
+			CheckedInAt: strfmt.DateTime(time.Now().Add(-time.Hour)),
+		})
+		Expect(ok).To(BeFalse())
+	})
+
+	It("doesn't exist for hosts that never checked in", func() {
+		_, ok := m.refreshDeadline(&models.Host{Status: swag.String(models.HostStatusDiscovering)})
+		Expect(ok).To(BeFalse())
+	})
+})
+
+var _ = Describe("Host refresh requests", func() {
+	var (
+		m          *Manager
+		hostID     = strfmt.UUID("1c6a8a4e-6a8f-4a5c-9c1a-2b0d8a1f0e11")
+		infraEnvID = strfmt.UUID("6a0d5f2c-0c3e-4c4b-8a4f-0d1e2f3a4b5c")
+		clusterID  = strfmt.UUID("b6f3c1d2-7e4a-4f5b-9c8d-1a2b3c4d5e6f")
+	)
+
+	BeforeEach(func() {
+		m = &Manager{Config: Config{RefreshQueueConfig: RefreshQueueConfig{EnableEventDrivenMonitoring: true}}}
+	})
+
+	It("are ignored until the workers are started", func() {
+		m.requestHostAndClusterRefresh(&models.Host{ID: &hostID, InfraEnvID: infraEnvID}, &clusterID)
+	})
+
+	It("queue each key once until it is processed", func() {
+		queue := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+		defer queue.ShutDown()
+		m.refreshQueue = queue
+
+		h := &models.Host{ID: &hostID, InfraEnvID: infraEnvID}
+		m.requestRefresh(h)
+		m.requestRefresh(h)
+		m.requestHostAndClusterRefresh(h, &clusterID)
+		Expect(queue.Len()).To(Equal(2))
+
+		item, _ := queue.Get()
+		Expect(item).To(Equal(refreshKey{kind: refreshHost, id: hostID, infraEnvID: infraEnvID}))
+		queue.Done(item)
+		item, _ = queue.Get()
+		Expect(item).To(Equal(refreshKey{kind: refreshClusterHosts, id: clusterID}))
+		queue.Done(item)
+	})
+
+	It("queue the hosts of an updated cluster", func() {
+		queue := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+		defer queue.ShutDown()
+		m.refreshQueue = queue
+
+		m.RequestClusterHostsRefresh(clusterID)
+		Expect(queue.Len()).To(Equal(1))
+		item, _ := queue.Get()
+		Expect(item).To(Equal(refreshKey{kind: refreshClusterHosts, id: clusterID}))
+		queue.Done(item)
+	})
+
+	It("of hosts owned by another replica are retried before being left to their owner", func() {
+		queue := workqueue.NewRateLimitingQueue(newRefreshRateLimiter(time.Millisecond))
+		defer queue.ShutDown()
+		m.refreshQueue = queue
+		m.log = common.GetTestLog()
+
+		key := refreshKey{kind: refreshHost, id: hostID, infraEnvID: infraEnvID}
+		for i := 0; i < unownedRefreshRetries; i++ {
+			m.requeueUnownedRefresh(key)
+			Expect(queue.NumRequeues(key)).To(Equal(i + 1))
+		}
+		m.requeueUnownedRefresh(key)
+		Expect(queue.NumRequeues(key)).To(BeZero())
+	})
+})
+
+type fakePartitioner struct {
+	participates bool
+	owned        map[string]bool
+}
+
+func (f *fakePartitioner) StartLeaderElection(ctx context.Context) error { return nil }
+func (f *fakePartitioner) IsLeader() bool                                { return f.participates }
+func (f *fakePartitioner) Participates() bool                            { return f.participates }
+func (f *fakePartitioner) Owns(key string) bool                          { return f.owned[key] }
+
+var _ = Describe("Host refresh ownership", func() {
+	var (
+		m           *Manager
+		partitioner *fakePartitioner
+		infraEnvID  = strfmt.UUID("6a0d5f2c-0c3e-4c4b-8a4f-0d1e2f3a4b5c")
+		clusterID   = strfmt.UUID("b6f3c1d2-7e4a-4f5b-9c8d-1a2b3c4d5e6f")
+	)
+
+	BeforeEach(func() {
+		partitioner = &fakePartitioner{participates: true, owned: map[string]bool{}}
+		m = &Manager{partitioner: partitioner}
+	})
+
+	It("follows the cluster of bound hosts", func() {
+		h := &models.Host{InfraEnvID: infraEnvID, ClusterID: &clusterID}
+		partitioner.owned[infraEnvID.String()] = true
+		Expect(m.ownsHost(h)).To(BeFalse())
+		partitioner.owned[clusterID.String()] = true
+		Expect(m.ownsHost(h)).To(BeTrue())
+	})
+
+	It("follows the infra-env of unbound hosts", func() {
+		h := &models.Host{InfraEnvID: infraEnvID}
+		Expect(m.ownsHost(h)).To(BeFalse())
+		partitioner.owned[infraEnvID.String()] = true
+		Expect(m.ownsHost(h)).To(BeTrue())
+	})
+
+	It("is lost when the replica doesn't participate in background work", func() {
+		partitioner.owned[clusterID.String()] = true
+		partitioner.participates = false
+		Expect(m.ownsHost(&models.Host{InfraEnvID: infraEnvID, ClusterID: &clusterID})).To(BeFalse())
+	})
+})
@@ -11,6 +11,7 @@ import (
 	"github.com/openshift/assisted-service/internal/operators"
 	"github.com/openshift/assisted-service/internal/operators/api"
 	"github.com/openshift/assisted-service/internal/provider/registry"
+	"github.com/openshift/assisted-service/internal/validationcache"
 	"github.com/openshift/assisted-service/models"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -37,10 +38,12 @@ type refreshPreprocessor struct {
 	conditions              []condition
 	operatorsApi            operators.API
 	disabledHostValidations DisabledHostValidations
+	cache                   *validationcache.Cache
 }
 
 func newRefreshPreprocessor(log logrus.FieldLogger, hwValidatorCfg *hardware.ValidatorCfg, hwValidator hardware.Validator,
-	operatorsApi operators.API, disabledHostValidations DisabledHostValidations, providerRegistry registry.ProviderRegistry) *refreshPreprocessor {
+	operatorsApi operators.API, disabledHostValidations DisabledHostValidations, providerRegistry registry.ProviderRegistry,
+	validationCacheConfig validationcache.Config) *refreshPreprocessor {
 	v := &validator{
 		log:              log,
 		hwValidatorCfg:   hwValidatorCfg,
@@ -54,6 +57,7 @@ func newRefreshPreprocessor(log logrus.FieldLogger, hwValidatorCfg *hardware.Val
 		conditions:              newConditions(v),
 		operatorsApi:            operatorsApi,
 		disabledHostValidations: disabledHostValidations,
+		cache:                   validationcache.New("host", validationCacheConfig),
 	}
 }
 
@@ -76,7 +80,7 @@ func (r *refreshPreprocessor) preprocess(ctx context.Context, c *validationConte
 			message = validationDisabledByConfiguration
 			conditions[v.id.String()] = true
 		} else {
-			st, message = v.condition(c)
+			st, message = r.evaluate(c, v)
 			conditions[v.id.String()] = funk.ContainsString([]string{ValidationSuccess.String(), ValidationSuccessSuppressOutput.String()}, st.String())
 			// Don't output this validation status to validations in case that the output needs to be suppressed
 			if st == ValidationSuccessSuppressOutput {
@@ -133,6 +137,21 @@ func (r *refreshPreprocessor) preprocess(ctx context.Context, c *validationConte
 	return conditions, validationsOutput, nil
 }
 
+// evaluate returns the result of the validation, reusing the cached one if its inputs didn't change
+func (r *refreshPreprocessor) evaluate(c *validationContext, v validation) (ValidationStatus, string) {
+	fingerprint, err := c.fingerprint(v.inputs)
+	if err != nil {
+		r.log.WithError(err).Warnf("Failed to fingerprint the inputs of validation %s of host %s, not using the cache", v.id, c.host.ID)
+		fingerprint = nil
+	}
+	owner := fmt.Sprintf("%s/%s", c.host.InfraEnvID, c.host.ID)
+	result := r.cache.Evaluate(owner, v.id.String(), fingerprint, func() validationcache.Result {
+		st, message := v.condition(c)
+		return validationcache.Result{Status: string(st), Message: message}
+	})
+	return ValidationStatus(result.Status), result.Message
+}
+
 // sortByValidationResultID sorts results by models.HostValidationID
 func sortByValidationResultID(validationResults []ValidationResult) {
 	sort.SliceStable(validationResults, func(i, j int) bool {
@@ -167,6 +186,7 @@ func newValidations(v *validator) []validation {
 		{
 			id:        HasMinValidDisks,
 			condition: v.hasMinValidDisks,
+			inputs:    []validationInput{inputInventory},
 		},
 		{
 			id:        IsMachineCidrDefined,
@@ -183,10 +203,12 @@ func newValidations(v *validator) []validation {
 		{
 			id:        IsHostnameUnique,
 			condition: v.isHostnameUnique,
+			inputs:    []validationInput{inputHost, inputInventory, inputClusterHosts},
 		},
 		{
 			id:        BelongsToMachineCidr,
 			condition: v.belongsToMachineCidr,
+			inputs:    []validationInput{inputHost, inputInventory, inputClusterNetwork},
 		},
 		{
 			id:        IsHostnameValid,
@@ -199,6 +221,7 @@ func newValidations(v *validator) []validation {
 		{
 			id:        BelongsToMajorityGroup,
 			condition: v.belongsToMajorityGroup,
+			inputs:    []validationInput{inputHost, inputClusterNetwork},
 		},
 		{
 			id:        IsPlatformNetworkSettingsValid,
@@ -225,9 +248,11 @@ func newValidations(v *validator) []validation {
 		{
 			id:        HasSufficientNetworkLatencyRequirementForRole,
 			condition: v.hasSufficientNetworkLatencyRequirementForRole,
+			inputs:    []validationInput{inputHost, inputConnectivity, inputHostRequirements, inputClusterHosts},
 		}, {
 			id:        HasSufficientPacketLossRequirementForRole,
 			condition: v.hasSufficientPacketLossRequirementForRole,
+			inputs:    []validationInput{inputHost, inputConnectivity, inputHostRequirements, inputClusterHosts},
 		},
 		{
 			id:        HasDefaultRoute,
@@ -248,6 +273,7 @@ func newValidations(v *validator) []validation {
 		{
 			id:        CompatibleWithClusterPlatform,
 			condition: v.compatibleWithClusterPlatform,
+			inputs:    []validationInput{inputHost, inputInventory, inputClusterNetwork},
 		},
 		{
 			id:        IsDNSWildcardNotConfigured,
@@ -277,6 +303,10 @@ func newValidations(v *validator) []validation {
 			id:        NoSkipMissingDisk,
 			condition: v.noSkipMissingDisk,
 		},
+		{
+			id:        RootDeviceHintsMatched,
+			condition: v.rootDeviceHintsMatched,
+		},
 	}
 }
 
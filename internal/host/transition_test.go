@@ -6041,6 +6041,7 @@ var allValidationIDs []validationID = []validationID{
 	CompatibleAgent,
 	NoSkipInstallationDisk,
 	NoSkipMissingDisk,
+	RootDeviceHintsMatched,
 }
 
 var allConditions []conditionId = []conditionId{
@@ -48,6 +48,7 @@ const (
 	CompatibleAgent                                = validationID(models.HostValidationIDCompatibleAgent)
 	NoSkipInstallationDisk                         = validationID(models.HostValidationIDNoSkipInstallationDisk)
 	NoSkipMissingDisk                              = validationID(models.HostValidationIDNoSkipMissingDisk)
+	RootDeviceHintsMatched                         = validationID(models.HostValidationIDRootDeviceHintsMatched)
 )
 
 func (v validationID) category() (string, error) {
@@ -85,7 +86,8 @@ func (v validationID) category() (string, error) {
 		DiskEncryptionRequirementsSatisfied,
 		CompatibleAgent,
 		NoSkipInstallationDisk,
-		NoSkipMissingDisk:
+		NoSkipMissingDisk,
+		RootDeviceHintsMatched:
 		return "hardware", nil
 	case AreLsoRequirementsSatisfied,
 		AreOdfRequirementsSatisfied,
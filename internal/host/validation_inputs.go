@@ -0,0 +1,150 @@
+package host
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/openshift/assisted-service/internal/validationcache"
+	"github.com/openshift/assisted-service/models"
+)
+
+// validationInput is a part of the state a validation reads. Validations that declare their
+// inputs are only recomputed when one of them changes, so the declaration must cover everything
+// the validation reads, including through the validation context. Validations depending on the
+// time or on the database must not declare inputs.
+type validationInput int
+
+const (
+	// The identity, kind, binding, role and user configuration of the host
+	inputHost validationInput = iota
+	inputInventory
+	inputConnectivity
+	// The requirements of the role of the host
+	inputHostRequirements
+	// The type, platform and network configuration of the cluster of the host
+	inputClusterNetwork
+	// The identity, hostname and role of every host of the cluster
+	inputClusterHosts
+)
+
+type hostInputs struct {
+	ID                   *strfmt.UUID
+	Kind                 *string
+	ClusterID            *strfmt.UUID
+	Bootstrap            bool
+	Role                 models.HostRole
+	SuggestedRole        models.HostRole
+	RequestedHostname    string
+	InstallationDiskID   string
+	InstallationDiskPath string
+}
+
+type clusterNetworkInputs struct {
+	Kind                       *string
+	Platform                   *models.Platform
+	HighAvailabilityMode       *string
+	UserManagedNetworking      *bool
+	VipDhcpAllocation          *bool
+	MachineNetworks            []*models.MachineNetwork
+	ClusterNetworks            []*models.ClusterNetwork
+	ServiceNetworks            []*models.ServiceNetwork
+	APIVips                    []*models.APIVip
+	IngressVips                []*models.IngressVip
+	ConnectivityMajorityGroups string
+	HostCount                  int
+}
+
+type clusterHostInputs struct {
+	ID                *strfmt.UUID
+	RequestedHostname string
+	Role              models.HostRole
+	SuggestedRole     models.HostRole
+	Inventory         validationcache.Fingerprint
+}
+
+func (c *validationContext) inputValue(input validationInput) (interface{}, error) {
+	switch input {
+	case inputHost:
+		return hostInputs{
+			ID:                   c.host.ID,
+			Kind:                 c.host.Kind,
+			ClusterID:            c.host.ClusterID,
+			Bootstrap:            c.host.Bootstrap,
+			Role:                 c.host.Role,
+			SuggestedRole:        c.host.SuggestedRole,
+			RequestedHostname:    c.host.RequestedHostname,
+			InstallationDiskID:   c.host.InstallationDiskID,
+			InstallationDiskPath: c.host.InstallationDiskPath,
+		}, nil
+	case inputInventory:
+		return c.host.Inventory, nil
+	case inputConnectivity:
+		return c.host.Connectivity, nil
+	case inputHostRequirements:
+		return c.clusterHostRequirements, nil
+	case inputClusterNetwork:
+		if c.cluster == nil {
+			return nil, nil
+		}
+		return clusterNetworkInputs{
+			Kind:                       c.cluster.Kind,
+			Platform:                   c.cluster.Platform,
+			HighAvailabilityMode:       c.cluster.HighAvailabilityMode,
+			UserManagedNetworking:      c.cluster.UserManagedNetworking,
+			VipDhcpAllocation:          c.cluster.VipDhcpAllocation,
+			MachineNetworks:            c.cluster.MachineNetworks,
+			ClusterNetworks:            c.cluster.ClusterNetworks,
+			ServiceNetworks:            c.cluster.ServiceNetworks,
+			APIVips:                    c.cluster.APIVips,
+			IngressVips:                c.cluster.IngressVips,
+			ConnectivityMajorityGroups: c.cluster.ConnectivityMajorityGroups,
+			HostCount:                  len(c.cluster.Hosts),
+		}, nil
+	case inputClusterHosts:
+		if c.cluster == nil {
+			return nil, nil
+		}
+		hosts := make([]clusterHostInputs, 0, len(c.cluster.Hosts))
+		for _, h := range c.cluster.Hosts {
+			inventory, err := validationcache.NewFingerprint(h.Inventory)
+			if err != nil {
+				return nil, err
+			}
+			hosts = append(hosts, clusterHostInputs{
+				ID:                h.ID,
+				RequestedHostname: h.RequestedHostname,
+				Role:              h.Role,
+				SuggestedRole:     h.SuggestedRole,
+				Inventory:         inventory,
+			})
+		}
+		return hosts, nil
+	}
+	return nil, nil
+}
+
+// fingerprint returns the fingerprint of the given inputs, or nil if there are none. The
+// fingerprint of each input is only calculated once per context.
+func (c *validationContext) fingerprint(inputs []validationInput) (*validationcache.Fingerprint, error) {
+	if len(inputs) == 0 {
+		return nil, nil
+	}
+	if c.inputFingerprints == nil {
+		c.inputFingerprints = make(map[validationInput]validationcache.Fingerprint)
+	}
+	fingerprints := make([]validationcache.Fingerprint, 0, len(inputs))
+	for _, input := range inputs {
+		fingerprint, ok := c.inputFingerprints[input]
+		if !ok {
+			value, err := c.inputValue(input)
+			if err != nil {
+				return nil, err
+			}
+			if fingerprint, err = validationcache.NewFingerprint(input, value); err != nil {
+				return nil, err
+			}
+			c.inputFingerprints[input] = fingerprint
+		}
+		fingerprints = append(fingerprints, fingerprint)
+	}
+	combined := validationcache.Combine(fingerprints...)
+	return &combined, nil
+}
@@ -0,0 +1,93 @@
+package host
+
+import (
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/validationcache"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("Validation inputs", func() {
+	var (
+		host    *models.Host
+		other   *models.Host
+		cluster *common.Cluster
+	)
+
+	newContext := func() *validationContext {
+		return &validationContext{host: host, cluster: cluster}
+	}
+
+	fingerprint := func(inputs ...validationInput) validationcache.Fingerprint {
+		f, err := newContext().fingerprint(inputs)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(f).ToNot(BeNil())
+		return *f
+	}
+
+	BeforeEach(func() {
+		clusterID := strfmt.UUID(uuid.New().String())
+		hostID := strfmt.UUID(uuid.New().String())
+		otherID := strfmt.UUID(uuid.New().String())
+		host = &models.Host{ID: &hostID, ClusterID: &clusterID, Inventory: `{"hostname":"a"}`}
+		other = &models.Host{ID: &otherID, ClusterID: &clusterID, Inventory: `{"hostname":"b"}`}
+		cluster = &common.Cluster{Cluster: models.Cluster{ID: &clusterID, Hosts: []*models.Host{host, other}}}
+	})
+
+	It("has no fingerprint without inputs", func() {
+		f, err := newContext().fingerprint(nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(f).To(BeNil())
+	})
+
+	It("changes the fingerprint only when a declared input changes", func() {
+		before := fingerprint(inputHost, inputInventory, inputClusterHosts)
+		host.Connectivity = "changed"
+		Expect(fingerprint(inputHost, inputInventory, inputClusterHosts)).To(Equal(before))
+
+		other.Inventory = `{"hostname":"a"}`
+		Expect(fingerprint(inputHost, inputInventory, inputClusterHosts)).ToNot(Equal(before))
+	})
+
+	It("tells apart bound and unbound hosts", func() {
+		bound := fingerprint(inputHost, inputClusterNetwork)
+		host.ClusterID = nil
+		cluster = nil
+		Expect(fingerprint(inputHost, inputClusterNetwork)).ToNot(Equal(bound))
+	})
+
+	It("reuses the result of a validation while its inputs don't change", func() {
+		rp := &refreshPreprocessor{
+			log:   common.GetTestLog(),
+			cache: validationcache.New("host", validationcache.Config{Enabled: true, MaxAge: time.Minute}),
+		}
+		calls := 0
+		v := validation{
+			id: IsHostnameUnique,
+			condition: func(c *validationContext) (ValidationStatus, string) {
+				calls++
+				return ValidationSuccess, "unique"
+			},
+			inputs: []validationInput{inputHost, inputInventory, inputClusterHosts},
+		}
+
+		st, message := rp.evaluate(newContext(), v)
+		Expect(st).To(Equal(ValidationSuccess))
+		Expect(message).To(Equal("unique"))
+		rp.evaluate(newContext(), v)
+		Expect(calls).To(Equal(1))
+
+		host.RequestedHostname = "c"
+		rp.evaluate(newContext(), v)
+		Expect(calls).To(Equal(2))
+
+		v.inputs = nil
+		rp.evaluate(newContext(), v)
+		Expect(calls).To(Equal(3))
+	})
+})
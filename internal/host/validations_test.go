@@ -1697,6 +1697,108 @@ var _ = Describe("Validations test", func() {
 				host = hostutil.GetHostFromDB(*host.ID, host.InfraEnvID, db).Host
 				status, message, ok := getValidationResult(host.ValidationsInfo, NoSkipMissingDisk)
 
+				// Validate expectations
+				Expect(ok).To(BeTrue())
+				Expect(message).To(Equal(test.expectedValidationMessage))
+				Expect(status).To(Equal(test.expectedValidationStatus))
+			})
+		}
+	})
+	Context("Root device hints matched validation", func() {
+		var (
+			host       models.Host
+			infraEnvID strfmt.UUID
+		)
+
+		const (
+			noHintsMessage string = "No root device hints are set"
+			successMessage string = "The installation disk matches the root device hints"
+			noMatchMessage string = "No eligible disk of the host matches the root device hints. Please change the root device hints of the host or of its infra-env"
+		)
+
+		BeforeEach(func() {
+			cluster := hostutil.GenerateTestCluster(clusterID)
+			Expect(db.Create(&cluster).Error).ToNot(HaveOccurred())
+
+			infraEnvID = strfmt.UUID(uuid.New().String())
+			Expect(db.Create(&common.InfraEnv{InfraEnv: models.InfraEnv{ID: &infraEnvID}}).Error).ToNot(HaveOccurred())
+
+			hostId := strfmt.UUID(uuid.New().String())
+			host = hostutil.GenerateTestHostByKind(hostId, infraEnvID, &clusterID, models.HostStatusKnown, models.HostKindHost, models.HostRoleMaster)
+			var inventory models.Inventory
+			Expect(json.Unmarshal([]byte(hostutil.GenerateMasterInventory()), &inventory)).To(Succeed())
+			inventory.Disks = []*models.Disk{
+				{ID: "/dev/sda", Path: "/dev/sda", Vendor: "ATA", InstallationEligibility: models.DiskInstallationEligibility{Eligible: true}},
+				{ID: "/dev/sdb", Path: "/dev/sdb", Vendor: "NVMe", InstallationEligibility: models.DiskInstallationEligibility{Eligible: true}},
+			}
+			inventoryBytes, err := json.Marshal(inventory)
+			Expect(err).ShouldNot(HaveOccurred())
+			host.Inventory = string(inventoryBytes)
+			host.InstallationDiskID = "/dev/sda"
+			Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+			host = hostutil.GetHostFromDB(*host.ID, host.InfraEnvID, db).Host
+		})
+
+		for _, test := range []struct {
+			name                      string
+			hostHints                 *models.RootDeviceHints
+			infraEnvHints             *models.RootDeviceHints
+			expectedValidationStatus  ValidationStatus
+			expectedValidationMessage string
+		}{
+			{
+				name:                      "No hints",
+				expectedValidationStatus:  ValidationSuccess,
+				expectedValidationMessage: noHintsMessage,
+			},
+			{
+				name:                      "Host hints matching the installation disk",
+				hostHints:                 &models.RootDeviceHints{Vendor: "ATA"},
+				expectedValidationStatus:  ValidationSuccess,
+				expectedValidationMessage: successMessage,
+			},
+			{
+				name:                      "Host hints matching another disk",
+				hostHints:                 &models.RootDeviceHints{Vendor: "NVMe"},
+				expectedValidationStatus:  ValidationFailure,
+				expectedValidationMessage: noMatchMessage,
+			},
+			{
+				name:                      "Host hints matching no disk",
+				hostHints:                 &models.RootDeviceHints{DeviceName: "/dev/sdc"},
+				expectedValidationStatus:  ValidationFailure,
+				expectedValidationMessage: noMatchMessage,
+			},
+			{
+				name:                      "Infra-env hints matching the installation disk",
+				infraEnvHints:             &models.RootDeviceHints{DeviceName: "/dev/sda"},
+				expectedValidationStatus:  ValidationSuccess,
+				expectedValidationMessage: successMessage,
+			},
+			{
+				name:                      "Host hints take precedence over infra-env hints",
+				hostHints:                 &models.RootDeviceHints{DeviceName: "/dev/sda"},
+				infraEnvHints:             &models.RootDeviceHints{DeviceName: "/dev/sdc"},
+				expectedValidationStatus:  ValidationSuccess,
+				expectedValidationMessage: successMessage,
+			},
+		} {
+			test := test
+			It(test.name, func() {
+				// Apply test inputs
+				host.RootDeviceHints = test.hostHints
+				if test.infraEnvHints != nil {
+					Expect(db.Model(&common.InfraEnv{}).Where("id = ?", infraEnvID).
+						Updates(common.RootDeviceHintsUpdates(test.infraEnvHints)).Error).ShouldNot(HaveOccurred())
+				}
+
+				// Trigger validations
+				mockAndRefreshStatus(&host)
+
+				// Retrieve results
+				host = hostutil.GetHostFromDB(*host.ID, host.InfraEnvID, db).Host
+				status, message, ok := getValidationResult(host.ValidationsInfo, RootDeviceHintsMatched)
+
 				// Validate expectations
 				Expect(ok).To(BeTrue())
 				Expect(message).To(Equal(test.expectedValidationMessage))
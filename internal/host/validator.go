@@ -22,6 +22,7 @@ import (
 	"github.com/openshift/assisted-service/internal/network"
 	"github.com/openshift/assisted-service/internal/operators"
 	"github.com/openshift/assisted-service/internal/provider/registry"
+	"github.com/openshift/assisted-service/internal/validationcache"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/conversions"
 	"github.com/openshift/assisted-service/pkg/s3wrapper"
@@ -94,6 +95,7 @@ type validationContext struct {
 	kubeApiEnabled          bool
 	objectHandler           s3wrapper.API
 	ctx                     context.Context
+	inputFingerprints       map[validationInput]validationcache.Fingerprint
 }
 
 type validationCondition func(context *validationContext) (ValidationStatus, string)
@@ -102,6 +104,8 @@ type validation struct {
 	id            validationID
 	condition     validationCondition
 	skippedStates []models.HostStage
+	// The inputs of the condition, its result is cached while they don't change
+	inputs []validationInput
 }
 
 func (c *validationContext) loadCluster() error {
@@ -1680,3 +1684,32 @@ func (v *validator) noSkipMissingDisk(c *validationContext) (ValidationStatus, s
 	}
 	return ValidationSuccess, successMessage
 }
+
+func (v *validator) rootDeviceHintsMatched(c *validationContext) (ValidationStatus, string) {
+	const (
+		noHintsMessage string = "No root device hints are set"
+		pendingMessage string = "Host inventory not available yet"
+		successMessage string = "The installation disk matches the root device hints"
+		noMatchMessage string = "No eligible disk of the host matches the root device hints. Please change the root device hints of the host or of its infra-env"
+		errorMessage   string = "Failed to get the infra-env of this host"
+	)
+
+	// The hints of the infra-env don't apply anymore if it was deleted while the host is bound to a cluster
+	if err := c.loadInfraEnv(); err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return ValidationError, errorMessage
+	}
+	hints := hostutil.GetRootDeviceHints(c.host, c.infraEnv)
+	if hints == nil {
+		return ValidationSuccess, noHintsMessage
+	}
+	if c.inventory == nil {
+		return ValidationPending, pendingMessage
+	}
+
+	for _, disk := range hostutil.GetAcceptableDisksWithHints(c.inventory.Disks, hints) {
+		if disk.ID == c.host.InstallationDiskID {
+			return ValidationSuccess, successMessage
+		}
+	}
+	return ValidationFailure, noMatchMessage
+}
@@ -38,6 +38,7 @@ import (
 	"github.com/openshift/assisted-service/internal/oc"
 	"github.com/openshift/assisted-service/internal/operators"
 	"github.com/openshift/assisted-service/internal/provider/registry"
+	"github.com/openshift/assisted-service/internal/provider/vsphere"
 	"github.com/openshift/assisted-service/internal/versions"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/auth"
@@ -325,7 +326,7 @@ func (g *installerGenerator) Generate(ctx context.Context, installConfig []byte,
 	}
 	defer removeIcspFile(icspFile)
 
-	installerPath, err := installercache.Get(g.installerReleaseImageOverride, g.releaseImageMirror, g.installerDir,
+	installerPath, err := installercache.Get(ctx, g.installerReleaseImageOverride, g.releaseImageMirror, g.installerDir,
 		g.cluster.PullSecret, platformType, icspFile, log)
 	if err != nil {
 		return errors.Wrap(err, "failed to get installer path")
@@ -456,7 +457,7 @@ func (g *installerGenerator) Generate(ctx context.Context, installConfig []byte,
 	}
 	// We want to save install-config.yaml
 	// Installer deletes it so we need to write it one more time
-	err = os.WriteFile(installConfigPath, installConfig, 0600)
+	err = writeStoredInstallConfig(installConfigPath, installConfig)
 	if err != nil {
 		log.Errorf("Failed to write file %s", installConfigPath)
 		return err
@@ -469,6 +470,16 @@ func (g *installerGenerator) Generate(ctx context.Context, installConfig []byte,
 	return nil
 }
 
+// writeStoredInstallConfig writes the install config that is uploaded with the files of the cluster, which users
+// can download, so the credentials handed to the installer are replaced by their placeholders
+func writeStoredInstallConfig(path string, installConfig []byte) error {
+	stored, err := vsphere.RemoveInstallConfigSecrets(installConfig)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, stored, 0600)
+}
+
 func (g *installerGenerator) addBootstrapKubeletIpIfRequired(log logrus.FieldLogger, envVars []string) ([]string, error) {
 	// setting bootstrap kubelet node ip
 	// We don't want to set bootstrap ip in None platform as user can't set machine cidr
@@ -1459,7 +1470,7 @@ func (ib *ignitionBuilder) shouldAppendOKDFiles(ctx context.Context, infraEnv *c
 		ib.log.Warnf("unable to find release image for %s/%s", infraEnv.OpenshiftVersion, infraEnv.CPUArchitecture)
 		return "", false
 	}
-	okdRpmsImage, err := ib.ocRelease.GetOKDRPMSImage(ib.log, *releaseImage.URL, "", infraEnv.PullSecret)
+	okdRpmsImage, err := ib.ocRelease.GetOKDRPMSImage(ctx, ib.log, *releaseImage.URL, "", infraEnv.PullSecret)
 	if err != nil {
 		return "", false
 	}
@@ -27,6 +27,7 @@ import (
 	"github.com/openshift/assisted-service/internal/oc"
 	"github.com/openshift/assisted-service/internal/operators"
 	"github.com/openshift/assisted-service/internal/provider/registry"
+	"github.com/openshift/assisted-service/internal/provider/vsphere"
 	"github.com/openshift/assisted-service/internal/versions"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/auth"
@@ -779,6 +780,40 @@ var _ = Describe("Generator UploadToS3", func() {
 	})
 })
 
+var _ = Describe("writeStoredInstallConfig", func() {
+	It("keeps the vCenter password out of the uploaded install config", func() {
+		cfg := installcfg.InstallerConfigBaremetal{}
+		cfg.Platform.Vsphere = &installcfg.VsphereInstallConfigPlatform{
+			VCenter:  "vcenter.example.com",
+			Username: "admin",
+			Password: "secret-password",
+		}
+		installConfig, err := yaml.Marshal(cfg)
+		Expect(err).ToNot(HaveOccurred())
+
+		installConfigPath := filepath.Join(workDir, "install-config.yaml")
+		Expect(writeStoredInstallConfig(installConfigPath, installConfig)).To(Succeed())
+
+		stored, err := os.ReadFile(installConfigPath)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(stored)).ToNot(ContainSubstring("secret-password"))
+		var storedCfg installcfg.InstallerConfigBaremetal
+		Expect(yaml.Unmarshal(stored, &storedCfg)).To(Succeed())
+		Expect(storedCfg.Platform.Vsphere.Password).To(BeEquivalentTo(vsphere.PhPassword))
+		Expect(storedCfg.Platform.Vsphere.VCenter).To(Equal("vcenter.example.com"))
+	})
+
+	It("writes install configs without credentials as they are", func() {
+		installConfig := []byte("apiVersion: v1\nbaseDomain: example.com\n")
+		installConfigPath := filepath.Join(workDir, "install-config.yaml")
+		Expect(writeStoredInstallConfig(installConfigPath, installConfig)).To(Succeed())
+
+		stored, err := os.ReadFile(installConfigPath)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stored).To(Equal(installConfig))
+	})
+})
+
 var _ = Describe("downloadManifest", func() {
 	var (
 		ctrl         *gomock.Controller
@@ -1259,7 +1294,7 @@ var _ = Describe("IgnitionBuilder", func() {
 			Version:          &okdNewImageVersion,
 		}
 		mockVersionHandler.EXPECT().GetReleaseImage(gomock.Any(), gomock.Any()).Return(okdNewImage, nil).Times(1)
-		mockOcRelease.EXPECT().GetOKDRPMSImage(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("quay.io/foo/bar:okd-rpms", nil)
+		mockOcRelease.EXPECT().GetOKDRPMSImage(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("quay.io/foo/bar:okd-rpms", nil)
 		text, err := builder.FormatDiscoveryIgnitionFile(context.Background(), &infraEnv, IgnitionConfig{}, false, auth.TypeRHSSO, "")
 
 		Expect(err).Should(BeNil())
@@ -2040,13 +2075,13 @@ var _ = Describe("OKD overrides", func() {
 
 	It("OKD_RPMS config option unset", func() {
 		mockVersionHandler.EXPECT().GetReleaseImage(gomock.Any(), gomock.Any()).Return(ocpImage, nil).Times(1)
-		mockOcRelease.EXPECT().GetOKDRPMSImage(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+		mockOcRelease.EXPECT().GetOKDRPMSImage(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
 		text, err := builder.FormatDiscoveryIgnitionFile(context.Background(), &infraEnv, defaultCfg, false, auth.TypeRHSSO, string(models.ImageTypeMinimalIso))
 		checkOKDFiles(text, err, false)
 	})
 	It("OKD_RPMS config option not set, OKD release has no RPM image", func() {
 		mockVersionHandler.EXPECT().GetReleaseImage(gomock.Any(), gomock.Any()).Return(okdOldImage, nil).Times(1)
-		mockOcRelease.EXPECT().GetOKDRPMSImage(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+		mockOcRelease.EXPECT().GetOKDRPMSImage(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
 		text, err := builder.FormatDiscoveryIgnitionFile(context.Background(), &infraEnv, defaultCfg, false, auth.TypeRHSSO, string(models.ImageTypeMinimalIso))
 		checkOKDFiles(text, err, false)
 	})
@@ -2056,7 +2091,7 @@ var _ = Describe("OKD overrides", func() {
 	})
 	It("OKD_RPMS config option not set, RPM image present in release payload", func() {
 		mockVersionHandler.EXPECT().GetReleaseImage(gomock.Any(), gomock.Any()).Return(okdNewImage, nil).Times(1)
-		mockOcRelease.EXPECT().GetOKDRPMSImage(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("quay.io/foo/bar:okd-rpms", nil)
+		mockOcRelease.EXPECT().GetOKDRPMSImage(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("quay.io/foo/bar:okd-rpms", nil)
 		text, err := builder.FormatDiscoveryIgnitionFile(context.Background(), &infraEnv, defaultCfg, false, auth.TypeRHSSO, string(models.ImageTypeMinimalIso))
 		checkOKDFiles(text, err, true)
 	})
@@ -5,6 +5,8 @@ import (
 
 	"github.com/go-openapi/strfmt"
 	"github.com/openshift/assisted-service/internal/common"
+	eventgen "github.com/openshift/assisted-service/internal/common/events"
+	eventsapi "github.com/openshift/assisted-service/internal/events/api"
 	"github.com/openshift/assisted-service/models"
 	logutil "github.com/openshift/assisted-service/pkg/log"
 	"github.com/openshift/assisted-service/pkg/s3wrapper"
@@ -15,7 +17,7 @@ import (
 
 //go:generate mockgen --build_flags=--mod=mod -package=infraenv -destination=mock_infraenv_api.go . API
 type API interface {
-	DeleteOrphanInfraEnvs(ctx context.Context, maxDeletePerInterval int, inactiveSince strfmt.DateTime) error
+	DeleteOrphanInfraEnvs(ctx context.Context, maxDeletePerInterval int, inactiveSince strfmt.DateTime, scopes ...func(*gorm.DB) *gorm.DB) error
 	DeregisterInfraEnv(ctx context.Context, infraEnvId strfmt.UUID) error
 }
 
@@ -23,20 +25,24 @@ type Manager struct {
 	log           logrus.FieldLogger
 	db            *gorm.DB
 	objectHandler s3wrapper.API
+	eventsHandler eventsapi.Handler
 }
 
-func NewManager(log logrus.FieldLogger, db *gorm.DB, objectHandler s3wrapper.API) *Manager {
+func NewManager(log logrus.FieldLogger, db *gorm.DB, objectHandler s3wrapper.API, eventsHandler eventsapi.Handler) *Manager {
 	return &Manager{
 		log:           log,
 		db:            db,
 		objectHandler: objectHandler,
+		eventsHandler: eventsHandler,
 	}
 }
 
-func (m Manager) DeleteOrphanInfraEnvs(ctx context.Context, maxDeletePerInterval int, inactiveSince strfmt.DateTime) error {
+// DeleteOrphanInfraEnvs deletes the infra-envs that weren't updated since inactiveSince and whose cluster was deleted,
+// the scopes narrow down the infra-envs that are considered. An event is sent for each deleted infra-env
+func (m Manager) DeleteOrphanInfraEnvs(ctx context.Context, maxDeletePerInterval int, inactiveSince strfmt.DateTime, scopes ...func(*gorm.DB) *gorm.DB) error {
 	log := logutil.FromContext(ctx, m.log)
 	var infraEnvs []*models.InfraEnv
-	if err := m.db.Limit(maxDeletePerInterval).Where("updated_at < ?", inactiveSince).Find(&infraEnvs).Error; err != nil {
+	if err := m.db.Scopes(scopes...).Limit(maxDeletePerInterval).Where("updated_at < ?", inactiveSince).Find(&infraEnvs).Error; err != nil {
 		return err
 	}
 	for _, infraEnv := range infraEnvs {
@@ -45,21 +51,25 @@ func (m Manager) DeleteOrphanInfraEnvs(ctx context.Context, maxDeletePerInterval
 			_, err := common.GetClusterFromDBWhere(m.db, common.SkipEagerLoading,
 				common.SkipDeletedRecords, "id = ?", infraEnv.ClusterID)
 			if errors.Is(err, gorm.ErrRecordNotFound) {
+				log.Infof("Infraenv %s of deleted cluster %s is deleted due to inactivity since %s", *infraEnv.ID, infraEnv.ClusterID, infraEnv.UpdatedAt)
 				err = m.DeregisterInfraEnvAndHosts(ctx, *infraEnv.ID)
 				if err != nil {
 					log.WithError(err).Errorf("failed to deregister infraEnv %s", *infraEnv.ID)
 					return err
 				}
+				eventgen.SendInfraEnvDeletedAfterInactivityEvent(ctx, m.eventsHandler, *infraEnv.ID)
 			} else if err != nil {
 				log.WithError(err).Errorf("failed to GetClusterFromDBWhere infraEnv %s", *infraEnv.ID)
 				return err
 			}
 		} else {
+			log.Infof("Infraenv %s is deleted due to inactivity since %s", *infraEnv.ID, infraEnv.UpdatedAt)
 			err := m.DeregisterInfraEnvAndHosts(ctx, *infraEnv.ID)
 			if err != nil {
 				log.WithError(err).Errorf("failed to deregister infraEnv %s", *infraEnv.ID)
 				return err
 			}
+			eventgen.SendInfraEnvDeletedAfterInactivityEvent(ctx, m.eventsHandler, *infraEnv.ID)
 		}
 	}
 	return nil
@@ -11,9 +11,13 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/openshift/assisted-service/internal/common"
+	eventgen "github.com/openshift/assisted-service/internal/common/events"
+	"github.com/openshift/assisted-service/internal/events"
+	eventsapi "github.com/openshift/assisted-service/internal/events/api"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/s3wrapper"
 	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
 )
 
@@ -41,7 +45,7 @@ var _ = Describe("DeregisterInfraEnv", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		db, dbName = common.PrepareTestDB()
 		mockS3Client = s3wrapper.NewMockAPI(ctrl)
-		state = NewManager(common.GetTestLog(), db, mockS3Client)
+		state = NewManager(common.GetTestLog(), db, mockS3Client, nil)
 		infraEnv = registerInfraEnv()
 	})
 
@@ -60,13 +64,14 @@ var _ = Describe("DeregisterInfraEnv", func() {
 
 var _ = Describe("Delete inactive infraenvs", func() {
 	var (
-		ctrl         *gomock.Controller
-		ctx          = context.Background()
-		db           *gorm.DB
-		state        API
-		infraEnv     common.InfraEnv
-		dbName       string
-		mockS3Client *s3wrapper.MockAPI
+		ctrl          *gomock.Controller
+		ctx           = context.Background()
+		db            *gorm.DB
+		state         API
+		infraEnv      common.InfraEnv
+		dbName        string
+		mockS3Client  *s3wrapper.MockAPI
+		eventsHandler eventsapi.Handler
 	)
 
 	registerInfraEnv := func(clusterId strfmt.UUID) common.InfraEnv {
@@ -105,10 +110,17 @@ var _ = Describe("Delete inactive infraenvs", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		db, dbName = common.PrepareTestDB()
 		mockS3Client = s3wrapper.NewMockAPI(ctrl)
-		state = NewManager(common.GetTestLog(), db, mockS3Client)
+		eventsHandler = events.New(db, nil, logrus.New())
+		state = NewManager(common.GetTestLog(), db, mockS3Client, eventsHandler)
 		infraEnv = registerInfraEnv("")
 	})
 
+	deletionEvents := func(infraEnvID strfmt.UUID) []*common.Event {
+		infraEnvEvents, err := eventsHandler.V2GetEvents(ctx, nil, nil, &infraEnvID)
+		Expect(err).NotTo(HaveOccurred())
+		return infraEnvEvents
+	}
+
 	// avoid races on slow/fast systems, could be any amount of time
 	nowPlus5sec := func() strfmt.DateTime {
 		return strfmt.DateTime(time.Now().Add(5 * time.Second))
@@ -117,6 +129,9 @@ var _ = Describe("Delete inactive infraenvs", func() {
 	It("Deregister inactive infraEnv", func() {
 		Expect(state.DeleteOrphanInfraEnvs(ctx, 10, nowPlus5sec())).ShouldNot(HaveOccurred())
 		Expect(wasDeleted(db, *infraEnv.ID)).To(BeTrue())
+		infraEnvEvents := deletionEvents(*infraEnv.ID)
+		Expect(infraEnvEvents).To(HaveLen(1))
+		Expect(infraEnvEvents[0].Name).To(Equal(eventgen.InfraEnvDeletedAfterInactivityEventName))
 	})
 
 	It("Deregister inactive infraEnv with hosts", func() {
@@ -133,6 +148,7 @@ var _ = Describe("Delete inactive infraenvs", func() {
 		Expect(state.DeleteOrphanInfraEnvs(ctx, 10, nowPlus5sec())).ShouldNot(HaveOccurred())
 		Expect(wasDeleted(db, *infraEnv2.ID)).To(BeTrue())
 		Expect(wasDeleted(db, *infraEnv.ID)).To(BeTrue())
+		Expect(deletionEvents(*infraEnv2.ID)).To(HaveLen(1))
 	})
 
 	It("Deregister inactive infraEnv with non existing cluster with hosts", func() {
@@ -164,6 +180,7 @@ var _ = Describe("Delete inactive infraenvs", func() {
 		lastActive := strfmt.DateTime(time.Now().Add(-time.Hour))
 		Expect(state.DeleteOrphanInfraEnvs(ctx, 10, lastActive)).ShouldNot(HaveOccurred())
 		Expect(wasDeleted(db, *infraEnv.ID)).To(BeFalse())
+		Expect(deletionEvents(*infraEnv.ID)).To(BeEmpty())
 	})
 
 	It("Delete inactive infraEnvs with new infraEnvs", func() {
@@ -6,10 +6,10 @@ package infraenv
 
 import (
 	context "context"
-	reflect "reflect"
-
 	strfmt "github.com/go-openapi/strfmt"
 	gomock "github.com/golang/mock/gomock"
+	gorm "gorm.io/gorm"
+	reflect "reflect"
 )
 
 // MockAPI is a mock of API interface.
@@ -36,17 +36,22 @@ func (m *MockAPI) EXPECT() *MockAPIMockRecorder {
 }
 
 // DeleteOrphanInfraEnvs mocks base method.
-func (m *MockAPI) DeleteOrphanInfraEnvs(arg0 context.Context, arg1 int, arg2 strfmt.DateTime) error {
+func (m *MockAPI) DeleteOrphanInfraEnvs(arg0 context.Context, arg1 int, arg2 strfmt.DateTime, arg3 ...func(*gorm.DB) *gorm.DB) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteOrphanInfraEnvs", arg0, arg1, arg2)
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteOrphanInfraEnvs", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteOrphanInfraEnvs indicates an expected call of DeleteOrphanInfraEnvs.
-func (mr *MockAPIMockRecorder) DeleteOrphanInfraEnvs(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) DeleteOrphanInfraEnvs(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrphanInfraEnvs", reflect.TypeOf((*MockAPI)(nil).DeleteOrphanInfraEnvs), arg0, arg1, arg2)
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrphanInfraEnvs", reflect.TypeOf((*MockAPI)(nil).DeleteOrphanInfraEnvs), varargs...)
 }
 
 // DeregisterInfraEnv mocks base method.
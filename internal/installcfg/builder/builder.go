@@ -9,6 +9,7 @@ import (
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/installcfg"
 	"github.com/openshift/assisted-service/internal/provider/registry"
+	"github.com/openshift/assisted-service/internal/provider/vsphere"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/mirrorregistries"
 	"github.com/sirupsen/logrus"
@@ -19,6 +20,7 @@ import (
 //go:generate mockgen -source=builder.go -package=builder -destination=mock_installcfg.go
 type InstallConfigBuilder interface {
 	GetInstallConfig(cluster *common.Cluster, addRhCa bool, ca string) ([]byte, error)
+	GetInstallConfigWithSecrets(cluster *common.Cluster, addRhCa bool, ca string) ([]byte, error)
 	ValidateInstallConfigPatch(cluster *common.Cluster, patch string) error
 }
 
@@ -208,7 +210,26 @@ func (i *installConfigBuilder) GetInstallConfig(cluster *common.Cluster, addRhCa
 	return yaml.Marshal(*cfg)
 }
 
+// GetInstallConfigWithSecrets returns the install config with the platform credentials instead of
+// their placeholders. The result must only be handed to the installer and never returned by the API.
+func (i *installConfigBuilder) GetInstallConfigWithSecrets(cluster *common.Cluster, addRhCa bool, ca string) ([]byte, error) {
+	cfg, err := i.getInstallConfig(cluster, addRhCa, ca)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = vsphere.SetInstallConfigSecrets(cfg, cluster); err != nil {
+		return nil, err
+	}
+
+	return yaml.Marshal(*cfg)
+}
+
 func (i *installConfigBuilder) ValidateInstallConfigPatch(cluster *common.Cluster, patch string) error {
+	if err := validateOverridesFields(cluster, patch); err != nil {
+		return err
+	}
+
 	config, err := i.getInstallConfig(cluster, false, "")
 	if err != nil {
 		return err
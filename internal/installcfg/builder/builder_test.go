@@ -3,6 +3,8 @@ package builder
 import (
 	"encoding/json"
 	"fmt"
+	"os"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -13,9 +15,11 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/gencrypto"
 	"github.com/openshift/assisted-service/internal/installcfg"
 	"github.com/openshift/assisted-service/internal/network"
 	"github.com/openshift/assisted-service/internal/provider/registry"
+	"github.com/openshift/assisted-service/internal/provider/vsphere"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/mirrorregistries"
 	"gopkg.in/yaml.v2"
@@ -396,6 +400,37 @@ var _ = Describe("installcfg", func() {
 		Expect(result.Platform.None).Should(BeNil())
 	})
 
+	It("vSphere password is only set for the installer", func() {
+		os.Setenv("CREDENTIALS_ENCRYPTION_KEY", "key")
+		defer os.Unsetenv("CREDENTIALS_ENCRYPTION_KEY")
+		encrypted, err := gencrypto.EncryptSecret("vcenter-password")
+		Expect(err).ShouldNot(HaveOccurred())
+		cluster.InstallConfigOverrides = ""
+		cluster.Platform = &models.Platform{
+			Type: common.PlatformTypePtr(models.PlatformTypeVsphere),
+			Vsphere: &models.VspherePlatform{
+				Vcenter:  "vcenter.example.com",
+				Username: "user",
+			},
+		}
+		cluster.VsphereEncryptedPassword = encrypted
+		cluster.UserManagedNetworking = swag.Bool(true)
+		mockMirrorRegistriesConfigBuilder.EXPECT().IsMirrorRegistriesConfigured().Return(false).Times(4)
+
+		var result installcfg.InstallerConfigBaremetal
+		data, err := installConfig.GetInstallConfig(&cluster, false, "")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(string(data)).ShouldNot(ContainSubstring("vcenter-password"))
+		Expect(yaml.Unmarshal(data, &result)).To(Succeed())
+		Expect(result.Platform.Vsphere.Password).To(Equal(strfmt.Password(vsphere.PhPassword)))
+
+		data, err = installConfig.GetInstallConfigWithSecrets(&cluster, false, "")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(yaml.Unmarshal(data, &result)).To(Succeed())
+		Expect(result.Platform.Vsphere.VCenter).To(Equal("vcenter.example.com"))
+		Expect(result.Platform.Vsphere.Password).To(Equal(strfmt.Password("vcenter-password")))
+	})
+
 	It("Single node", func() {
 		var result installcfg.InstallerConfigBaremetal
 		cluster.InstallConfigOverrides = ""
@@ -628,6 +663,46 @@ var _ = Describe("ValidateInstallConfigPatch", func() {
 		err := installConfig.ValidateInstallConfigPatch(cluster, s)
 		Expect(err).ShouldNot(HaveOccurred())
 	})
+
+	It("Fails when overriding a field that is set by the service", func() {
+		s := `{"networking": {"networkType": "OVNKubernetes", "machinenetwork": [{"cidr": "1.2.3.0/24"}]}}`
+		err := installConfig.ValidateInstallConfigPatch(cluster, s)
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("/networking/machineNetwork"))
+	})
+
+	It("Fails when overriding a field that isn't supported by the OpenShift version", func() {
+		s := `{"capabilities": {"baselineCapabilitySet": "None"}}`
+		err := installConfig.ValidateInstallConfigPatch(cluster, s)
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("requires OpenShift version 4.11"))
+	})
+
+	It("Succeeds when overriding a field that is supported by the OpenShift version", func() {
+		cluster.OpenshiftVersion = "4.12"
+		s := `{"capabilities": {"baselineCapabilitySet": "None"}}`
+		mockMirrorRegistriesConfigBuilder.EXPECT().IsMirrorRegistriesConfigured().Return(false).Times(2)
+		err := installConfig.ValidateInstallConfigPatch(cluster, s)
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	It("Takes the minimal versions of the fields from the install config types", func() {
+		Expect(overrideFieldsMinVersion).To(HaveKeyWithValue("/capabilities", "4.11"))
+
+		type nested struct {
+			Old string `yaml:"old"`
+			New string `yaml:"new,omitempty" minVersion:"4.13"`
+		}
+		type config struct {
+			Plain   string  `yaml:"plain"`
+			Nested  *nested `yaml:"nested" minVersion:"4.12"`
+			Skipped string  `yaml:"-" minVersion:"4.14"`
+		}
+		Expect(fieldsMinVersion(reflect.TypeOf(config{}), "")).To(Equal(map[string]string{
+			"/nested":     "4.12",
+			"/nested/new": "4.13",
+		}))
+	})
 })
 
 func getInventoryStr(hostname, bootMode string, ipv4 bool, ipv6 bool) string {
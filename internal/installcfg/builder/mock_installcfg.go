@@ -49,6 +49,21 @@ func (mr *MockInstallConfigBuilderMockRecorder) GetInstallConfig(cluster, addRhC
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstallConfig", reflect.TypeOf((*MockInstallConfigBuilder)(nil).GetInstallConfig), cluster, addRhCa, ca)
 }
 
+// GetInstallConfigWithSecrets mocks base method.
+func (m *MockInstallConfigBuilder) GetInstallConfigWithSecrets(cluster *common.Cluster, addRhCa bool, ca string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInstallConfigWithSecrets", cluster, addRhCa, ca)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInstallConfigWithSecrets indicates an expected call of GetInstallConfigWithSecrets.
+func (mr *MockInstallConfigBuilderMockRecorder) GetInstallConfigWithSecrets(cluster, addRhCa, ca interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstallConfigWithSecrets", reflect.TypeOf((*MockInstallConfigBuilder)(nil).GetInstallConfigWithSecrets), cluster, addRhCa, ca)
+}
+
 // ValidateInstallConfigPatch mocks base method.
 func (m *MockInstallConfigBuilder) ValidateInstallConfigPatch(cluster *common.Cluster, patch string) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,149 @@
+package builder
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/installcfg"
+	"github.com/pkg/errors"
+	jsonpatchdiff "gomodules.xyz/jsonpatch/v2"
+)
+
+// ownedOverrideFields are the install config fields that are set from the cluster configuration. Overriding them would
+// make the install config disagree with the cluster, so they must be changed through the cluster API instead.
+var ownedOverrideFields = []string{
+	"/networking/clusterNetwork",
+	"/networking/machineNetwork",
+	"/networking/serviceNetwork",
+	"/platform",
+	"/pullSecret",
+}
+
+// overrideFieldsMinVersion are the install config fields that the installer accepts only from a given OpenShift version,
+// taken from the minVersion tags of the install config types
+var overrideFieldsMinVersion = fieldsMinVersion(reflect.TypeOf(installcfg.InstallerConfigBaremetal{}), "")
+
+// IsJSONPatch returns true if the install config params are an RFC 6902 JSON-Patch rather than overrides to merge
+func IsJSONPatch(installConfigParams string) bool {
+	return strings.HasPrefix(strings.TrimSpace(installConfigParams), "[")
+}
+
+// ApplyJSONPatch applies an RFC 6902 JSON-Patch to the install config overrides and returns the patched overrides
+func ApplyJSONPatch(overrides string, patch string) (string, error) {
+	decoded, err := jsonpatch.DecodePatch([]byte(patch))
+	if err != nil {
+		return "", errors.Wrap(err, "failed to decode the install config JSON-Patch")
+	}
+	patched, err := decoded.Apply([]byte(overridesDocument(overrides)))
+	if err != nil {
+		return "", errors.Wrap(err, "failed to apply the install config JSON-Patch")
+	}
+	return string(patched), nil
+}
+
+// OverridesDiff returns the RFC 6902 JSON-Patch that transforms the previous install config overrides to the current
+// ones, or an empty string if they are equivalent
+func OverridesDiff(previous string, current string) (string, error) {
+	operations, err := jsonpatchdiff.CreatePatch([]byte(overridesDocument(previous)), []byte(overridesDocument(current)))
+	if err != nil {
+		return "", err
+	}
+	if len(operations) == 0 {
+		return "", nil
+	}
+	diff, err := json.Marshal(operations)
+	if err != nil {
+		return "", err
+	}
+	return string(diff), nil
+}
+
+func overridesDocument(overrides string) string {
+	if strings.TrimSpace(overrides) == "" {
+		return "{}"
+	}
+	return overrides
+}
+
+func validateOverridesFields(cluster *common.Cluster, overrides string) error {
+	var document map[string]interface{}
+	if err := json.Unmarshal([]byte(overridesDocument(overrides)), &document); err != nil {
+		return err
+	}
+	for _, field := range ownedOverrideFields {
+		if hasField(document, field) {
+			return errors.Errorf("install config field %s is set by the service and can't be overridden", field)
+		}
+	}
+	for field, minVersion := range overrideFieldsMinVersion {
+		if !hasField(document, field) {
+			continue
+		}
+		supported, err := common.VersionGreaterOrEqual(cluster.OpenshiftVersion, minVersion)
+		if err != nil {
+			return err
+		}
+		if !supported {
+			return errors.Errorf("install config field %s requires OpenShift version %s or above", field, minVersion)
+		}
+	}
+	return nil
+}
+
+// fieldsMinVersion returns the JSON pointers of the fields of the given type, and of its nested structs, that have a
+// minVersion tag
+func fieldsMinVersion(t reflect.Type, prefix string) map[string]string {
+	fields := map[string]string{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := strings.Split(field.Tag.Get("yaml"), ",")[0]
+		if name == "" || name == "-" {
+			continue
+		}
+		pointer := prefix + "/" + name
+		if minVersion, ok := field.Tag.Lookup("minVersion"); ok {
+			fields[pointer] = minVersion
+		}
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+		if fieldType.Kind() == reflect.Struct {
+			for nested, minVersion := range fieldsMinVersion(fieldType, pointer) {
+				fields[nested] = minVersion
+			}
+		}
+	}
+	return fields
+}
+
+func hasField(document map[string]interface{}, pointer string) bool {
+	var current interface{} = document
+	for _, key := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+		object, ok := current.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		if current, ok = lookupField(object, key); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// lookupField finds the field of a JSON object the way encoding/json does when the overrides are decoded, preferring an
+// exact match of the key over a case-insensitive one
+func lookupField(object map[string]interface{}, key string) (interface{}, bool) {
+	if value, ok := object[key]; ok {
+		return value, true
+	}
+	for name, value := range object {
+		if strings.EqualFold(name, key) {
+			return value, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,81 @@
+package builder
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("install config overrides", func() {
+	DescribeTable("IsJSONPatch",
+		func(params string, expected bool) {
+			Expect(IsJSONPatch(params)).To(Equal(expected))
+		},
+		Entry("patch", `[{"op": "add", "path": "/fips", "value": true}]`, true),
+		Entry("patch with leading whitespace", " \n[]", true),
+		Entry("overrides", `{"fips": true}`, false),
+		Entry("empty", "", false),
+	)
+
+	Context("ApplyJSONPatch", func() {
+		It("applies the patch to the overrides", func() {
+			patched, err := ApplyJSONPatch(`{"fips": true, "controlPlane": {"hyperthreading": "Disabled"}}`,
+				`[{"op": "remove", "path": "/fips"}, {"op": "replace", "path": "/controlPlane/hyperthreading", "value": "Enabled"}]`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(patched).To(MatchJSON(`{"controlPlane": {"hyperthreading": "Enabled"}}`))
+		})
+
+		It("applies the patch to empty overrides", func() {
+			patched, err := ApplyJSONPatch("", `[{"op": "add", "path": "/fips", "value": true}]`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(patched).To(MatchJSON(`{"fips": true}`))
+		})
+
+		It("fails on an invalid patch", func() {
+			_, err := ApplyJSONPatch("", `[{"op": "add", "path": "/fips"`)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("fails when the patch doesn't apply", func() {
+			_, err := ApplyJSONPatch(`{"fips": true}`, `[{"op": "remove", "path": "/sshKey"}]`)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("OverridesDiff", func() {
+		It("returns the patch between the overrides", func() {
+			diff, err := OverridesDiff(`{"fips": true}`, `{"fips": false, "sshKey": "key"}`)
+			Expect(err).ToNot(HaveOccurred())
+			var operations []map[string]interface{}
+			Expect(json.Unmarshal([]byte(diff), &operations)).To(Succeed())
+			Expect(operations).To(ConsistOf(
+				map[string]interface{}{"op": "replace", "path": "/fips", "value": false},
+				map[string]interface{}{"op": "add", "path": "/sshKey", "value": "key"},
+			))
+		})
+
+		It("returns the patch from empty overrides", func() {
+			diff, err := OverridesDiff("", `{"fips": true}`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(diff).To(MatchJSON(`[{"op": "add", "path": "/fips", "value": true}]`))
+		})
+
+		It("returns an empty patch for equivalent overrides", func() {
+			diff, err := OverridesDiff(`{"fips":true}`, ` {"fips": true}`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(diff).To(BeEmpty())
+		})
+
+		It("round trips with ApplyJSONPatch", func() {
+			previous := `{"controlPlane": {"hyperthreading": "Disabled"}}`
+			current := `{"controlPlane": {"hyperthreading": "Enabled"}, "fips": true}`
+			diff, err := OverridesDiff(previous, current)
+			Expect(err).ToNot(HaveOccurred())
+			patched, err := ApplyJSONPatch(previous, diff)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(patched).To(MatchJSON(current))
+		})
+	})
+})
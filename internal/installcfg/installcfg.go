@@ -117,6 +117,9 @@ type Capabilities struct {
 	AdditionalEnabledCapabilities []ClusterVersionCapability  `yaml:"additionalEnabledCapabilities,omitempty"`
 }
 
+// InstallerConfigBaremetal is the install config handed to the installer. Fields that the installer accepts only from a
+// given OpenShift version are tagged with that version as minVersion, install config overrides setting them are
+// rejected for older versions.
 type InstallerConfigBaremetal struct {
 	APIVersion string `yaml:"apiVersion"`
 	BaseDomain string `yaml:"baseDomain"`
@@ -147,7 +150,7 @@ type InstallerConfigBaremetal struct {
 	SSHKey                string               `yaml:"sshKey"`
 	AdditionalTrustBundle string               `yaml:"additionalTrustBundle,omitempty"`
 	ImageContentSources   []ImageContentSource `yaml:"imageContentSources,omitempty"`
-	Capabilities          *Capabilities        `yaml:"capabilities,omitempty"`
+	Capabilities          *Capabilities        `yaml:"capabilities,omitempty" minVersion:"4.11"`
 }
 
 func (c *InstallerConfigBaremetal) Validate() error {
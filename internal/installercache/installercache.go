@@ -1,6 +1,7 @@
 package installercache
 
 import (
+	"context"
 	"sync"
 
 	"github.com/openshift/assisted-service/internal/oc"
@@ -40,7 +41,7 @@ func (i *installers) Get(releaseID string) *release {
 // Get returns the path to an openshift-baremetal-install binary extracted from
 // the referenced release image. Tries the mirror release image first if it's set. It is safe for concurrent use. A cache of
 // binaries is maintained to reduce re-downloading of the same release.
-func Get(releaseID, releaseIDMirror, cacheDir, pullSecret string, platformType models.PlatformType, icspFile string, log logrus.FieldLogger) (string, error) {
+func Get(ctx context.Context, releaseID, releaseIDMirror, cacheDir, pullSecret string, platformType models.PlatformType, icspFile string, log logrus.FieldLogger) (string, error) {
 	r := cache.Get(releaseID)
 	r.Lock()
 	defer r.Unlock()
@@ -51,7 +52,7 @@ func Get(releaseID, releaseIDMirror, cacheDir, pullSecret string, platformType m
 	if r.path == "" {
 		mirrorRegistriesBuilder := mirrorregistries.New()
 		path, err = oc.NewRelease(&executer.CommonExecuter{}, oc.Config{
-			MaxTries: oc.DefaultTries, RetryDelay: oc.DefaltRetryDelay}, mirrorRegistriesBuilder).Extract(log, releaseID, releaseIDMirror, cacheDir, pullSecret, platformType, icspFile)
+			MaxTries: oc.DefaultTries, RetryDelay: oc.DefaltRetryDelay}, mirrorRegistriesBuilder).Extract(ctx, log, releaseID, releaseIDMirror, cacheDir, pullSecret, platformType, icspFile)
 		if err != nil {
 			return "", err
 		}
@@ -0,0 +1,83 @@
+package manifests
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/openshift/assisted-service/internal/common"
+	manifestsapi "github.com/openshift/assisted-service/internal/manifests/api"
+	"github.com/openshift/assisted-service/models"
+	operations "github.com/openshift/assisted-service/restapi/operations/manifests"
+	"github.com/pkg/errors"
+)
+
+var _ manifestsapi.ClusterManifestsInternals = &DirectoryManifests{}
+
+// DirectoryManifests stores the manifests of a single cluster as files of a local directory, in the folders they
+// have in the installation directory. It is used to render the manifests of a cluster without storing them.
+type DirectoryManifests struct {
+	dir string
+}
+
+func NewDirectoryManifests(dir string) *DirectoryManifests {
+	return &DirectoryManifests{dir: dir}
+}
+
+func (d *DirectoryManifests) CreateClusterManifestInternal(ctx context.Context, params operations.V2CreateClusterManifestParams) (*models.Manifest, error) {
+	folder := models.CreateManifestParamsFolderManifests
+	if params.CreateManifestParams.Folder != nil {
+		folder = *params.CreateManifestParams.Folder
+	}
+	if strings.ContainsRune(*params.CreateManifestParams.FileName, os.PathSeparator) {
+		return nil, common.NewApiError(http.StatusBadRequest, errors.New("Manifest should not include a directory in its name"))
+	}
+	fileName := filepath.Join(folder, *params.CreateManifestParams.FileName)
+	manifestContent, err := base64.StdEncoding.DecodeString(*params.CreateManifestParams.Content)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, errors.New("failed to base64-decode cluster manifest content"))
+	}
+	if err = validateManifestContent(fileName, manifestContent); err != nil {
+		return nil, err
+	}
+	if err = os.MkdirAll(filepath.Join(d.dir, folder), 0o755); err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if err = os.WriteFile(filepath.Join(d.dir, fileName), manifestContent, 0o600); err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return &models.Manifest{FileName: *params.CreateManifestParams.FileName, Folder: folder}, nil
+}
+
+func (d *DirectoryManifests) ListClusterManifestsInternal(ctx context.Context, params operations.V2ListClusterManifestsParams) (models.ListManifests, error) {
+	manifests := models.ListManifests{}
+	for _, folder := range []string{models.ManifestFolderManifests, models.ManifestFolderOpenshift} {
+		entries, err := os.ReadDir(filepath.Join(d.dir, folder))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, common.NewApiError(http.StatusInternalServerError, err)
+		}
+		for _, entry := range entries {
+			if !entry.IsDir() {
+				manifests = append(manifests, &models.Manifest{FileName: entry.Name(), Folder: folder})
+			}
+		}
+	}
+	return manifests, nil
+}
+
+func (d *DirectoryManifests) DeleteClusterManifestInternal(ctx context.Context, params operations.V2DeleteClusterManifestParams) error {
+	folder := models.CreateManifestParamsFolderManifests
+	if params.Folder != nil {
+		folder = *params.Folder
+	}
+	if err := os.Remove(filepath.Join(d.dir, folder, params.FileName)); err != nil && !os.IsNotExist(err) {
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return nil
+}
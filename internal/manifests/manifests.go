@@ -21,6 +21,7 @@ import (
 	"github.com/openshift/assisted-service/pkg/filemiddleware"
 	logutil "github.com/openshift/assisted-service/pkg/log"
 	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/openshift/assisted-service/pkg/transaction"
 	operations "github.com/openshift/assisted-service/restapi/operations/manifests"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -81,23 +82,8 @@ func (m *Manifests) CreateClusterManifestInternal(ctx context.Context, params op
 			fileName, params.ClusterID.String(), *params.CreateManifestParams.Content)
 		return nil, common.NewApiError(http.StatusBadRequest, errors.New("failed to base64-decode cluster manifest content"))
 	}
-	extension := filepath.Ext(fileName)
-	if extension == ".yaml" || extension == ".yml" {
-		var s map[interface{}]interface{}
-		if yaml.Unmarshal(manifestContent, &s) != nil {
-			return nil, common.NewApiError(http.StatusBadRequest, errors.New("Manifest content has an invalid YAML format"))
-		}
-	} else if extension == ".json" {
-		if !json.Valid(manifestContent) {
-			return nil, common.NewApiError(http.StatusBadRequest, errors.New("Manifest content has an illegal JSON format"))
-		}
-	} else if strings.HasPrefix(extension, ".patch") && (strings.Contains(fileName, ".yaml.patch") || strings.Contains(fileName, ".yml.patch")) {
-		var s []map[interface{}]interface{}
-		if yaml.Unmarshal(manifestContent, &s) != nil {
-			return nil, common.NewApiError(http.StatusBadRequest, errors.New("Patch content has an invalid YAML format"))
-		}
-	} else {
-		return nil, common.NewApiError(http.StatusBadRequest, errors.New("Unsupported manifest extension. Only json, yaml and yml extensions are supported"))
+	if err = validateManifestContent(fileName, manifestContent); err != nil {
+		return nil, err
 	}
 
 	objectName := GetManifestObjectName(params.ClusterID, fileName)
@@ -233,8 +219,8 @@ func (m *Manifests) V2DownloadClusterManifest(ctx context.Context, params operat
 	return filemiddleware.NewResponder(operations.NewV2DownloadClusterManifestOK().WithPayload(respBody), params.FileName, contentLength, nil)
 }
 
-func (m *Manifests) setUsage(active bool, manifest *models.Manifest, clusterID strfmt.UUID) error {
-	err := m.db.Transaction(func(tx *gorm.DB) error {
+func (m *Manifests) setUsage(ctx context.Context, active bool, manifest *models.Manifest, clusterID strfmt.UUID) error {
+	err := transaction.Transaction(ctx, m.db, "set manifest usage", func(tx *gorm.DB) error {
 		cluster, err := common.GetClusterFromDB(tx, clusterID, common.SkipEagerLoading)
 		if err != nil {
 			return err
@@ -252,6 +238,29 @@ func (m *Manifests) setUsage(active bool, manifest *models.Manifest, clusterID s
 	return err
 }
 
+// validateManifestContent checks that the content of a manifest matches the format its extension implies
+func validateManifestContent(fileName string, manifestContent []byte) error {
+	extension := filepath.Ext(fileName)
+	if extension == ".yaml" || extension == ".yml" {
+		var s map[interface{}]interface{}
+		if yaml.Unmarshal(manifestContent, &s) != nil {
+			return common.NewApiError(http.StatusBadRequest, errors.New("Manifest content has an invalid YAML format"))
+		}
+	} else if extension == ".json" {
+		if !json.Valid(manifestContent) {
+			return common.NewApiError(http.StatusBadRequest, errors.New("Manifest content has an illegal JSON format"))
+		}
+	} else if strings.HasPrefix(extension, ".patch") && (strings.Contains(fileName, ".yaml.patch") || strings.Contains(fileName, ".yml.patch")) {
+		var s []map[interface{}]interface{}
+		if yaml.Unmarshal(manifestContent, &s) != nil {
+			return common.NewApiError(http.StatusBadRequest, errors.New("Patch content has an invalid YAML format"))
+		}
+	} else {
+		return common.NewApiError(http.StatusBadRequest, errors.New("Unsupported manifest extension. Only json, yaml and yml extensions are supported"))
+	}
+	return nil
+}
+
 // GetManifestObjectName returns the manifest object name as stored in S3
 func GetManifestObjectName(clusterID strfmt.UUID, fileName string) string {
 	return filepath.Join(string(clusterID), ManifestFolder, fileName)
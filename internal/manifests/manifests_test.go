@@ -6,6 +6,8 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/go-openapi/strfmt"
@@ -571,6 +573,72 @@ spec:
 	})
 })
 
+var _ = Describe("DirectoryManifests", func() {
+	var (
+		dir                string
+		directoryManifests *manifests.DirectoryManifests
+		ctx                = context.Background()
+		clusterID          = strfmt.UUID(uuid.New().String())
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "manifests")
+		Expect(err).ToNot(HaveOccurred())
+		directoryManifests = manifests.NewDirectoryManifests(dir)
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	create := func(folder, fileName, content string) error {
+		_, err := directoryManifests.CreateClusterManifestInternal(ctx, operations.V2CreateClusterManifestParams{
+			ClusterID: clusterID,
+			CreateManifestParams: &models.CreateManifestParams{
+				Folder:   swag.String(folder),
+				FileName: swag.String(fileName),
+				Content:  swag.String(encodeToBase64(content)),
+			},
+		})
+		return err
+	}
+
+	It("writes, lists and deletes manifests in their folders", func() {
+		Expect(create(models.ManifestFolderManifests, "a.yaml", contentAsYAML)).To(Succeed())
+		Expect(create(models.ManifestFolderOpenshift, "b.json", contentAsJSON)).To(Succeed())
+
+		content, err := os.ReadFile(filepath.Join(dir, "openshift", "b.json"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(content)).To(Equal(contentAsJSON))
+
+		list, err := directoryManifests.ListClusterManifestsInternal(ctx, operations.V2ListClusterManifestsParams{ClusterID: clusterID})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(list).To(ConsistOf(
+			&models.Manifest{Folder: models.ManifestFolderManifests, FileName: "a.yaml"},
+			&models.Manifest{Folder: models.ManifestFolderOpenshift, FileName: "b.json"},
+		))
+
+		Expect(directoryManifests.DeleteClusterManifestInternal(ctx, operations.V2DeleteClusterManifestParams{
+			ClusterID: clusterID,
+			FileName:  "a.yaml",
+		})).To(Succeed())
+		list, err = directoryManifests.ListClusterManifestsInternal(ctx, operations.V2ListClusterManifestsParams{ClusterID: clusterID})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(list).To(HaveLen(1))
+	})
+
+	It("validates the content of the manifests", func() {
+		err := create(models.ManifestFolderManifests, "a.json", contentAsYAML)
+		Expect(err).To(HaveOccurred())
+		Expect(err.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusBadRequest)))
+
+		err = create(models.ManifestFolderManifests, "a.txt", "text")
+		Expect(err).To(HaveOccurred())
+		Expect(err.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusBadRequest)))
+	})
+})
+
 type VoidReadCloser struct {
 }
 
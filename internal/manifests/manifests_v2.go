@@ -16,7 +16,7 @@ func (m *Manifests) V2CreateClusterManifest(ctx context.Context, params operatio
 	if err != nil {
 		return common.GenerateErrorResponder(err)
 	}
-	err = m.setUsage(true, manifest, params.ClusterID)
+	err = m.setUsage(ctx, true, manifest, params.ClusterID)
 	if err != nil {
 		// We don't want to return the error - the requested manifest was set successfully,  setting the feature usage failed.
 		log.Infof("Failed to set feature usage '%s' Error: %v. Manifest %v created by user successfully.", usage.CustomManifest, err, manifest)
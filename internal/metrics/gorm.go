@@ -0,0 +1,103 @@
+package metrics
+
+import (
+	"time"
+
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+const (
+	gormPluginName    = "metrics"
+	gormStartedAtKey  = "metrics:started_at"
+	unknownTableLabel = "unknown"
+	DBOperationCreate = "create"
+	DBOperationQuery  = "query"
+	DBOperationUpdate = "update"
+	DBOperationDelete = "delete"
+	DBOperationRow    = "row"
+	DBOperationRaw    = "raw"
+)
+
+// GormPlugin is a gorm plugin that reports the duration of every database query to the metrics
+// manager, by table and operation, and logs the queries that take longer than the slow query
+// threshold.
+type GormPlugin struct {
+	log                logrus.FieldLogger
+	metricsApi         API
+	slowQueryThreshold time.Duration
+}
+
+var _ gorm.Plugin = &GormPlugin{}
+
+// gormCallback is implemented by the (unexported) callback type returned by the gorm processors
+type gormCallback interface {
+	Register(name string, fn func(*gorm.DB)) error
+}
+
+// NewGormPlugin creates a gorm plugin reporting to the given metrics manager. A zero slow query
+// threshold disables the slow query reporting.
+func NewGormPlugin(log logrus.FieldLogger, metricsApi API, slowQueryThreshold time.Duration) *GormPlugin {
+	return &GormPlugin{
+		log:                log,
+		metricsApi:         metricsApi,
+		slowQueryThreshold: slowQueryThreshold,
+	}
+}
+
+func (p *GormPlugin) Name() string {
+	return gormPluginName
+}
+
+func (p *GormPlugin) Initialize(db *gorm.DB) error {
+	callbacks := db.Callback()
+	for _, c := range []struct {
+		operation string
+		before    gormCallback
+		after     gormCallback
+	}{
+		{DBOperationCreate, callbacks.Create().Before("*"), callbacks.Create().After("*")},
+		{DBOperationQuery, callbacks.Query().Before("*"), callbacks.Query().After("*")},
+		{DBOperationUpdate, callbacks.Update().Before("*"), callbacks.Update().After("*")},
+		{DBOperationDelete, callbacks.Delete().Before("*"), callbacks.Delete().After("*")},
+		{DBOperationRow, callbacks.Row().Before("*"), callbacks.Row().After("*")},
+		{DBOperationRaw, callbacks.Raw().Before("*"), callbacks.Raw().After("*")},
+	} {
+		if err := c.before.Register(gormPluginName+":before_"+c.operation, p.before); err != nil {
+			return err
+		}
+		if err := c.after.Register(gormPluginName+":after_"+c.operation, p.after(c.operation)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *GormPlugin) before(db *gorm.DB) {
+	db.InstanceSet(gormStartedAtKey, time.Now())
+}
+
+func (p *GormPlugin) after(operation string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		value, ok := db.InstanceGet(gormStartedAtKey)
+		if !ok {
+			return
+		}
+		startedAt, ok := value.(time.Time)
+		if !ok {
+			return
+		}
+		duration := time.Since(startedAt)
+		table := db.Statement.Table
+		if table == "" {
+			table = unknownTableLabel
+		}
+		p.metricsApi.DBQueryDuration(table, operation, duration)
+		if p.slowQueryThreshold > 0 && duration >= p.slowQueryThreshold {
+			p.metricsApi.DBSlowQuery(table, operation)
+			logutil.FromContext(db.Statement.Context, p.log).Warnf("Slow database %s on table %s took %s: %s",
+				operation, table, duration, db.Statement.SQL.String())
+		}
+	}
+}
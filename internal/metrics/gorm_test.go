@@ -0,0 +1,62 @@
+package metrics
+
+import (
+	"time"
+
+	gomock "github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("Gorm plugin", func() {
+	var (
+		ctrl       *gomock.Controller
+		metricsApi *MockAPI
+	)
+
+	// Statements are only built, not executed, so no database is needed:
+	openDB := func(slowQueryThreshold time.Duration) *gorm.DB {
+		db, err := gorm.Open(postgres.Open("host=localhost"), &gorm.Config{DryRun: true, DisableAutomaticPing: true, SkipDefaultTransaction: true})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(db.Use(NewGormPlugin(logrus.New(), metricsApi, slowQueryThreshold))).To(Succeed())
+		return db
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		metricsApi = NewMockAPI(ctrl)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("reports the query duration by table and operation", func() {
+		db := openDB(0)
+		metricsApi.EXPECT().DBQueryDuration("hosts", DBOperationQuery, gomock.Any()).Times(1)
+		metricsApi.EXPECT().DBQueryDuration("clusters", DBOperationUpdate, gomock.Any()).Times(1)
+		metricsApi.EXPECT().DBQueryDuration("hosts", DBOperationDelete, gomock.Any()).Times(1)
+		var hosts []*models.Host
+		Expect(db.Find(&hosts).Error).ToNot(HaveOccurred())
+		Expect(db.Model(&models.Cluster{}).Where("id = ?", "x").Update("name", "y").Error).ToNot(HaveOccurred())
+		Expect(db.Where("id = ?", "x").Delete(&models.Host{}).Error).ToNot(HaveOccurred())
+	})
+
+	It("reports raw queries without a table as unknown", func() {
+		db := openDB(0)
+		metricsApi.EXPECT().DBQueryDuration(unknownTableLabel, DBOperationRaw, gomock.Any()).Times(1)
+		Expect(db.Exec("SELECT 1").Error).ToNot(HaveOccurred())
+	})
+
+	It("reports slow queries", func() {
+		db := openDB(time.Nanosecond)
+		metricsApi.EXPECT().DBQueryDuration("hosts", DBOperationQuery, gomock.Any()).Times(1)
+		metricsApi.EXPECT().DBSlowQuery("hosts", DBOperationQuery).Times(1)
+		var hosts []*models.Host
+		Expect(db.Find(&hosts).Error).ToNot(HaveOccurred())
+	})
+})
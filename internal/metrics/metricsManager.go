@@ -39,6 +39,10 @@ const (
 	counterFilesystemUsagePercentage              = "assisted_installer_filesystem_usage_percentage"
 	counterMonitoredHosts                         = "assisted_installer_monitored_hosts"
 	counterMonitoredClusters                      = "assisted_installer_monitored_clusters"
+	counterDBQueryDurationMiliSeconds             = "assisted_installer_db_query_duration_miliseconds"
+	counterDBSlowQueries                          = "assisted_installer_db_slow_queries"
+	counterStepReplyDurationMiliSeconds           = "assisted_installer_step_reply_duration_miliseconds"
+	counterMonitorDurationSeconds                 = "assisted_installer_monitor_duration_seconds"
 )
 
 const (
@@ -61,6 +65,10 @@ const (
 	counterDescriptionFilesystemUsagePercentage              = "The percentage of the filesystem usage by the service"
 	counterDescriptionMonitoredHosts                         = "Number of hosts monitored by host monitor"
 	counterDescriptionMonitoredClusters                      = "Number of clusters monitored by cluster monitor"
+	counterDescriptionDBQueryDurationMiliSeconds             = "Histogram/sum/count of database query time, by table, operation"
+	counterDescriptionDBSlowQueries                          = "Number of database queries that took longer than the slow query threshold, by table, operation"
+	counterDescriptionStepReplyDurationMiliSeconds           = "Histogram/sum/count of the time spent handling a step reply, by step type"
+	counterDescriptionMonitorDurationSeconds                 = "Histogram/sum/count of the duration of a single monitor loop, by monitor"
 )
 
 const (
@@ -77,6 +85,9 @@ const (
 	imageLabel                 = "imageName"
 	hosts                      = "hosts"
 	clusters                   = "clusters"
+	tableLabel                 = "table"
+	stepTypeLabel              = "stepType"
+	monitorLabel               = "monitor"
 )
 
 type API interface {
@@ -94,6 +105,10 @@ type API interface {
 	FileSystemUsage(usageInPercentage float64)
 	MonitoredHostsCount(monitoredHosts int64)
 	MonitoredClusterCount(monitoredClusters int64)
+	DBQueryDuration(table, operation string, duration time.Duration)
+	DBSlowQuery(table, operation string)
+	StepReplyDuration(stepType models.StepType, duration time.Duration)
+	MonitorDuration(monitor string, duration time.Duration)
 }
 
 type MetricsManager struct {
@@ -119,6 +134,10 @@ type MetricsManager struct {
 	serviceLogicFilesystemUsagePercentage              *prometheus.GaugeVec
 	serviceLogicMonitoredHosts                         *prometheus.GaugeVec
 	serviceLogicMonitoredClusters                      *prometheus.GaugeVec
+	serviceLogicDBQueryDurationMiliSeconds             *prometheus.HistogramVec
+	serviceLogicDBSlowQueries                          *prometheus.CounterVec
+	serviceLogicStepReplyDurationMiliSeconds           *prometheus.HistogramVec
+	serviceLogicMonitorDurationSeconds                 *prometheus.HistogramVec
 }
 
 var _ API = &MetricsManager{}
@@ -280,6 +299,38 @@ func NewMetricsManager(registry prometheus.Registerer, eventsHandler eventsapi.H
 			Name:      counterMonitoredClusters,
 			Help:      counterDescriptionMonitoredClusters,
 		}, []string{hosts}),
+
+		serviceLogicDBQueryDurationMiliSeconds: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      counterDBQueryDurationMiliSeconds,
+			Help:      counterDescriptionDBQueryDurationMiliSeconds,
+			Buckets:   []float64{1, 5, 10, 25, 50, 100, 250, 500, 1000, 2500, 5000},
+		}, []string{tableLabel, operation}),
+
+		serviceLogicDBSlowQueries: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: subsystem,
+				Name:      counterDBSlowQueries,
+				Help:      counterDescriptionDBSlowQueries,
+			}, []string{tableLabel, operation}),
+
+		serviceLogicStepReplyDurationMiliSeconds: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      counterStepReplyDurationMiliSeconds,
+			Help:      counterDescriptionStepReplyDurationMiliSeconds,
+			Buckets:   []float64{5, 10, 25, 50, 100, 250, 500, 1000, 2500, 5000},
+		}, []string{stepTypeLabel}),
+
+		serviceLogicMonitorDurationSeconds: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      counterMonitorDurationSeconds,
+			Help:      counterDescriptionMonitorDurationSeconds,
+			Buckets:   []float64{0.1, 0.5, 1, 2, 5, 10, 20, 30, 60, 120, 300},
+		}, []string{monitorLabel}),
 	}
 
 	registry.MustRegister(
@@ -302,6 +353,10 @@ func NewMetricsManager(registry prometheus.Registerer, eventsHandler eventsapi.H
 		m.serviceLogicFilesystemUsagePercentage,
 		m.serviceLogicMonitoredHosts,
 		m.serviceLogicMonitoredClusters,
+		m.serviceLogicDBQueryDurationMiliSeconds,
+		m.serviceLogicDBSlowQueries,
+		m.serviceLogicStepReplyDurationMiliSeconds,
+		m.serviceLogicMonitorDurationSeconds,
 	)
 	return m
 }
@@ -468,6 +523,22 @@ func (m *MetricsManager) MonitoredClusterCount(monitoredClusters int64) {
 	m.serviceLogicMonitoredClusters.WithLabelValues(clusters).Set(float64(monitoredClusters))
 }
 
+func (m *MetricsManager) DBQueryDuration(table, operation string, duration time.Duration) {
+	m.serviceLogicDBQueryDurationMiliSeconds.WithLabelValues(table, operation).Observe(float64(duration.Milliseconds()))
+}
+
+func (m *MetricsManager) DBSlowQuery(table, operation string) {
+	m.serviceLogicDBSlowQueries.WithLabelValues(table, operation).Inc()
+}
+
+func (m *MetricsManager) StepReplyDuration(stepType models.StepType, duration time.Duration) {
+	m.serviceLogicStepReplyDurationMiliSeconds.WithLabelValues(string(stepType)).Observe(float64(duration.Milliseconds()))
+}
+
+func (m *MetricsManager) MonitorDuration(monitor string, duration time.Duration) {
+	m.serviceLogicMonitorDurationSeconds.WithLabelValues(monitor).Observe(duration.Seconds())
+}
+
 func bytesToGib(bytes int64) int64 {
 	return bytes / int64(units.GiB)
 }
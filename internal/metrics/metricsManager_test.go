@@ -81,3 +81,45 @@ var _ = DescribeTable(
 		`^service_assisted_installer_host_installation_phase_seconds_count\{.*phase="Configuring".*\} .*$`,
 	),
 )
+
+var _ = Describe("Timing metrics", func() {
+	var (
+		server  *MetricsServer
+		manager *MetricsManager
+	)
+
+	BeforeEach(func() {
+		server = NewMetricsServer()
+		manager = NewMetricsManager(server.Registry(), nil)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("reports the step reply duration by step type", func() {
+		manager.StepReplyDuration(models.StepTypeInventory, 30*time.Millisecond)
+		Expect(server.Metrics()).To(MatchLine(
+			`^service_assisted_installer_step_reply_duration_miliseconds_bucket\{stepType="inventory",le="50"\} 1$`,
+		))
+	})
+
+	It("reports the monitor loop duration by monitor", func() {
+		manager.MonitorDuration("HostMonitoring", 7*time.Second)
+		Expect(server.Metrics()).To(MatchLine(
+			`^service_assisted_installer_monitor_duration_seconds_sum\{monitor="HostMonitoring"\} 7$`,
+		))
+	})
+
+	It("reports the database query duration and slow queries by table and operation", func() {
+		manager.DBQueryDuration("hosts", "update", 20*time.Millisecond)
+		manager.DBSlowQuery("hosts", "update")
+		metrics := server.Metrics()
+		Expect(metrics).To(MatchLine(
+			`^service_assisted_installer_db_query_duration_miliseconds_count\{operation="update",table="hosts"\} 1$`,
+		))
+		Expect(metrics).To(MatchLine(
+			`^service_assisted_installer_db_slow_queries\{operation="update",table="hosts"\} 1$`,
+		))
+	})
+})
@@ -85,6 +85,30 @@ func (mr *MockAPIMockRecorder) ClusterValidationFailed(clusterValidationType int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClusterValidationFailed", reflect.TypeOf((*MockAPI)(nil).ClusterValidationFailed), clusterValidationType)
 }
 
+// DBQueryDuration mocks base method.
+func (m *MockAPI) DBQueryDuration(table, operation string, duration time.Duration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DBQueryDuration", table, operation, duration)
+}
+
+// DBQueryDuration indicates an expected call of DBQueryDuration.
+func (mr *MockAPIMockRecorder) DBQueryDuration(table, operation, duration interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DBQueryDuration", reflect.TypeOf((*MockAPI)(nil).DBQueryDuration), table, operation, duration)
+}
+
+// DBSlowQuery mocks base method.
+func (m *MockAPI) DBSlowQuery(table, operation string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DBSlowQuery", table, operation)
+}
+
+// DBSlowQuery indicates an expected call of DBSlowQuery.
+func (mr *MockAPIMockRecorder) DBSlowQuery(table, operation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DBSlowQuery", reflect.TypeOf((*MockAPI)(nil).DBSlowQuery), table, operation)
+}
+
 // DiskSyncDuration mocks base method.
 func (m *MockAPI) DiskSyncDuration(syncDuration int64) {
 	m.ctrl.T.Helper()
@@ -169,6 +193,18 @@ func (mr *MockAPIMockRecorder) InstallationStarted() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallationStarted", reflect.TypeOf((*MockAPI)(nil).InstallationStarted))
 }
 
+// MonitorDuration mocks base method.
+func (m *MockAPI) MonitorDuration(monitor string, duration time.Duration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "MonitorDuration", monitor, duration)
+}
+
+// MonitorDuration indicates an expected call of MonitorDuration.
+func (mr *MockAPIMockRecorder) MonitorDuration(monitor, duration interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MonitorDuration", reflect.TypeOf((*MockAPI)(nil).MonitorDuration), monitor, duration)
+}
+
 // MonitoredClusterCount mocks base method.
 func (m *MockAPI) MonitoredClusterCount(monitoredClusters int64) {
 	m.ctrl.T.Helper()
@@ -204,3 +240,15 @@ func (mr *MockAPIMockRecorder) ReportHostInstallationMetrics(ctx, clusterVersion
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportHostInstallationMetrics", reflect.TypeOf((*MockAPI)(nil).ReportHostInstallationMetrics), ctx, clusterVersion, clusterID, emailDomain, boot, h, previousProgress, currentStage)
 }
+
+// StepReplyDuration mocks base method.
+func (m *MockAPI) StepReplyDuration(stepType models.StepType, duration time.Duration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "StepReplyDuration", stepType, duration)
+}
+
+// StepReplyDuration indicates an expected call of StepReplyDuration.
+func (mr *MockAPIMockRecorder) StepReplyDuration(stepType, duration interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StepReplyDuration", reflect.TypeOf((*MockAPI)(nil).StepReplyDuration), stepType, duration)
+}
@@ -0,0 +1,30 @@
+package migrations
+
+import (
+	"fmt"
+
+	gormigrate "github.com/go-gormigrate/gormigrate/v2"
+	"github.com/openshift/assisted-service/internal/common"
+	"gorm.io/gorm"
+)
+
+const indexHostMacAddressesID = "20261019100000"
+
+// indexHostMacAddresses adds a GIN index on the MAC addresses of the host inventories, so that hosts can be looked
+// up by MAC address without scanning the inventories of all the hosts
+func indexHostMacAddresses() *gormigrate.Migration {
+	migrate := func(tx *gorm.DB) error {
+		return tx.Exec(fmt.Sprintf("CREATE INDEX IF NOT EXISTS %s ON hosts USING gin ((%s))",
+			common.HostMacAddressesIndex, common.HostMacAddressesQuery)).Error
+	}
+
+	rollback := func(tx *gorm.DB) error {
+		return tx.Exec(fmt.Sprintf("DROP INDEX IF EXISTS %s", common.HostMacAddressesIndex)).Error
+	}
+
+	return &gormigrate.Migration{
+		ID:       indexHostMacAddressesID,
+		Migrate:  migrate,
+		Rollback: rollback,
+	}
+}
@@ -0,0 +1,38 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("index host MAC addresses", func() {
+	var (
+		db     *gorm.DB
+		dbName string
+		gm     *gormigrate.Gormigrate
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		gm = gormigrate.New(db, gormigrate.DefaultOptions, post())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("Migrates down and up", func() {
+		Expect(gm.MigrateTo(indexHostMacAddressesID)).ToNot(HaveOccurred())
+		Expect(db.Migrator().HasIndex(&models.Host{}, common.HostMacAddressesIndex)).To(BeTrue())
+
+		Expect(gm.RollbackMigration(indexHostMacAddresses())).ToNot(HaveOccurred())
+		Expect(db.Migrator().HasIndex(&models.Host{}, common.HostMacAddressesIndex)).To(BeFalse())
+
+		Expect(gm.MigrateTo(indexHostMacAddressesID)).ToNot(HaveOccurred())
+		Expect(db.Migrator().HasIndex(&models.Host{}, common.HostMacAddressesIndex)).To(BeTrue())
+	})
+})
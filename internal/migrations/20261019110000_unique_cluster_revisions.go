@@ -0,0 +1,35 @@
+package migrations
+
+import (
+	"fmt"
+
+	gormigrate "github.com/go-gormigrate/gormigrate/v2"
+	"github.com/openshift/assisted-service/internal/common"
+	"gorm.io/gorm"
+)
+
+const uniqueClusterRevisionsID = "20261019110000"
+
+// uniqueClusterRevisions adds a unique index on the revision numbers of each cluster. Revisions that were given the
+// same number by concurrent updates are renumbered first, keeping their order
+func uniqueClusterRevisions() *gormigrate.Migration {
+	migrate := func(tx *gorm.DB) error {
+		if err := tx.Exec(`UPDATE cluster_revisions r SET revision = n.revision
+			FROM (SELECT id, ROW_NUMBER() OVER (PARTITION BY cluster_id ORDER BY revision, id) AS revision FROM cluster_revisions) n
+			WHERE r.id = n.id AND r.revision <> n.revision`).Error; err != nil {
+			return err
+		}
+		return tx.Exec(fmt.Sprintf("CREATE UNIQUE INDEX IF NOT EXISTS %s ON cluster_revisions (cluster_id, revision)",
+			common.ClusterRevisionsUniqueIndex)).Error
+	}
+
+	rollback := func(tx *gorm.DB) error {
+		return tx.Exec(fmt.Sprintf("DROP INDEX IF EXISTS %s", common.ClusterRevisionsUniqueIndex)).Error
+	}
+
+	return &gormigrate.Migration{
+		ID:       uniqueClusterRevisionsID,
+		Migrate:  migrate,
+		Rollback: rollback,
+	}
+}
@@ -0,0 +1,68 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("unique cluster revisions", func() {
+	var (
+		db        *gorm.DB
+		dbName    string
+		gm        *gormigrate.Gormigrate
+		clusterID strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		gm = gormigrate.New(db, gormigrate.DefaultOptions, post())
+		clusterID = strfmt.UUID(uuid.New().String())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	createRevision := func(revision int64) error {
+		return db.Create(&common.ClusterRevision{ClusterRevision: models.ClusterRevision{
+			ClusterID:     &clusterID,
+			Revision:      swag.Int64(revision),
+			Configuration: swag.String("{}"),
+		}}).Error
+	}
+
+	revisions := func() []int64 {
+		var result []int64
+		Expect(db.Model(&common.ClusterRevision{}).Where("cluster_id = ?", clusterID.String()).
+			Order("id").Pluck("revision", &result).Error).ToNot(HaveOccurred())
+		return result
+	}
+
+	It("Renumbers duplicate revisions", func() {
+		for _, revision := range []int64{1, 2, 2, 3} {
+			Expect(createRevision(revision)).To(Succeed())
+		}
+
+		Expect(gm.MigrateTo(uniqueClusterRevisionsID)).ToNot(HaveOccurred())
+		Expect(revisions()).To(Equal([]int64{1, 2, 3, 4}))
+		Expect(createRevision(4)).ToNot(Succeed())
+	})
+
+	It("Migrates down and up", func() {
+		Expect(gm.MigrateTo(uniqueClusterRevisionsID)).ToNot(HaveOccurred())
+		Expect(db.Migrator().HasIndex(&common.ClusterRevision{}, common.ClusterRevisionsUniqueIndex)).To(BeTrue())
+
+		Expect(gm.RollbackMigration(uniqueClusterRevisions())).ToNot(HaveOccurred())
+		Expect(db.Migrator().HasIndex(&common.ClusterRevision{}, common.ClusterRevisionsUniqueIndex)).To(BeFalse())
+
+		Expect(gm.MigrateTo(uniqueClusterRevisionsID)).ToNot(HaveOccurred())
+		Expect(db.Migrator().HasIndex(&common.ClusterRevision{}, common.ClusterRevisionsUniqueIndex)).To(BeTrue())
+	})
+})
@@ -41,6 +41,8 @@ func post() []*gormigrate.Migration {
 		dropClusterIgnitionOverrides(),
 		multipleVips(),
 		renameKernelArguments(),
+		indexHostMacAddresses(),
+		uniqueClusterRevisions(),
 	}
 
 	sort.SliceStable(postMigrations, func(i, j int) bool { return postMigrations[i].ID < postMigrations[j].ID })
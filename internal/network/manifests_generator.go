@@ -31,6 +31,8 @@ type ManifestsGeneratorAPI interface {
 	AddSchedulableMastersManifest(ctx context.Context, log logrus.FieldLogger, c *common.Cluster) error
 	AddDiskEncryptionManifest(ctx context.Context, log logrus.FieldLogger, c *common.Cluster) error
 	IsSNODNSMasqEnabled() bool
+	// WithManifestsAPI returns a generator with the same configuration that creates the manifests with the given API
+	WithManifestsAPI(manifestsApi manifestsapi.ClusterManifestsInternals) ManifestsGeneratorAPI
 }
 
 type Config struct {
@@ -39,17 +41,21 @@ type Config struct {
 }
 
 type ManifestsGenerator struct {
-	manifestsApi manifestsapi.ManifestsAPI
+	manifestsApi manifestsapi.ClusterManifestsInternals
 	Config       Config
 }
 
-func NewManifestsGenerator(manifestsApi manifestsapi.ManifestsAPI, config Config) *ManifestsGenerator {
+func NewManifestsGenerator(manifestsApi manifestsapi.ClusterManifestsInternals, config Config) *ManifestsGenerator {
 	return &ManifestsGenerator{
 		manifestsApi: manifestsApi,
 		Config:       config,
 	}
 }
 
+func (m *ManifestsGenerator) WithManifestsAPI(manifestsApi manifestsapi.ClusterManifestsInternals) ManifestsGeneratorAPI {
+	return NewManifestsGenerator(manifestsApi, m.Config)
+}
+
 const defaultChronyConf = `
 pool 0.rhel.pool.ntp.org iburst
 driftfile /var/lib/chrony/drift
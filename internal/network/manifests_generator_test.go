@@ -164,6 +164,13 @@ var _ = Describe("chrony manifest", func() {
 			manifestsApi.EXPECT().CreateClusterManifestInternal(gomock.Any(), gomock.Any()).Return(nil, errors.Errorf("Failed to create manifest %s", fileName)).Times(1)
 			Expect(ntpUtils.AddChronyManifest(ctx, log, &cluster)).Should(HaveOccurred())
 		})
+
+		It("CreateClusterManifest with another manifests API", func() {
+			otherManifestsApi := manifestsapi.NewMockClusterManifestsInternals(ctrl)
+			otherManifestsApi.EXPECT().CreateClusterManifestInternal(gomock.Any(), gomock.Any()).Return(&models.Manifest{}, nil).Times(2)
+			manifestsApi.EXPECT().CreateClusterManifestInternal(gomock.Any(), gomock.Any()).Times(0)
+			Expect(ntpUtils.WithManifestsAPI(otherManifestsApi).AddChronyManifest(ctx, log, &cluster)).ShouldNot(HaveOccurred())
+		})
 	})
 })
 
@@ -10,6 +10,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	common "github.com/openshift/assisted-service/internal/common"
+	api "github.com/openshift/assisted-service/internal/manifests/api"
 	logrus "github.com/sirupsen/logrus"
 )
 
@@ -133,3 +134,17 @@ func (mr *MockManifestsGeneratorAPIMockRecorder) IsSNODNSMasqEnabled() *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSNODNSMasqEnabled", reflect.TypeOf((*MockManifestsGeneratorAPI)(nil).IsSNODNSMasqEnabled))
 }
+
+// WithManifestsAPI mocks base method.
+func (m *MockManifestsGeneratorAPI) WithManifestsAPI(manifestsApi api.ClusterManifestsInternals) ManifestsGeneratorAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithManifestsAPI", manifestsApi)
+	ret0, _ := ret[0].(ManifestsGeneratorAPI)
+	return ret0
+}
+
+// WithManifestsAPI indicates an expected call of WithManifestsAPI.
+func (mr *MockManifestsGeneratorAPIMockRecorder) WithManifestsAPI(manifestsApi interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithManifestsAPI", reflect.TypeOf((*MockManifestsGeneratorAPI)(nil).WithManifestsAPI), manifestsApi)
+}
@@ -5,6 +5,7 @@
 package oc
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -36,121 +37,121 @@ func (m *MockRelease) EXPECT() *MockReleaseMockRecorder {
 }
 
 // Extract mocks base method.
-func (m *MockRelease) Extract(log logrus.FieldLogger, releaseImage, releaseImageMirror, cacheDir, pullSecret string, platformType models.PlatformType, icspFile string) (string, error) {
+func (m *MockRelease) Extract(ctx context.Context, log logrus.FieldLogger, releaseImage, releaseImageMirror, cacheDir, pullSecret string, platformType models.PlatformType, icspFile string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Extract", log, releaseImage, releaseImageMirror, cacheDir, pullSecret, platformType, icspFile)
+	ret := m.ctrl.Call(m, "Extract", ctx, log, releaseImage, releaseImageMirror, cacheDir, pullSecret, platformType, icspFile)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Extract indicates an expected call of Extract.
-func (mr *MockReleaseMockRecorder) Extract(log, releaseImage, releaseImageMirror, cacheDir, pullSecret, platformType, icspFile interface{}) *gomock.Call {
+func (mr *MockReleaseMockRecorder) Extract(ctx, log, releaseImage, releaseImageMirror, cacheDir, pullSecret, platformType, icspFile interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Extract", reflect.TypeOf((*MockRelease)(nil).Extract), log, releaseImage, releaseImageMirror, cacheDir, pullSecret, platformType, icspFile)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Extract", reflect.TypeOf((*MockRelease)(nil).Extract), ctx, log, releaseImage, releaseImageMirror, cacheDir, pullSecret, platformType, icspFile)
 }
 
 // GetIronicAgentImage mocks base method.
-func (m *MockRelease) GetIronicAgentImage(log logrus.FieldLogger, releaseImage, releaseImageMirror, pullSecret string) (string, error) {
+func (m *MockRelease) GetIronicAgentImage(ctx context.Context, log logrus.FieldLogger, releaseImage, releaseImageMirror, pullSecret string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetIronicAgentImage", log, releaseImage, releaseImageMirror, pullSecret)
+	ret := m.ctrl.Call(m, "GetIronicAgentImage", ctx, log, releaseImage, releaseImageMirror, pullSecret)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetIronicAgentImage indicates an expected call of GetIronicAgentImage.
-func (mr *MockReleaseMockRecorder) GetIronicAgentImage(log, releaseImage, releaseImageMirror, pullSecret interface{}) *gomock.Call {
+func (mr *MockReleaseMockRecorder) GetIronicAgentImage(ctx, log, releaseImage, releaseImageMirror, pullSecret interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIronicAgentImage", reflect.TypeOf((*MockRelease)(nil).GetIronicAgentImage), log, releaseImage, releaseImageMirror, pullSecret)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIronicAgentImage", reflect.TypeOf((*MockRelease)(nil).GetIronicAgentImage), ctx, log, releaseImage, releaseImageMirror, pullSecret)
 }
 
 // GetMCOImage mocks base method.
-func (m *MockRelease) GetMCOImage(log logrus.FieldLogger, releaseImage, releaseImageMirror, pullSecret string) (string, error) {
+func (m *MockRelease) GetMCOImage(ctx context.Context, log logrus.FieldLogger, releaseImage, releaseImageMirror, pullSecret string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMCOImage", log, releaseImage, releaseImageMirror, pullSecret)
+	ret := m.ctrl.Call(m, "GetMCOImage", ctx, log, releaseImage, releaseImageMirror, pullSecret)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetMCOImage indicates an expected call of GetMCOImage.
-func (mr *MockReleaseMockRecorder) GetMCOImage(log, releaseImage, releaseImageMirror, pullSecret interface{}) *gomock.Call {
+func (mr *MockReleaseMockRecorder) GetMCOImage(ctx, log, releaseImage, releaseImageMirror, pullSecret interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMCOImage", reflect.TypeOf((*MockRelease)(nil).GetMCOImage), log, releaseImage, releaseImageMirror, pullSecret)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMCOImage", reflect.TypeOf((*MockRelease)(nil).GetMCOImage), ctx, log, releaseImage, releaseImageMirror, pullSecret)
 }
 
 // GetMajorMinorVersion mocks base method.
-func (m *MockRelease) GetMajorMinorVersion(log logrus.FieldLogger, releaseImage, releaseImageMirror, pullSecret string) (string, error) {
+func (m *MockRelease) GetMajorMinorVersion(ctx context.Context, log logrus.FieldLogger, releaseImage, releaseImageMirror, pullSecret string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMajorMinorVersion", log, releaseImage, releaseImageMirror, pullSecret)
+	ret := m.ctrl.Call(m, "GetMajorMinorVersion", ctx, log, releaseImage, releaseImageMirror, pullSecret)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetMajorMinorVersion indicates an expected call of GetMajorMinorVersion.
-func (mr *MockReleaseMockRecorder) GetMajorMinorVersion(log, releaseImage, releaseImageMirror, pullSecret interface{}) *gomock.Call {
+func (mr *MockReleaseMockRecorder) GetMajorMinorVersion(ctx, log, releaseImage, releaseImageMirror, pullSecret interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMajorMinorVersion", reflect.TypeOf((*MockRelease)(nil).GetMajorMinorVersion), log, releaseImage, releaseImageMirror, pullSecret)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMajorMinorVersion", reflect.TypeOf((*MockRelease)(nil).GetMajorMinorVersion), ctx, log, releaseImage, releaseImageMirror, pullSecret)
 }
 
 // GetMustGatherImage mocks base method.
-func (m *MockRelease) GetMustGatherImage(log logrus.FieldLogger, releaseImage, releaseImageMirror, pullSecret string) (string, error) {
+func (m *MockRelease) GetMustGatherImage(ctx context.Context, log logrus.FieldLogger, releaseImage, releaseImageMirror, pullSecret string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMustGatherImage", log, releaseImage, releaseImageMirror, pullSecret)
+	ret := m.ctrl.Call(m, "GetMustGatherImage", ctx, log, releaseImage, releaseImageMirror, pullSecret)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetMustGatherImage indicates an expected call of GetMustGatherImage.
-func (mr *MockReleaseMockRecorder) GetMustGatherImage(log, releaseImage, releaseImageMirror, pullSecret interface{}) *gomock.Call {
+func (mr *MockReleaseMockRecorder) GetMustGatherImage(ctx, log, releaseImage, releaseImageMirror, pullSecret interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMustGatherImage", reflect.TypeOf((*MockRelease)(nil).GetMustGatherImage), log, releaseImage, releaseImageMirror, pullSecret)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMustGatherImage", reflect.TypeOf((*MockRelease)(nil).GetMustGatherImage), ctx, log, releaseImage, releaseImageMirror, pullSecret)
 }
 
 // GetOKDRPMSImage mocks base method.
-func (m *MockRelease) GetOKDRPMSImage(log logrus.FieldLogger, releaseImage, releaseImageMirror, pullSecret string) (string, error) {
+func (m *MockRelease) GetOKDRPMSImage(ctx context.Context, log logrus.FieldLogger, releaseImage, releaseImageMirror, pullSecret string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOKDRPMSImage", log, releaseImage, releaseImageMirror, pullSecret)
+	ret := m.ctrl.Call(m, "GetOKDRPMSImage", ctx, log, releaseImage, releaseImageMirror, pullSecret)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetOKDRPMSImage indicates an expected call of GetOKDRPMSImage.
-func (mr *MockReleaseMockRecorder) GetOKDRPMSImage(log, releaseImage, releaseImageMirror, pullSecret interface{}) *gomock.Call {
+func (mr *MockReleaseMockRecorder) GetOKDRPMSImage(ctx, log, releaseImage, releaseImageMirror, pullSecret interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOKDRPMSImage", reflect.TypeOf((*MockRelease)(nil).GetOKDRPMSImage), log, releaseImage, releaseImageMirror, pullSecret)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOKDRPMSImage", reflect.TypeOf((*MockRelease)(nil).GetOKDRPMSImage), ctx, log, releaseImage, releaseImageMirror, pullSecret)
 }
 
 // GetOpenshiftVersion mocks base method.
-func (m *MockRelease) GetOpenshiftVersion(log logrus.FieldLogger, releaseImage, releaseImageMirror, pullSecret string) (string, error) {
+func (m *MockRelease) GetOpenshiftVersion(ctx context.Context, log logrus.FieldLogger, releaseImage, releaseImageMirror, pullSecret string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOpenshiftVersion", log, releaseImage, releaseImageMirror, pullSecret)
+	ret := m.ctrl.Call(m, "GetOpenshiftVersion", ctx, log, releaseImage, releaseImageMirror, pullSecret)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetOpenshiftVersion indicates an expected call of GetOpenshiftVersion.
-func (mr *MockReleaseMockRecorder) GetOpenshiftVersion(log, releaseImage, releaseImageMirror, pullSecret interface{}) *gomock.Call {
+func (mr *MockReleaseMockRecorder) GetOpenshiftVersion(ctx, log, releaseImage, releaseImageMirror, pullSecret interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenshiftVersion", reflect.TypeOf((*MockRelease)(nil).GetOpenshiftVersion), log, releaseImage, releaseImageMirror, pullSecret)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenshiftVersion", reflect.TypeOf((*MockRelease)(nil).GetOpenshiftVersion), ctx, log, releaseImage, releaseImageMirror, pullSecret)
 }
 
 // GetReleaseArchitecture mocks base method.
-func (m *MockRelease) GetReleaseArchitecture(log logrus.FieldLogger, releaseImage, releaseImageMirror, pullSecret string) ([]string, error) {
+func (m *MockRelease) GetReleaseArchitecture(ctx context.Context, log logrus.FieldLogger, releaseImage, releaseImageMirror, pullSecret string) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetReleaseArchitecture", log, releaseImage, releaseImageMirror, pullSecret)
+	ret := m.ctrl.Call(m, "GetReleaseArchitecture", ctx, log, releaseImage, releaseImageMirror, pullSecret)
 	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetReleaseArchitecture indicates an expected call of GetReleaseArchitecture.
-func (mr *MockReleaseMockRecorder) GetReleaseArchitecture(log, releaseImage, releaseImageMirror, pullSecret interface{}) *gomock.Call {
+func (mr *MockReleaseMockRecorder) GetReleaseArchitecture(ctx, log, releaseImage, releaseImageMirror, pullSecret interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReleaseArchitecture", reflect.TypeOf((*MockRelease)(nil).GetReleaseArchitecture), log, releaseImage, releaseImageMirror, pullSecret)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReleaseArchitecture", reflect.TypeOf((*MockRelease)(nil).GetReleaseArchitecture), ctx, log, releaseImage, releaseImageMirror, pullSecret)
 }
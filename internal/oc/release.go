@@ -1,6 +1,7 @@
 package oc
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -16,10 +17,12 @@ import (
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/executer"
 	"github.com/openshift/assisted-service/pkg/mirrorregistries"
+	"github.com/openshift/assisted-service/pkg/tracing"
 	"github.com/patrickmn/go-cache"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/thedevsaddam/retry"
+	"go.opentelemetry.io/otel/attribute"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8syaml "sigs.k8s.io/yaml"
 )
@@ -33,6 +36,8 @@ const (
 	DefaltRetryDelay     = time.Second * 5
 )
 
+const releaseImageKey = attribute.Key("release_image")
+
 type Config struct {
 	MaxTries   uint
 	RetryDelay time.Duration
@@ -40,14 +45,14 @@ type Config struct {
 
 //go:generate mockgen -source=release.go -package=oc -destination=mock_release.go
 type Release interface {
-	GetMCOImage(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) (string, error)
-	GetIronicAgentImage(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) (string, error)
-	GetOKDRPMSImage(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) (string, error)
-	GetMustGatherImage(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) (string, error)
-	GetOpenshiftVersion(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) (string, error)
-	GetMajorMinorVersion(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) (string, error)
-	GetReleaseArchitecture(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) ([]string, error)
-	Extract(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, cacheDir string, pullSecret string, platformType models.PlatformType, icspFile string) (string, error)
+	GetMCOImage(ctx context.Context, log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) (string, error)
+	GetIronicAgentImage(ctx context.Context, log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) (string, error)
+	GetOKDRPMSImage(ctx context.Context, log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) (string, error)
+	GetMustGatherImage(ctx context.Context, log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) (string, error)
+	GetOpenshiftVersion(ctx context.Context, log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) (string, error)
+	GetMajorMinorVersion(ctx context.Context, log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) (string, error)
+	GetReleaseArchitecture(ctx context.Context, log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) ([]string, error)
+	Extract(ctx context.Context, log logrus.FieldLogger, releaseImage string, releaseImageMirror string, cacheDir string, pullSecret string, platformType models.PlatformType, icspFile string) (string, error)
 }
 
 type imageValue struct {
@@ -85,27 +90,27 @@ const (
 
 // GetMCOImage gets mcoImage url from the releaseImageMirror if provided.
 // Else gets it from the source releaseImage
-func (r *release) GetMCOImage(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) (string, error) {
-	return r.getImageByName(log, mcoImageName, releaseImage, releaseImageMirror, pullSecret)
+func (r *release) GetMCOImage(ctx context.Context, log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) (string, error) {
+	return r.getImageByName(ctx, log, mcoImageName, releaseImage, releaseImageMirror, pullSecret)
 }
 
 // GetIronicAgentImage gets the ironic agent image url from the releaseImageMirror if provided.
 // Else gets it from the source releaseImage
-func (r *release) GetIronicAgentImage(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) (string, error) {
-	return r.getImageByName(log, ironicAgentImageName, releaseImage, releaseImageMirror, pullSecret)
+func (r *release) GetIronicAgentImage(ctx context.Context, log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) (string, error) {
+	return r.getImageByName(ctx, log, ironicAgentImageName, releaseImage, releaseImageMirror, pullSecret)
 }
 
 // GetOKDRPMSImage gets okd RPMS image URL from the release image or releaseImageMirror, if provided.
-func (r *release) GetOKDRPMSImage(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) (string, error) {
-	return r.getImageByName(log, okdRPMSImageName, releaseImage, releaseImageMirror, pullSecret)
+func (r *release) GetOKDRPMSImage(ctx context.Context, log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) (string, error) {
+	return r.getImageByName(ctx, log, okdRPMSImageName, releaseImage, releaseImageMirror, pullSecret)
 }
 
 // GetMustGatherImage gets must-gather image URL from the release image or releaseImageMirror, if provided.
-func (r *release) GetMustGatherImage(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) (string, error) {
-	return r.getImageByName(log, mustGatherImageName, releaseImage, releaseImageMirror, pullSecret)
+func (r *release) GetMustGatherImage(ctx context.Context, log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) (string, error) {
+	return r.getImageByName(ctx, log, mustGatherImageName, releaseImage, releaseImageMirror, pullSecret)
 }
 
-func (r *release) getImageByName(log logrus.FieldLogger, imageName, releaseImage, releaseImageMirror, pullSecret string) (string, error) {
+func (r *release) getImageByName(ctx context.Context, log logrus.FieldLogger, imageName, releaseImage, releaseImageMirror, pullSecret string) (string, error) {
 	var image string
 	var err error
 	if releaseImage == "" && releaseImageMirror == "" {
@@ -120,13 +125,13 @@ func (r *release) getImageByName(log logrus.FieldLogger, imageName, releaseImage
 
 	if releaseImageMirror != "" {
 		//TODO: Get mirror registry certificate from install-config
-		image, err = r.getImageFromRelease(log, imageName, releaseImageMirror, pullSecret, icspFile, true)
+		image, err = r.getImageFromRelease(ctx, log, imageName, releaseImageMirror, pullSecret, icspFile, true)
 		if err != nil {
 			log.WithError(err).Errorf("failed to get %s image from mirror release image %s", imageName, releaseImageMirror)
 			return "", err
 		}
 	} else {
-		image, err = r.getImageFromRelease(log, imageName, releaseImage, pullSecret, icspFile, false)
+		image, err = r.getImageFromRelease(ctx, log, imageName, releaseImage, pullSecret, icspFile, false)
 		if err != nil {
 			log.WithError(err).Errorf("failed to get %s image from release image %s", imageName, releaseImage)
 			return "", err
@@ -135,7 +140,7 @@ func (r *release) getImageByName(log logrus.FieldLogger, imageName, releaseImage
 	return image, err
 }
 
-func (r *release) GetOpenshiftVersion(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) (string, error) {
+func (r *release) GetOpenshiftVersion(ctx context.Context, log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) (string, error) {
 	var openshiftVersion string
 	var err error
 	if releaseImage == "" && releaseImageMirror == "" {
@@ -150,13 +155,13 @@ func (r *release) GetOpenshiftVersion(log logrus.FieldLogger, releaseImage strin
 
 	if releaseImageMirror != "" {
 		//TODO: Get mirror registry certificate from install-config
-		openshiftVersion, err = r.getOpenshiftVersionFromRelease(log, releaseImageMirror, pullSecret, icspFile, true)
+		openshiftVersion, err = r.getOpenshiftVersionFromRelease(ctx, log, releaseImageMirror, pullSecret, icspFile, true)
 		if err != nil {
 			log.WithError(err).Errorf("failed to get image openshift version from mirror release image %s", releaseImageMirror)
 			return "", err
 		}
 	} else {
-		openshiftVersion, err = r.getOpenshiftVersionFromRelease(log, releaseImage, pullSecret, icspFile, false)
+		openshiftVersion, err = r.getOpenshiftVersionFromRelease(ctx, log, releaseImage, pullSecret, icspFile, false)
 		if err != nil {
 			log.WithError(err).Errorf("failed to get image openshift version from release image %s", releaseImage)
 			return "", err
@@ -166,8 +171,8 @@ func (r *release) GetOpenshiftVersion(log logrus.FieldLogger, releaseImage strin
 	return openshiftVersion, err
 }
 
-func (r *release) GetMajorMinorVersion(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) (string, error) {
-	openshiftVersion, err := r.GetOpenshiftVersion(log, releaseImage, releaseImageMirror, pullSecret)
+func (r *release) GetMajorMinorVersion(ctx context.Context, log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) (string, error) {
+	openshiftVersion, err := r.GetOpenshiftVersion(ctx, log, releaseImage, releaseImageMirror, pullSecret)
 	if err != nil {
 		return "", err
 	}
@@ -180,7 +185,7 @@ func (r *release) GetMajorMinorVersion(log logrus.FieldLogger, releaseImage stri
 	return fmt.Sprintf("%d.%d", v.Segments()[0], v.Segments()[1]), nil
 }
 
-func (r *release) GetReleaseArchitecture(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) ([]string, error) {
+func (r *release) GetReleaseArchitecture(ctx context.Context, log logrus.FieldLogger, releaseImage string, releaseImageMirror string, pullSecret string) ([]string, error) {
 	image := releaseImageMirror
 	if image == "" {
 		image = releaseImage
@@ -266,7 +271,7 @@ func (r *release) getImageValue(imageName, releaseImage string) (*imageValue, er
 	return value, nil
 }
 
-func (r *release) getImageFromRelease(log logrus.FieldLogger, imageName, releaseImage, pullSecret, icspFile string, insecure bool) (string, error) {
+func (r *release) getImageFromRelease(ctx context.Context, log logrus.FieldLogger, imageName, releaseImage, pullSecret, icspFile string, insecure bool) (image string, err error) {
 	// Fetch image URL from cache
 	actualImageValue, err := r.getImageValue(imageName, releaseImage)
 	if err != nil {
@@ -288,8 +293,13 @@ func (r *release) getImageFromRelease(log logrus.FieldLogger, imageName, release
 		cmd = fmt.Sprintf(templateGetImageWithIcsp, imageName, insecure, icspFile, releaseImage)
 	}
 
+	_, span := tracing.StartSpan(ctx, "oc release info "+imageName, releaseImageKey.String(releaseImage))
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
 	log.Infof("Fetching image from OCP release (%s)", cmd)
-	image, err := execute(log, r.executer, pullSecret, cmd, ocAuthArgument)
+	image, err = execute(log, r.executer, pullSecret, cmd, ocAuthArgument)
 	if err != nil {
 		return "", err
 	}
@@ -300,7 +310,12 @@ func (r *release) getImageFromRelease(log logrus.FieldLogger, imageName, release
 	return image, nil
 }
 
-func (r *release) getOpenshiftVersionFromRelease(log logrus.FieldLogger, releaseImage, pullSecret, icspFile string, insecure bool) (string, error) {
+func (r *release) getOpenshiftVersionFromRelease(ctx context.Context, log logrus.FieldLogger, releaseImage, pullSecret, icspFile string, insecure bool) (_ string, err error) {
+	_, span := tracing.StartSpan(ctx, "oc release info version", releaseImageKey.String(releaseImage))
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
 	var cmd string
 	if icspFile == "" {
 		cmd = fmt.Sprintf(templateGetVersion, insecure, releaseImage)
@@ -317,7 +332,7 @@ func (r *release) getOpenshiftVersionFromRelease(log logrus.FieldLogger, release
 
 // Extract openshift-baremetal-install binary from releaseImageMirror if provided.
 // Else extract from the source releaseImage
-func (r *release) Extract(log logrus.FieldLogger, releaseImage string, releaseImageMirror string, cacheDir string, pullSecret string, platformType models.PlatformType, icspFile string) (string, error) {
+func (r *release) Extract(ctx context.Context, log logrus.FieldLogger, releaseImage string, releaseImageMirror string, cacheDir string, pullSecret string, platformType models.PlatformType, icspFile string) (string, error) {
 	var path string
 	var err error
 	if releaseImage == "" && releaseImageMirror == "" {
@@ -325,13 +340,13 @@ func (r *release) Extract(log logrus.FieldLogger, releaseImage string, releaseIm
 	}
 	if releaseImageMirror != "" {
 		//TODO: Get mirror registry certificate from install-config
-		path, err = r.extractFromRelease(log, releaseImageMirror, cacheDir, pullSecret, true, platformType, icspFile)
+		path, err = r.extractFromRelease(ctx, log, releaseImageMirror, cacheDir, pullSecret, true, platformType, icspFile)
 		if err != nil {
 			log.WithError(err).Errorf("failed to extract openshift-baremetal-install from mirror release image %s", releaseImageMirror)
 			return "", err
 		}
 	} else {
-		path, err = r.extractFromRelease(log, releaseImage, cacheDir, pullSecret, false, platformType, icspFile)
+		path, err = r.extractFromRelease(ctx, log, releaseImage, cacheDir, pullSecret, false, platformType, icspFile)
 		if err != nil {
 			log.WithError(err).Errorf("failed to extract openshift-baremetal-install from release image %s", releaseImage)
 			return "", err
@@ -342,7 +357,7 @@ func (r *release) Extract(log logrus.FieldLogger, releaseImage string, releaseIm
 
 // extractFromRelease returns the path to an openshift-baremetal-install binary extracted from
 // the referenced release image.
-func (r *release) extractFromRelease(log logrus.FieldLogger, releaseImage, cacheDir, pullSecret string, insecure bool, platformType models.PlatformType, icspFile string) (string, error) {
+func (r *release) extractFromRelease(ctx context.Context, log logrus.FieldLogger, releaseImage, cacheDir, pullSecret string, insecure bool, platformType models.PlatformType, icspFile string) (path string, err error) {
 	// Using platform type as an indication for which openshift install binary to use
 	// (e.g. as non-x86_64 clusters should use the openshift-install binary).
 	var binary string
@@ -352,9 +367,14 @@ func (r *release) extractFromRelease(log logrus.FieldLogger, releaseImage, cache
 		binary = "openshift-baremetal-install"
 	}
 
+	_, span := tracing.StartSpan(ctx, "oc extract "+binary, releaseImageKey.String(releaseImage))
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
 	workdir := filepath.Join(cacheDir, releaseImage)
 	log.Infof("extracting %s binary to %s", binary, workdir)
-	err := os.MkdirAll(workdir, 0755)
+	err = os.MkdirAll(workdir, 0755)
 	if err != nil {
 		return "", err
 	}
@@ -371,7 +391,7 @@ func (r *release) extractFromRelease(log logrus.FieldLogger, releaseImage, cache
 		return "", err
 	}
 	// set path
-	path := filepath.Join(workdir, binary)
+	path = filepath.Join(workdir, binary)
 	log.Infof("Successfully extracted %s binary from the release to: %s", binary, path)
 	return path, nil
 }
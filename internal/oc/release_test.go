@@ -1,6 +1,7 @@
 package oc
 
 import (
+	"context"
 	_ "embed"
 	"fmt"
 	os "os"
@@ -16,6 +17,7 @@ import (
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/executer"
 	"github.com/openshift/assisted-service/pkg/mirrorregistries"
+	"github.com/openshift/assisted-service/pkg/tracing"
 	logrus "github.com/sirupsen/logrus"
 )
 
@@ -70,7 +72,7 @@ var _ = Describe("oc", func() {
 			args := splitStringToInterfacesArray(command)
 			mockExecuter.EXPECT().Execute(args[0], args[1:]...).Return(mcoImage, "", 0).Times(1)
 
-			mco, err := oc.GetMCOImage(log, releaseImage, "", pullSecret)
+			mco, err := oc.GetMCOImage(context.Background(), log, releaseImage, "", pullSecret)
 			Expect(mco).Should(Equal(mcoImage))
 			Expect(err).ShouldNot(HaveOccurred())
 		})
@@ -81,7 +83,7 @@ var _ = Describe("oc", func() {
 			args := splitStringToInterfacesArray(command)
 			mockExecuter.EXPECT().Execute(args[0], args[1:]...).Return(mcoImage, "", 0).Times(1)
 
-			mco, err := oc.GetMCOImage(log, releaseImage, releaseImageMirror, pullSecret)
+			mco, err := oc.GetMCOImage(context.Background(), log, releaseImage, releaseImageMirror, pullSecret)
 			Expect(mco).Should(Equal(mcoImage))
 			Expect(err).ShouldNot(HaveOccurred())
 		})
@@ -92,18 +94,18 @@ var _ = Describe("oc", func() {
 			args := splitStringToInterfacesArray(command)
 			mockExecuter.EXPECT().Execute(args[0], args[1:]...).Return(mcoImage, "", 0).Times(1)
 
-			mco, err := oc.GetMCOImage(log, releaseImage, releaseImageMirror, pullSecret)
+			mco, err := oc.GetMCOImage(context.Background(), log, releaseImage, releaseImageMirror, pullSecret)
 			Expect(mco).Should(Equal(mcoImage))
 			Expect(err).ShouldNot(HaveOccurred())
 
 			// Fetch image again
-			mco, err = oc.GetMCOImage(log, releaseImage, releaseImageMirror, pullSecret)
+			mco, err = oc.GetMCOImage(context.Background(), log, releaseImage, releaseImageMirror, pullSecret)
 			Expect(mco).Should(Equal(mcoImage))
 			Expect(err).ShouldNot(HaveOccurred())
 		})
 
 		It("mco image with no release image or mirror", func() {
-			mco, err := oc.GetMCOImage(log, "", "", pullSecret)
+			mco, err := oc.GetMCOImage(context.Background(), log, "", "", pullSecret)
 			Expect(mco).Should(BeEmpty())
 			Expect(err).Should(HaveOccurred())
 		})
@@ -116,7 +118,7 @@ var _ = Describe("oc", func() {
 			args := splitStringToInterfacesArray(command)
 			mockExecuter.EXPECT().Execute(args[0], args[1:]...).Return(stdout, "", 0).Times(1)
 
-			mco, err := oc.GetMCOImage(log, releaseImage, "", pullSecret)
+			mco, err := oc.GetMCOImage(context.Background(), log, releaseImage, "", pullSecret)
 			Expect(mco).Should(Equal(mcoImage))
 			Expect(err).ShouldNot(HaveOccurred())
 		})
@@ -129,7 +131,7 @@ var _ = Describe("oc", func() {
 			args := splitStringToInterfacesArray(command)
 			mockExecuter.EXPECT().Execute(args[0], args[1:]...).Return(mustGatherImage, "", 0).Times(1)
 
-			mustGather, err := oc.GetMustGatherImage(log, releaseImage, "", pullSecret)
+			mustGather, err := oc.GetMustGatherImage(context.Background(), log, releaseImage, "", pullSecret)
 			Expect(mustGather).Should(Equal(mustGatherImage))
 			Expect(err).ShouldNot(HaveOccurred())
 		})
@@ -140,7 +142,7 @@ var _ = Describe("oc", func() {
 			args := splitStringToInterfacesArray(command)
 			mockExecuter.EXPECT().Execute(args[0], args[1:]...).Return(mustGatherImage, "", 0).Times(1)
 
-			mustGather, err := oc.GetMustGatherImage(log, releaseImage, releaseImageMirror, pullSecret)
+			mustGather, err := oc.GetMustGatherImage(context.Background(), log, releaseImage, releaseImageMirror, pullSecret)
 			Expect(mustGather).Should(Equal(mustGatherImage))
 			Expect(err).ShouldNot(HaveOccurred())
 		})
@@ -151,18 +153,18 @@ var _ = Describe("oc", func() {
 			args := splitStringToInterfacesArray(command)
 			mockExecuter.EXPECT().Execute(args[0], args[1:]...).Return(mustGatherImage, "", 0).Times(1)
 
-			mustGather, err := oc.GetMustGatherImage(log, releaseImage, releaseImageMirror, pullSecret)
+			mustGather, err := oc.GetMustGatherImage(context.Background(), log, releaseImage, releaseImageMirror, pullSecret)
 			Expect(mustGather).Should(Equal(mustGatherImage))
 			Expect(err).ShouldNot(HaveOccurred())
 
 			// Fetch image again
-			mustGather, err = oc.GetMustGatherImage(log, releaseImage, releaseImageMirror, pullSecret)
+			mustGather, err = oc.GetMustGatherImage(context.Background(), log, releaseImage, releaseImageMirror, pullSecret)
 			Expect(mustGather).Should(Equal(mustGatherImage))
 			Expect(err).ShouldNot(HaveOccurred())
 		})
 
 		It("must-gather image with no release image or mirror", func() {
-			mustGather, err := oc.GetMustGatherImage(log, "", "", pullSecret)
+			mustGather, err := oc.GetMustGatherImage(context.Background(), log, "", "", pullSecret)
 			Expect(mustGather).Should(BeEmpty())
 			Expect(err).Should(HaveOccurred())
 		})
@@ -175,7 +177,7 @@ var _ = Describe("oc", func() {
 			args := splitStringToInterfacesArray(command)
 			mockExecuter.EXPECT().Execute(args[0], args[1:]...).Return(stdout, "", 0).Times(1)
 
-			mustGather, err := oc.GetMustGatherImage(log, releaseImage, "", pullSecret)
+			mustGather, err := oc.GetMustGatherImage(context.Background(), log, releaseImage, "", pullSecret)
 			Expect(mustGather).Should(Equal(mustGatherImage))
 			Expect(err).ShouldNot(HaveOccurred())
 		})
@@ -188,7 +190,7 @@ var _ = Describe("oc", func() {
 			args := splitStringToInterfacesArray(command)
 			mockExecuter.EXPECT().Execute(args[0], args[1:]...).Return(fullVersion, "", 0).Times(1)
 
-			version, err := oc.GetOpenshiftVersion(log, releaseImage, "", pullSecret)
+			version, err := oc.GetOpenshiftVersion(context.Background(), log, releaseImage, "", pullSecret)
 			Expect(version).Should(Equal(fullVersion))
 			Expect(err).ShouldNot(HaveOccurred())
 		})
@@ -199,16 +201,34 @@ var _ = Describe("oc", func() {
 			args := splitStringToInterfacesArray(command)
 			mockExecuter.EXPECT().Execute(args[0], args[1:]...).Return(fullVersion, "", 0).Times(1)
 
-			version, err := oc.GetOpenshiftVersion(log, releaseImage, releaseImageMirror, pullSecret)
+			version, err := oc.GetOpenshiftVersion(context.Background(), log, releaseImage, releaseImageMirror, pullSecret)
 			Expect(version).Should(Equal(fullVersion))
 			Expect(err).ShouldNot(HaveOccurred())
 		})
 
 		It("image version with no release image or mirror", func() {
-			version, err := oc.GetOpenshiftVersion(log, "", "", pullSecret)
+			version, err := oc.GetOpenshiftVersion(context.Background(), log, "", "", pullSecret)
 			Expect(version).Should(BeEmpty())
 			Expect(err).Should(HaveOccurred())
 		})
+
+		It("traces the command as part of the caller's span", func() {
+			exporter, restore := tracing.InstallInMemoryExporter()
+			defer restore()
+			command := fmt.Sprintf(templateGetVersion+" --registry-config=%s",
+				false, releaseImage, tempFilePath)
+			args := splitStringToInterfacesArray(command)
+			mockExecuter.EXPECT().Execute(args[0], args[1:]...).Return(fullVersion, "", 0).Times(1)
+
+			ctx, parent := tracing.StartSpan(context.Background(), "caller")
+			_, err := oc.GetOpenshiftVersion(ctx, log, releaseImage, "", pullSecret)
+			Expect(err).ShouldNot(HaveOccurred())
+			tracing.EndSpan(parent, nil)
+
+			span := exporter.SpanByName("oc release info version")
+			Expect(span).ToNot(BeNil())
+			Expect(span.Parent.SpanID()).To(Equal(exporter.SpanByName("caller").SpanContext.SpanID()))
+		})
 	})
 
 	Context("GetMajorMinorVersion", func() {
@@ -251,7 +271,7 @@ var _ = Describe("oc", func() {
 				args := splitStringToInterfacesArray(command)
 				mockExecuter.EXPECT().Execute(args[0], args[1:]...).Return(t.fullVersion, "", 0).Times(1)
 
-				version, err := oc.GetMajorMinorVersion(log, releaseImage, "", pullSecret)
+				version, err := oc.GetMajorMinorVersion(context.Background(), log, releaseImage, "", pullSecret)
 
 				if t.isValid {
 					Expect(err).ShouldNot(HaveOccurred())
@@ -272,7 +292,7 @@ var _ = Describe("oc", func() {
 				imageInfoStr := fmt.Sprintf("{ \"config\": { \"architecture\": \"%s\" }}", common.TestDefaultConfig.CPUArchitecture)
 				mockExecuter.EXPECT().Execute(args[0], args[1:]...).Return(imageInfoStr, "", 0).Times(1)
 
-				arch, err := oc.GetReleaseArchitecture(log, releaseImage, "", pullSecret)
+				arch, err := oc.GetReleaseArchitecture(context.Background(), log, releaseImage, "", pullSecret)
 				Expect(arch).Should(Equal([]string{common.TestDefaultConfig.CPUArchitecture}))
 				Expect(err).ShouldNot(HaveOccurred())
 			})
@@ -283,7 +303,7 @@ var _ = Describe("oc", func() {
 				imageInfoStr := fmt.Sprintf("{ \"config\": { \"not-an-architecture\": \"%s\" }}", common.TestDefaultConfig.CPUArchitecture)
 				mockExecuter.EXPECT().Execute(args[0], args[1:]...).Return(imageInfoStr, "", 0).Times(1)
 
-				arch, err := oc.GetReleaseArchitecture(log, releaseImage, "", pullSecret)
+				arch, err := oc.GetReleaseArchitecture(context.Background(), log, releaseImage, "", pullSecret)
 				Expect(arch).Should(BeEmpty())
 				Expect(err).Should(HaveOccurred())
 			})
@@ -298,7 +318,7 @@ var _ = Describe("oc", func() {
 				mockExecuter.EXPECT().Execute(args[0], args[1:]...).Return("", "the image is a manifest list", 1).Times(1)
 				mockExecuter.EXPECT().Execute(args2[0], args2[1:]...).Return(test_skopeo_multiarch_image_output, "", 0).Times(1)
 
-				arch, err := oc.GetReleaseArchitecture(log, releaseImage, "", pullSecret)
+				arch, err := oc.GetReleaseArchitecture(context.Background(), log, releaseImage, "", pullSecret)
 				Expect(arch).Should(ConsistOf([]string{"x86_64", "ppc64le", "s390x", "arm64"}))
 				Expect(err).ShouldNot(HaveOccurred())
 			})
@@ -312,7 +332,7 @@ var _ = Describe("oc", func() {
 				mockExecuter.EXPECT().Execute(args[0], args[1:]...).Return("", "the image is a manifest list", 1).Times(1)
 				mockExecuter.EXPECT().Execute(args2[0], args2[1:]...).Return(imageInfoStr, "", 0).Times(1)
 
-				arch, err := oc.GetReleaseArchitecture(log, releaseImage, "", pullSecret)
+				arch, err := oc.GetReleaseArchitecture(context.Background(), log, releaseImage, "", pullSecret)
 				Expect(arch).Should(BeEmpty())
 				Expect(err).Should(HaveOccurred())
 				Expect(err.Error()).Should(ContainSubstring("failed to get image info using oc"))
@@ -327,7 +347,7 @@ var _ = Describe("oc", func() {
 				mockExecuter.EXPECT().Execute(args[0], args[1:]...).Return("", "the image is a manifest list", 1).Times(1)
 				mockExecuter.EXPECT().Execute(args2[0], args2[1:]...).Return(imageInfoStr, "", 0).Times(1)
 
-				arch, err := oc.GetReleaseArchitecture(log, releaseImage, "", pullSecret)
+				arch, err := oc.GetReleaseArchitecture(context.Background(), log, releaseImage, "", pullSecret)
 				Expect(arch).Should(BeEmpty())
 				Expect(err).Should(HaveOccurred())
 				Expect(err.Error()).Should(ContainSubstring("image manifest does not contain architecture"))
@@ -342,7 +362,7 @@ var _ = Describe("oc", func() {
 				mockExecuter.EXPECT().Execute(args[0], args[1:]...).Return("", "the image is a manifest list", 1).Times(1)
 				mockExecuter.EXPECT().Execute(args2[0], args2[1:]...).Return(imageInfoStr, "", 0).Times(1)
 
-				arch, err := oc.GetReleaseArchitecture(log, releaseImage, "", pullSecret)
+				arch, err := oc.GetReleaseArchitecture(context.Background(), log, releaseImage, "", pullSecret)
 				Expect(arch).Should(BeEmpty())
 				Expect(err).Should(HaveOccurred())
 				Expect(err.Error()).Should(ContainSubstring("image manifest does not contain architecture"))
@@ -357,13 +377,13 @@ var _ = Describe("oc", func() {
 			mockExecuter.EXPECT().Execute(args[0], args[1:]...).Return("", "that's not even an image", 1).Times(1)
 			mockExecuter.EXPECT().Execute(args2[0], args2[1:]...).Return("", "that's still not an image", 1).Times(1)
 
-			arch, err := oc.GetReleaseArchitecture(log, releaseImage, "", pullSecret)
+			arch, err := oc.GetReleaseArchitecture(context.Background(), log, releaseImage, "", pullSecret)
 			Expect(arch).Should(BeEmpty())
 			Expect(err).Should(HaveOccurred())
 		})
 
 		It("no release image", func() {
-			arch, err := oc.GetReleaseArchitecture(log, "", "", pullSecret)
+			arch, err := oc.GetReleaseArchitecture(context.Background(), log, "", "", pullSecret)
 			Expect(arch).Should(BeEmpty())
 			Expect(err).Should(HaveOccurred())
 		})
@@ -376,7 +396,7 @@ var _ = Describe("oc", func() {
 			args := splitStringToInterfacesArray(command)
 			mockExecuter.EXPECT().Execute(args[0], args[1:]...).Return("", "", 0).Times(1)
 
-			path, err := oc.Extract(log, releaseImage, "", cacheDir, pullSecret, models.PlatformTypeBaremetal, "")
+			path, err := oc.Extract(context.Background(), log, releaseImage, "", cacheDir, pullSecret, models.PlatformTypeBaremetal, "")
 			filePath := filepath.Join(cacheDir+"/"+releaseImage, baremetalInstallBinary)
 			Expect(path).To(Equal(filePath))
 			Expect(err).ShouldNot(HaveOccurred())
@@ -388,14 +408,14 @@ var _ = Describe("oc", func() {
 			args := splitStringToInterfacesArray(command)
 			mockExecuter.EXPECT().Execute(args[0], args[1:]...).Return("", "", 0).Times(1)
 
-			path, err := oc.Extract(log, releaseImage, releaseImageMirror, cacheDir, pullSecret, models.PlatformTypeBaremetal, "")
+			path, err := oc.Extract(context.Background(), log, releaseImage, releaseImageMirror, cacheDir, pullSecret, models.PlatformTypeBaremetal, "")
 			filePath := filepath.Join(cacheDir+"/"+releaseImageMirror, baremetalInstallBinary)
 			Expect(path).To(Equal(filePath))
 			Expect(err).ShouldNot(HaveOccurred())
 		})
 
 		It("extract baremetal-install with no release image or mirror", func() {
-			path, err := oc.Extract(log, "", "", cacheDir, pullSecret, models.PlatformTypeBaremetal, "")
+			path, err := oc.Extract(context.Background(), log, "", "", cacheDir, pullSecret, models.PlatformTypeBaremetal, "")
 			Expect(path).Should(BeEmpty())
 			Expect(err).Should(HaveOccurred())
 		})
@@ -406,7 +426,7 @@ var _ = Describe("oc", func() {
 			mockExecuter.EXPECT().Execute(args[0], args[1:]...).Return("", "Failed to extract the installer", 1).Times(1)
 			mockExecuter.EXPECT().Execute(args[0], args[1:]...).Return("", "", 0).Times(1)
 
-			path, err := oc.Extract(log, releaseImage, "", cacheDir, pullSecret, models.PlatformTypeBaremetal, "")
+			path, err := oc.Extract(context.Background(), log, releaseImage, "", cacheDir, pullSecret, models.PlatformTypeBaremetal, "")
 			filePath := filepath.Join(cacheDir+"/"+releaseImage, baremetalInstallBinary)
 			Expect(path).To(Equal(filePath))
 			Expect(err).ShouldNot(HaveOccurred())
@@ -418,7 +438,7 @@ var _ = Describe("oc", func() {
 			args := splitStringToInterfacesArray(command)
 			mockExecuter.EXPECT().Execute(args[0], args[1:]...).Return("", "Failed to extract the installer", 1).Times(5)
 
-			path, err := oc.Extract(log, releaseImage, "", cacheDir, pullSecret, models.PlatformTypeBaremetal, "")
+			path, err := oc.Extract(context.Background(), log, releaseImage, "", cacheDir, pullSecret, models.PlatformTypeBaremetal, "")
 			Expect(path).To(Equal(""))
 			Expect(err).Should(HaveOccurred())
 		})
@@ -429,7 +449,7 @@ var _ = Describe("oc", func() {
 			args := splitStringToInterfacesArray(command)
 			mockExecuter.EXPECT().Execute(args[0], args[1:]...).Return("", "", 0).Times(1)
 
-			path, err := oc.Extract(log, releaseImage, "", cacheDir, pullSecret, models.PlatformTypeNone, "")
+			path, err := oc.Extract(context.Background(), log, releaseImage, "", cacheDir, pullSecret, models.PlatformTypeNone, "")
 			filePath := filepath.Join(cacheDir+"/"+releaseImage, installBinary)
 			Expect(path).To(Equal(filePath))
 			Expect(err).ShouldNot(HaveOccurred())
@@ -537,7 +557,7 @@ var _ = Describe("getImageFromRelease", func() {
 							}
 							doneChan <- true
 						}()
-						ret, err := oc.getImageFromRelease(log, r.imageName, r.releaseName, "pull", "", false)
+						ret, err := oc.getImageFromRelease(context.Background(), log, r.imageName, r.releaseName, "pull", "", false)
 						Expect(err).ToNot(HaveOccurred())
 						Expect(ret).To(Equal(r.expectedResult))
 					}()
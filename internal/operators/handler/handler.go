@@ -14,6 +14,7 @@ import (
 	"github.com/openshift/assisted-service/internal/operators"
 	"github.com/openshift/assisted-service/models"
 	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/transaction"
 	restoperators "github.com/openshift/assisted-service/restapi/operations/operators"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -41,7 +42,7 @@ func (h *Handler) V2ReportMonitoredOperatorStatus(ctx context.Context, params re
 	log := logutil.FromContext(ctx, h.log)
 
 	txSuccess := false
-	tx := h.db.Begin()
+	tx := transaction.Begin(ctx, h.db, "report monitored operator status")
 	defer func() {
 		if !txSuccess {
 			log.Error("update monitored operator failed")
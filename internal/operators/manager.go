@@ -53,6 +53,9 @@ type API interface {
 	// GenerateManifests generates manifests for all enabled operators.
 	// Returns map assigning manifest content to its desired file name
 	GenerateManifests(ctx context.Context, cluster *common.Cluster) error
+	// RenderManifests creates the manifests of all enabled operators with the given manifests API, and returns the
+	// content of the custom manifests file instead of uploading it
+	RenderManifests(ctx context.Context, cluster *common.Cluster, manifestsAPI manifestsapi.ClusterManifestsInternals) ([]byte, error)
 	// AnyOLMOperatorEnabled checks whether any OLM operator has been enabled for the given cluster
 	AnyOLMOperatorEnabled(cluster *common.Cluster) bool
 	// ResolveDependencies amends the list of requested additional operators with any missing dependencies
@@ -120,6 +123,21 @@ func (mgr *Manager) GetRequirementsBreakdownForHostInCluster(ctx context.Context
 // GenerateManifests generates manifests for all enabled operators.
 // Returns map assigning manifest content to its desired file name
 func (mgr *Manager) GenerateManifests(ctx context.Context, cluster *common.Cluster) error {
+	customManifests, err := mgr.RenderManifests(ctx, cluster, mgr.manifestsAPI)
+	if err != nil {
+		return err
+	}
+	if customManifests != nil {
+		if err := mgr.createCustomManifest(ctx, cluster, string(customManifests)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RenderManifests creates the manifests of all enabled operators with the given manifests API, and returns the
+// content of the custom manifests file instead of uploading it, or nil if there are no custom manifests
+func (mgr *Manager) RenderManifests(ctx context.Context, cluster *common.Cluster, manifestsAPI manifestsapi.ClusterManifestsInternals) ([]byte, error) {
 	var customManifests []Manifest
 	// Generate manifests for all the generic operators
 	for _, clusterOperator := range cluster.MonitoredOperators {
@@ -132,12 +150,12 @@ func (mgr *Manager) GenerateManifests(ctx context.Context, cluster *common.Clust
 			openshiftManifests, manifest, err := operator.GenerateManifests(cluster)
 			if err != nil {
 				mgr.log.Error(fmt.Sprintf("Cannot generate %s manifests due to ", clusterOperator.Name), err)
-				return err
+				return nil, err
 			}
 			for k, v := range openshiftManifests {
-				err = mgr.createManifests(ctx, cluster, k, v, models.ManifestFolderOpenshift)
+				err = createManifests(ctx, manifestsAPI, cluster, k, v, models.ManifestFolderOpenshift)
 				if err != nil {
-					return err
+					return nil, err
 				}
 			}
 
@@ -145,17 +163,10 @@ func (mgr *Manager) GenerateManifests(ctx context.Context, cluster *common.Clust
 		}
 	}
 
-	if len(customManifests) > 0 {
-		content, err := json.Marshal(customManifests)
-		if err != nil {
-			return err
-		}
-		if err := mgr.createCustomManifest(ctx, cluster, string(content)); err != nil {
-			return err
-		}
+	if len(customManifests) == 0 {
+		return nil, nil
 	}
-
-	return nil
+	return json.Marshal(customManifests)
 }
 
 // createCustomManifest create a file called custom_manifests.json, which is later obtained by the
@@ -169,9 +180,10 @@ func (mgr *Manager) createCustomManifest(ctx context.Context, cluster *common.Cl
 	return nil
 }
 
-func (mgr *Manager) createManifests(ctx context.Context, cluster *common.Cluster, filename string, content []byte, folder string) error {
+func createManifests(ctx context.Context, manifestsAPI manifestsapi.ClusterManifestsInternals, cluster *common.Cluster,
+	filename string, content []byte, folder string) error {
 	// all relevant logs of creating manifest will be inside CreateClusterManifest
-	_, err := mgr.manifestsAPI.CreateClusterManifestInternal(ctx, operations.V2CreateClusterManifestParams{
+	_, err := manifestsAPI.CreateClusterManifestInternal(ctx, operations.V2CreateClusterManifestParams{
 		ClusterID: *cluster.ID,
 		CreateManifestParams: &models.CreateManifestParams{
 			Content:  swag.String(base64.StdEncoding.EncodeToString(content)),
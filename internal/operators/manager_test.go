@@ -3,6 +3,7 @@ package operators_test
 import (
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"errors"
 
 	"github.com/go-openapi/strfmt"
@@ -136,6 +137,29 @@ var _ = Describe("Operators manager", func() {
 		})
 	})
 
+	Context("RenderManifests", func() {
+		It("creates the manifests with the given API and returns the custom manifests", func() {
+			cluster.MonitoredOperators = []*models.MonitoredOperator{
+				&lso.Operator,
+			}
+			renderedManifests := manifestsapi.NewMockClusterManifestsInternals(ctrl)
+			renderedManifests.EXPECT().CreateClusterManifestInternal(gomock.Any(), gomock.Any()).Return(&models.Manifest{}, nil).Times(3)
+
+			customManifests, err := manager.RenderManifests(ctx, cluster, renderedManifests)
+			Expect(err).ShouldNot(HaveOccurred())
+			var rendered []operators.Manifest
+			Expect(json.Unmarshal(customManifests, &rendered)).To(Succeed())
+			Expect(rendered).To(HaveLen(1))
+			Expect(rendered[0].Name).To(Equal(lso.Operator.Name))
+		})
+
+		It("returns no custom manifests without OLM operators", func() {
+			customManifests, err := manager.RenderManifests(ctx, cluster, manifestsapi.NewMockClusterManifestsInternals(ctrl))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(customManifests).To(BeNil())
+		})
+	})
+
 	Context("AnyOLMOperatorEnabled", func() {
 		table.DescribeTable("should report any operator enabled", func(operators []*models.MonitoredOperator, expected bool) {
 			cluster.MonitoredOperators = operators
@@ -10,7 +10,8 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	common "github.com/openshift/assisted-service/internal/common"
-	api "github.com/openshift/assisted-service/internal/operators/api"
+	api "github.com/openshift/assisted-service/internal/manifests/api"
+	api0 "github.com/openshift/assisted-service/internal/operators/api"
 	models "github.com/openshift/assisted-service/models"
 )
 
@@ -167,6 +168,21 @@ func (mr *MockAPIMockRecorder) GetSupportedOperatorsByType(arg0 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSupportedOperatorsByType", reflect.TypeOf((*MockAPI)(nil).GetSupportedOperatorsByType), arg0)
 }
 
+// RenderManifests mocks base method.
+func (m *MockAPI) RenderManifests(arg0 context.Context, arg1 *common.Cluster, arg2 api.ClusterManifestsInternals) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenderManifests", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenderManifests indicates an expected call of RenderManifests.
+func (mr *MockAPIMockRecorder) RenderManifests(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderManifests", reflect.TypeOf((*MockAPI)(nil).RenderManifests), arg0, arg1, arg2)
+}
+
 // ResolveDependencies mocks base method.
 func (m *MockAPI) ResolveDependencies(arg0 *common.Cluster, arg1 []*models.MonitoredOperator) ([]*models.MonitoredOperator, error) {
 	m.ctrl.T.Helper()
@@ -183,10 +199,10 @@ func (mr *MockAPIMockRecorder) ResolveDependencies(arg0, arg1 interface{}) *gomo
 }
 
 // ValidateCluster mocks base method.
-func (m *MockAPI) ValidateCluster(arg0 context.Context, arg1 *common.Cluster) ([]api.ValidationResult, error) {
+func (m *MockAPI) ValidateCluster(arg0 context.Context, arg1 *common.Cluster) ([]api0.ValidationResult, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ValidateCluster", arg0, arg1)
-	ret0, _ := ret[0].([]api.ValidationResult)
+	ret0, _ := ret[0].([]api0.ValidationResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -198,10 +214,10 @@ func (mr *MockAPIMockRecorder) ValidateCluster(arg0, arg1 interface{}) *gomock.C
 }
 
 // ValidateHost mocks base method.
-func (m *MockAPI) ValidateHost(arg0 context.Context, arg1 *common.Cluster, arg2 *models.Host) ([]api.ValidationResult, error) {
+func (m *MockAPI) ValidateHost(arg0 context.Context, arg1 *common.Cluster, arg2 *models.Host) ([]api0.ValidationResult, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ValidateHost", arg0, arg1, arg2)
-	ret0, _ := ret[0].([]api.ValidationResult)
+	ret0, _ := ret[0].([]api0.ValidationResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
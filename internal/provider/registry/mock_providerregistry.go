@@ -82,6 +82,21 @@ func (mr *MockProviderRegistryMockRecorder) Get(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockProviderRegistry)(nil).Get), arg0)
 }
 
+// GetProvidersSupportByHosts mocks base method.
+func (m *MockProviderRegistry) GetProvidersSupportByHosts(arg0 []*models.Host) ([]*models.PlatformSupport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProvidersSupportByHosts", arg0)
+	ret0, _ := ret[0].([]*models.PlatformSupport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProvidersSupportByHosts indicates an expected call of GetProvidersSupportByHosts.
+func (mr *MockProviderRegistryMockRecorder) GetProvidersSupportByHosts(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProvidersSupportByHosts", reflect.TypeOf((*MockProviderRegistry)(nil).GetProvidersSupportByHosts), arg0)
+}
+
 // GetSupportedProvidersByHosts mocks base method.
 func (m *MockProviderRegistry) GetSupportedProvidersByHosts(arg0 []*models.Host) ([]models.PlatformType, error) {
 	m.ctrl.T.Helper()
@@ -3,8 +3,12 @@ package registry
 import (
 	"errors"
 	"fmt"
+	"sort"
+
+	"github.com/go-openapi/swag"
 
 	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
 	"github.com/openshift/assisted-service/internal/installcfg"
 	"github.com/openshift/assisted-service/internal/provider"
 	"github.com/openshift/assisted-service/internal/provider/baremetal"
@@ -28,6 +32,9 @@ type ProviderRegistry interface {
 	// GetSupportedProvidersByHosts returns a slice of all the providers names which support
 	// installation with the given hosts
 	GetSupportedProvidersByHosts(hosts []*models.Host) ([]models.PlatformType, error)
+	// GetProvidersSupportByHosts returns, for each of the registered providers, whether it supports
+	// installation with the given hosts and the reasons why it doesn't
+	GetProvidersSupportByHosts(hosts []*models.Host) ([]*models.PlatformSupport, error)
 	// AddPlatformToInstallConfig adds the provider platform to the installconfig platform field,
 	// sets platform fields from values within the cluster model.
 	AddPlatformToInstallConfig(p models.PlatformType, cfg *installcfg.InstallerConfigBaremetal, cluster *common.Cluster) error
@@ -135,6 +142,49 @@ func (r *registry) GetSupportedProvidersByHosts(hosts []*models.Host) ([]models.
 	return clusterSupportedPlatforms, nil
 }
 
+func (r *registry) GetProvidersSupportByHosts(hosts []*models.Host) ([]*models.PlatformSupport, error) {
+	names := make([]string, 0, len(r.providers))
+	for name := range r.providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	ret := make([]*models.PlatformSupport, 0, len(names))
+	for _, name := range names {
+		p := r.providers[name]
+		var reasons []string
+		for _, h := range hosts {
+			supported, err := p.IsHostSupported(h)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"error while checking if host %s is supported by platform %s, error %w",
+					h.ID.String(), p.Name(), err)
+			}
+			if !supported {
+				reasons = append(reasons, unsupportedHostReason(h, p.Name()))
+			}
+		}
+		ret = append(ret, &models.PlatformSupport{
+			PlatformType:       common.PlatformTypePtr(p.Name()),
+			Supported:          swag.Bool(len(hosts) > 0 && len(reasons) == 0),
+			UnsupportedReasons: reasons,
+		})
+	}
+	return ret, nil
+}
+
+func unsupportedHostReason(host *models.Host, platform models.PlatformType) string {
+	hostname := hostutil.GetHostnameForMsg(host)
+	if host.Inventory == "" {
+		return fmt.Sprintf("Host %s didn't report its inventory yet", hostname)
+	}
+	inventory, err := common.UnmarshalInventory(host.Inventory)
+	if err != nil || inventory.SystemVendor == nil {
+		return fmt.Sprintf("Host %s is not supported by platform %s", hostname, platform)
+	}
+	return fmt.Sprintf("Host %s (manufacturer %q, product %q) is not supported by platform %s",
+		hostname, inventory.SystemVendor.Manufacturer, inventory.SystemVendor.ProductName, platform)
+}
+
 func (r *registry) PreCreateManifestsHook(cluster *common.Cluster, envVars *[]string, workDir string) error {
 	if cluster == nil || cluster.Platform == nil {
 		return errors.New("unable to get the platform type")
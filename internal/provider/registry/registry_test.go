@@ -2,6 +2,7 @@ package registry
 
 import (
 	"encoding/json"
+	"os"
 	"testing"
 
 	"github.com/go-openapi/strfmt"
@@ -11,6 +12,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/gencrypto"
 	"github.com/openshift/assisted-service/internal/installcfg"
 	"github.com/openshift/assisted-service/internal/provider/vsphere"
 	"github.com/openshift/assisted-service/internal/usage"
@@ -140,6 +142,69 @@ var _ = Describe("Test GetSupportedProvidersByHosts", func() {
 	})
 })
 
+var _ = Describe("Test GetProvidersSupportByHosts", func() {
+	bmInventory := getBaremetalInventoryStr("hostname0", "bootMode", true, false)
+	vsphereInventory := getVsphereInventoryStr("hostname0", "bootMode", true, false)
+	BeforeEach(func() {
+		providerRegistry = InitProviderRegistry(common.GetTestLog())
+		ctrl = gomock.NewController(GinkgoT())
+	})
+	getSupport := func(support []*models.PlatformSupport, platform models.PlatformType) *models.PlatformSupport {
+		for _, s := range support {
+			if *s.PlatformType == platform {
+				return s
+			}
+		}
+		return nil
+	}
+	It("no hosts", func() {
+		support, err := providerRegistry.GetProvidersSupportByHosts(make([]*models.Host, 0))
+		Expect(err).To(BeNil())
+		Expect(support).ToNot(BeEmpty())
+		for _, s := range support {
+			Expect(swag.BoolValue(s.Supported)).To(BeFalse())
+			Expect(s.UnsupportedReasons).To(BeEmpty())
+		}
+	})
+	It("vsphere hosts", func() {
+		hosts := []*models.Host{
+			createHost(true, models.HostStatusKnown, vsphereInventory),
+			createHost(false, models.HostStatusKnown, vsphereInventory),
+		}
+		support, err := providerRegistry.GetProvidersSupportByHosts(hosts)
+		Expect(err).To(BeNil())
+		for _, platform := range []models.PlatformType{models.PlatformTypeBaremetal, models.PlatformTypeVsphere, models.PlatformTypeNone} {
+			s := getSupport(support, platform)
+			Expect(s).ToNot(BeNil())
+			Expect(swag.BoolValue(s.Supported)).To(BeTrue())
+			Expect(s.UnsupportedReasons).To(BeEmpty())
+		}
+		s := getSupport(support, models.PlatformTypeNutanix)
+		Expect(s).ToNot(BeNil())
+		Expect(swag.BoolValue(s.Supported)).To(BeFalse())
+		Expect(s.UnsupportedReasons).To(HaveLen(2))
+	})
+	It("vsphere and generic hosts", func() {
+		hosts := []*models.Host{
+			createHost(true, models.HostStatusKnown, vsphereInventory),
+			createHost(true, models.HostStatusKnown, bmInventory),
+		}
+		support, err := providerRegistry.GetProvidersSupportByHosts(hosts)
+		Expect(err).To(BeNil())
+		s := getSupport(support, models.PlatformTypeVsphere)
+		Expect(s).ToNot(BeNil())
+		Expect(swag.BoolValue(s.Supported)).To(BeFalse())
+		Expect(s.UnsupportedReasons).To(HaveLen(1))
+		Expect(s.UnsupportedReasons[0]).To(ContainSubstring("is not supported by platform vsphere"))
+		Expect(swag.BoolValue(getSupport(support, models.PlatformTypeBaremetal).Supported)).To(BeTrue())
+	})
+	It("host with an invalid inventory", func() {
+		hosts := []*models.Host{createHost(true, models.HostStatusKnown, invalidInventory)}
+		_, err := providerRegistry.GetProvidersSupportByHosts(hosts)
+		Expect(err).ToNot(BeNil())
+	})
+})
+
 var _ = Describe("Test AddPlatformToInstallConfig", func() {
 	BeforeEach(func() {
 		providerRegistry = InitProviderRegistry(common.GetTestLog())
@@ -301,6 +366,44 @@ var _ = Describe("Test AddPlatformToInstallConfig", func() {
 				Expect(cfg.Platform.Vsphere.DeprecatedIngressVIP).To(Equal(cluster.Cluster.IngressVip))
 				Expect(cfg.Platform.Vsphere.VCenter).To(Equal(vsphere.PhVcenter))
 			})
+			It("with vCenter configuration", func() {
+				os.Setenv("CREDENTIALS_ENCRYPTION_KEY", "key")
+				defer os.Unsetenv("CREDENTIALS_ENCRYPTION_KEY")
+				cfg := getInstallerConfigBaremetal()
+				hosts := []*models.Host{createHost(true, models.HostStatusKnown, getVsphereInventoryStr("hostname0", "bootMode", true, false))}
+				cluster := createClusterFromHosts(hosts)
+				cluster.Cluster.OpenshiftVersion = "4.12"
+				cluster.Platform = createVspherePlatformParams()
+				cluster.Platform.Vsphere = &models.VspherePlatform{
+					Vcenter:          "vcenter.example.com",
+					Datacenter:       "dc",
+					Cluster:          "cluster",
+					DefaultDatastore: "datastore",
+					Network:          "VM Network",
+					Username:         "user",
+				}
+				var err error
+				cluster.VsphereEncryptedPassword, err = gencrypto.EncryptSecret("pass")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(providerRegistry.AddPlatformToInstallConfig(models.PlatformTypeVsphere, &cfg, &cluster)).To(Succeed())
+				Expect(cfg.Platform.Vsphere.VCenter).To(Equal("vcenter.example.com"))
+				Expect(cfg.Platform.Vsphere.Datacenter).To(Equal("dc"))
+				Expect(cfg.Platform.Vsphere.Cluster).To(Equal("cluster"))
+				Expect(cfg.Platform.Vsphere.DefaultDatastore).To(Equal("datastore"))
+				Expect(cfg.Platform.Vsphere.Network).To(Equal("VM Network"))
+				Expect(cfg.Platform.Vsphere.Username).To(Equal("user"))
+				Expect(cfg.Platform.Vsphere.Password).To(Equal(strfmt.Password(vsphere.PhPassword)))
+
+				Expect(vsphere.SetInstallConfigSecrets(&cfg, &cluster)).To(Succeed())
+				Expect(cfg.Platform.Vsphere.Password).To(Equal(strfmt.Password("pass")))
+
+				cfg.Platform.Vsphere.Password = vsphere.PhPassword
+				cluster.VsphereEncryptedPassword = "invalid"
+				Expect(vsphere.SetInstallConfigSecrets(&cfg, &cluster)).ToNot(Succeed())
+
+				cluster.VsphereEncryptedPassword = ""
+				Expect(vsphere.SetInstallConfigSecrets(&cfg, &cluster)).To(MatchError(ContainSubstring("password of cluster")))
+			})
 		})
 	})
 
@@ -0,0 +1,107 @@
+package vsphere
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	sessionPath            = "/rest/com/vmware/cis/session"
+	sessionIDHeader        = "vmware-api-session-id"
+	defaultValidateTimeout = 30 * time.Second
+)
+
+type CredentialsValidatorConfig struct {
+	SkipTLSVerify bool          `envconfig:"VSPHERE_CREDENTIALS_VALIDATION_SKIP_TLS_VERIFY" default:"false"`
+	Timeout       time.Duration `envconfig:"VSPHERE_CREDENTIALS_VALIDATION_TIMEOUT" default:"30s"`
+}
+
+//go:generate mockgen --build_flags=--mod=mod -package vsphere -destination mock_credentials_validator.go . CredentialsValidator
+type CredentialsValidator interface {
+	// ValidateCredentials verifies that the vCenter server is reachable and accepts the given credentials
+	ValidateCredentials(ctx context.Context, platform *models.VspherePlatform, password string) error
+}
+
+type credentialsValidator struct {
+	log    logrus.FieldLogger
+	client *http.Client
+}
+
+func NewCredentialsValidator(log logrus.FieldLogger, cfg CredentialsValidatorConfig) CredentialsValidator {
+	timeout := cfg.Timeout
+	if timeout == 0 {
+		timeout = defaultValidateTimeout
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: cfg.SkipTLSVerify} //nolint:gosec
+	return &credentialsValidator{
+		log:    log,
+		client: &http.Client{Transport: transport, Timeout: timeout},
+	}
+}
+
+// ValidateCredentials opens a session using the vSphere automation API, which is served both by
+// vCenter and by the vcsim simulator, and closes it right away.
+func (v *credentialsValidator) ValidateCredentials(ctx context.Context, platform *models.VspherePlatform, password string) error {
+	if platform == nil || platform.Vcenter == "" {
+		return errors.New("vCenter server is not set")
+	}
+	sessionURL := (&url.URL{Scheme: "https", Host: platform.Vcenter, Path: sessionPath}).String()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sessionURL, nil)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create a session request for vCenter %s", platform.Vcenter)
+	}
+	req.SetBasicAuth(platform.Username, password)
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "vCenter %s is not reachable", platform.Vcenter)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return errors.Errorf("vCenter %s rejected the credentials of user %s", platform.Vcenter, platform.Username)
+	default:
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return errors.Errorf("unexpected response from vCenter %s, status %d: %s", platform.Vcenter, resp.StatusCode, string(body))
+	}
+
+	var session struct {
+		Value string `json:"value"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&session); err != nil || session.Value == "" {
+		v.log.Debugf("vCenter %s didn't return a session ID, the session will expire on its own", platform.Vcenter)
+		return nil
+	}
+	v.logout(ctx, sessionURL, session.Value)
+	return nil
+}
+
+func (v *credentialsValidator) logout(ctx context.Context, sessionURL, sessionID string) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, sessionURL, nil)
+	if err != nil {
+		v.log.WithError(err).Warn("failed to create a vCenter logout request")
+		return
+	}
+	req.Header.Set(sessionIDHeader, sessionID)
+	resp, err := v.client.Do(req)
+	if err != nil {
+		v.log.WithError(err).Warn("failed to log out of vCenter")
+		return
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		v.log.Warnf("unexpected status %d while logging out of vCenter", resp.StatusCode)
+	}
+}
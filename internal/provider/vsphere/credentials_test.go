@@ -0,0 +1,79 @@
+package vsphere
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("credentials validator", func() {
+	var (
+		server    *httptest.Server
+		validator CredentialsValidator
+		platform  *models.VspherePlatform
+		loggedOut bool
+	)
+
+	BeforeEach(func() {
+		loggedOut = false
+		server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.URL.Path).To(Equal(sessionPath))
+			switch r.Method {
+			case http.MethodPost:
+				username, password, ok := r.BasicAuth()
+				if !ok || username != "user" || password != "pass" {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				_, _ = w.Write([]byte(`{"value":"session-id"}`))
+			case http.MethodDelete:
+				Expect(r.Header.Get(sessionIDHeader)).To(Equal("session-id"))
+				loggedOut = true
+			}
+		}))
+		validator = NewCredentialsValidator(common.GetTestLog(), CredentialsValidatorConfig{SkipTLSVerify: true})
+		platform = &models.VspherePlatform{
+			Vcenter:  strings.TrimPrefix(server.URL, "https://"),
+			Username: "user",
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("accepts valid credentials and closes the session", func() {
+		Expect(validator.ValidateCredentials(context.Background(), platform, "pass")).To(Succeed())
+		Expect(loggedOut).To(BeTrue())
+	})
+
+	It("rejects invalid credentials", func() {
+		err := validator.ValidateCredentials(context.Background(), platform, "wrong")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("rejected the credentials of user user"))
+	})
+
+	It("fails when vCenter is not reachable", func() {
+		server.Close()
+		err := validator.ValidateCredentials(context.Background(), platform, "pass")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("is not reachable"))
+	})
+
+	It("verifies the vCenter certificate by default", func() {
+		validator = NewCredentialsValidator(common.GetTestLog(), CredentialsValidatorConfig{})
+		err := validator.ValidateCredentials(context.Background(), platform, "pass")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("is not reachable"))
+	})
+
+	It("fails without a vCenter server", func() {
+		Expect(validator.ValidateCredentials(context.Background(), &models.VspherePlatform{}, "pass")).ToNot(Succeed())
+	})
+})
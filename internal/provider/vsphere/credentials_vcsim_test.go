@@ -0,0 +1,87 @@
+package vsphere
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/ory/dockertest/v3"
+)
+
+const (
+	vcsimPort     = "8989"
+	vcsimUsername = "user"
+	vcsimPassword = "pass"
+)
+
+// The vcsim tests run the validator against the vCenter simulator. They use the simulator listening on
+// VCSIM_ADDRESS when it is set, otherwise they start it in a container, and are skipped when docker
+// isn't available.
+var _ = Describe("credentials validator against vcsim", func() {
+	var (
+		pool      *dockertest.Pool
+		resource  *dockertest.Resource
+		validator CredentialsValidator
+		platform  *models.VspherePlatform
+	)
+
+	BeforeEach(func() {
+		address := os.Getenv("VCSIM_ADDRESS")
+		if address == "" {
+			var err error
+			pool, err = dockertest.NewPool("")
+			if err != nil || pool.Client.Ping() != nil {
+				Skip("vcsim is not available, set VCSIM_ADDRESS or run docker to run these tests")
+			}
+			resource, err = pool.RunWithOptions(&dockertest.RunOptions{
+				Repository: "docker.io/vmware/vcsim",
+				Tag:        "latest",
+				Cmd:        []string{"-l", "0.0.0.0:" + vcsimPort, "-username", vcsimUsername, "-password", vcsimPassword},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			address = "127.0.0.1:" + resource.GetPort(vcsimPort+"/tcp")
+			client := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}} //nolint:gosec
+			Expect(pool.Retry(func() error {
+				resp, err := client.Get("https://" + address + "/about")
+				if err != nil {
+					return err
+				}
+				return resp.Body.Close()
+			})).To(Succeed())
+		}
+		validator = NewCredentialsValidator(common.GetTestLog(), CredentialsValidatorConfig{SkipTLSVerify: true})
+		platform = &models.VspherePlatform{
+			Vcenter:  address,
+			Username: vcsimUsername,
+		}
+	})
+
+	AfterEach(func() {
+		if resource != nil {
+			Expect(pool.Purge(resource)).To(Succeed())
+			resource = nil
+		}
+	})
+
+	It("accepts valid credentials", func() {
+		Expect(validator.ValidateCredentials(context.Background(), platform, vcsimPassword)).To(Succeed())
+	})
+
+	It("rejects invalid credentials", func() {
+		err := validator.ValidateCredentials(context.Background(), platform, "wrong")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("rejected the credentials of user " + vcsimUsername))
+	})
+
+	It("verifies the vCenter certificate by default", func() {
+		validator = NewCredentialsValidator(common.GetTestLog(), CredentialsValidatorConfig{})
+		err := validator.ValidateCredentials(context.Background(), platform, vcsimPassword)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("is not reachable"))
+	})
+})
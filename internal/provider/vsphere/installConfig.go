@@ -2,14 +2,18 @@ package vsphere
 
 import (
 	"errors"
+	"fmt"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/featuresupport"
+	"github.com/openshift/assisted-service/internal/gencrypto"
 	"github.com/openshift/assisted-service/internal/installcfg"
 	"github.com/openshift/assisted-service/internal/network"
 	"github.com/openshift/assisted-service/internal/provider"
 	"github.com/openshift/assisted-service/models"
+	"gopkg.in/yaml.v2"
 )
 
 func setPlatformValues(platform *installcfg.VsphereInstallConfigPlatform) {
@@ -23,6 +27,61 @@ func setPlatformValues(platform *installcfg.VsphereInstallConfigPlatform) {
 	platform.Datacenter = PhDatacenter
 }
 
+// setConfiguredPlatformValues fills the platform with the vCenter configuration provided by the user.
+// Clusters that were created without it keep using placeholders. The password is always left as a
+// placeholder, SetInstallConfigSecrets replaces it when the install config is handed to the installer.
+func setConfiguredPlatformValues(platform *installcfg.VsphereInstallConfigPlatform, cluster *common.Cluster) {
+	if !hasVcenterConfiguration(cluster) {
+		setPlatformValues(platform)
+		return
+	}
+	vsphere := cluster.Platform.Vsphere
+	platform.Cluster = vsphere.Cluster
+	platform.VCenter = vsphere.Vcenter
+	platform.Network = vsphere.Network
+	platform.DefaultDatastore = vsphere.DefaultDatastore
+	platform.Username = vsphere.Username
+	platform.Password = PhPassword
+	platform.Datacenter = vsphere.Datacenter
+}
+
+func hasVcenterConfiguration(cluster *common.Cluster) bool {
+	return cluster.Platform != nil && cluster.Platform.Vsphere != nil && cluster.Platform.Vsphere.Vcenter != ""
+}
+
+// SetInstallConfigSecrets replaces the vCenter password placeholder with the password stored for the
+// cluster. It must only be used on install configs that are handed to the installer, the ones returned
+// by the API keep the placeholder.
+func SetInstallConfigSecrets(cfg *installcfg.InstallerConfigBaremetal, cluster *common.Cluster) error {
+	if cfg.Platform.Vsphere == nil || cfg.Platform.Vsphere.Password != PhPassword || !hasVcenterConfiguration(cluster) {
+		return nil
+	}
+	// Clusters imported from a bundle have no password until it is set by updating the cluster
+	if cluster.VsphereEncryptedPassword == "" {
+		return fmt.Errorf("the vCenter password of cluster %s is not set", cluster.ID)
+	}
+	password, err := gencrypto.DecryptSecret(cluster.VsphereEncryptedPassword)
+	if err != nil {
+		return fmt.Errorf("failed to decrypt the vCenter password: %w", err)
+	}
+	cfg.Platform.Vsphere.Password = strfmt.Password(password)
+	return nil
+}
+
+// RemoveInstallConfigSecrets puts the vCenter password placeholder back in an install config that went
+// through SetInstallConfigSecrets, for the copy of the install config stored with the files of the cluster
+func RemoveInstallConfigSecrets(installConfig []byte) ([]byte, error) {
+	var cfg installcfg.InstallerConfigBaremetal
+	if err := yaml.Unmarshal(installConfig, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse the install config: %w", err)
+	}
+	if cfg.Platform.Vsphere == nil || cfg.Platform.Vsphere.Password == "" || cfg.Platform.Vsphere.Password == PhPassword {
+		return installConfig, nil
+	}
+	cfg.Platform.Vsphere.Password = PhPassword
+	return yaml.Marshal(cfg)
+}
+
 func (p vsphereProvider) AddPlatformToInstallConfig(cfg *installcfg.InstallerConfigBaremetal, cluster *common.Cluster) error {
 	vsPlatform := &installcfg.VsphereInstallConfigPlatform{}
 
@@ -49,7 +108,7 @@ func (p vsphereProvider) AddPlatformToInstallConfig(cfg *installcfg.InstallerCon
 		}
 	}
 
-	setPlatformValues(vsPlatform)
+	setConfiguredPlatformValues(vsPlatform, cluster)
 	cfg.Platform = installcfg.Platform{
 		Vsphere: vsPlatform,
 	}
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/openshift/assisted-service/internal/provider/vsphere (interfaces: CredentialsValidator)
+
+// Package vsphere is a generated GoMock package.
+package vsphere
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	models "github.com/openshift/assisted-service/models"
+)
+
+// MockCredentialsValidator is a mock of CredentialsValidator interface.
+type MockCredentialsValidator struct {
+	ctrl     *gomock.Controller
+	recorder *MockCredentialsValidatorMockRecorder
+}
+
+// MockCredentialsValidatorMockRecorder is the mock recorder for MockCredentialsValidator.
+type MockCredentialsValidatorMockRecorder struct {
+	mock *MockCredentialsValidator
+}
+
+// NewMockCredentialsValidator creates a new mock instance.
+func NewMockCredentialsValidator(ctrl *gomock.Controller) *MockCredentialsValidator {
+	mock := &MockCredentialsValidator{ctrl: ctrl}
+	mock.recorder = &MockCredentialsValidatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCredentialsValidator) EXPECT() *MockCredentialsValidatorMockRecorder {
+	return m.recorder
+}
+
+// ValidateCredentials mocks base method.
+func (m *MockCredentialsValidator) ValidateCredentials(arg0 context.Context, arg1 *models.VspherePlatform, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateCredentials", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateCredentials indicates an expected call of ValidateCredentials.
+func (mr *MockCredentialsValidatorMockRecorder) ValidateCredentials(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateCredentials", reflect.TypeOf((*MockCredentialsValidator)(nil).ValidateCredentials), arg0, arg1, arg2)
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/openshift/assisted-service/internal/reclaim (interfaces: Reclaimer)
+
+// Package reclaim is a generated GoMock package.
+package reclaim
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	logrus "github.com/sirupsen/logrus"
+	client "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// MockReclaimer is a mock of Reclaimer interface.
+type MockReclaimer struct {
+	ctrl     *gomock.Controller
+	recorder *MockReclaimerMockRecorder
+}
+
+// MockReclaimerMockRecorder is the mock recorder for MockReclaimer.
+type MockReclaimerMockRecorder struct {
+	mock *MockReclaimer
+}
+
+// NewMockReclaimer creates a new mock instance.
+func NewMockReclaimer(ctrl *gomock.Controller) *MockReclaimer {
+	mock := &MockReclaimer{ctrl: ctrl}
+	mock.recorder = &MockReclaimerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReclaimer) EXPECT() *MockReclaimerMockRecorder {
+	return m.recorder
+}
+
+// StartReclaimAgent mocks base method.
+func (m *MockReclaimer) StartReclaimAgent(arg0 context.Context, arg1 client.Client, arg2 logrus.FieldLogger, arg3, arg4, arg5 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartReclaimAgent", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartReclaimAgent indicates an expected call of StartReclaimAgent.
+func (mr *MockReclaimerMockRecorder) StartReclaimAgent(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartReclaimAgent", reflect.TypeOf((*MockReclaimer)(nil).StartReclaimAgent), arg0, arg1, arg2, arg3, arg4, arg5)
+}
@@ -0,0 +1,13 @@
+package reclaim
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestReclaim(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "reclaim tests")
+}
@@ -1,4 +1,4 @@
-package controllers
+package reclaim
 
 import (
 	"context"
@@ -27,27 +27,62 @@ const (
 	spokeRBACName             = "node-reclaim"
 )
 
-type reclaimConfig struct {
+type Config struct {
 	AgentContainerImage  string        `envconfig:"AGENT_DOCKER_IMAGE" default:"quay.io/edge-infrastructure/assisted-installer-agent:latest"`
 	AuthType             auth.AuthType `envconfig:"AUTH_TYPE" default:""`
 	ServiceBaseURL       string        `envconfig:"SERVICE_BASE_URL"`
 	ServiceCACertPath    string        `envconfig:"SERVICE_CA_CERT_PATH" default:""`
 	SkipCertVerification bool          `envconfig:"SKIP_CERT_VERIFICATION" default:"false"`
-	hostFSMountDir       string
+	HostFSMountDir       string        `ignored:"true"`
 }
 
-type agentReclaimer struct {
-	reclaimConfig
+// Reclaimer starts the assisted agent on a node of an installed spoke cluster so the
+// host can be reclaimed back into its infra-env without being booted from the discovery ISO
+//
+//go:generate mockgen --build_flags=--mod=mod -package=reclaim -destination=mock_reclaimer.go . Reclaimer
+type Reclaimer interface {
+	StartReclaimAgent(ctx context.Context, c client.Client, log logrus.FieldLogger, nodeName string, infraEnvID string, hostID string) error
 }
 
-func newAgentReclaimer(hostFSMountDir string) (*agentReclaimer, error) {
-	config := reclaimConfig{}
+type reclaimer struct {
+	Config
+}
+
+func NewReclaimer(config Config) Reclaimer {
+	return &reclaimer{Config: config}
+}
+
+func NewReclaimerFromEnv(hostFSMountDir string) (Reclaimer, error) {
+	config := Config{}
 
 	if err := envconfig.Process("", &config); err != nil {
-		return nil, errors.Wrapf(err, "failed to populate reclaimConfig")
+		return nil, errors.Wrapf(err, "failed to populate reclaim config")
+	}
+	config.HostFSMountDir = hostFSMountDir
+	return NewReclaimer(config), nil
+}
+
+func (r *reclaimer) StartReclaimAgent(ctx context.Context, c client.Client, log logrus.FieldLogger, nodeName string, infraEnvID string, hostID string) error {
+	log.Infof("Starting agent pod for reclaim on node %s", nodeName)
+	if err := ensureSpokeNamespace(ctx, c, log); err != nil {
+		return err
+	}
+	if err := ensureSpokeServiceAccount(ctx, c, log); err != nil {
+		return err
+	}
+	if err := ensureSpokeRole(ctx, c, log); err != nil {
+		return err
+	}
+	if err := ensureSpokeRoleBinding(ctx, c, log); err != nil {
+		return err
+	}
+	if err := r.ensureSpokeAgentSecret(ctx, c, log, infraEnvID); err != nil {
+		return err
+	}
+	if err := r.ensureSpokeAgentCertCM(ctx, c, log); err != nil {
+		return err
 	}
-	config.hostFSMountDir = hostFSMountDir
-	return &agentReclaimer{reclaimConfig: config}, nil
+	return r.createNextStepRunnerDaemonSet(ctx, c, log, nodeName, infraEnvID, hostID)
 }
 
 func ensureSpokeNamespace(ctx context.Context, c client.Client, log logrus.FieldLogger) error {
@@ -138,7 +173,7 @@ func spokeReclaimSecretName(infraEnvID string) string {
 	return fmt.Sprintf("reclaim-%s-token", infraEnvID)
 }
 
-func (r *agentReclaimer) ensureSpokeAgentSecret(ctx context.Context, c client.Client, log logrus.FieldLogger, infraEnvID string) error {
+func (r *reclaimer) ensureSpokeAgentSecret(ctx context.Context, c client.Client, log logrus.FieldLogger, infraEnvID string) error {
 	authToken := ""
 	if r.AuthType == auth.TypeLocal {
 		var err error
@@ -166,7 +201,7 @@ func (r *agentReclaimer) ensureSpokeAgentSecret(ctx context.Context, c client.Cl
 	return err
 }
 
-func (r *agentReclaimer) ensureSpokeAgentCertCM(ctx context.Context, c client.Client, log logrus.FieldLogger) error {
+func (r *reclaimer) ensureSpokeAgentCertCM(ctx context.Context, c client.Client, log logrus.FieldLogger) error {
 	if r.ServiceCACertPath == "" {
 		return nil
 	}
@@ -192,7 +227,7 @@ func (r *agentReclaimer) ensureSpokeAgentCertCM(ctx context.Context, c client.Cl
 	return err
 }
 
-func (r *agentReclaimer) createNextStepRunnerDaemonSet(ctx context.Context, c client.Client, log logrus.FieldLogger, nodeName string, infraEnvID string, hostID string) error {
+func (r *reclaimer) createNextStepRunnerDaemonSet(ctx context.Context, c client.Client, log logrus.FieldLogger, nodeName string, infraEnvID string, hostID string) error {
 	node := &corev1.Node{}
 	if err := c.Get(ctx, types.NamespacedName{Name: nodeName}, node); err != nil {
 		return errors.Wrapf(err, "failed to find node %s", nodeName)
@@ -216,7 +251,7 @@ func (r *agentReclaimer) createNextStepRunnerDaemonSet(ctx context.Context, c cl
 		},
 	}}
 
-	volumeMounts := []corev1.VolumeMount{{Name: "host", MountPath: r.hostFSMountDir}}
+	volumeMounts := []corev1.VolumeMount{{Name: "host", MountPath: r.HostFSMountDir}}
 	if r.ServiceCACertPath != "" {
 		cliArgs = append(cliArgs, fmt.Sprintf("-cacert=%s", common.HostCACertPath))
 		volumes = append(volumes, corev1.Volume{
@@ -1,4 +1,4 @@
-package controllers
+package reclaim
 
 import (
 	"context"
@@ -23,7 +23,7 @@ import (
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
-var _ = It("newAgentReclaimer pulls config from env vars", func() {
+var _ = It("NewReclaimerFromEnv pulls config from env vars", func() {
 	image := "registry.example.com/agent:latest"
 	authType := auth.TypeLocal
 	serviceURL := "https://assisted.example.com"
@@ -36,8 +36,10 @@ var _ = It("newAgentReclaimer pulls config from env vars", func() {
 	os.Setenv("SERVICE_CA_CERT_PATH", certPath)
 	os.Setenv("SKIP_CERT_VERIFICATION", skipVerify)
 
-	r, err := newAgentReclaimer("/host")
+	api, err := NewReclaimerFromEnv("/host")
 	Expect(err).NotTo(HaveOccurred())
+	r := api.(*reclaimer)
+	Expect(r.HostFSMountDir).To(Equal("/host"))
 	Expect(r.AgentContainerImage).To(Equal(image))
 	Expect(r.AuthType).To(Equal(authType))
 	Expect(r.ServiceBaseURL).To(Equal(serviceURL))
@@ -51,7 +53,7 @@ var _ = Context("with a fake client", func() {
 		ctx             = context.Background()
 		agentImage      = "registry.example.com/assisted-installer/agent:latest"
 		assistedBaseURL = "https://assisted.example.com"
-		reclaimer       *agentReclaimer
+		rec             *reclaimer
 	)
 
 	BeforeEach(func() {
@@ -59,7 +61,7 @@ var _ = Context("with a fake client", func() {
 		Expect(scheme.AddToScheme(schemes)).To(Succeed())
 		Expect(authzv1.AddToScheme(schemes)).To(Succeed())
 		c = fakeclient.NewClientBuilder().WithScheme(schemes).Build()
-		reclaimer = &agentReclaimer{reclaimConfig{
+		rec = &reclaimer{Config{
 			AgentContainerImage: agentImage,
 			ServiceBaseURL:      assistedBaseURL,
 		}}
@@ -174,8 +176,8 @@ var _ = Context("with a fake client", func() {
 		})
 
 		It("creates the secret with an empty value with none auth", func() {
-			reclaimer.AuthType = auth.TypeNone
-			Expect(reclaimer.ensureSpokeAgentSecret(ctx, c, common.GetTestLog(), infraEnvID)).To(Succeed())
+			rec.AuthType = auth.TypeNone
+			Expect(rec.ensureSpokeAgentSecret(ctx, c, common.GetTestLog(), infraEnvID)).To(Succeed())
 
 			key := types.NamespacedName{
 				Name:      fmt.Sprintf("reclaim-%s-token", infraEnvID),
@@ -194,8 +196,8 @@ var _ = Context("with a fake client", func() {
 			os.Setenv("EC_PRIVATE_KEY_PEM", priv)
 			defer os.Unsetenv("EC_PROVATE_KEY_PEM")
 
-			reclaimer.AuthType = auth.TypeLocal
-			Expect(reclaimer.ensureSpokeAgentSecret(ctx, c, common.GetTestLog(), infraEnvID)).To(Succeed())
+			rec.AuthType = auth.TypeLocal
+			Expect(rec.ensureSpokeAgentSecret(ctx, c, common.GetTestLog(), infraEnvID)).To(Succeed())
 
 			key := types.NamespacedName{
 				Name:      fmt.Sprintf("reclaim-%s-token", infraEnvID),
@@ -219,16 +221,16 @@ var _ = Context("with a fake client", func() {
 			}
 			Expect(c.Create(ctx, secret)).To(Succeed())
 
-			reclaimer.AuthType = auth.TypeNone
-			Expect(reclaimer.ensureSpokeAgentSecret(ctx, c, common.GetTestLog(), infraEnvID)).To(Succeed())
+			rec.AuthType = auth.TypeNone
+			Expect(rec.ensureSpokeAgentSecret(ctx, c, common.GetTestLog(), infraEnvID)).To(Succeed())
 		})
 
 		It("creates a second secret if one exists for a different infra-env", func() {
-			reclaimer.AuthType = auth.TypeNone
-			Expect(reclaimer.ensureSpokeAgentSecret(ctx, c, common.GetTestLog(), infraEnvID)).To(Succeed())
+			rec.AuthType = auth.TypeNone
+			Expect(rec.ensureSpokeAgentSecret(ctx, c, common.GetTestLog(), infraEnvID)).To(Succeed())
 
 			otherInfraEnvID := uuid.New().String()
-			Expect(reclaimer.ensureSpokeAgentSecret(ctx, c, common.GetTestLog(), otherInfraEnvID)).To(Succeed())
+			Expect(rec.ensureSpokeAgentSecret(ctx, c, common.GetTestLog(), otherInfraEnvID)).To(Succeed())
 
 			key := types.NamespacedName{
 				Name:      fmt.Sprintf("reclaim-%s-token", infraEnvID),
@@ -252,8 +254,8 @@ var _ = Context("with a fake client", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(certFile.Sync()).To(Succeed())
 
-			reclaimer.ServiceCACertPath = fileName
-			Expect(reclaimer.ensureSpokeAgentCertCM(ctx, c, common.GetTestLog())).To(Succeed())
+			rec.ServiceCACertPath = fileName
+			Expect(rec.ensureSpokeAgentCertCM(ctx, c, common.GetTestLog())).To(Succeed())
 
 			key := types.NamespacedName{
 				Name:      spokeReclaimCMName,
@@ -267,7 +269,7 @@ var _ = Context("with a fake client", func() {
 		})
 
 		It("does not create a configmap when no cert path is set", func() {
-			Expect(reclaimer.ensureSpokeAgentCertCM(ctx, c, common.GetTestLog())).To(Succeed())
+			Expect(rec.ensureSpokeAgentCertCM(ctx, c, common.GetTestLog())).To(Succeed())
 			key := types.NamespacedName{
 				Name:      spokeReclaimCMName,
 				Namespace: spokeReclaimNamespaceName,
@@ -286,7 +288,7 @@ var _ = Context("with a fake client", func() {
 			}
 			Expect(c.Create(ctx, cm)).To(Succeed())
 
-			Expect(reclaimer.ensureSpokeAgentCertCM(ctx, c, common.GetTestLog())).To(Succeed())
+			Expect(rec.ensureSpokeAgentCertCM(ctx, c, common.GetTestLog())).To(Succeed())
 		})
 	})
 
@@ -322,7 +324,7 @@ var _ = Context("with a fake client", func() {
 
 		It("creates a daemon set correctly on the spoke node", func() {
 			withANode(nodeName)
-			Expect(reclaimer.createNextStepRunnerDaemonSet(ctx, c, common.GetTestLog(), nodeName, infraEnvID, hostID)).To(Succeed())
+			Expect(rec.createNextStepRunnerDaemonSet(ctx, c, common.GetTestLog(), nodeName, infraEnvID, hostID)).To(Succeed())
 
 			ds := &appsv1.DaemonSet{}
 			daemonSetNsName := types.NamespacedName{
@@ -354,8 +356,8 @@ var _ = Context("with a fake client", func() {
 
 		It("adds cert configuration when CA cert path is set", func() {
 			withANode(nodeName)
-			reclaimer.ServiceCACertPath = "/etc/assisted/cert.crt"
-			Expect(reclaimer.createNextStepRunnerDaemonSet(ctx, c, common.GetTestLog(), nodeName, infraEnvID, hostID)).To(Succeed())
+			rec.ServiceCACertPath = "/etc/assisted/cert.crt"
+			Expect(rec.createNextStepRunnerDaemonSet(ctx, c, common.GetTestLog(), nodeName, infraEnvID, hostID)).To(Succeed())
 
 			ds := &appsv1.DaemonSet{}
 			daemonSetNsName := types.NamespacedName{
@@ -382,7 +384,30 @@ var _ = Context("with a fake client", func() {
 		})
 
 		It("fails when the node doesn't exist", func() {
-			Expect(reclaimer.createNextStepRunnerDaemonSet(ctx, c, common.GetTestLog(), nodeName, infraEnvID, hostID)).ToNot(Succeed())
+			Expect(rec.createNextStepRunnerDaemonSet(ctx, c, common.GetTestLog(), nodeName, infraEnvID, hostID)).ToNot(Succeed())
+		})
+	})
+
+	Describe("StartReclaimAgent", func() {
+		It("creates all the spoke resources and the daemon set", func() {
+			nodeName := "node.example.com"
+			infraEnvID := uuid.New().String()
+			Expect(c.Create(ctx, &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: nodeName}})).To(Succeed())
+			Expect(rec.StartReclaimAgent(ctx, c, common.GetTestLog(), nodeName, infraEnvID, uuid.New().String())).To(Succeed())
+
+			Expect(c.Get(ctx, types.NamespacedName{Name: spokeReclaimNamespaceName}, &corev1.Namespace{})).To(Succeed())
+			rbacKey := types.NamespacedName{Name: spokeRBACName, Namespace: spokeReclaimNamespaceName}
+			Expect(c.Get(ctx, rbacKey, &corev1.ServiceAccount{})).To(Succeed())
+			Expect(c.Get(ctx, rbacKey, &authzv1.Role{})).To(Succeed())
+			Expect(c.Get(ctx, rbacKey, &authzv1.RoleBinding{})).To(Succeed())
+			secretKey := types.NamespacedName{Name: spokeReclaimSecretName(infraEnvID), Namespace: spokeReclaimNamespaceName}
+			Expect(c.Get(ctx, secretKey, &corev1.Secret{})).To(Succeed())
+			dsKey := types.NamespacedName{Name: "node.example.com-reclaim", Namespace: spokeReclaimNamespaceName}
+			Expect(c.Get(ctx, dsKey, &appsv1.DaemonSet{})).To(Succeed())
+		})
+
+		It("fails when the node doesn't exist", func() {
+			Expect(rec.StartReclaimAgent(ctx, c, common.GetTestLog(), "missing.example.com", uuid.New().String(), uuid.New().String())).ToNot(Succeed())
 		})
 	})
 })
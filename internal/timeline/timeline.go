@@ -0,0 +1,359 @@
+package timeline
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	eventgen "github.com/openshift/assisted-service/internal/common/events"
+	"github.com/openshift/assisted-service/models"
+)
+
+const (
+	clusterLane = "cluster"
+	logsLane    = "logs"
+)
+
+// EventNames are the names of the events the timeline is built from
+var EventNames = []string{
+	eventgen.ClusterStatusUpdatedEventName,
+	eventgen.HostInstallProgressUpdatedEventName,
+	eventgen.ClusterOperatorStatusEventName,
+	eventgen.HostLogsUploadedEventName,
+	eventgen.ClusterLogsUploadedEventName,
+}
+
+// hostStagePrefix starts the event of the host install progress events, see HostStageEvent
+const hostStagePrefix = "reached installation stage "
+
+// The events don't keep their properties, so the transitions are parsed from the messages of the events
+var (
+	clusterStatusRegex = regexp.MustCompile(`^Updated status of the cluster to (\S+)`)
+	hostStageRegex     = regexp.MustCompile(`(?s)^Host: (.*), ` + regexp.QuoteMeta(hostStagePrefix) + `([^:]+)(?:: (.*))?$`)
+	operatorRegex      = regexp.MustCompile(`(?s)^Operator (\S+) status: (\S+) message: (.*)$`)
+	hostLogsRegex      = regexp.MustCompile(`^Uploaded logs for host (.*) cluster \S+$`)
+)
+
+// HostStageEvent formats the event of the host install progress events, the host stages of the
+// timeline are parsed from it
+func HostStageEvent(stage models.HostStage, info string) string {
+	event := hostStagePrefix + string(stage)
+	if info != "" {
+		event += ": " + info
+	}
+	return event
+}
+
+// The last transition of a lane to one of these states doesn't last, it is shown as an instant
+var terminalStates = map[string][]string{
+	models.InstallationTimelineEntryKindClusterStatus:  {models.ClusterStatusInstalled, models.ClusterStatusError, models.ClusterStatusCancelled},
+	models.InstallationTimelineEntryKindHostStage:      {string(models.HostStageDone), string(models.HostStageFailed)},
+	models.InstallationTimelineEntryKindOperatorStatus: {string(models.OperatorStatusAvailable), string(models.OperatorStatusFailed)},
+}
+
+type entry struct {
+	kind string
+	// key groups the transitions of a lane, lane is the label of the lane
+	key       string
+	lane      string
+	name      string
+	info      string
+	hostID    strfmt.UUID
+	startedAt time.Time
+	endedAt   time.Time
+	// inProgress entries last until the generation of the timeline
+	inProgress bool
+	critical   bool
+}
+
+func (e *entry) end(now time.Time) time.Time {
+	if e.inProgress {
+		return now
+	}
+	return e.endedAt
+}
+
+func (e *entry) duration(now time.Time) time.Duration {
+	return e.end(now).Sub(e.startedAt).Round(time.Second)
+}
+
+func (e *entry) isTerminal() bool {
+	for _, state := range terminalStates[e.kind] {
+		if state == e.name {
+			return true
+		}
+	}
+	return false
+}
+
+// Build builds the timeline of the last installation of a cluster from its events, which must be ordered by event
+// time. Each transition lasts until the next transition in the same lane: the cluster, a host or an operator. The
+// installation starts when the cluster last moved to preparing-for-installation and completes when the cluster
+// reaches a final status.
+func Build(cluster *common.Cluster, events []*common.Event, now time.Time) *models.InstallationTimeline {
+	startedAt := installationStart(cluster, events)
+	var completedAt time.Time
+
+	var keys []string
+	transitions := map[string][]*entry{}
+	for _, event := range events {
+		if event.EventTime == nil || time.Time(*event.EventTime).Before(startedAt) {
+			continue
+		}
+		e := parseEvent(event)
+		if e == nil {
+			continue
+		}
+		if e.kind == models.InstallationTimelineEntryKindClusterStatus {
+			completedAt = time.Time{}
+			if e.isTerminal() {
+				completedAt = e.startedAt
+			}
+		}
+		if _, ok := transitions[e.key]; !ok {
+			keys = append(keys, e.key)
+		}
+		transitions[e.key] = append(transitions[e.key], e)
+	}
+
+	var entries []*entry
+	for _, key := range keys {
+		laneEntries := transitions[key]
+		for i, e := range laneEntries {
+			// The lane of a host is labeled with the last name the host had
+			e.lane = laneEntries[len(laneEntries)-1].lane
+			switch {
+			case e.kind == models.InstallationTimelineEntryKindLogs:
+				e.endedAt = e.startedAt
+			case i+1 < len(laneEntries):
+				e.endedAt = laneEntries[i+1].startedAt
+			case e.isTerminal():
+				e.endedAt = e.startedAt
+			case !completedAt.IsZero() && !completedAt.Before(e.startedAt):
+				e.endedAt = completedAt
+			default:
+				e.inProgress = true
+			}
+			entries = append(entries, e)
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].startedAt.Before(entries[j].startedAt)
+	})
+	if startedAt.IsZero() && len(entries) > 0 {
+		startedAt = entries[0].startedAt
+	}
+
+	end := completedAt
+	if end.IsZero() {
+		end = now
+	}
+	critical := criticalPath(entries, startedAt, end, now)
+
+	ret := &models.InstallationTimeline{
+		ClusterID:    cluster.ID,
+		GeneratedAt:  (*strfmt.DateTime)(&now),
+		Status:       swag.StringValue(cluster.Status),
+		Entries:      make([]*models.InstallationTimelineEntry, 0, len(entries)),
+		CriticalPath: make([]string, 0, len(critical)),
+	}
+	if !startedAt.IsZero() {
+		ret.StartedAt = strfmt.DateTime(startedAt)
+		ret.DurationSeconds = seconds(end.Sub(startedAt))
+	}
+	if !completedAt.IsZero() {
+		ret.CompletedAt = strfmt.DateTime(completedAt)
+	}
+	ids := map[*entry]string{}
+	counts := map[string]int{}
+	for _, e := range entries {
+		counts[e.kind]++
+		ids[e] = fmt.Sprintf("%s-%d", e.kind, counts[e.kind])
+		entryStartedAt := strfmt.DateTime(e.startedAt)
+		timelineEntry := &models.InstallationTimelineEntry{
+			ID:              swag.String(ids[e]),
+			Kind:            swag.String(e.kind),
+			Lane:            swag.String(e.lane),
+			Name:            swag.String(e.name),
+			Info:            e.info,
+			HostID:          e.hostID,
+			StartedAt:       &entryStartedAt,
+			DurationSeconds: seconds(e.duration(now)),
+			Critical:        e.critical,
+		}
+		if !e.inProgress {
+			timelineEntry.EndedAt = strfmt.DateTime(e.endedAt)
+		}
+		ret.Entries = append(ret.Entries, timelineEntry)
+	}
+	for _, e := range critical {
+		ret.CriticalPath = append(ret.CriticalPath, ids[e])
+	}
+	ret.Summary = summary(cluster, ret, keys, transitions, critical, now)
+	return ret
+}
+
+// installationStart returns the time the cluster last moved to preparing-for-installation, falling back to the
+// install start time of the cluster. A zero time means that the start of the installation is unknown.
+func installationStart(cluster *common.Cluster, events []*common.Event) time.Time {
+	var startedAt time.Time
+	for _, event := range events {
+		if event.Name != eventgen.ClusterStatusUpdatedEventName || event.EventTime == nil {
+			continue
+		}
+		if match := clusterStatusRegex.FindStringSubmatch(swag.StringValue(event.Message)); match != nil &&
+			match[1] == models.ClusterStatusPreparingForInstallation {
+			startedAt = time.Time(*event.EventTime)
+		}
+	}
+	if startedAt.IsZero() {
+		startedAt = time.Time(cluster.InstallStartedAt)
+	}
+	return startedAt
+}
+
+func parseEvent(event *common.Event) *entry {
+	message := swag.StringValue(event.Message)
+	e := &entry{startedAt: time.Time(*event.EventTime)}
+	if event.HostID != nil {
+		e.hostID = *event.HostID
+	}
+	switch event.Name {
+	case eventgen.ClusterStatusUpdatedEventName:
+		match := clusterStatusRegex.FindStringSubmatch(message)
+		if match == nil {
+			return nil
+		}
+		e.kind, e.lane, e.name = models.InstallationTimelineEntryKindClusterStatus, clusterLane, match[1]
+	case eventgen.HostInstallProgressUpdatedEventName:
+		match := hostStageRegex.FindStringSubmatch(message)
+		if match == nil {
+			return nil
+		}
+		e.kind, e.lane, e.name, e.info = models.InstallationTimelineEntryKindHostStage, match[1], match[2], match[3]
+		if e.hostID != "" {
+			e.key = e.hostID.String()
+		}
+	case eventgen.ClusterOperatorStatusEventName:
+		match := operatorRegex.FindStringSubmatch(message)
+		if match == nil {
+			return nil
+		}
+		e.kind, e.lane, e.name, e.info = models.InstallationTimelineEntryKindOperatorStatus, "operator "+match[1], match[2], match[3]
+	case eventgen.HostLogsUploadedEventName:
+		e.kind, e.lane, e.name = models.InstallationTimelineEntryKindLogs, logsLane, "host logs"
+		if match := hostLogsRegex.FindStringSubmatch(message); match != nil {
+			e.name = "logs of " + match[1]
+		}
+	case eventgen.ClusterLogsUploadedEventName:
+		e.kind, e.lane, e.name = models.InstallationTimelineEntryKindLogs, logsLane, "cluster logs"
+	default:
+		return nil
+	}
+	if e.key == "" {
+		e.key = e.kind + "/" + e.lane
+	}
+	return e
+}
+
+// criticalPath walks the installation back from its end: at each point, the host stage or operator status that
+// ended last is the one the installation was waiting for, and the walk continues from the start of that entry.
+func criticalPath(entries []*entry, startedAt, end, now time.Time) []*entry {
+	var path []*entry
+	for end.After(startedAt) {
+		var next *entry
+		for _, e := range entries {
+			if e.kind != models.InstallationTimelineEntryKindHostStage && e.kind != models.InstallationTimelineEntryKindOperatorStatus {
+				continue
+			}
+			if !e.startedAt.Before(end) || e.end(now).After(end) {
+				continue
+			}
+			if next == nil || e.end(now).After(next.end(now)) ||
+				(e.end(now).Equal(next.end(now)) && e.startedAt.Before(next.startedAt)) {
+				next = e
+			}
+		}
+		if next == nil {
+			break
+		}
+		next.critical = true
+		path = append([]*entry{next}, path...)
+		end = next.startedAt
+	}
+	return path
+}
+
+func seconds(d time.Duration) int64 {
+	return int64(d.Round(time.Second) / time.Second)
+}
+
+// summary describes the timeline in a few lines: the overall duration, the statuses of the cluster, the stages of
+// each host with the longest one, the statuses of the operators, the log uploads and the critical path
+func summary(cluster *common.Cluster, timeline *models.InstallationTimeline, keys []string, transitions map[string][]*entry,
+	critical []*entry, now time.Time) string {
+	var lines []string
+	state := "in progress"
+	if !time.Time(timeline.CompletedAt).IsZero() {
+		state = "completed"
+	}
+	if time.Time(timeline.StartedAt).IsZero() {
+		lines = append(lines, fmt.Sprintf("Cluster %s (%s) %s: no installation found", cluster.Name, cluster.ID, timeline.Status))
+	} else {
+		lines = append(lines, fmt.Sprintf("Cluster %s (%s) %s: installation %s after %s", cluster.Name, cluster.ID, timeline.Status,
+			state, time.Duration(timeline.DurationSeconds)*time.Second))
+	}
+
+	var logs int
+	for _, key := range keys {
+		laneEntries := transitions[key]
+		switch laneEntries[0].kind {
+		case models.InstallationTimelineEntryKindHostStage:
+			longest := laneEntries[0]
+			for _, e := range laneEntries[1:] {
+				if e.duration(now) > longest.duration(now) {
+					longest = e
+				}
+			}
+			last := laneEntries[len(laneEntries)-1]
+			lines = append(lines, fmt.Sprintf("Host %s: %s after %s, longest stage %s",
+				last.lane, last.name, last.end(now).Sub(laneEntries[0].startedAt).Round(time.Second), describe(longest, now)))
+		case models.InstallationTimelineEntryKindLogs:
+			logs += len(laneEntries)
+		default:
+			described := make([]string, 0, len(laneEntries))
+			for _, e := range laneEntries {
+				described = append(described, describe(e, now))
+			}
+			lines = append(lines, fmt.Sprintf("%s%s: %s", strings.ToUpper(laneEntries[0].lane[:1]), laneEntries[0].lane[1:],
+				strings.Join(described, ", ")))
+		}
+	}
+	if logs > 0 {
+		lines = append(lines, fmt.Sprintf("Log uploads: %d", logs))
+	}
+	if len(critical) > 0 {
+		described := make([]string, 0, len(critical))
+		for _, e := range critical {
+			described = append(described, fmt.Sprintf("%s %s", e.lane, describe(e, now)))
+		}
+		lines = append(lines, "Critical path: "+strings.Join(described, " > "))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func describe(e *entry, now time.Time) string {
+	switch {
+	case e.inProgress:
+		return fmt.Sprintf("%s %s (in progress)", e.name, e.duration(now))
+	case e.endedAt.Equal(e.startedAt) && e.isTerminal():
+		return e.name
+	default:
+		return fmt.Sprintf("%s %s", e.name, e.duration(now))
+	}
+}
@@ -0,0 +1,231 @@
+package timeline
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	eventgen "github.com/openshift/assisted-service/internal/common/events"
+	"github.com/openshift/assisted-service/models"
+)
+
+func TestTimeline(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Installation Timeline Tests")
+}
+
+var _ = Describe("Build", func() {
+	var (
+		clusterID strfmt.UUID
+		hostA     strfmt.UUID
+		hostB     strfmt.UUID
+		cluster   *common.Cluster
+		start     time.Time
+		events    []*common.Event
+	)
+
+	at := func(minutes int) time.Time {
+		return start.Add(time.Duration(minutes) * time.Minute)
+	}
+
+	newEvent := func(name, message string, minutes int, hostID *strfmt.UUID) *common.Event {
+		eventTime := strfmt.DateTime(at(minutes))
+		return &common.Event{Event: models.Event{
+			Name:      name,
+			Message:   swag.String(message),
+			EventTime: &eventTime,
+			ClusterID: &clusterID,
+			HostID:    hostID,
+		}}
+	}
+
+	clusterStatus := func(status string, minutes int) *common.Event {
+		return newEvent(eventgen.ClusterStatusUpdatedEventName,
+			eventgen.NewClusterStatusUpdatedEvent(clusterID, status, "").FormatMessage(), minutes, nil)
+	}
+
+	hostStage := func(hostID strfmt.UUID, hostName string, stage models.HostStage, info string, minutes int) *common.Event {
+		return newEvent(eventgen.HostInstallProgressUpdatedEventName,
+			eventgen.NewHostInstallProgressUpdatedEvent(hostID, hostID, &clusterID, hostName, HostStageEvent(stage, info)).FormatMessage(), minutes, &hostID)
+	}
+
+	operatorStatus := func(operator string, status models.OperatorStatus, minutes int) *common.Event {
+		return newEvent(eventgen.ClusterOperatorStatusEventName,
+			eventgen.NewClusterOperatorStatusEvent(clusterID, operator, string(status), "status of "+operator).FormatMessage(), minutes, nil)
+	}
+
+	hostLogs := func(hostID strfmt.UUID, hostName string, minutes int) *common.Event {
+		return newEvent(eventgen.HostLogsUploadedEventName,
+			eventgen.NewHostLogsUploadedEvent(hostID, hostID, &clusterID, hostName).FormatMessage(), minutes, &hostID)
+	}
+
+	entry := func(timeline *models.InstallationTimeline, lane, name string) *models.InstallationTimelineEntry {
+		for _, e := range timeline.Entries {
+			if swag.StringValue(e.Lane) == lane && swag.StringValue(e.Name) == name {
+				return e
+			}
+		}
+		return nil
+	}
+
+	pathOf := func(timeline *models.InstallationTimeline) []string {
+		var path []string
+		for _, id := range timeline.CriticalPath {
+			for _, e := range timeline.Entries {
+				if swag.StringValue(e.ID) == id {
+					path = append(path, swag.StringValue(e.Lane)+" "+swag.StringValue(e.Name))
+				}
+			}
+		}
+		return path
+	}
+
+	BeforeEach(func() {
+		clusterID = strfmt.UUID(uuid.New().String())
+		hostA = strfmt.UUID(uuid.New().String())
+		hostB = strfmt.UUID(uuid.New().String())
+		start = time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+		cluster = &common.Cluster{Cluster: models.Cluster{
+			ID:     &clusterID,
+			Name:   "test-cluster",
+			Status: swag.String(models.ClusterStatusInstalled),
+		}}
+		events = []*common.Event{
+			// A previous installation attempt that was reset
+			clusterStatus(models.ClusterStatusPreparingForInstallation, -60),
+			hostStage(hostA, "master-0", models.HostStageStartingInstallation, "", -50),
+			clusterStatus(models.ClusterStatusReady, -40),
+
+			clusterStatus(models.ClusterStatusPreparingForInstallation, 0),
+			clusterStatus(models.ClusterStatusInstalling, 1),
+			hostStage(hostA, "master-0", models.HostStageStartingInstallation, "", 2),
+			hostStage(hostB, "master-1", models.HostStageStartingInstallation, "", 2),
+			hostStage(hostA, "master-0", models.HostStageInstalling, "", 5),
+			hostStage(hostB, "master-1", models.HostStageWritingImageToDisk, "50%", 10),
+			hostStage(hostA, "master-0", models.HostStageRebooting, "", 20),
+			hostLogs(hostB, "master-1", 21),
+			hostStage(hostB, "master-1", models.HostStageDone, "", 25),
+			hostStage(hostA, "master-0", models.HostStageDone, "", 30),
+			clusterStatus(models.ClusterStatusFinalizing, 31),
+			operatorStatus("lso", models.OperatorStatusProgressing, 31),
+			operatorStatus("lso", models.OperatorStatusAvailable, 40),
+			clusterStatus(models.ClusterStatusInstalled, 42),
+		}
+	})
+
+	It("builds the entries of the last installation", func() {
+		timeline := Build(cluster, events, at(60))
+
+		Expect(*timeline.ClusterID).To(Equal(clusterID))
+		Expect(timeline.Status).To(Equal(models.ClusterStatusInstalled))
+		Expect(time.Time(timeline.StartedAt)).To(BeTemporally("==", at(0)))
+		Expect(time.Time(timeline.CompletedAt)).To(BeTemporally("==", at(42)))
+		Expect(timeline.DurationSeconds).To(Equal(int64(42 * 60)))
+		Expect(timeline.Entries).To(HaveLen(14))
+		for i := 1; i < len(timeline.Entries); i++ {
+			Expect(time.Time(*timeline.Entries[i].StartedAt)).ToNot(BeTemporally("<", time.Time(*timeline.Entries[i-1].StartedAt)))
+		}
+
+		installing := entry(timeline, "cluster", models.ClusterStatusInstalling)
+		Expect(installing).ToNot(BeNil())
+		Expect(*installing.Kind).To(Equal(models.InstallationTimelineEntryKindClusterStatus))
+		Expect(time.Time(installing.EndedAt)).To(BeTemporally("==", at(31)))
+		Expect(installing.DurationSeconds).To(Equal(int64(30 * 60)))
+
+		writing := entry(timeline, "master-1", string(models.HostStageWritingImageToDisk))
+		Expect(writing).ToNot(BeNil())
+		Expect(*writing.Kind).To(Equal(models.InstallationTimelineEntryKindHostStage))
+		Expect(writing.HostID).To(Equal(hostB))
+		Expect(writing.Info).To(Equal("50%"))
+		Expect(writing.DurationSeconds).To(Equal(int64(15 * 60)))
+
+		done := entry(timeline, "master-0", string(models.HostStageDone))
+		Expect(done).ToNot(BeNil())
+		Expect(time.Time(done.EndedAt)).To(BeTemporally("==", at(30)))
+		Expect(done.DurationSeconds).To(BeZero())
+
+		progressing := entry(timeline, "operator lso", string(models.OperatorStatusProgressing))
+		Expect(progressing).ToNot(BeNil())
+		Expect(*progressing.Kind).To(Equal(models.InstallationTimelineEntryKindOperatorStatus))
+		Expect(progressing.Info).To(Equal("status of lso"))
+		Expect(progressing.DurationSeconds).To(Equal(int64(9 * 60)))
+
+		logs := entry(timeline, "logs", "logs of master-1")
+		Expect(logs).ToNot(BeNil())
+		Expect(*logs.Kind).To(Equal(models.InstallationTimelineEntryKindLogs))
+		Expect(logs.HostID).To(Equal(hostB))
+	})
+
+	It("finds the critical path", func() {
+		timeline := Build(cluster, events, at(60))
+
+		Expect(pathOf(timeline)).To(Equal([]string{
+			"master-0 " + string(models.HostStageStartingInstallation),
+			"master-0 " + string(models.HostStageInstalling),
+			"master-0 " + string(models.HostStageRebooting),
+			"operator lso " + string(models.OperatorStatusProgressing),
+		}))
+		for _, e := range timeline.Entries {
+			Expect(e.Critical).To(Equal(contains(timeline.CriticalPath, swag.StringValue(e.ID))))
+		}
+	})
+
+	It("summarizes the installation", func() {
+		timeline := Build(cluster, events, at(60))
+
+		Expect(timeline.Summary).To(Equal(
+			"Cluster test-cluster (" + clusterID.String() + ") installed: installation completed after 42m0s\n" +
+				"Cluster: preparing-for-installation 1m0s, installing 30m0s, finalizing 11m0s, installed\n" +
+				"Host master-0: Done after 28m0s, longest stage Installing 15m0s\n" +
+				"Host master-1: Done after 23m0s, longest stage Writing image to disk 15m0s\n" +
+				"Operator lso: progressing 9m0s, available\n" +
+				"Log uploads: 1\n" +
+				"Critical path: master-0 Starting installation 3m0s > master-0 Installing 15m0s > master-0 Rebooting 10m0s > " +
+				"operator lso progressing 9m0s"))
+	})
+
+	It("keeps the entries of an installation in progress open", func() {
+		cluster.Status = swag.String(models.ClusterStatusInstalling)
+		events = events[:10]
+
+		timeline := Build(cluster, events, at(22))
+
+		Expect(time.Time(timeline.CompletedAt).IsZero()).To(BeTrue())
+		Expect(timeline.DurationSeconds).To(Equal(int64(22 * 60)))
+		rebooting := entry(timeline, "master-0", string(models.HostStageRebooting))
+		Expect(rebooting).ToNot(BeNil())
+		Expect(time.Time(rebooting.EndedAt).IsZero()).To(BeTrue())
+		Expect(rebooting.DurationSeconds).To(Equal(int64(2 * 60)))
+		Expect(pathOf(timeline)).To(Equal([]string{
+			"master-1 " + string(models.HostStageStartingInstallation),
+			"master-1 " + string(models.HostStageWritingImageToDisk),
+		}))
+		Expect(timeline.Summary).To(ContainSubstring("installation in progress after 22m0s"))
+		Expect(timeline.Summary).To(ContainSubstring("Writing image to disk 12m0s (in progress)"))
+	})
+
+	It("reports clusters that weren't installed", func() {
+		cluster.Status = swag.String(models.ClusterStatusReady)
+
+		timeline := Build(cluster, nil, at(0))
+
+		Expect(timeline.Entries).To(BeEmpty())
+		Expect(timeline.CriticalPath).To(BeEmpty())
+		Expect(time.Time(timeline.StartedAt).IsZero()).To(BeTrue())
+		Expect(timeline.Summary).To(Equal("Cluster test-cluster (" + clusterID.String() + ") ready: no installation found"))
+	})
+})
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
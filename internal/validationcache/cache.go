@@ -0,0 +1,170 @@
+package validationcache
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type Config struct {
+	// Reuse the result of a validation while the inputs it declares didn't change
+	Enabled bool `envconfig:"VALIDATION_CACHE_ENABLED" default:"true"`
+	// Cached results are recomputed after this time even if their inputs didn't change, it also
+	// bounds how long the results of deleted hosts and clusters are kept
+	MaxAge time.Duration `envconfig:"VALIDATION_CACHE_MAX_AGE" default:"10m"`
+}
+
+var (
+	lookupsMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "assisted_installer_validation_cache_lookups_total",
+		Help: "Number of lookups of cached validation results, by kind, validation and result (hit, miss)",
+	}, []string{"kind", "validation", "result"})
+	durationMetric = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "assisted_installer_validation_duration_seconds",
+		Help:    "Histogram/sum/count of the time spent computing validations, by kind and validation",
+		Buckets: []float64{0.00001, 0.0001, 0.0005, 0.001, 0.005, 0.01, 0.05, 0.1, 0.5, 1},
+	}, []string{"kind", "validation"})
+	entriesMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "assisted_installer_validation_cache_entries",
+		Help: "Number of cached validation results, by kind",
+	}, []string{"kind"})
+)
+
+func init() {
+	prometheus.MustRegister(lookupsMetric, durationMetric, entriesMetric)
+}
+
+// Fingerprint identifies the inputs of a validation
+type Fingerprint [sha256.Size]byte
+
+// NewFingerprint hashes the JSON encoding of the given inputs
+func NewFingerprint(inputs ...interface{}) (Fingerprint, error) {
+	hash := sha256.New()
+	encoder := json.NewEncoder(hash)
+	for _, input := range inputs {
+		if err := encoder.Encode(input); err != nil {
+			return Fingerprint{}, err
+		}
+	}
+	var fingerprint Fingerprint
+	copy(fingerprint[:], hash.Sum(nil))
+	return fingerprint, nil
+}
+
+// Combine returns the fingerprint of a set of fingerprints, the order matters
+func Combine(fingerprints ...Fingerprint) Fingerprint {
+	hash := sha256.New()
+	for _, fingerprint := range fingerprints {
+		hash.Write(fingerprint[:])
+	}
+	var combined Fingerprint
+	copy(combined[:], hash.Sum(nil))
+	return combined
+}
+
+type Result struct {
+	Status  string
+	Message string
+}
+
+type entry struct {
+	fingerprint Fingerprint
+	result      Result
+	computedAt  time.Time
+}
+
+// Cache keeps the last result of each validation of each owner (host or cluster) together with
+// the fingerprint of the inputs it was computed from
+type Cache struct {
+	config    Config
+	kind      string
+	mu        sync.Mutex
+	entries   map[string]map[string]*entry
+	size      int
+	lastPrune time.Time
+	now       func() time.Time
+}
+
+// New creates a cache for the validations of the given kind, used to label the metrics
+func New(kind string, config Config) *Cache {
+	return &Cache{
+		config:    config,
+		kind:      kind,
+		entries:   make(map[string]map[string]*entry),
+		lastPrune: time.Now(),
+		now:       time.Now,
+	}
+}
+
+// Evaluate returns the cached result of the validation of the owner if it was computed from the
+// same fingerprint, otherwise it computes and caches it. Validations without a fingerprint don't
+// declare their inputs and are always computed.
+func (c *Cache) Evaluate(owner, validation string, fingerprint *Fingerprint, compute func() Result) Result {
+	cacheable := c != nil && c.config.Enabled && fingerprint != nil
+	if cacheable {
+		if result, ok := c.get(owner, validation, *fingerprint); ok {
+			lookupsMetric.WithLabelValues(c.kind, validation, "hit").Inc()
+			return result
+		}
+		lookupsMetric.WithLabelValues(c.kind, validation, "miss").Inc()
+	}
+
+	start := time.Now()
+	result := compute()
+	if c != nil {
+		durationMetric.WithLabelValues(c.kind, validation).Observe(time.Since(start).Seconds())
+	}
+
+	if cacheable {
+		c.set(owner, validation, *fingerprint, result)
+	}
+	return result
+}
+
+func (c *Cache) get(owner, validation string, fingerprint Fingerprint) (Result, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[owner][validation]
+	if !ok || e.fingerprint != fingerprint || c.now().Sub(e.computedAt) > c.config.MaxAge {
+		return Result{}, false
+	}
+	return e.result, true
+}
+
+func (c *Cache) set(owner, validation string, fingerprint Fingerprint, result Result) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now()
+	if now.Sub(c.lastPrune) > c.config.MaxAge {
+		c.prune(now)
+	}
+	results, ok := c.entries[owner]
+	if !ok {
+		results = make(map[string]*entry)
+		c.entries[owner] = results
+	}
+	if _, ok = results[validation]; !ok {
+		c.size++
+	}
+	results[validation] = &entry{fingerprint: fingerprint, result: result, computedAt: now}
+	entriesMetric.WithLabelValues(c.kind).Set(float64(c.size))
+}
+
+// prune drops the expired results, so that the results of deleted owners don't accumulate
+func (c *Cache) prune(now time.Time) {
+	for owner, results := range c.entries {
+		for validation, e := range results {
+			if now.Sub(e.computedAt) > c.config.MaxAge {
+				delete(results, validation)
+				c.size--
+			}
+		}
+		if len(results) == 0 {
+			delete(c.entries, owner)
+		}
+	}
+	c.lastPrune = now
+}
@@ -0,0 +1,99 @@
+package validationcache
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestValidationCache(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Validation cache")
+}
+
+var _ = Describe("Fingerprint", func() {
+	It("depends on the values and the order of the inputs", func() {
+		a, err := NewFingerprint("a", 1)
+		Expect(err).ToNot(HaveOccurred())
+		same, err := NewFingerprint("a", 1)
+		Expect(err).ToNot(HaveOccurred())
+		swapped, err := NewFingerprint(1, "a")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(a).To(Equal(same))
+		Expect(a).ToNot(Equal(swapped))
+		Expect(Combine(a, swapped)).ToNot(Equal(Combine(swapped, a)))
+	})
+})
+
+var _ = Describe("Cache", func() {
+	var (
+		cache    *Cache
+		now      time.Time
+		computed int
+	)
+
+	compute := func(status string) func() Result {
+		return func() Result {
+			computed++
+			return Result{Status: status, Message: "message"}
+		}
+	}
+
+	fingerprint := func(inputs ...interface{}) *Fingerprint {
+		f, err := NewFingerprint(inputs...)
+		Expect(err).ToNot(HaveOccurred())
+		return &f
+	}
+
+	BeforeEach(func() {
+		now = time.Now()
+		computed = 0
+		cache = New("host", Config{Enabled: true, MaxAge: 10 * time.Minute})
+		cache.now = func() time.Time { return now }
+	})
+
+	It("reuses the result while the inputs don't change", func() {
+		Expect(cache.Evaluate("h1", "v", fingerprint("x"), compute("success")).Status).To(Equal("success"))
+		Expect(cache.Evaluate("h1", "v", fingerprint("x"), compute("failure")).Status).To(Equal("success"))
+		Expect(computed).To(Equal(1))
+
+		Expect(cache.Evaluate("h1", "v", fingerprint("y"), compute("failure")).Status).To(Equal("failure"))
+		Expect(computed).To(Equal(2))
+	})
+
+	It("keeps the results of each owner and validation apart", func() {
+		cache.Evaluate("h1", "v", fingerprint("x"), compute("success"))
+		Expect(cache.Evaluate("h2", "v", fingerprint("x"), compute("failure")).Status).To(Equal("failure"))
+		Expect(cache.Evaluate("h1", "w", fingerprint("x"), compute("failure")).Status).To(Equal("failure"))
+		Expect(computed).To(Equal(3))
+	})
+
+	It("always computes validations without inputs", func() {
+		cache.Evaluate("h1", "v", nil, compute("success"))
+		cache.Evaluate("h1", "v", nil, compute("success"))
+		Expect(computed).To(Equal(2))
+	})
+
+	It("always computes when disabled", func() {
+		cache.config.Enabled = false
+		cache.Evaluate("h1", "v", fingerprint("x"), compute("success"))
+		cache.Evaluate("h1", "v", fingerprint("x"), compute("success"))
+		Expect(computed).To(Equal(2))
+	})
+
+	It("recomputes and prunes expired results", func() {
+		cache.Evaluate("h1", "v", fingerprint("x"), compute("success"))
+		now = now.Add(11 * time.Minute)
+		cache.Evaluate("h1", "v", fingerprint("x"), compute("success"))
+		Expect(computed).To(Equal(2))
+
+		cache.Evaluate("h2", "v", fingerprint("x"), compute("success"))
+		now = now.Add(11 * time.Minute)
+		cache.Evaluate("h3", "v", fingerprint("x"), compute("success"))
+		Expect(cache.entries).To(HaveLen(1))
+		Expect(cache.entries).To(HaveKey("h3"))
+		Expect(cache.size).To(Equal(1))
+	})
+})
@@ -38,18 +38,18 @@ func (m *MockHandler) EXPECT() *MockHandlerMockRecorder {
 }
 
 // AddReleaseImage mocks base method.
-func (m *MockHandler) AddReleaseImage(arg0, arg1, arg2 string, arg3 []string) (*models.ReleaseImage, error) {
+func (m *MockHandler) AddReleaseImage(arg0 context.Context, arg1, arg2, arg3 string, arg4 []string) (*models.ReleaseImage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddReleaseImage", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "AddReleaseImage", arg0, arg1, arg2, arg3, arg4)
 	ret0, _ := ret[0].(*models.ReleaseImage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddReleaseImage indicates an expected call of AddReleaseImage.
-func (mr *MockHandlerMockRecorder) AddReleaseImage(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+func (mr *MockHandlerMockRecorder) AddReleaseImage(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReleaseImage", reflect.TypeOf((*MockHandler)(nil).AddReleaseImage), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReleaseImage", reflect.TypeOf((*MockHandler)(nil).AddReleaseImage), arg0, arg1, arg2, arg3, arg4)
 }
 
 // GetCPUArchitectures mocks base method.
@@ -97,18 +97,18 @@ func (mr *MockHandlerMockRecorder) GetLatestOsImage(arg0 interface{}) *gomock.Ca
 }
 
 // GetMustGatherImages mocks base method.
-func (m *MockHandler) GetMustGatherImages(arg0, arg1, arg2 string) (MustGatherVersion, error) {
+func (m *MockHandler) GetMustGatherImages(arg0 context.Context, arg1, arg2, arg3 string) (MustGatherVersion, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMustGatherImages", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "GetMustGatherImages", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(MustGatherVersion)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetMustGatherImages indicates an expected call of GetMustGatherImages.
-func (mr *MockHandlerMockRecorder) GetMustGatherImages(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockHandlerMockRecorder) GetMustGatherImages(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMustGatherImages", reflect.TypeOf((*MockHandler)(nil).GetMustGatherImages), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMustGatherImages", reflect.TypeOf((*MockHandler)(nil).GetMustGatherImages), arg0, arg1, arg2, arg3)
 }
 
 // GetOpenshiftVersions mocks base method.
@@ -36,7 +36,7 @@ type Versions struct {
 //go:generate mockgen --build_flags=--mod=mod -package versions -destination mock_versions.go -self_package github.com/openshift/assisted-service/internal/versions . Handler
 type Handler interface {
 	restapi.VersionsAPI
-	GetMustGatherImages(openshiftVersion, cpuArchitecture, pullSecret string) (MustGatherVersion, error)
+	GetMustGatherImages(ctx context.Context, openshiftVersion, cpuArchitecture, pullSecret string) (MustGatherVersion, error)
 	GetReleaseImage(openshiftVersion, cpuArchitecture string) (*models.ReleaseImage, error)
 	GetDefaultReleaseImage(cpuArchitecture string) (*models.ReleaseImage, error)
 	GetOsImage(openshiftVersion, cpuArchitecture string) (*models.OsImage, error)
@@ -44,7 +44,7 @@ type Handler interface {
 	GetOsImageOrLatest(version string, cpuArch string) (*models.OsImage, error)
 	GetCPUArchitectures(openshiftVersion string) []string
 	GetOpenshiftVersions() []string
-	AddReleaseImage(releaseImageUrl, pullSecret, ocpReleaseVersion string, cpuArchitectures []string) (*models.ReleaseImage, error)
+	AddReleaseImage(ctx context.Context, releaseImageUrl, pullSecret, ocpReleaseVersion string, cpuArchitectures []string) (*models.ReleaseImage, error)
 	ValidateReleaseImageForRHCOS(rhcosVersion, cpuArch string) error
 }
 
@@ -177,7 +177,7 @@ func (h *handler) V2ListSupportedOpenshiftVersions(ctx context.Context, params o
 	return operations.NewV2ListSupportedOpenshiftVersionsOK().WithPayload(openshiftVersions)
 }
 
-func (h *handler) GetMustGatherImages(openshiftVersion, cpuArchitecture, pullSecret string) (MustGatherVersion, error) {
+func (h *handler) GetMustGatherImages(ctx context.Context, openshiftVersion, cpuArchitecture, pullSecret string) (MustGatherVersion, error) {
 	versionKey, err := toMajorMinor(openshiftVersion)
 	if err != nil {
 		return nil, err
@@ -199,7 +199,7 @@ func (h *handler) GetMustGatherImages(openshiftVersion, cpuArchitecture, pullSec
 	if err != nil {
 		return nil, err
 	}
-	ocpMustGatherImage, err := h.releaseHandler.GetMustGatherImage(h.log, *releaseImage.URL, h.releaseImageMirror, pullSecret)
+	ocpMustGatherImage, err := h.releaseHandler.GetMustGatherImage(ctx, h.log, *releaseImage.URL, h.releaseImageMirror, pullSecret)
 	if err != nil {
 		return nil, err
 	}
@@ -401,7 +401,7 @@ func (h *handler) GetOsImageOrLatest(version string, cpuArch string) (*models.Os
 	return osImage, nil
 }
 
-func (h *handler) AddReleaseImage(releaseImageUrl, pullSecret, ocpReleaseVersion string, cpuArchitectures []string) (*models.ReleaseImage, error) {
+func (h *handler) AddReleaseImage(ctx context.Context, releaseImageUrl, pullSecret, ocpReleaseVersion string, cpuArchitectures []string) (*models.ReleaseImage, error) {
 	var err error
 	var cpuArchitecture string
 	var osImage *models.OsImage
@@ -410,7 +410,7 @@ func (h *handler) AddReleaseImage(releaseImageUrl, pullSecret, ocpReleaseVersion
 	// If cpu architecture is passed as "multiarch" instead of unwrapped architectures, recalculate it.
 	if ocpReleaseVersion == "" || len(cpuArchitectures) == 0 || cpuArchitectures[0] == common.MultiCPUArchitecture {
 		// Get openshift version from release image metadata (oc adm release info)
-		ocpReleaseVersion, err = h.releaseHandler.GetOpenshiftVersion(h.log, releaseImageUrl, "", pullSecret)
+		ocpReleaseVersion, err = h.releaseHandler.GetOpenshiftVersion(ctx, h.log, releaseImageUrl, "", pullSecret)
 		if err != nil {
 			return nil, err
 		}
@@ -419,7 +419,7 @@ func (h *handler) AddReleaseImage(releaseImageUrl, pullSecret, ocpReleaseVersion
 		// Get CPU architecture from release image. For single-arch image the returned list will contain
 		// as single entry with the architecture. For multi-arch image the list will contain all the architectures
 		// that the image references to.
-		cpuArchitectures, err = h.releaseHandler.GetReleaseArchitecture(h.log, releaseImageUrl, "", pullSecret)
+		cpuArchitectures, err = h.releaseHandler.GetReleaseArchitecture(ctx, h.log, releaseImageUrl, "", pullSecret)
 		if err != nil {
 			return nil, err
 		}
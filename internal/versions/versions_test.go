@@ -692,8 +692,8 @@ var _ = Describe("list versions", func() {
 		}
 
 		It("happy flow", func() {
-			mockRelease.EXPECT().GetMustGatherImage(gomock.Any(), "release_4.8", mirror, pullSecret).Return("blah", nil).Times(1)
-			images, err = h.GetMustGatherImages(ocpVersion, cpuArchitecture, pullSecret)
+			mockRelease.EXPECT().GetMustGatherImage(gomock.Any(), gomock.Any(), "release_4.8", mirror, pullSecret).Return("blah", nil).Times(1)
+			images, err = h.GetMustGatherImages(context.Background(), ocpVersion, cpuArchitecture, pullSecret)
 			Expect(err).ShouldNot(HaveOccurred())
 
 			verifyOcpVersion(images, 4)
@@ -701,24 +701,24 @@ var _ = Describe("list versions", func() {
 		})
 
 		It("unsupported_key", func() {
-			images, err = h.GetMustGatherImages("unsupported", cpuArchitecture, pullSecret)
+			images, err = h.GetMustGatherImages(context.Background(), "unsupported", cpuArchitecture, pullSecret)
 			Expect(err).Should(HaveOccurred())
 			Expect(images).Should(BeEmpty())
 		})
 
 		It("caching", func() {
-			mockRelease.EXPECT().GetMustGatherImage(gomock.Any(), "release_4.8", mirror, pullSecret).Return("blah", nil).Times(1)
-			images, err = h.GetMustGatherImages(ocpVersion, cpuArchitecture, pullSecret)
+			mockRelease.EXPECT().GetMustGatherImage(gomock.Any(), gomock.Any(), "release_4.8", mirror, pullSecret).Return("blah", nil).Times(1)
+			images, err = h.GetMustGatherImages(context.Background(), ocpVersion, cpuArchitecture, pullSecret)
 			Expect(err).ShouldNot(HaveOccurred())
 			verifyOcpVersion(images, 4)
 
-			images, err = h.GetMustGatherImages(ocpVersion, cpuArchitecture, pullSecret)
+			images, err = h.GetMustGatherImages(context.Background(), ocpVersion, cpuArchitecture, pullSecret)
 			Expect(err).ShouldNot(HaveOccurred())
 			verifyOcpVersion(images, 4)
 		})
 
 		It("missing release image", func() {
-			images, err = h.GetMustGatherImages("4.7", cpuArchitecture, pullSecret)
+			images, err = h.GetMustGatherImages(context.Background(), "4.7", cpuArchitecture, pullSecret)
 			Expect(err).Should(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("isn't specified in release images list"))
 			Expect(images).Should(BeEmpty())
@@ -744,12 +744,12 @@ var _ = Describe("list versions", func() {
 
 		Context("for single-arch release image", func() {
 			It("added successfully", func() {
-				mockRelease.EXPECT().GetOpenshiftVersion(
+				mockRelease.EXPECT().GetOpenshiftVersion(gomock.Any(),
 					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(customOcpVersion, nil).AnyTimes()
-				mockRelease.EXPECT().GetReleaseArchitecture(
+				mockRelease.EXPECT().GetReleaseArchitecture(gomock.Any(),
 					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]string{cpuArchitecture}, nil).AnyTimes()
 
-				releaseImage, err = h.AddReleaseImage(releaseImageUrl, pullSecret, "", nil)
+				releaseImage, err = h.AddReleaseImage(context.Background(), releaseImageUrl, pullSecret, "", nil)
 				Expect(err).ShouldNot(HaveOccurred())
 
 				Expect(*releaseImage.CPUArchitecture).Should(Equal(cpuArchitecture))
@@ -760,7 +760,7 @@ var _ = Describe("list versions", func() {
 			})
 
 			It("added successfuly using specified ocpReleaseVersion and cpuArchitecture", func() {
-				_, err = h.AddReleaseImage(releaseImageUrl, pullSecret, customOcpVersion, []string{cpuArchitecture})
+				_, err = h.AddReleaseImage(context.Background(), releaseImageUrl, pullSecret, customOcpVersion, []string{cpuArchitecture})
 				Expect(err).ShouldNot(HaveOccurred())
 				releaseImageFromCache, err = h.GetReleaseImage(customOcpVersion, cpuArchitecture)
 				Expect(err).ShouldNot(HaveOccurred())
@@ -772,9 +772,9 @@ var _ = Describe("list versions", func() {
 			})
 
 			It("when release image already exists", func() {
-				mockRelease.EXPECT().GetOpenshiftVersion(
+				mockRelease.EXPECT().GetOpenshiftVersion(gomock.Any(),
 					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(existingOcpVersion, nil).AnyTimes()
-				mockRelease.EXPECT().GetReleaseArchitecture(
+				mockRelease.EXPECT().GetReleaseArchitecture(gomock.Any(),
 					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]string{cpuArchitecture}, nil).AnyTimes()
 
 				releaseImageFromCache := funk.Find(h.releaseImages, func(releaseImage *models.ReleaseImage) bool {
@@ -782,7 +782,7 @@ var _ = Describe("list versions", func() {
 				})
 				Expect(releaseImageFromCache).ShouldNot(BeNil())
 
-				_, err = h.AddReleaseImage(releaseImageUrl, pullSecret, "", nil)
+				_, err = h.AddReleaseImage(context.Background(), releaseImageUrl, pullSecret, "", nil)
 				Expect(err).ShouldNot(HaveOccurred())
 
 				releaseImage, err = h.GetReleaseImage(existingOcpVersion, cpuArchitecture)
@@ -792,12 +792,12 @@ var _ = Describe("list versions", func() {
 
 			It("fails when missing OS image", func() {
 				ocpVersion := "4.7"
-				mockRelease.EXPECT().GetOpenshiftVersion(
+				mockRelease.EXPECT().GetOpenshiftVersion(gomock.Any(),
 					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(ocpVersion, nil).AnyTimes()
-				mockRelease.EXPECT().GetReleaseArchitecture(
+				mockRelease.EXPECT().GetReleaseArchitecture(gomock.Any(),
 					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]string{cpuArchitecture}, nil).AnyTimes()
 
-				_, err = h.AddReleaseImage("invalidRelease", pullSecret, "", nil)
+				_, err = h.AddReleaseImage(context.Background(), "invalidRelease", pullSecret, "", nil)
 				Expect(err).Should(HaveOccurred())
 				Expect(err.Error()).Should(Equal(fmt.Sprintf("No OS images are available for version %s and architecture %s", ocpVersion, cpuArchitecture)))
 			})
@@ -805,12 +805,12 @@ var _ = Describe("list versions", func() {
 
 		Context("for multi-arch release image", func() {
 			It("added successfully", func() {
-				mockRelease.EXPECT().GetOpenshiftVersion(
+				mockRelease.EXPECT().GetOpenshiftVersion(gomock.Any(),
 					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(customOcpVersion, nil).AnyTimes()
-				mockRelease.EXPECT().GetReleaseArchitecture(
+				mockRelease.EXPECT().GetReleaseArchitecture(gomock.Any(),
 					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]string{cpuArchitecture, common.ARM64CPUArchitecture}, nil).AnyTimes()
 
-				releaseImage, err = h.AddReleaseImage(releaseImageUrl, pullSecret, "", nil)
+				releaseImage, err = h.AddReleaseImage(context.Background(), releaseImageUrl, pullSecret, "", nil)
 				Expect(err).ShouldNot(HaveOccurred())
 
 				Expect(*releaseImage.CPUArchitecture).Should(Equal(common.MultiCPUArchitecture))
@@ -821,7 +821,7 @@ var _ = Describe("list versions", func() {
 			})
 
 			It("added successfuly using specified ocpReleaseVersion and cpuArchitecture", func() {
-				_, err = h.AddReleaseImage(releaseImageUrl, pullSecret, customOcpVersion, []string{cpuArchitecture, common.ARM64CPUArchitecture})
+				_, err = h.AddReleaseImage(context.Background(), releaseImageUrl, pullSecret, customOcpVersion, []string{cpuArchitecture, common.ARM64CPUArchitecture})
 				Expect(err).ShouldNot(HaveOccurred())
 				releaseImageFromCache, err = h.GetReleaseImage(customOcpVersion, common.MultiCPUArchitecture)
 				Expect(err).ShouldNot(HaveOccurred())
@@ -833,12 +833,12 @@ var _ = Describe("list versions", func() {
 			})
 
 			It("added successfuly and recalculated using specified ocpReleaseVersion and 'multiarch' cpuArchitecture", func() {
-				mockRelease.EXPECT().GetOpenshiftVersion(
+				mockRelease.EXPECT().GetOpenshiftVersion(gomock.Any(),
 					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(customOcpVersion, nil).AnyTimes()
-				mockRelease.EXPECT().GetReleaseArchitecture(
+				mockRelease.EXPECT().GetReleaseArchitecture(gomock.Any(),
 					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]string{cpuArchitecture, common.ARM64CPUArchitecture}, nil).AnyTimes()
 
-				_, err = h.AddReleaseImage(releaseImageUrl, pullSecret, customOcpVersion, []string{common.MultiCPUArchitecture})
+				_, err = h.AddReleaseImage(context.Background(), releaseImageUrl, pullSecret, customOcpVersion, []string{common.MultiCPUArchitecture})
 				Expect(err).ShouldNot(HaveOccurred())
 				releaseImageFromCache, err = h.GetReleaseImage(customOcpVersion, common.MultiCPUArchitecture)
 				Expect(err).ShouldNot(HaveOccurred())
@@ -850,9 +850,9 @@ var _ = Describe("list versions", func() {
 			})
 
 			It("when release image already exists", func() {
-				mockRelease.EXPECT().GetOpenshiftVersion(
+				mockRelease.EXPECT().GetOpenshiftVersion(gomock.Any(),
 					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("4.11.1", nil).AnyTimes()
-				mockRelease.EXPECT().GetReleaseArchitecture(
+				mockRelease.EXPECT().GetReleaseArchitecture(gomock.Any(),
 					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]string{cpuArchitecture, common.ARM64CPUArchitecture}, nil).AnyTimes()
 
 				releaseImageFromCache := funk.Find(h.releaseImages, func(releaseImage *models.ReleaseImage) bool {
@@ -860,7 +860,7 @@ var _ = Describe("list versions", func() {
 				})
 				Expect(releaseImageFromCache).ShouldNot(BeNil())
 
-				_, err = h.AddReleaseImage(releaseImageUrl, pullSecret, "", nil)
+				_, err = h.AddReleaseImage(context.Background(), releaseImageUrl, pullSecret, "", nil)
 				Expect(err).ShouldNot(HaveOccurred())
 
 				// Query for multi-arch release image using generic multiarch
@@ -884,55 +884,55 @@ var _ = Describe("list versions", func() {
 
 		Context("with failing OCP version extraction", func() {
 			It("using default syntax", func() {
-				mockRelease.EXPECT().GetOpenshiftVersion(
+				mockRelease.EXPECT().GetOpenshiftVersion(gomock.Any(),
 					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("invalid")).AnyTimes()
-				mockRelease.EXPECT().GetReleaseArchitecture(
+				mockRelease.EXPECT().GetReleaseArchitecture(gomock.Any(),
 					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]string{cpuArchitecture}, nil).AnyTimes()
 
-				_, err = h.AddReleaseImage(releaseImageUrl, pullSecret, "", nil)
+				_, err = h.AddReleaseImage(context.Background(), releaseImageUrl, pullSecret, "", nil)
 				Expect(err).Should(HaveOccurred())
 			})
 
 			It("using specified cpuArchitectures", func() {
-				mockRelease.EXPECT().GetOpenshiftVersion(
+				mockRelease.EXPECT().GetOpenshiftVersion(gomock.Any(),
 					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("invalid")).AnyTimes()
-				mockRelease.EXPECT().GetReleaseArchitecture(
+				mockRelease.EXPECT().GetReleaseArchitecture(gomock.Any(),
 					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]string{cpuArchitecture}, nil).AnyTimes()
 
-				_, err = h.AddReleaseImage(releaseImageUrl, pullSecret, "", []string{cpuArchitecture})
+				_, err = h.AddReleaseImage(context.Background(), releaseImageUrl, pullSecret, "", []string{cpuArchitecture})
 				Expect(err).Should(HaveOccurred())
 			})
 		})
 
 		Context("with failing architecture extraction", func() {
 			It("using default syntax", func() {
-				mockRelease.EXPECT().GetOpenshiftVersion(
+				mockRelease.EXPECT().GetOpenshiftVersion(gomock.Any(),
 					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(customOcpVersion, nil).AnyTimes()
-				mockRelease.EXPECT().GetReleaseArchitecture(
+				mockRelease.EXPECT().GetReleaseArchitecture(gomock.Any(),
 					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("some error when getting architecture")).AnyTimes()
 
-				_, err = h.AddReleaseImage(releaseImageUrl, pullSecret, "", nil)
+				_, err = h.AddReleaseImage(context.Background(), releaseImageUrl, pullSecret, "", nil)
 				Expect(err).Should(HaveOccurred())
 			})
 
 			It("using specified ocpReleaseVersion", func() {
-				mockRelease.EXPECT().GetOpenshiftVersion(
+				mockRelease.EXPECT().GetOpenshiftVersion(gomock.Any(),
 					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(customOcpVersion, nil).AnyTimes()
-				mockRelease.EXPECT().GetReleaseArchitecture(
+				mockRelease.EXPECT().GetReleaseArchitecture(gomock.Any(),
 					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("some error when getting architecture")).AnyTimes()
 
-				_, err = h.AddReleaseImage(releaseImageUrl, pullSecret, customOcpVersion, nil)
+				_, err = h.AddReleaseImage(context.Background(), releaseImageUrl, pullSecret, customOcpVersion, nil)
 				Expect(err).Should(HaveOccurred())
 			})
 		})
 
 		It("keep support level from cache", func() {
-			mockRelease.EXPECT().GetOpenshiftVersion(
+			mockRelease.EXPECT().GetOpenshiftVersion(gomock.Any(),
 				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(customOcpVersion, nil).AnyTimes()
-			mockRelease.EXPECT().GetReleaseArchitecture(
+			mockRelease.EXPECT().GetReleaseArchitecture(gomock.Any(),
 				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]string{cpuArchitecture}, nil).AnyTimes()
 
-			releaseImage, err = h.AddReleaseImage(releaseImageUrl, pullSecret, "", nil)
+			releaseImage, err = h.AddReleaseImage(context.Background(), releaseImageUrl, pullSecret, "", nil)
 			Expect(err).ShouldNot(HaveOccurred())
 			releaseImage, err = h.GetReleaseImage(customOcpVersion, cpuArchitecture)
 			Expect(err).ShouldNot(HaveOccurred())
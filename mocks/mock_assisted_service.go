@@ -92,6 +92,20 @@ func (mr *MockInstallerAPIMockRecorder) GetClusterSupportedPlatforms(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterSupportedPlatforms", reflect.TypeOf((*MockInstallerAPI)(nil).GetClusterSupportedPlatforms), arg0, arg1)
 }
 
+// GetClusterSupportedPlatformsDetails mocks base method.
+func (m *MockInstallerAPI) GetClusterSupportedPlatformsDetails(arg0 context.Context, arg1 installer.GetClusterSupportedPlatformsDetailsParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClusterSupportedPlatformsDetails", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// GetClusterSupportedPlatformsDetails indicates an expected call of GetClusterSupportedPlatformsDetails.
+func (mr *MockInstallerAPIMockRecorder) GetClusterSupportedPlatformsDetails(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterSupportedPlatformsDetails", reflect.TypeOf((*MockInstallerAPI)(nil).GetClusterSupportedPlatformsDetails), arg0, arg1)
+}
+
 // GetInfraEnv mocks base method.
 func (m *MockInstallerAPI) GetInfraEnv(arg0 context.Context, arg1 installer.GetInfraEnvParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -232,6 +246,20 @@ func (mr *MockInstallerAPIMockRecorder) UpdateInfraEnv(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInfraEnv", reflect.TypeOf((*MockInstallerAPI)(nil).UpdateInfraEnv), arg0, arg1)
 }
 
+// V2BulkHostsAction mocks base method.
+func (m *MockInstallerAPI) V2BulkHostsAction(arg0 context.Context, arg1 installer.V2BulkHostsActionParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2BulkHostsAction", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2BulkHostsAction indicates an expected call of V2BulkHostsAction.
+func (mr *MockInstallerAPIMockRecorder) V2BulkHostsAction(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2BulkHostsAction", reflect.TypeOf((*MockInstallerAPI)(nil).V2BulkHostsAction), arg0, arg1)
+}
+
 // V2CancelInstallation mocks base method.
 func (m *MockInstallerAPI) V2CancelInstallation(arg0 context.Context, arg1 installer.V2CancelInstallationParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -358,6 +386,34 @@ func (mr *MockInstallerAPIMockRecorder) V2DownloadInfraEnvFiles(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2DownloadInfraEnvFiles", reflect.TypeOf((*MockInstallerAPI)(nil).V2DownloadInfraEnvFiles), arg0, arg1)
 }
 
+// V2ExportCluster mocks base method.
+func (m *MockInstallerAPI) V2ExportCluster(arg0 context.Context, arg1 installer.V2ExportClusterParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2ExportCluster", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2ExportCluster indicates an expected call of V2ExportCluster.
+func (mr *MockInstallerAPIMockRecorder) V2ExportCluster(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2ExportCluster", reflect.TypeOf((*MockInstallerAPI)(nil).V2ExportCluster), arg0, arg1)
+}
+
+// V2GarbageCollectionDryRun mocks base method.
+func (m *MockInstallerAPI) V2GarbageCollectionDryRun(arg0 context.Context, arg1 installer.V2GarbageCollectionDryRunParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2GarbageCollectionDryRun", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2GarbageCollectionDryRun indicates an expected call of V2GarbageCollectionDryRun.
+func (mr *MockInstallerAPIMockRecorder) V2GarbageCollectionDryRun(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2GarbageCollectionDryRun", reflect.TypeOf((*MockInstallerAPI)(nil).V2GarbageCollectionDryRun), arg0, arg1)
+}
+
 // V2GetCluster mocks base method.
 func (m *MockInstallerAPI) V2GetCluster(arg0 context.Context, arg1 installer.V2GetClusterParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -400,6 +456,34 @@ func (mr *MockInstallerAPIMockRecorder) V2GetClusterInstallConfig(arg0, arg1 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2GetClusterInstallConfig", reflect.TypeOf((*MockInstallerAPI)(nil).V2GetClusterInstallConfig), arg0, arg1)
 }
 
+// V2GetClusterInstallationTimeline mocks base method.
+func (m *MockInstallerAPI) V2GetClusterInstallationTimeline(arg0 context.Context, arg1 installer.V2GetClusterInstallationTimelineParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2GetClusterInstallationTimeline", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2GetClusterInstallationTimeline indicates an expected call of V2GetClusterInstallationTimeline.
+func (mr *MockInstallerAPIMockRecorder) V2GetClusterInstallationTimeline(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2GetClusterInstallationTimeline", reflect.TypeOf((*MockInstallerAPI)(nil).V2GetClusterInstallationTimeline), arg0, arg1)
+}
+
+// V2GetClusterRenderedFiles mocks base method.
+func (m *MockInstallerAPI) V2GetClusterRenderedFiles(arg0 context.Context, arg1 installer.V2GetClusterRenderedFilesParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2GetClusterRenderedFiles", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2GetClusterRenderedFiles indicates an expected call of V2GetClusterRenderedFiles.
+func (mr *MockInstallerAPIMockRecorder) V2GetClusterRenderedFiles(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2GetClusterRenderedFiles", reflect.TypeOf((*MockInstallerAPI)(nil).V2GetClusterRenderedFiles), arg0, arg1)
+}
+
 // V2GetCredentials mocks base method.
 func (m *MockInstallerAPI) V2GetCredentials(arg0 context.Context, arg1 installer.V2GetCredentialsParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -512,6 +596,20 @@ func (mr *MockInstallerAPIMockRecorder) V2ImportCluster(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2ImportCluster", reflect.TypeOf((*MockInstallerAPI)(nil).V2ImportCluster), arg0, arg1)
 }
 
+// V2ImportClusterBundle mocks base method.
+func (m *MockInstallerAPI) V2ImportClusterBundle(arg0 context.Context, arg1 installer.V2ImportClusterBundleParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2ImportClusterBundle", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2ImportClusterBundle indicates an expected call of V2ImportClusterBundle.
+func (mr *MockInstallerAPIMockRecorder) V2ImportClusterBundle(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2ImportClusterBundle", reflect.TypeOf((*MockInstallerAPI)(nil).V2ImportClusterBundle), arg0, arg1)
+}
+
 // V2InstallCluster mocks base method.
 func (m *MockInstallerAPI) V2InstallCluster(arg0 context.Context, arg1 installer.V2InstallClusterParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -540,6 +638,34 @@ func (mr *MockInstallerAPIMockRecorder) V2InstallHost(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2InstallHost", reflect.TypeOf((*MockInstallerAPI)(nil).V2InstallHost), arg0, arg1)
 }
 
+// V2ListClusterInstallConfigRevisions mocks base method.
+func (m *MockInstallerAPI) V2ListClusterInstallConfigRevisions(arg0 context.Context, arg1 installer.V2ListClusterInstallConfigRevisionsParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2ListClusterInstallConfigRevisions", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2ListClusterInstallConfigRevisions indicates an expected call of V2ListClusterInstallConfigRevisions.
+func (mr *MockInstallerAPIMockRecorder) V2ListClusterInstallConfigRevisions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2ListClusterInstallConfigRevisions", reflect.TypeOf((*MockInstallerAPI)(nil).V2ListClusterInstallConfigRevisions), arg0, arg1)
+}
+
+// V2ListClusterRevisions mocks base method.
+func (m *MockInstallerAPI) V2ListClusterRevisions(arg0 context.Context, arg1 installer.V2ListClusterRevisionsParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2ListClusterRevisions", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2ListClusterRevisions indicates an expected call of V2ListClusterRevisions.
+func (mr *MockInstallerAPIMockRecorder) V2ListClusterRevisions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2ListClusterRevisions", reflect.TypeOf((*MockInstallerAPI)(nil).V2ListClusterRevisions), arg0, arg1)
+}
+
 // V2ListClusters mocks base method.
 func (m *MockInstallerAPI) V2ListClusters(arg0 context.Context, arg1 installer.V2ListClustersParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -596,6 +722,20 @@ func (mr *MockInstallerAPIMockRecorder) V2PostStepReply(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2PostStepReply", reflect.TypeOf((*MockInstallerAPI)(nil).V2PostStepReply), arg0, arg1)
 }
 
+// V2ReclaimHost mocks base method.
+func (m *MockInstallerAPI) V2ReclaimHost(arg0 context.Context, arg1 installer.V2ReclaimHostParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2ReclaimHost", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2ReclaimHost indicates an expected call of V2ReclaimHost.
+func (mr *MockInstallerAPIMockRecorder) V2ReclaimHost(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2ReclaimHost", reflect.TypeOf((*MockInstallerAPI)(nil).V2ReclaimHost), arg0, arg1)
+}
+
 // V2RegisterCluster mocks base method.
 func (m *MockInstallerAPI) V2RegisterCluster(arg0 context.Context, arg1 installer.V2RegisterClusterParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -666,6 +806,20 @@ func (mr *MockInstallerAPIMockRecorder) V2ResetHostValidation(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2ResetHostValidation", reflect.TypeOf((*MockInstallerAPI)(nil).V2ResetHostValidation), arg0, arg1)
 }
 
+// V2RollbackCluster mocks base method.
+func (m *MockInstallerAPI) V2RollbackCluster(arg0 context.Context, arg1 installer.V2RollbackClusterParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2RollbackCluster", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2RollbackCluster indicates an expected call of V2RollbackCluster.
+func (mr *MockInstallerAPIMockRecorder) V2RollbackCluster(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2RollbackCluster", reflect.TypeOf((*MockInstallerAPI)(nil).V2RollbackCluster), arg0, arg1)
+}
+
 // V2UpdateCluster mocks base method.
 func (m *MockInstallerAPI) V2UpdateCluster(arg0 context.Context, arg1 installer.V2UpdateClusterParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -166,6 +166,9 @@ type Cluster struct {
 	// Enum: [Cluster AddHostsCluster]
 	Kind *string `json:"kind"`
 
+	// Json containing the user-defined labels of the cluster.
+	Labels string `json:"labels,omitempty" gorm:"type:text"`
+
 	// The progress of log collection or empty if logs are not applicable
 	LogsInfo LogsState `json:"logs_info,omitempty" gorm:"type:varchar(2048)"`
 
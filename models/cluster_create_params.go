@@ -34,6 +34,9 @@ type ClusterCreateParams struct {
 	// Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.
 	BaseDNSDomain string `json:"base_dns_domain,omitempty"`
 
+	// clone
+	Clone *ClusterCloneParams `json:"clone,omitempty"`
+
 	// IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.
 	// Pattern: ^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$
 	ClusterNetworkCidr *string `json:"cluster_network_cidr,omitempty"`
@@ -82,6 +85,9 @@ type ClusterCreateParams struct {
 	// The virtual IPs used for cluster ingress traffic. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.
 	IngressVips []*IngressVip `json:"ingress_vips"`
 
+	// User-defined labels of the cluster.
+	Labels []*LabelParams `json:"labels"`
+
 	// Machine networks that are associated with this cluster.
 	MachineNetworks []*MachineNetwork `json:"machine_networks"`
 
@@ -150,6 +156,10 @@ func (m *ClusterCreateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateClone(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateClusterNetworkCidr(formats); err != nil {
 		res = append(res, err)
 	}
@@ -186,6 +196,10 @@ func (m *ClusterCreateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateLabels(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateMachineNetworks(formats); err != nil {
 		res = append(res, err)
 	}
@@ -266,6 +280,25 @@ func (m *ClusterCreateParams) validateAPIVips(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *ClusterCreateParams) validateClone(formats strfmt.Registry) error {
+	if swag.IsZero(m.Clone) { // not required
+		return nil
+	}
+
+	if m.Clone != nil {
+		if err := m.Clone.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("clone")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("clone")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *ClusterCreateParams) validateClusterNetworkCidr(formats strfmt.Registry) error {
 	if swag.IsZero(m.ClusterNetworkCidr) { // not required
 		return nil
@@ -486,6 +519,32 @@ func (m *ClusterCreateParams) validateIngressVips(formats strfmt.Registry) error
 	return nil
 }
 
+func (m *ClusterCreateParams) validateLabels(formats strfmt.Registry) error {
+	if swag.IsZero(m.Labels) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Labels); i++ {
+		if swag.IsZero(m.Labels[i]) { // not required
+			continue
+		}
+
+		if m.Labels[i] != nil {
+			if err := m.Labels[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("labels" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("labels" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *ClusterCreateParams) validateMachineNetworks(formats strfmt.Registry) error {
 	if swag.IsZero(m.MachineNetworks) { // not required
 		return nil
@@ -680,6 +739,10 @@ func (m *ClusterCreateParams) ContextValidate(ctx context.Context, formats strfm
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateClone(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateClusterNetworks(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -696,6 +759,10 @@ func (m *ClusterCreateParams) ContextValidate(ctx context.Context, formats strfm
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateLabels(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateMachineNetworks(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -738,6 +805,22 @@ func (m *ClusterCreateParams) contextValidateAPIVips(ctx context.Context, format
 	return nil
 }
 
+func (m *ClusterCreateParams) contextValidateClone(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Clone != nil {
+		if err := m.Clone.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("clone")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("clone")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *ClusterCreateParams) contextValidateClusterNetworks(ctx context.Context, formats strfmt.Registry) error {
 
 	for i := 0; i < len(m.ClusterNetworks); i++ {
@@ -810,6 +893,26 @@ func (m *ClusterCreateParams) contextValidateIngressVips(ctx context.Context, fo
 	return nil
 }
 
+func (m *ClusterCreateParams) contextValidateLabels(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Labels); i++ {
+
+		if m.Labels[i] != nil {
+			if err := m.Labels[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("labels" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("labels" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *ClusterCreateParams) contextValidateMachineNetworks(ctx context.Context, formats strfmt.Registry) error {
 
 	for i := 0; i < len(m.MachineNetworks); i++ {
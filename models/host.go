@@ -112,6 +112,9 @@ type Host struct {
 	// Enum: [Host AddToExistingClusterHost]
 	Kind *string `json:"kind"`
 
+	// Json containing the user-defined labels of the host.
+	Labels string `json:"labels,omitempty" gorm:"type:text"`
+
 	// logs collected at
 	// Format: date-time
 	LogsCollectedAt strfmt.DateTime `json:"logs_collected_at,omitempty" gorm:"type:timestamp with time zone"`
@@ -152,6 +155,9 @@ type Host struct {
 	// role
 	Role HostRole `json:"role,omitempty"`
 
+	// root device hints
+	RootDeviceHints *RootDeviceHints `json:"root_device_hints,omitempty" gorm:"embedded;embeddedPrefix:root_device_hints_"`
+
 	// A comma-seperated list of host disks that the service will avoid
 	// formatting.
 	SkipFormattingDisks string `json:"skip_formatting_disks,omitempty" gorm:"type:text"`
@@ -261,6 +267,10 @@ func (m *Host) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateRootDeviceHints(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateStageStartedAt(formats); err != nil {
 		res = append(res, err)
 	}
@@ -560,6 +570,25 @@ func (m *Host) validateRole(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Host) validateRootDeviceHints(formats strfmt.Registry) error {
+	if swag.IsZero(m.RootDeviceHints) { // not required
+		return nil
+	}
+
+	if m.RootDeviceHints != nil {
+		if err := m.RootDeviceHints.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("root_device_hints")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("root_device_hints")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Host) validateStageStartedAt(formats strfmt.Registry) error {
 	if swag.IsZero(m.StageStartedAt) { // not required
 		return nil
@@ -775,6 +804,10 @@ func (m *Host) ContextValidate(ctx context.Context, formats strfmt.Registry) err
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateRootDeviceHints(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateSuggestedRole(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -847,6 +880,22 @@ func (m *Host) contextValidateRole(ctx context.Context, formats strfmt.Registry)
 	return nil
 }
 
+func (m *Host) contextValidateRootDeviceHints(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.RootDeviceHints != nil {
+		if err := m.RootDeviceHints.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("root_device_hints")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("root_device_hints")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Host) contextValidateSuggestedRole(ctx context.Context, formats strfmt.Registry) error {
 
 	if err := m.SuggestedRole.ContextValidate(ctx, formats); err != nil {
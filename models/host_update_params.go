@@ -37,11 +37,17 @@ type HostUpdateParams struct {
 	// A string which will be used as Authorization Bearer token to fetch the ignition from ignition_endpoint_url.
 	IgnitionEndpointToken *string `json:"ignition_endpoint_token,omitempty"`
 
+	// User-defined labels of the host, the existing labels are replaced.
+	Labels []*LabelParams `json:"labels"`
+
 	// machine config pool name
 	MachineConfigPoolName *string `json:"machine_config_pool_name,omitempty"`
 
 	// Labels to be added to the corresponding node.
 	NodeLabels []*NodeLabelParams `json:"node_labels"`
+
+	// root device hints
+	RootDeviceHints *RootDeviceHints `json:"root_device_hints,omitempty" gorm:"embedded;embeddedPrefix:root_device_hints_"`
 }
 
 // Validate validates this host update params
@@ -60,10 +66,18 @@ func (m *HostUpdateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateLabels(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateNodeLabels(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateRootDeviceHints(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -167,6 +181,32 @@ func (m *HostUpdateParams) validateHostRole(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *HostUpdateParams) validateLabels(formats strfmt.Registry) error {
+	if swag.IsZero(m.Labels) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Labels); i++ {
+		if swag.IsZero(m.Labels[i]) { // not required
+			continue
+		}
+
+		if m.Labels[i] != nil {
+			if err := m.Labels[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("labels" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("labels" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *HostUpdateParams) validateNodeLabels(formats strfmt.Registry) error {
 	if swag.IsZero(m.NodeLabels) { // not required
 		return nil
@@ -193,6 +233,25 @@ func (m *HostUpdateParams) validateNodeLabels(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *HostUpdateParams) validateRootDeviceHints(formats strfmt.Registry) error {
+	if swag.IsZero(m.RootDeviceHints) { // not required
+		return nil
+	}
+
+	if m.RootDeviceHints != nil {
+		if err := m.RootDeviceHints.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("root_device_hints")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("root_device_hints")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // ContextValidate validate this host update params based on the context it is used
 func (m *HostUpdateParams) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
@@ -205,10 +264,18 @@ func (m *HostUpdateParams) ContextValidate(ctx context.Context, formats strfmt.R
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateLabels(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateNodeLabels(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateRootDeviceHints(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -255,6 +322,26 @@ func (m *HostUpdateParams) contextValidateDisksSkipFormatting(ctx context.Contex
 	return nil
 }
 
+func (m *HostUpdateParams) contextValidateLabels(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Labels); i++ {
+
+		if m.Labels[i] != nil {
+			if err := m.Labels[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("labels" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("labels" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *HostUpdateParams) contextValidateNodeLabels(ctx context.Context, formats strfmt.Registry) error {
 
 	for i := 0; i < len(m.NodeLabels); i++ {
@@ -275,6 +362,22 @@ func (m *HostUpdateParams) contextValidateNodeLabels(ctx context.Context, format
 	return nil
 }
 
+func (m *HostUpdateParams) contextValidateRootDeviceHints(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.RootDeviceHints != nil {
+		if err := m.RootDeviceHints.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("root_device_hints")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("root_device_hints")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *HostUpdateParams) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -23,6 +23,12 @@ type ImportClusterParams struct {
 	// Required: true
 	APIVipDnsname *string `json:"api_vip_dnsname"`
 
+	// Admin kubeconfig of the OpenShift cluster. When set, it is stored by the service and used to
+	// act on the cluster on behalf of the user, e.g. to approve the certificate signing requests of added hosts
+	// or to reclaim hosts.
+	//
+	Kubeconfig string `json:"kubeconfig,omitempty"`
+
 	// OpenShift cluster name.
 	// Required: true
 	Name *string `json:"name"`
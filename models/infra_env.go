@@ -69,6 +69,9 @@ type InfraEnv struct {
 	// Enum: [InfraEnv]
 	Kind *string `json:"kind"`
 
+	// Json containing the user-defined labels of the infra-env.
+	Labels string `json:"labels,omitempty" gorm:"type:text"`
+
 	// Name of the infra-env.
 	// Required: true
 	Name *string `json:"name"`
@@ -85,6 +88,9 @@ type InfraEnv struct {
 	// True if the pull secret has been added to the cluster.
 	PullSecretSet bool `json:"pull_secret_set,omitempty"`
 
+	// root device hints
+	RootDeviceHints *RootDeviceHints `json:"root_device_hints,omitempty" gorm:"embedded;embeddedPrefix:root_device_hints_"`
+
 	// size bytes
 	// Minimum: 0
 	SizeBytes *int64 `json:"size_bytes,omitempty"`
@@ -144,6 +150,10 @@ func (m *InfraEnv) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateRootDeviceHints(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateSizeBytes(formats); err != nil {
 		res = append(res, err)
 	}
@@ -289,6 +299,25 @@ func (m *InfraEnv) validateProxy(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *InfraEnv) validateRootDeviceHints(formats strfmt.Registry) error {
+	if swag.IsZero(m.RootDeviceHints) { // not required
+		return nil
+	}
+
+	if m.RootDeviceHints != nil {
+		if err := m.RootDeviceHints.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("root_device_hints")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("root_device_hints")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *InfraEnv) validateSizeBytes(formats strfmt.Registry) error {
 	if swag.IsZero(m.SizeBytes) { // not required
 		return nil
@@ -346,6 +375,10 @@ func (m *InfraEnv) ContextValidate(ctx context.Context, formats strfmt.Registry)
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateRootDeviceHints(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateType(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -372,6 +405,22 @@ func (m *InfraEnv) contextValidateProxy(ctx context.Context, formats strfmt.Regi
 	return nil
 }
 
+func (m *InfraEnv) contextValidateRootDeviceHints(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.RootDeviceHints != nil {
+		if err := m.RootDeviceHints.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("root_device_hints")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("root_device_hints")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *InfraEnv) contextValidateType(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.Type != nil {
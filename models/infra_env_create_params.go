@@ -39,6 +39,9 @@ type InfraEnvCreateParams struct {
 	// kernel arguments
 	KernelArguments KernelArguments `json:"kernel_arguments"`
 
+	// User-defined labels of the infra-env.
+	Labels []*LabelParams `json:"labels"`
+
 	// Name of the infra-env.
 	// Required: true
 	Name *string `json:"name"`
@@ -53,6 +56,9 @@ type InfraEnvCreateParams struct {
 	// Required: true
 	PullSecret *string `json:"pull_secret"`
 
+	// root device hints
+	RootDeviceHints *RootDeviceHints `json:"root_device_hints,omitempty" gorm:"embedded;embeddedPrefix:root_device_hints_"`
+
 	// SSH public key for debugging the installation.
 	SSHAuthorizedKey *string `json:"ssh_authorized_key,omitempty"`
 
@@ -76,6 +82,10 @@ func (m *InfraEnvCreateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateLabels(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateName(formats); err != nil {
 		res = append(res, err)
 	}
@@ -88,6 +98,10 @@ func (m *InfraEnvCreateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateRootDeviceHints(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateStaticNetworkConfig(formats); err != nil {
 		res = append(res, err)
 	}
@@ -144,6 +158,32 @@ func (m *InfraEnvCreateParams) validateKernelArguments(formats strfmt.Registry)
 	return nil
 }
 
+func (m *InfraEnvCreateParams) validateLabels(formats strfmt.Registry) error {
+	if swag.IsZero(m.Labels) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Labels); i++ {
+		if swag.IsZero(m.Labels[i]) { // not required
+			continue
+		}
+
+		if m.Labels[i] != nil {
+			if err := m.Labels[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("labels" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("labels" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *InfraEnvCreateParams) validateName(formats strfmt.Registry) error {
 
 	if err := validate.Required("name", "body", m.Name); err != nil {
@@ -181,6 +221,25 @@ func (m *InfraEnvCreateParams) validatePullSecret(formats strfmt.Registry) error
 	return nil
 }
 
+func (m *InfraEnvCreateParams) validateRootDeviceHints(formats strfmt.Registry) error {
+	if swag.IsZero(m.RootDeviceHints) { // not required
+		return nil
+	}
+
+	if m.RootDeviceHints != nil {
+		if err := m.RootDeviceHints.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("root_device_hints")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("root_device_hints")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *InfraEnvCreateParams) validateStaticNetworkConfig(formats strfmt.Registry) error {
 	if swag.IsZero(m.StaticNetworkConfig) { // not required
 		return nil
@@ -219,10 +278,18 @@ func (m *InfraEnvCreateParams) ContextValidate(ctx context.Context, formats strf
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateLabels(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateProxy(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateRootDeviceHints(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateStaticNetworkConfig(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -261,6 +328,26 @@ func (m *InfraEnvCreateParams) contextValidateKernelArguments(ctx context.Contex
 	return nil
 }
 
+func (m *InfraEnvCreateParams) contextValidateLabels(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Labels); i++ {
+
+		if m.Labels[i] != nil {
+			if err := m.Labels[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("labels" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("labels" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *InfraEnvCreateParams) contextValidateProxy(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.Proxy != nil {
@@ -277,6 +364,22 @@ func (m *InfraEnvCreateParams) contextValidateProxy(ctx context.Context, formats
 	return nil
 }
 
+func (m *InfraEnvCreateParams) contextValidateRootDeviceHints(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.RootDeviceHints != nil {
+		if err := m.RootDeviceHints.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("root_device_hints")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("root_device_hints")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *InfraEnvCreateParams) contextValidateStaticNetworkConfig(ctx context.Context, formats strfmt.Registry) error {
 
 	for i := 0; i < len(m.StaticNetworkConfig); i++ {
@@ -31,12 +31,18 @@ type InfraEnvUpdateParams struct {
 	// kernel arguments
 	KernelArguments KernelArguments `json:"kernel_arguments"`
 
+	// User-defined labels of the infra-env, the existing labels are replaced.
+	Labels []*LabelParams `json:"labels"`
+
 	// proxy
 	Proxy *Proxy `json:"proxy,omitempty" gorm:"embedded;embeddedPrefix:proxy_"`
 
 	// The pull secret obtained from Red Hat OpenShift Cluster Manager at console.redhat.com/openshift/install/pull-secret.
 	PullSecret string `json:"pull_secret,omitempty"`
 
+	// root device hints
+	RootDeviceHints *RootDeviceHints `json:"root_device_hints,omitempty" gorm:"embedded;embeddedPrefix:root_device_hints_"`
+
 	// SSH public key for debugging the installation.
 	SSHAuthorizedKey *string `json:"ssh_authorized_key,omitempty"`
 
@@ -56,10 +62,18 @@ func (m *InfraEnvUpdateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateLabels(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateProxy(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateRootDeviceHints(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateStaticNetworkConfig(formats); err != nil {
 		res = append(res, err)
 	}
@@ -104,6 +118,32 @@ func (m *InfraEnvUpdateParams) validateKernelArguments(formats strfmt.Registry)
 	return nil
 }
 
+func (m *InfraEnvUpdateParams) validateLabels(formats strfmt.Registry) error {
+	if swag.IsZero(m.Labels) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Labels); i++ {
+		if swag.IsZero(m.Labels[i]) { // not required
+			continue
+		}
+
+		if m.Labels[i] != nil {
+			if err := m.Labels[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("labels" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("labels" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *InfraEnvUpdateParams) validateProxy(formats strfmt.Registry) error {
 	if swag.IsZero(m.Proxy) { // not required
 		return nil
@@ -123,6 +163,25 @@ func (m *InfraEnvUpdateParams) validateProxy(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *InfraEnvUpdateParams) validateRootDeviceHints(formats strfmt.Registry) error {
+	if swag.IsZero(m.RootDeviceHints) { // not required
+		return nil
+	}
+
+	if m.RootDeviceHints != nil {
+		if err := m.RootDeviceHints.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("root_device_hints")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("root_device_hints")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *InfraEnvUpdateParams) validateStaticNetworkConfig(formats strfmt.Registry) error {
 	if swag.IsZero(m.StaticNetworkConfig) { // not required
 		return nil
@@ -161,10 +220,18 @@ func (m *InfraEnvUpdateParams) ContextValidate(ctx context.Context, formats strf
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateLabels(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateProxy(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateRootDeviceHints(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateStaticNetworkConfig(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -203,6 +270,26 @@ func (m *InfraEnvUpdateParams) contextValidateKernelArguments(ctx context.Contex
 	return nil
 }
 
+func (m *InfraEnvUpdateParams) contextValidateLabels(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Labels); i++ {
+
+		if m.Labels[i] != nil {
+			if err := m.Labels[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("labels" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("labels" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *InfraEnvUpdateParams) contextValidateProxy(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.Proxy != nil {
@@ -219,6 +306,22 @@ func (m *InfraEnvUpdateParams) contextValidateProxy(ctx context.Context, formats
 	return nil
 }
 
+func (m *InfraEnvUpdateParams) contextValidateRootDeviceHints(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.RootDeviceHints != nil {
+		if err := m.RootDeviceHints.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("root_device_hints")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("root_device_hints")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *InfraEnvUpdateParams) contextValidateStaticNetworkConfig(ctx context.Context, formats strfmt.Registry) error {
 
 	for i := 0; i < len(m.StaticNetworkConfig); i++ {
@@ -22,6 +22,9 @@ type Platform struct {
 	// type
 	// Required: true
 	Type *PlatformType `json:"type"`
+
+	// vsphere
+	Vsphere *VspherePlatform `json:"vsphere,omitempty" gorm:"embedded;embeddedPrefix:vsphere_"`
 }
 
 // Validate validates this platform
@@ -32,6 +35,10 @@ func (m *Platform) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateVsphere(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -62,6 +69,25 @@ func (m *Platform) validateType(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Platform) validateVsphere(formats strfmt.Registry) error {
+	if swag.IsZero(m.Vsphere) { // not required
+		return nil
+	}
+
+	if m.Vsphere != nil {
+		if err := m.Vsphere.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("vsphere")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("vsphere")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // ContextValidate validate this platform based on the context it is used
 func (m *Platform) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
@@ -70,6 +96,10 @@ func (m *Platform) ContextValidate(ctx context.Context, formats strfmt.Registry)
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateVsphere(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -92,6 +122,22 @@ func (m *Platform) contextValidateType(ctx context.Context, formats strfmt.Regis
 	return nil
 }
 
+func (m *Platform) contextValidateVsphere(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Vsphere != nil {
+		if err := m.Vsphere.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("vsphere")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("vsphere")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *Platform) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -1,9 +1,13 @@
 package app
 
 import (
+	"bytes"
 	"fmt"
+	"io"
+	"mime"
 	"net/http"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -12,6 +16,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rs/cors"
 	"github.com/sirupsen/logrus"
+	"sigs.k8s.io/yaml"
 )
 
 const (
@@ -21,6 +26,10 @@ const (
 
 var ipxeScriptPattern = regexp.MustCompile(fmt.Sprintf(`^%s/v2/infra-envs/([0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12})/downloads/files`, client.DefaultBasePath))
 
+var clusterBundlePattern = regexp.MustCompile(fmt.Sprintf(`^%s/v2/clusters/(import-bundle|[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}/export)$`, client.DefaultBasePath))
+
+var yamlMediaTypes = map[string]bool{"application/yaml": true, "application/x-yaml": true, "text/yaml": true}
+
 // WithMetricsResponderMiddleware Returns middleware which responds to /metrics endpoint with the prometheus metrics
 // of the service
 func WithMetricsResponderMiddleware(next http.Handler) http.Handler {
@@ -104,3 +113,80 @@ func WithIPXEScriptMiddleware(next http.Handler) http.Handler {
 		next.ServeHTTP(w, r)
 	})
 }
+
+// WithClusterBundleYAMLMiddleware returns middleware which lets the cluster bundle endpoints, that are described
+// in JSON by the API, be used with YAML. YAML request bodies are converted to JSON, and JSON responses are
+// converted to YAML when YAML is the first media type accepted by the client.
+func WithClusterBundleYAMLMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !clusterBundlePattern.MatchString(r.URL.Path) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if isYAMLMediaType(r.Header.Get("Content-Type")) {
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				http.Error(w, fmt.Sprintf("failed to read the request: %s", err), http.StatusBadRequest)
+				return
+			}
+			if body, err = yaml.YAMLToJSON(body); err != nil {
+				http.Error(w, fmt.Sprintf("failed to parse the YAML request: %s", err), http.StatusBadRequest)
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+			r.ContentLength = int64(len(body))
+			r.Header.Set("Content-Type", "application/json")
+			r.Header.Set("Content-Length", strconv.Itoa(len(body)))
+		}
+		accept := strings.Split(r.Header.Get("Accept"), ",")
+		if !isYAMLMediaType(accept[0]) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		r.Header.Set("Accept", "application/json")
+		recorder := &bufferedResponseWriter{header: http.Header{}, status: http.StatusOK}
+		next.ServeHTTP(recorder, r)
+		body := recorder.body.Bytes()
+		if isJSONMediaType(recorder.header.Get("Content-Type")) {
+			if converted, err := yaml.JSONToYAML(body); err == nil {
+				body = converted
+				recorder.header.Set("Content-Type", "application/yaml")
+			}
+		}
+		for key, values := range recorder.header {
+			w.Header()[key] = values
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+		w.WriteHeader(recorder.status)
+		_, _ = w.Write(body)
+	})
+}
+
+func isYAMLMediaType(value string) bool {
+	mediaType, _, err := mime.ParseMediaType(value)
+	return err == nil && yamlMediaTypes[mediaType]
+}
+
+func isJSONMediaType(value string) bool {
+	mediaType, _, err := mime.ParseMediaType(value)
+	return err == nil && mediaType == "application/json"
+}
+
+// bufferedResponseWriter keeps a response in memory, so that it can be converted before being sent
+type bufferedResponseWriter struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (b *bufferedResponseWriter) Header() http.Header {
+	return b.header
+}
+
+func (b *bufferedResponseWriter) WriteHeader(status int) {
+	b.status = status
+}
+
+func (b *bufferedResponseWriter) Write(data []byte) (int, error) {
+	return b.body.Write(data)
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strings"
 	"testing"
 	"time"
 
@@ -159,3 +160,68 @@ var _ = Describe("WithInitrdViaHTTPMiddleware", func() {
 		Expect(respStatus).To(Equal(200))
 	})
 })
+
+var _ = Describe("WithClusterBundleYAMLMiddleware", func() {
+	var (
+		received    []byte
+		contentType string
+		handler     http.Handler
+	)
+
+	BeforeEach(func() {
+		received = nil
+		contentType = ""
+		handler = WithClusterBundleYAMLMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var err error
+			received, err = io.ReadAll(r.Body)
+			Expect(err).NotTo(HaveOccurred())
+			contentType = r.Header.Get("Content-Type")
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"cluster_id":"b9b3bb0c-8b51-4e5e-9d6b-1f8e8e0d7c9a","conflicts":[]}`)
+		}))
+	})
+
+	It("converts YAML bundles to JSON", func() {
+		req := httptest.NewRequest(http.MethodPost, "/api/assisted-install/v2/clusters/import-bundle",
+			strings.NewReader("pull_secret: secret\nbundle:\n  version: v1\n"))
+		req.Header.Set("Content-Type", "application/yaml")
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+
+		Expect(rr.Code).To(Equal(http.StatusCreated))
+		Expect(contentType).To(Equal("application/json"))
+		Expect(received).To(MatchJSON(`{"pull_secret":"secret","bundle":{"version":"v1"}}`))
+		Expect(rr.Header().Get("Content-Type")).To(Equal("application/json"))
+	})
+
+	It("refuses invalid YAML", func() {
+		req := httptest.NewRequest(http.MethodPost, "/api/assisted-install/v2/clusters/import-bundle", strings.NewReader("bundle: ["))
+		req.Header.Set("Content-Type", "application/yaml")
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+
+		Expect(rr.Code).To(Equal(http.StatusBadRequest))
+		Expect(received).To(BeNil())
+	})
+
+	It("exports YAML when the client accepts it", func() {
+		req := httptest.NewRequest(http.MethodGet, "/api/assisted-install/v2/clusters/b9b3bb0c-8b51-4e5e-9d6b-1f8e8e0d7c9a/export", nil)
+		req.Header.Set("Accept", "application/yaml, application/json;q=0.5")
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+
+		Expect(rr.Code).To(Equal(http.StatusCreated))
+		Expect(rr.Header().Get("Content-Type")).To(Equal("application/yaml"))
+		Expect(rr.Body.String()).To(Equal("cluster_id: b9b3bb0c-8b51-4e5e-9d6b-1f8e8e0d7c9a\nconflicts: []\n"))
+	})
+
+	It("leaves the other endpoints alone", func() {
+		req := httptest.NewRequest(http.MethodGet, "/api/assisted-install/v2/clusters/b9b3bb0c-8b51-4e5e-9d6b-1f8e8e0d7c9a", nil)
+		req.Header.Set("Accept", "application/yaml")
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+
+		Expect(rr.Header().Get("Content-Type")).To(Equal("application/json"))
+	})
+})
@@ -29,6 +29,10 @@ func (f fakeInventory) V2GetPreflightRequirements(ctx context.Context, params in
 	return installer.NewV2GetPreflightRequirementsOK().WithPayload(&models.PreflightHardwareRequirements{})
 }
 
+func (f fakeInventory) V2BulkHostsAction(ctx context.Context, params installer.V2BulkHostsActionParams) middleware.Responder {
+	return installer.NewV2BulkHostsActionOK()
+}
+
 func (f fakeInventory) V2CancelInstallation(ctx context.Context, params installer.V2CancelInstallationParams) middleware.Responder {
 	return installer.NewV2CancelInstallationAccepted()
 }
@@ -105,6 +109,26 @@ func (f fakeInventory) V2GetClusterInstallConfig(ctx context.Context, params ins
 	return installer.NewV2GetClusterInstallConfigOK()
 }
 
+func (f fakeInventory) V2GetClusterInstallationTimeline(ctx context.Context, params installer.V2GetClusterInstallationTimelineParams) middleware.Responder {
+	return installer.NewV2GetClusterInstallationTimelineOK()
+}
+
+func (f fakeInventory) V2GetClusterRenderedFiles(ctx context.Context, params installer.V2GetClusterRenderedFilesParams) middleware.Responder {
+	return installer.NewV2GetClusterRenderedFilesOK()
+}
+
+func (f fakeInventory) V2ListClusterInstallConfigRevisions(ctx context.Context, params installer.V2ListClusterInstallConfigRevisionsParams) middleware.Responder {
+	return installer.NewV2ListClusterInstallConfigRevisionsOK()
+}
+
+func (f fakeInventory) V2ListClusterRevisions(ctx context.Context, params installer.V2ListClusterRevisionsParams) middleware.Responder {
+	return installer.NewV2ListClusterRevisionsOK()
+}
+
+func (f fakeInventory) V2RollbackCluster(ctx context.Context, params installer.V2RollbackClusterParams) middleware.Responder {
+	return installer.NewV2RollbackClusterAccepted()
+}
+
 func (f fakeInventory) V2UpdateClusterInstallConfig(ctx context.Context, params installer.V2UpdateClusterInstallConfigParams) middleware.Responder {
 	return installer.NewV2UpdateClusterInstallConfigCreated()
 }
@@ -125,6 +149,10 @@ func (f fakeInventory) GetClusterSupportedPlatforms(ctx context.Context, params
 	return installer.NewGetClusterSupportedPlatformsOK()
 }
 
+func (f fakeInventory) GetClusterSupportedPlatformsDetails(ctx context.Context, params installer.GetClusterSupportedPlatformsDetailsParams) middleware.Responder {
+	return installer.NewGetClusterSupportedPlatformsDetailsOK()
+}
+
 func (f fakeInventory) V2UpdateHostIgnition(ctx context.Context, params installer.V2UpdateHostIgnitionParams) middleware.Responder {
 	return installer.NewV2UpdateHostIgnitionCreated()
 }
@@ -189,6 +217,18 @@ func (f fakeInventory) UnbindHost(ctx context.Context, params installer.UnbindHo
 	return installer.NewUnbindHostOK()
 }
 
+func (f fakeInventory) V2ExportCluster(ctx context.Context, params installer.V2ExportClusterParams) middleware.Responder {
+	return installer.NewV2ExportClusterOK()
+}
+
+func (f fakeInventory) V2ImportClusterBundle(ctx context.Context, params installer.V2ImportClusterBundleParams) middleware.Responder {
+	return installer.NewV2ImportClusterBundleCreated()
+}
+
+func (f fakeInventory) V2ReclaimHost(ctx context.Context, params installer.V2ReclaimHostParams) middleware.Responder {
+	return installer.NewV2ReclaimHostOK()
+}
+
 func (f fakeInventory) V2ListHosts(ctx context.Context, params installer.V2ListHostsParams) middleware.Responder {
 	return installer.NewV2ListHostsOK()
 }
@@ -226,6 +266,10 @@ func (f fakeInventory) V2GetPresignedForClusterFiles(ctx context.Context, params
 	return installer.NewV2GetPresignedForClusterFilesOK()
 }
 
+func (f fakeInventory) V2GarbageCollectionDryRun(ctx context.Context, params installer.V2GarbageCollectionDryRunParams) middleware.Responder {
+	return installer.NewV2GarbageCollectionDryRunOK()
+}
+
 func (f fakeInventory) V2GetClusterDefaultConfig(ctx context.Context, params installer.V2GetClusterDefaultConfigParams) middleware.Responder {
 	return installer.NewV2GetClusterDefaultConfigOK()
 }
@@ -18,3 +18,16 @@ func MeasureOperation(operation string, log logrus.FieldLogger, metricsApi metri
 		}
 	}
 }
+
+// MeasureMonitor measures a single loop of a background monitor. On top of the operation duration
+// it reports the loop duration to the monitor specific histogram.
+func MeasureMonitor(monitor string, log logrus.FieldLogger, metricsApi metrics.API) func() {
+	start := time.Now()
+	measureOperation := MeasureOperation(monitor, log, metricsApi)
+	return func() {
+		measureOperation()
+		if metricsApi != nil {
+			metricsApi.MonitorDuration(monitor, time.Since(start))
+		}
+	}
+}
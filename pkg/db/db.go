@@ -1,9 +1,47 @@
 package db
 
+import (
+	"fmt"
+	"strings"
+)
+
+const defaultSSLMode = "disable"
+
 type Config struct {
 	Host string `envconfig:"DB_HOST"`
 	Port string `envconfig:"DB_PORT"`
 	User string `envconfig:"DB_USER"`
 	Pass string `envconfig:"DB_PASS"`
 	Name string `envconfig:"DB_NAME"`
+	// SSLMode is the libpq sslmode of the connection: disable, allow, prefer, require, verify-ca or verify-full
+	SSLMode string `envconfig:"DB_SSL_MODE" default:"disable"`
+	// SSLRootCert is the path of the file holding the CA certificates used to verify the database server
+	SSLRootCert string `envconfig:"DB_SSL_ROOT_CERT"`
+}
+
+// DSN returns the keyword/value connection string of the database. Every value is quoted, so values holding
+// spaces, quotes or backslashes, such as passwords, are passed as they are
+func (c *Config) DSN() string {
+	sslMode := c.SSLMode
+	if sslMode == "" {
+		sslMode = defaultSSLMode
+	}
+	settings := []string{
+		dsnSetting("host", c.Host),
+		dsnSetting("port", c.Port),
+		dsnSetting("user", c.User),
+		dsnSetting("database", c.Name),
+		dsnSetting("password", c.Pass),
+		dsnSetting("sslmode", sslMode),
+	}
+	if c.SSLRootCert != "" {
+		settings = append(settings, dsnSetting("sslrootcert", c.SSLRootCert))
+	}
+	return strings.Join(settings, " ")
+}
+
+var dsnValueEscaper = strings.NewReplacer(`\`, `\\`, `'`, `\'`)
+
+func dsnSetting(key, value string) string {
+	return fmt.Sprintf("%s='%s'", key, dsnValueEscaper.Replace(value))
 }
@@ -0,0 +1,33 @@
+package db
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestDB(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "DB Tests")
+}
+
+var _ = Describe("DSN", func() {
+	It("disables SSL by default", func() {
+		cfg := Config{Host: "db.example.com", Port: "5432", User: "admin", Pass: "secret", Name: "installer"}
+		Expect(cfg.DSN()).To(Equal(
+			"host='db.example.com' port='5432' user='admin' database='installer' password='secret' sslmode='disable'"))
+	})
+
+	It("sets the SSL mode and root certificate", func() {
+		cfg := Config{Host: "db.example.com", Port: "5432", User: "admin", Pass: "secret", Name: "installer",
+			SSLMode: "verify-full", SSLRootCert: "/etc/assisted-db-ca/ca-bundle.crt"}
+		Expect(cfg.DSN()).To(Equal("host='db.example.com' port='5432' user='admin' database='installer' password='secret' " +
+			"sslmode='verify-full' sslrootcert='/etc/assisted-db-ca/ca-bundle.crt'"))
+	})
+
+	It("escapes values holding spaces, quotes and backslashes", func() {
+		cfg := Config{Host: "db.example.com", Port: "5432", User: "admin", Pass: `p@ss word's \secret`, Name: "installer"}
+		Expect(cfg.DSN()).To(ContainSubstring(`password='p@ss word\'s \\secret' `))
+	})
+})
@@ -0,0 +1,394 @@
+package leader
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"os"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+	coordv1 "k8s.io/api/coordination/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	coordinationv1 "k8s.io/client-go/kubernetes/typed/coordination/v1"
+)
+
+const (
+	// PartitionMemberLabel marks the leases that hold the member list of the replicas sharing the background work
+	PartitionMemberLabel = "assisted-service.openshift.io/partition-member"
+
+	partitionMemberLeasePrefix = "assisted-service-member-"
+
+	// Expired member leases are kept for a while, so that a replica that was only briefly
+	// disconnected doesn't have to create its lease again
+	expiredMemberLeaseRetention = 10 * time.Minute
+)
+
+type PartitionConfig struct {
+	// Share the background work between all the replicas instead of running it on the leader only
+	Enabled bool `envconfig:"ENABLE_WORK_PARTITIONING" default:"false"`
+	// Interval between renewals of the member lease of the replica and refreshes of the member list
+	RenewInterval time.Duration `envconfig:"WORK_PARTITIONING_RENEW_INTERVAL" default:"5s"`
+	// Members that didn't renew their lease for this duration are removed from the member list.
+	// Ownership of work moved to a replica is only taken after the same duration, so that
+	// the previous owner had time to notice it lost it.
+	LeaseDuration time.Duration `envconfig:"WORK_PARTITIONING_LEASE_DURATION" default:"20s"`
+	// Number of points of each member in the hash ring, more points give a more even distribution
+	VirtualNodes int `envconfig:"WORK_PARTITIONING_VIRTUAL_NODES" default:"100"`
+}
+
+var (
+	partitionMembers = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "assisted_installer_partition_members",
+		Help: "Number of replicas sharing the background work, as seen by this replica",
+	})
+	partitionOwnedShare = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "assisted_installer_partition_owned_share",
+		Help: "Fraction of the background work owned by this replica",
+	})
+	partitionRebalances = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "assisted_installer_partition_rebalances_total",
+		Help: "Number of changes of the member list seen by this replica",
+	})
+	partitionOwnershipChecks = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "assisted_installer_partition_ownership_checks_total",
+		Help: "Number of ownership checks of background work items by this replica, by result",
+	}, []string{"result"})
+)
+
+func init() {
+	prometheus.MustRegister(
+		partitionMembers,
+		partitionOwnedShare,
+		partitionRebalances,
+		partitionOwnershipChecks,
+	)
+}
+
+// Partitioner decides which replica is responsible for each item of background work, like
+// monitoring a cluster or running a garbage collector.
+type Partitioner interface {
+	Leader
+	// Participates returns true if the replica currently takes part in the background work
+	Participates() bool
+	// Owns returns true if the replica is responsible for the item with the given key
+	Owns(key string) bool
+}
+
+// PartitionerFor returns the given leader if it partitions the work, otherwise a partitioner
+// that assigns all the work to the leader.
+func PartitionerFor(l Leader) Partitioner {
+	if p, ok := l.(Partitioner); ok {
+		return p
+	}
+	return &leaderPartitioner{Leader: l}
+}
+
+type leaderPartitioner struct {
+	Leader
+}
+
+func (p *leaderPartitioner) Participates() bool {
+	return p.IsLeader()
+}
+
+func (p *leaderPartitioner) Owns(key string) bool {
+	return p.IsLeader()
+}
+
+var _ Partitioner = &LeasePartitioner{}
+var _ ElectorInterface = &LeasePartitioner{}
+
+// LeasePartitioner spreads the background work between the replicas by consistent hashing of
+// the work keys over the member list. Each replica keeps its own lease renewed, and the member
+// list is made of the replicas with a valid lease. The elector is still used for the work that
+// has to run on a single replica.
+type LeasePartitioner struct {
+	ElectorInterface
+	log      logrus.FieldLogger
+	config   PartitionConfig
+	leases   coordinationv1.LeaseInterface
+	identity string
+	now      func() time.Time
+	cancel   context.CancelFunc
+	done     chan struct{}
+
+	sync.RWMutex
+	members   []string
+	ring      *hashRing
+	settled   *hashRing
+	changedAt time.Time
+	renewedAt time.Time
+}
+
+func NewLeasePartitioner(leases coordinationv1.LeaseInterface, config PartitionConfig, elector ElectorInterface,
+	logger logrus.FieldLogger) (*LeasePartitioner, error) {
+	// The host name is the pod name, so it is unique and stays the same when the container restarts
+	identity, err := os.Hostname()
+	if err != nil {
+		return nil, err
+	}
+	return &LeasePartitioner{
+		ElectorInterface: elector,
+		log:              logger.WithField("member", identity),
+		config:           config,
+		leases:           leases,
+		identity:         identity,
+		now:              time.Now,
+	}, nil
+}
+
+// Start renews the member lease and refreshes the member list until Stop is called
+func (p *LeasePartitioner) Start(ctx context.Context) error {
+	if err := p.sync(ctx); err != nil {
+		return err
+	}
+	ctx, p.cancel = context.WithCancel(ctx)
+	p.done = make(chan struct{})
+	go func() {
+		defer close(p.done)
+		ticker := time.NewTicker(p.config.RenewInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := p.sync(ctx); err != nil {
+					p.log.WithError(err).Warn("Failed to sync work partitioning member list")
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+func (p *LeasePartitioner) Participates() bool {
+	p.RLock()
+	defer p.RUnlock()
+	return p.participates(p.now())
+}
+
+func (p *LeasePartitioner) participates(now time.Time) bool {
+	// A replica that couldn't renew its lease may already be considered gone by the others
+	return p.ring != nil && now.Sub(p.renewedAt) < p.config.LeaseDuration
+}
+
+func (p *LeasePartitioner) Owns(key string) bool {
+	p.RLock()
+	defer p.RUnlock()
+
+	now := p.now()
+	owns := p.participates(now) && p.ring.owner(key) == p.identity
+	// While the member list settles other replicas may not have noticed the change yet, so
+	// only the work that was already owned before the change is kept
+	if owns && !p.isSettled(now) {
+		owns = p.settled != nil && p.settled.owner(key) == p.identity
+	}
+
+	if owns {
+		partitionOwnershipChecks.WithLabelValues("owned").Inc()
+	} else {
+		partitionOwnershipChecks.WithLabelValues("not_owned").Inc()
+	}
+	return owns
+}
+
+func (p *LeasePartitioner) isSettled(now time.Time) bool {
+	return now.Sub(p.changedAt) >= p.config.LeaseDuration
+}
+
+func (p *LeasePartitioner) sync(ctx context.Context) error {
+	if err := p.renew(ctx); err != nil {
+		return err
+	}
+	members, err := p.listMembers(ctx)
+	if err != nil {
+		return err
+	}
+	p.setMembers(members)
+	return nil
+}
+
+func (p *LeasePartitioner) leaseName() string {
+	return partitionMemberLeasePrefix + p.identity
+}
+
+func (p *LeasePartitioner) renew(ctx context.Context) error {
+	now := p.now()
+	spec := coordv1.LeaseSpec{
+		HolderIdentity:       swag.String(p.identity),
+		LeaseDurationSeconds: swag.Int32(int32(math.Ceil(p.config.LeaseDuration.Seconds()))),
+		RenewTime:            &metav1.MicroTime{Time: now},
+	}
+
+	lease, err := p.leases.Get(ctx, p.leaseName(), metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		spec.AcquireTime = &metav1.MicroTime{Time: now}
+		lease = &coordv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   p.leaseName(),
+				Labels: map[string]string{PartitionMemberLabel: "true"},
+			},
+			Spec: spec,
+		}
+		_, err = p.leases.Create(ctx, lease, metav1.CreateOptions{})
+	} else if err == nil {
+		spec.AcquireTime = lease.Spec.AcquireTime
+		lease.Spec = spec
+		_, err = p.leases.Update(ctx, lease, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to renew member lease %s", p.leaseName())
+	}
+
+	p.Lock()
+	p.renewedAt = now
+	p.Unlock()
+	return nil
+}
+
+func (p *LeasePartitioner) listMembers(ctx context.Context) ([]string, error) {
+	leases, err := p.leases.List(ctx, metav1.ListOptions{LabelSelector: PartitionMemberLabel})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list member leases")
+	}
+
+	now := p.now()
+	members := make([]string, 0, len(leases.Items))
+	for i := range leases.Items {
+		lease := &leases.Items[i]
+		if lease.Spec.HolderIdentity == nil || lease.Spec.RenewTime == nil {
+			continue
+		}
+		expiry := lease.Spec.RenewTime.Add(time.Duration(swag.Int32Value(lease.Spec.LeaseDurationSeconds)) * time.Second)
+		if now.Before(expiry) {
+			members = append(members, *lease.Spec.HolderIdentity)
+			continue
+		}
+		if now.After(expiry.Add(expiredMemberLeaseRetention)) {
+			err = p.leases.Delete(ctx, lease.Name, metav1.DeleteOptions{})
+			if err != nil && !k8serrors.IsNotFound(err) {
+				p.log.WithError(err).Warnf("Failed to delete expired member lease %s", lease.Name)
+			}
+		}
+	}
+	return members, nil
+}
+
+func (p *LeasePartitioner) setMembers(members []string) {
+	sort.Strings(members)
+
+	p.Lock()
+	defer p.Unlock()
+
+	if p.ring != nil && reflect.DeepEqual(members, p.members) {
+		return
+	}
+
+	now := p.now()
+	// Ownership is checked against the last ring all replicas had time to see, so keep it
+	// while the member list is still settling from a previous change
+	if p.ring != nil && p.isSettled(now) {
+		p.settled = p.ring
+	}
+	p.members = members
+	p.ring = newHashRing(members, p.config.VirtualNodes)
+	p.changedAt = now
+
+	partitionRebalances.Inc()
+	partitionMembers.Set(float64(len(members)))
+	partitionOwnedShare.Set(p.ring.share(p.identity))
+	p.log.Infof("Work partitioning members changed to %v", members)
+}
+
+// Stop deletes the member lease, so that the other replicas take over the work immediately
+func (p *LeasePartitioner) Stop() {
+	if p.cancel != nil {
+		p.cancel()
+		<-p.done
+	}
+	p.leave()
+}
+
+func (p *LeasePartitioner) leave() {
+	err := p.leases.Delete(context.Background(), p.leaseName(), metav1.DeleteOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		p.log.WithError(err).Warnf("Failed to delete member lease %s", p.leaseName())
+	}
+	p.Lock()
+	p.ring = nil
+	p.Unlock()
+}
+
+type hashRingPoint struct {
+	hash   uint64
+	member string
+}
+
+// hashRing maps keys to members so that only the keys of a member that joins or leaves move
+type hashRing struct {
+	points []hashRingPoint
+}
+
+func newHashRing(members []string, virtualNodes int) *hashRing {
+	if virtualNodes < 1 {
+		virtualNodes = 1
+	}
+	points := make([]hashRingPoint, 0, len(members)*virtualNodes)
+	for _, member := range members {
+		for i := 0; i < virtualNodes; i++ {
+			points = append(points, hashRingPoint{hash: hashKey(fmt.Sprintf("%s#%d", member, i)), member: member})
+		}
+	}
+	sort.Slice(points, func(i, j int) bool {
+		return points[i].hash < points[j].hash
+	})
+	return &hashRing{points: points}
+}
+
+func hashKey(key string) uint64 {
+	sum := sha256.Sum256([]byte(key))
+	return binary.BigEndian.Uint64(sum[:8])
+}
+
+// owner returns the member of the first point following the key on the ring
+func (r *hashRing) owner(key string) string {
+	if len(r.points) == 0 {
+		return ""
+	}
+	hash := hashKey(key)
+	i := sort.Search(len(r.points), func(i int) bool {
+		return r.points[i].hash >= hash
+	})
+	if i == len(r.points) {
+		i = 0
+	}
+	return r.points[i].member
+}
+
+// share returns the fraction of the ring owned by the given member
+func (r *hashRing) share(member string) float64 {
+	var owned float64
+	for i, point := range r.points {
+		if point.member != member {
+			continue
+		}
+		if len(r.points) == 1 {
+			return 1
+		}
+		// Each point owns the arc from the previous point, the subtraction wraps around for the first one
+		previous := r.points[(i+len(r.points)-1)%len(r.points)]
+		owned += float64(point.hash - previous.hash)
+	}
+	return owned / math.Pow(2, 64)
+}
@@ -0,0 +1,255 @@
+package leader
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+	coordv1 "k8s.io/api/coordination/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	coordinationv1 "k8s.io/client-go/kubernetes/typed/coordination/v1"
+)
+
+func TestLeader(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Leader")
+}
+
+// fakeLeases keeps the leases in memory, only the methods used by the partitioner are implemented
+type fakeLeases struct {
+	coordinationv1.LeaseInterface
+	leases map[string]coordv1.Lease
+	err    error
+}
+
+func (f *fakeLeases) Get(_ context.Context, name string, _ metav1.GetOptions) (*coordv1.Lease, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	lease, ok := f.leases[name]
+	if !ok {
+		return nil, k8serrors.NewNotFound(schema.GroupResource{Resource: "leases"}, name)
+	}
+	return lease.DeepCopy(), nil
+}
+
+func (f *fakeLeases) Create(_ context.Context, lease *coordv1.Lease, _ metav1.CreateOptions) (*coordv1.Lease, error) {
+	f.leases[lease.Name] = *lease.DeepCopy()
+	return lease, nil
+}
+
+func (f *fakeLeases) Update(_ context.Context, lease *coordv1.Lease, _ metav1.UpdateOptions) (*coordv1.Lease, error) {
+	f.leases[lease.Name] = *lease.DeepCopy()
+	return lease, nil
+}
+
+func (f *fakeLeases) Delete(_ context.Context, name string, _ metav1.DeleteOptions) error {
+	delete(f.leases, name)
+	return nil
+}
+
+func (f *fakeLeases) List(_ context.Context, _ metav1.ListOptions) (*coordv1.LeaseList, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	list := &coordv1.LeaseList{}
+	for _, lease := range f.leases {
+		list.Items = append(list.Items, lease)
+	}
+	return list, nil
+}
+
+var _ = Describe("hashRing", func() {
+	keys := func(count int) []string {
+		result := make([]string, count)
+		for i := range result {
+			result[i] = fmt.Sprintf("key-%d", i)
+		}
+		return result
+	}
+
+	It("spreads the keys evenly", func() {
+		ring := newHashRing([]string{"a", "b", "c"}, 100)
+		counts := map[string]int{}
+		for _, key := range keys(3000) {
+			counts[ring.owner(key)]++
+		}
+		Expect(counts).To(HaveLen(3))
+		for member, count := range counts {
+			Expect(count).To(BeNumerically("~", 1000, 250), member)
+		}
+		Expect(ring.share("a") + ring.share("b") + ring.share("c")).To(BeNumerically("~", 1, 0.0001))
+	})
+
+	It("only moves keys to a joining member", func() {
+		before := newHashRing([]string{"a", "b"}, 100)
+		after := newHashRing([]string{"a", "b", "c"}, 100)
+		for _, key := range keys(1000) {
+			if after.owner(key) != "c" {
+				Expect(after.owner(key)).To(Equal(before.owner(key)))
+			}
+		}
+	})
+
+	It("has no owner without members", func() {
+		ring := newHashRing(nil, 100)
+		Expect(ring.owner("key")).To(BeEmpty())
+		Expect(ring.share("a")).To(BeZero())
+	})
+
+	It("gives the whole ring to a single member", func() {
+		Expect(newHashRing([]string{"a"}, 1).share("a")).To(Equal(float64(1)))
+	})
+})
+
+var _ = Describe("PartitionerFor", func() {
+	It("assigns all the work to the leader", func() {
+		partitioner := PartitionerFor(&DummyElector{})
+		Expect(partitioner.Participates()).To(BeTrue())
+		Expect(partitioner.Owns("key")).To(BeTrue())
+	})
+
+	It("returns partitioners as is", func() {
+		partitioner := &LeasePartitioner{}
+		Expect(PartitionerFor(partitioner)).To(BeIdenticalTo(partitioner))
+	})
+})
+
+var _ = Describe("LeasePartitioner", func() {
+	var (
+		ctx    = context.Background()
+		leases *fakeLeases
+		now    time.Time
+		config = PartitionConfig{
+			RenewInterval: 5 * time.Second,
+			LeaseDuration: 20 * time.Second,
+			VirtualNodes:  100,
+		}
+	)
+
+	newPartitioner := func(identity string) *LeasePartitioner {
+		return &LeasePartitioner{
+			ElectorInterface: &DummyElector{},
+			log:              logrus.New(),
+			config:           config,
+			leases:           leases,
+			identity:         identity,
+			now:              func() time.Time { return now },
+		}
+	}
+
+	ownedKeys := func(p *LeasePartitioner) map[string]bool {
+		result := map[string]bool{}
+		for i := 0; i < 200; i++ {
+			key := fmt.Sprintf("key-%d", i)
+			if p.Owns(key) {
+				result[key] = true
+			}
+		}
+		return result
+	}
+
+	BeforeEach(func() {
+		leases = &fakeLeases{leases: map[string]coordv1.Lease{}}
+		now = time.Now()
+	})
+
+	It("takes ownership only after the member list settled", func() {
+		a := newPartitioner("a")
+		Expect(a.sync(ctx)).To(Succeed())
+		Expect(a.Participates()).To(BeTrue())
+		Expect(ownedKeys(a)).To(BeEmpty())
+
+		now = now.Add(config.LeaseDuration)
+		Expect(a.sync(ctx)).To(Succeed())
+		Expect(ownedKeys(a)).To(HaveLen(200))
+		Expect(leases.leases).To(HaveKey("assisted-service-member-a"))
+	})
+
+	It("hands over keys to a joining member without overlap", func() {
+		a := newPartitioner("a")
+		Expect(a.sync(ctx)).To(Succeed())
+		now = now.Add(config.LeaseDuration)
+		Expect(a.sync(ctx)).To(Succeed())
+
+		b := newPartitioner("b")
+		Expect(b.sync(ctx)).To(Succeed())
+		Expect(a.sync(ctx)).To(Succeed())
+
+		By("the previous owner releases the moved keys immediately")
+		ownedByA := ownedKeys(a)
+		Expect(len(ownedByA)).To(BeNumerically("<", 200))
+		Expect(ownedKeys(b)).To(BeEmpty())
+
+		By("the new owner takes them once settled")
+		now = now.Add(config.LeaseDuration)
+		Expect(a.sync(ctx)).To(Succeed())
+		Expect(b.sync(ctx)).To(Succeed())
+		ownedByB := ownedKeys(b)
+		Expect(ownedKeys(a)).To(Equal(ownedByA))
+		Expect(len(ownedByA) + len(ownedByB)).To(Equal(200))
+		for key := range ownedByB {
+			Expect(ownedByA).ToNot(HaveKey(key))
+		}
+	})
+
+	It("takes over the keys of a member that stopped renewing its lease", func() {
+		a := newPartitioner("a")
+		b := newPartitioner("b")
+		Expect(a.sync(ctx)).To(Succeed())
+		Expect(b.sync(ctx)).To(Succeed())
+		Expect(a.sync(ctx)).To(Succeed())
+		now = now.Add(config.LeaseDuration)
+		Expect(a.sync(ctx)).To(Succeed())
+		Expect(len(ownedKeys(a))).To(BeNumerically("<", 200))
+
+		now = now.Add(config.LeaseDuration + time.Second)
+		Expect(a.sync(ctx)).To(Succeed())
+		Expect(a.members).To(Equal([]string{"a"}))
+		Expect(b.Participates()).To(BeFalse())
+		Expect(ownedKeys(b)).To(BeEmpty())
+
+		now = now.Add(config.LeaseDuration)
+		Expect(a.sync(ctx)).To(Succeed())
+		Expect(ownedKeys(a)).To(HaveLen(200))
+
+		By("the expired lease is eventually deleted")
+		Expect(leases.leases).To(HaveKey("assisted-service-member-b"))
+		now = now.Add(expiredMemberLeaseRetention)
+		Expect(a.sync(ctx)).To(Succeed())
+		Expect(leases.leases).ToNot(HaveKey("assisted-service-member-b"))
+	})
+
+	It("stops owning work when the lease can't be renewed", func() {
+		a := newPartitioner("a")
+		Expect(a.sync(ctx)).To(Succeed())
+		now = now.Add(config.LeaseDuration)
+		Expect(a.sync(ctx)).To(Succeed())
+		Expect(a.Owns("key")).To(BeTrue())
+
+		leases.err = fmt.Errorf("connection refused")
+		now = now.Add(config.RenewInterval)
+		Expect(a.sync(ctx)).ToNot(Succeed())
+		Expect(a.Owns("key")).To(BeTrue())
+
+		now = now.Add(config.LeaseDuration)
+		Expect(a.sync(ctx)).ToNot(Succeed())
+		Expect(a.Participates()).To(BeFalse())
+		Expect(a.Owns("key")).To(BeFalse())
+	})
+
+	It("deletes its lease when stopped", func() {
+		a := newPartitioner("a")
+		Expect(a.Start(ctx)).To(Succeed())
+		Expect(leases.leases).To(HaveKey("assisted-service-member-a"))
+		a.Stop()
+		Expect(leases.leases).To(BeEmpty())
+		Expect(a.Participates()).To(BeFalse())
+	})
+})
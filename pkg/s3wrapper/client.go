@@ -25,14 +25,22 @@ import (
 	"github.com/go-openapi/swag"
 	"github.com/openshift/assisted-service/internal/common"
 	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/tracing"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
 	awsEndpointSuffix = ".amazonaws.com"
 )
 
+const (
+	bucketKey = attribute.Key("s3_bucket")
+	objectKey = attribute.Key("s3_object")
+)
+
 //go:generate mockgen --build_flags=--mod=mod -package=s3wrapper -destination=mock_s3wrapper.go . API
 //go:generate mockgen --build_flags=--mod=mod -package s3wrapper -destination mock_s3iface.go github.com/aws/aws-sdk-go/service/s3/s3iface S3API
 //go:generate mockgen --build_flags=--mod=mod -package s3wrapper -destination mock_s3manageriface.go github.com/aws/aws-sdk-go/service/s3/s3manager/s3manageriface UploaderAPI
@@ -148,6 +156,20 @@ func (c *S3Client) CreateBucket() error {
 	return c.createBucket(c.client, c.cfg.S3Bucket)
 }
 
+// CheckBucket verifies that the configured bucket exists and is accessible with the configured credentials
+func (c *S3Client) CheckBucket(ctx context.Context) (err error) {
+	ctx, span := c.startSpan(ctx, "head bucket", "")
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+	if _, err = c.client.HeadBucketWithContext(ctx, &s3.HeadBucketInput{
+		Bucket: swag.String(c.cfg.S3Bucket),
+	}); err != nil {
+		return errors.Wrapf(err, "failed to access S3 bucket %s", c.cfg.S3Bucket)
+	}
+	return nil
+}
+
 func (c *S3Client) uploadStream(ctx context.Context, reader io.Reader, objectName, bucket string, uploader s3manageriface.UploaderAPI) error {
 	log := logutil.FromContext(ctx, c.log)
 	if reader == nil {
@@ -170,7 +192,11 @@ func (c *S3Client) uploadStream(ctx context.Context, reader io.Reader, objectNam
 	return err
 }
 
-func (c *S3Client) UploadStream(ctx context.Context, reader io.Reader, objectName string) error {
+func (c *S3Client) UploadStream(ctx context.Context, reader io.Reader, objectName string) (err error) {
+	ctx, span := c.startSpan(ctx, "upload", objectName)
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
 	return c.uploadStream(ctx, reader, objectName, c.cfg.S3Bucket, c.uploader)
 }
 
@@ -189,7 +215,11 @@ func (c *S3Client) uploadFile(ctx context.Context, filePath, objectName, bucket
 	return c.uploadStream(ctx, reader, objectName, bucket, uploader)
 }
 
-func (c *S3Client) UploadFile(ctx context.Context, filePath, objectName string) error {
+func (c *S3Client) UploadFile(ctx context.Context, filePath, objectName string) (err error) {
+	ctx, span := c.startSpan(ctx, "upload", objectName)
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
 	return c.uploadFile(ctx, filePath, objectName, c.cfg.S3Bucket, c.uploader)
 }
 
@@ -225,7 +255,11 @@ func (c *S3Client) download(ctx context.Context, objectName, bucket string, clie
 	return getResp.Body, contentLength, nil
 }
 
-func (c *S3Client) Download(ctx context.Context, objectName string) (io.ReadCloser, int64, error) {
+func (c *S3Client) Download(ctx context.Context, objectName string) (_ io.ReadCloser, _ int64, err error) {
+	ctx, span := c.startSpan(ctx, "download", objectName)
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
 	return c.download(ctx, objectName, c.cfg.S3Bucket, c.client)
 }
 
@@ -247,15 +281,23 @@ func (c *S3Client) doesObjectExist(ctx context.Context, objectName, bucket strin
 	return true, nil
 }
 
-func (c *S3Client) DoesObjectExist(ctx context.Context, objectName string) (bool, error) {
+func (c *S3Client) DoesObjectExist(ctx context.Context, objectName string) (_ bool, err error) {
+	ctx, span := c.startSpan(ctx, "head object", objectName)
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
 	return c.doesObjectExist(ctx, objectName, c.cfg.S3Bucket, c.client)
 }
 
-func (c *S3Client) DeleteObject(ctx context.Context, objectName string) (bool, error) {
+func (c *S3Client) DeleteObject(ctx context.Context, objectName string) (_ bool, err error) {
+	ctx, span := c.startSpan(ctx, "delete object", objectName)
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
 	log := logutil.FromContext(ctx, c.log)
 	log.Infof("Deleting object %s from %s", objectName, c.cfg.S3Bucket)
 
-	_, err := c.client.DeleteObject(&s3.DeleteObjectInput{
+	_, err = c.client.DeleteObject(&s3.DeleteObjectInput{
 		Bucket: aws.String(c.cfg.S3Bucket),
 		Key:    aws.String(objectName),
 	})
@@ -273,10 +315,14 @@ func (c *S3Client) DeleteObject(ctx context.Context, objectName string) (bool, e
 	return true, nil
 }
 
-func (c *S3Client) UpdateObjectTimestamp(ctx context.Context, objectName string) (bool, error) {
+func (c *S3Client) UpdateObjectTimestamp(ctx context.Context, objectName string) (_ bool, err error) {
+	ctx, span := c.startSpan(ctx, "put object tagging", objectName)
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
 	log := logutil.FromContext(ctx, c.log)
 	log.Infof("Updating timestamp of object %s", objectName)
-	_, err := c.client.PutObjectTagging(&s3.PutObjectTaggingInput{
+	_, err = c.client.PutObjectTagging(&s3.PutObjectTaggingInput{
 		Bucket: aws.String(c.cfg.S3Bucket),
 		Key:    aws.String(objectName),
 		Tagging: &s3.Tagging{
@@ -315,7 +361,11 @@ func (c *S3Client) getObjectSizeBytes(ctx context.Context, objectName, bucket st
 	return *headResp.ContentLength, nil
 }
 
-func (c *S3Client) GetObjectSizeBytes(ctx context.Context, objectName string) (int64, error) {
+func (c *S3Client) GetObjectSizeBytes(ctx context.Context, objectName string) (_ int64, err error) {
+	ctx, span := c.startSpan(ctx, "head object", objectName)
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
 	return c.getObjectSizeBytes(ctx, objectName, c.cfg.S3Bucket, c.client)
 }
 
@@ -346,6 +396,8 @@ func (c S3Client) transformErrorIfNeeded(err error, objectName string) (bool, er
 
 func (c *S3Client) ExpireObjects(ctx context.Context, prefix string, deleteTime time.Duration,
 	callback func(ctx context.Context, log logrus.FieldLogger, objectName string)) {
+	ctx, span := c.startSpan(ctx, "expire objects", prefix)
+	defer span.End()
 	log := logutil.FromContext(ctx, c.log)
 	now := time.Now()
 
@@ -359,6 +411,7 @@ func (c *S3Client) ExpireObjects(ctx context.Context, prefix string, deleteTime
 		})
 	if err != nil {
 		log.WithError(err).Error("Error listing objects")
+		span.RecordError(err)
 		return
 	}
 }
@@ -397,7 +450,11 @@ func (c *S3Client) handleObject(ctx context.Context, log logrus.FieldLogger, obj
 	}
 }
 
-func (c *S3Client) ListObjectsByPrefix(ctx context.Context, prefix string) ([]string, error) {
+func (c *S3Client) ListObjectsByPrefix(ctx context.Context, prefix string) (_ []string, err error) {
+	ctx, span := c.startSpan(ctx, "list objects", prefix)
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
 	log := logutil.FromContext(ctx, c.log)
 	var objects []string
 	log.Infof("Listing objects by with prefix %s", prefix)
@@ -415,3 +472,12 @@ func (c *S3Client) ListObjectsByPrefix(ctx context.Context, prefix string) ([]st
 	}
 	return objects, nil
 }
+
+// startSpan starts a span for an S3 operation on the given object, or objects prefix
+func (c *S3Client) startSpan(ctx context.Context, operation, objectName string) (context.Context, trace.Span) {
+	attrs := []attribute.KeyValue{bucketKey.String(c.cfg.S3Bucket)}
+	if objectName != "" {
+		attrs = append(attrs, objectKey.String(objectName))
+	}
+	return tracing.StartSpan(ctx, "s3 "+operation, attrs...)
+}
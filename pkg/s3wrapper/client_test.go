@@ -13,7 +13,10 @@ import (
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/pkg/requestid"
+	"github.com/openshift/assisted-service/pkg/tracing"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/codes"
 )
 
 var _ = Describe("s3client", func() {
@@ -137,6 +140,40 @@ var _ = Describe("s3client", func() {
 		})
 	})
 
+	Describe("CheckBucket", func() {
+		It("succeeds if the bucket is accessible", func() {
+			mockAPI.EXPECT().HeadBucketWithContext(gomock.Any(), &s3.HeadBucketInput{Bucket: &bucket}).Return(&s3.HeadBucketOutput{}, nil)
+			Expect(client.CheckBucket(ctx)).To(Succeed())
+		})
+
+		It("fails if the bucket is not accessible", func() {
+			mockAPI.EXPECT().HeadBucketWithContext(gomock.Any(), gomock.Any()).Return(nil, awserr.New("Forbidden", "Forbidden", errors.New("Forbidden")))
+			err := client.CheckBucket(ctx)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(bucket))
+		})
+	})
+
+	Describe("Tracing", func() {
+		It("traces the deletion of an object", func() {
+			exporter, restore := tracing.InstallInMemoryExporter()
+			defer restore()
+			deleteInput := s3.DeleteObjectInput{Bucket: &bucket, Key: &objKey}
+			mockAPI.EXPECT().DeleteObject(&deleteInput).Return(nil, awserr.New("UnknownError", "UnknownError", errors.New("UnknownError")))
+			_, err := client.DeleteObject(requestid.ToContext(ctx, "delete-request"), objKey)
+			Expect(err).To(HaveOccurred())
+
+			span := exporter.SpanByName("s3 delete object")
+			Expect(span).ToNot(BeNil())
+			Expect(span.StatusCode).To(Equal(codes.Error))
+			Expect(span.Attributes).To(ContainElements(
+				tracing.RequestIDKey.String("delete-request"),
+				bucketKey.String(bucket),
+				objectKey.String(objKey),
+			))
+		})
+	})
+
 	AfterEach(func() {
 		ctrl.Finish()
 	})
@@ -0,0 +1,68 @@
+package tracing
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// InMemoryExporter keeps the exported spans in memory, to be used in tests
+type InMemoryExporter struct {
+	mutex sync.Mutex
+	spans []*sdktrace.SpanSnapshot
+}
+
+var _ sdktrace.SpanExporter = &InMemoryExporter{}
+
+// InstallInMemoryExporter installs a global tracer provider that synchronously exports all the
+// spans to the returned in memory exporter. The returned function restores the previous provider,
+// so that the spans of other tests aren't exported.
+func InstallInMemoryExporter() (*InMemoryExporter, func()) {
+	exporter := &InMemoryExporter{}
+	previous := otel.GetTracerProvider()
+	provider := NewTracerProvider(&Config{ServiceName: "assisted-service", SampleRatio: 1}, sdktrace.WithSyncer(exporter))
+	otel.SetTracerProvider(provider)
+	return exporter, func() {
+		otel.SetTracerProvider(previous)
+		_ = provider.Shutdown(context.Background())
+	}
+}
+
+func (e *InMemoryExporter) ExportSpans(_ context.Context, spans []*sdktrace.SpanSnapshot) error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	e.spans = append(e.spans, spans...)
+	return nil
+}
+
+func (e *InMemoryExporter) Shutdown(context.Context) error {
+	e.Reset()
+	return nil
+}
+
+// Spans returns the spans exported so far
+func (e *InMemoryExporter) Spans() []*sdktrace.SpanSnapshot {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	return append([]*sdktrace.SpanSnapshot{}, e.spans...)
+}
+
+// SpanByName returns the last exported span with the given name, nil if there is none
+func (e *InMemoryExporter) SpanByName(name string) *sdktrace.SpanSnapshot {
+	spans := e.Spans()
+	for i := len(spans) - 1; i >= 0; i-- {
+		if spans[i].Name == name {
+			return spans[i]
+		}
+	}
+	return nil
+}
+
+// Reset drops the spans exported so far
+func (e *InMemoryExporter) Reset() {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	e.spans = nil
+}
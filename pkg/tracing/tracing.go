@@ -0,0 +1,119 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/filanov/stateswitch"
+	"github.com/openshift/assisted-service/pkg/requestid"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpgrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/semconv"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type Config struct {
+	Enabled      bool    `envconfig:"ENABLE_TRACING" default:"false"`
+	OTLPEndpoint string  `envconfig:"OTEL_EXPORTER_OTLP_ENDPOINT" default:"localhost:4317"`
+	OTLPInsecure bool    `envconfig:"OTEL_EXPORTER_OTLP_INSECURE" default:"true"`
+	ServiceName  string  `envconfig:"OTEL_SERVICE_NAME" default:"assisted-service"`
+	SampleRatio  float64 `envconfig:"TRACING_SAMPLE_RATIO" default:"1"`
+}
+
+const tracerName = "github.com/openshift/assisted-service"
+
+const (
+	RequestIDKey        = attribute.Key("request_id")
+	StateMachineKey     = attribute.Key("state_machine")
+	TransitionTypeKey   = attribute.Key("transition_type")
+	SourceStateKey      = attribute.Key("source_state")
+	DestinationStateKey = attribute.Key("destination_state")
+)
+
+// Init installs a global tracer provider exporting the spans to the configured OTLP collector.
+// The returned function flushes the pending spans and stops the exporter.
+func Init(ctx context.Context, cfg *Config, log logrus.FieldLogger) (func(context.Context) error, error) {
+	if !cfg.Enabled {
+		return func(context.Context) error { return nil }, nil
+	}
+	options := []otlpgrpc.Option{otlpgrpc.WithEndpoint(cfg.OTLPEndpoint)}
+	if cfg.OTLPInsecure {
+		options = append(options, otlpgrpc.WithInsecure())
+	}
+	exporter, err := otlp.NewExporter(ctx, otlpgrpc.NewDriver(options...))
+	if err != nil {
+		return nil, err
+	}
+	provider := NewTracerProvider(cfg, sdktrace.WithBatcher(exporter))
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	log.Infof("Exporting traces of %s to %s", cfg.ServiceName, cfg.OTLPEndpoint)
+	return provider.Shutdown, nil
+}
+
+// NewTracerProvider creates a tracer provider for the service, the spans are handed to the given
+// span processor option (sdktrace.WithBatcher, sdktrace.WithSyncer, ...).
+func NewTracerProvider(cfg *Config, processor sdktrace.TracerProviderOption) *sdktrace.TracerProvider {
+	return sdktrace.NewTracerProvider(
+		processor,
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.ServiceNameKey.String(cfg.ServiceName))),
+	)
+}
+
+// StartSpan starts a span as a child of the span in the context, if any. The request id of the
+// context is added to the span attributes.
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	ctx, span := otel.Tracer(tracerName).Start(ctx, name)
+	if requestID := requestid.FromContext(ctx); requestID != "" {
+		span.SetAttributes(RequestIDKey.String(requestID))
+	}
+	span.SetAttributes(attrs...)
+	return ctx, span
+}
+
+// EndSpan records the error, if any, and ends the span
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// RunTransition runs a transition of the state machine in a span holding the source and
+// destination states
+func RunTransition(ctx context.Context, sm stateswitch.StateMachine, stateMachine string, transitionType stateswitch.TransitionType,
+	stateSwitch stateswitch.StateSwitch, args stateswitch.TransitionArgs) error {
+	_, span := StartSpan(ctx, stateMachine+" "+string(transitionType),
+		StateMachineKey.String(stateMachine),
+		TransitionTypeKey.String(string(transitionType)),
+		SourceStateKey.String(string(stateSwitch.State())))
+	err := sm.Run(transitionType, stateSwitch, args)
+	span.SetAttributes(DestinationStateKey.String(string(stateSwitch.State())))
+	EndSpan(span, err)
+	return err
+}
+
+// Middleware wraps an http handler with a span per request, named by the given function.
+// It must be added after the request id middleware so the request id is part of the span.
+func Middleware(inner http.Handler, spanName func(*http.Request) string) http.Handler {
+	withRequestID := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requestID := requestid.FromContext(r.Context()); requestID != "" {
+			trace.SpanFromContext(r.Context()).SetAttributes(RequestIDKey.String(requestID))
+		}
+		inner.ServeHTTP(w, r)
+	})
+	return otelhttp.NewHandler(withRequestID, "",
+		otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+			return spanName(r)
+		}))
+}
@@ -0,0 +1,128 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/filanov/stateswitch"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/pkg/requestid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestTracing(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Tracing Tests")
+}
+
+func attributeValue(span *sdktrace.SpanSnapshot, key attribute.Key) string {
+	for _, attr := range span.Attributes {
+		if attr.Key == key {
+			return attr.Value.Emit()
+		}
+	}
+	return ""
+}
+
+type stateHolder struct {
+	state stateswitch.State
+}
+
+func (s *stateHolder) State() stateswitch.State {
+	return s.state
+}
+
+func (s *stateHolder) SetState(state stateswitch.State) error {
+	s.state = state
+	return nil
+}
+
+var _ = Describe("Tracing", func() {
+	var (
+		exporter *InMemoryExporter
+		restore  func()
+	)
+
+	BeforeEach(func() {
+		exporter, restore = InstallInMemoryExporter()
+	})
+
+	AfterEach(func() {
+		restore()
+	})
+
+	It("adds the request id to the spans", func() {
+		ctx := requestid.ToContext(context.Background(), "some-request-id")
+		_, span := StartSpan(ctx, "operation", attribute.String("key", "value"))
+		EndSpan(span, nil)
+
+		exported := exporter.SpanByName("operation")
+		Expect(exported).ToNot(BeNil())
+		Expect(attributeValue(exported, RequestIDKey)).To(Equal("some-request-id"))
+		Expect(attributeValue(exported, "key")).To(Equal("value"))
+		Expect(exported.StatusCode).To(Equal(codes.Unset))
+	})
+
+	It("nests the spans and records the errors", func() {
+		ctx, parent := StartSpan(context.Background(), "parent")
+		_, child := StartSpan(ctx, "child")
+		EndSpan(child, errors.New("failure"))
+		EndSpan(parent, nil)
+
+		exportedParent := exporter.SpanByName("parent")
+		exportedChild := exporter.SpanByName("child")
+		Expect(exportedChild.Parent.SpanID()).To(Equal(exportedParent.SpanContext.SpanID()))
+		Expect(exportedChild.StatusCode).To(Equal(codes.Error))
+		Expect(exportedChild.StatusMessage).To(Equal("failure"))
+	})
+
+	It("stops exporting the spans once the previous provider is restored", func() {
+		restore()
+		_, span := StartSpan(context.Background(), "after restore")
+		EndSpan(span, nil)
+
+		Expect(exporter.SpanByName("after restore")).To(BeNil())
+	})
+
+	It("traces the state machine transitions", func() {
+		sm := stateswitch.NewStateMachine()
+		sm.AddTransitionRule(stateswitch.TransitionRule{
+			TransitionType:   "install",
+			SourceStates:     stateswitch.States{"ready"},
+			DestinationState: "installing",
+		})
+		holder := &stateHolder{state: "ready"}
+		Expect(RunTransition(context.Background(), sm, "host", "install", holder, nil)).To(Succeed())
+
+		exported := exporter.SpanByName("host install")
+		Expect(exported).ToNot(BeNil())
+		Expect(attributeValue(exported, SourceStateKey)).To(Equal("ready"))
+		Expect(attributeValue(exported, DestinationStateKey)).To(Equal("installing"))
+
+		Expect(RunTransition(context.Background(), sm, "host", "install", holder, nil)).ToNot(Succeed())
+		Expect(exporter.SpanByName("host install").StatusCode).To(Equal(codes.Error))
+	})
+
+	It("traces the http requests with their request id", func() {
+		handler := requestid.Middleware(Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, span := StartSpan(r.Context(), "handler")
+			EndSpan(span, nil)
+		}), func(r *http.Request) string {
+			return "V2ListClusters"
+		}))
+		request := httptest.NewRequest(http.MethodGet, "/api/assisted-install/v2/clusters", nil)
+		request.Header.Set("X-Request-ID", "http-request-id")
+		handler.ServeHTTP(httptest.NewRecorder(), request)
+
+		exported := exporter.SpanByName("V2ListClusters")
+		Expect(exported).ToNot(BeNil())
+		Expect(attributeValue(exported, RequestIDKey)).To(Equal("http-request-id"))
+		Expect(exporter.SpanByName("handler").Parent.SpanID()).To(Equal(exported.SpanContext.SpanID()))
+	})
+})
@@ -1,10 +1,17 @@
 package transaction
 
 import (
+	"context"
+	"errors"
+
+	"github.com/openshift/assisted-service/pkg/tracing"
+	"go.opentelemetry.io/otel/trace"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
 
+var errRolledBack = errors.New("transaction rolled back")
+
 func AddForUpdateQueryOption(db *gorm.DB) *gorm.DB {
 	if db.Name() != "sqlite3" {
 		// return a new object and not overwrite pointer value because GORM have a pointer to parent
@@ -12,3 +19,52 @@ func AddForUpdateQueryOption(db *gorm.DB) *gorm.DB {
 	}
 	return db
 }
+
+// Transaction runs fc in a database transaction traced by a span with the given name. The
+// transaction is committed if fc returns nil, otherwise it is rolled back.
+func Transaction(ctx context.Context, db *gorm.DB, name string, fc func(tx *gorm.DB) error) error {
+	ctx, span := tracing.StartSpan(ctx, "transaction "+name)
+	err := db.WithContext(ctx).Transaction(fc)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+// Begin starts a database transaction traced by a span with the given name, for the code that
+// manages the transaction itself instead of using Transaction. The span ends when the transaction
+// is committed or rolled back.
+func Begin(ctx context.Context, db *gorm.DB, name string) *gorm.DB {
+	_, span := tracing.StartSpan(ctx, "transaction "+name)
+	tx := db.Begin()
+	if tx.Error != nil {
+		tracing.EndSpan(span, tx.Error)
+		return tx
+	}
+	if t, ok := tx.Statement.ConnPool.(gorm.Tx); ok {
+		tx.Statement.ConnPool = &tracedTx{Tx: t, span: span}
+	} else {
+		span.End()
+	}
+	return tx
+}
+
+// tracedTx ends the span of the transaction when it is committed or rolled back
+type tracedTx struct {
+	gorm.Tx
+	span trace.Span
+}
+
+func (t *tracedTx) Commit() error {
+	err := t.Tx.Commit()
+	tracing.EndSpan(t.span, err)
+	return err
+}
+
+func (t *tracedTx) Rollback() error {
+	err := t.Tx.Rollback()
+	if err != nil {
+		tracing.EndSpan(t.span, err)
+	} else {
+		tracing.EndSpan(t.span, errRolledBack)
+	}
+	return err
+}
@@ -1,6 +1,7 @@
 package v1beta1
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
@@ -161,11 +162,8 @@ func (a *AgentClusterInstallValidatingAdmissionHook) validateCreate(admissionSpe
 	// Add the new data to the contextLogger
 	contextLogger.Data["object.Name"] = newObject.Name
 
-	// verify that UserNetworkManagement is not set to false with SNO.
-	// if the user leave this field empty it is fine because the AI knows
-	// what to set as default
-	if isUserManagedNetworkingSetToFalseWithSNO(newObject) {
-		message := "UserManagedNetworking must be set to true with SNO"
+	if err := ValidateAgentClusterInstall(newObject); err != nil {
+		message := err.Error()
 		contextLogger.Errorf("Failed validation: %v", message)
 		return &admissionv1.AdmissionResponse{
 			Allowed: false,
@@ -233,8 +231,8 @@ func (a *AgentClusterInstallValidatingAdmissionHook) validateUpdate(admissionSpe
 		}
 	}
 
-	if isUserManagedNetworkingSetToFalseWithSNO(newObject) {
-		message := "UserManagedNetworking must be set to true with SNO"
+	if err := ValidateAgentClusterInstall(newObject); err != nil {
+		message := err.Error()
 		contextLogger.Errorf("Failed validation: %v", message)
 		return &admissionv1.AdmissionResponse{
 			Allowed: false,
@@ -268,6 +266,18 @@ func (a *AgentClusterInstallValidatingAdmissionHook) validateUpdate(admissionSpe
 	}
 }
 
+// ValidateAgentClusterInstall returns the reason an AgentClusterInstall is invalid regardless of its previous state, or
+// nil when it is valid. It is also used to validate manifests offline.
+func ValidateAgentClusterInstall(newObject *hiveext.AgentClusterInstall) error {
+	// verify that UserNetworkManagement is not set to false with SNO.
+	// if the user leave this field empty it is fine because the AI knows
+	// what to set as default
+	if isUserManagedNetworkingSetToFalseWithSNO(newObject) {
+		return errors.New("UserManagedNetworking must be set to true with SNO")
+	}
+	return nil
+}
+
 func installAlreadyStarted(conditions []hivev1.ClusterInstallCondition) bool {
 	cond := FindStatusCondition(conditions, hiveext.ClusterCompletedCondition)
 	if cond == nil {
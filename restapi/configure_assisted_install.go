@@ -52,6 +52,9 @@ type InstallerAPI interface {
 	/* GetClusterSupportedPlatforms A list of platforms that this cluster can support in its current configuration. */
 	GetClusterSupportedPlatforms(ctx context.Context, params installer.GetClusterSupportedPlatformsParams) middleware.Responder
 
+	/* GetClusterSupportedPlatformsDetails The platforms that this cluster can support in its current configuration, including the reasons why unsupported platforms were excluded. */
+	GetClusterSupportedPlatformsDetails(ctx context.Context, params installer.GetClusterSupportedPlatformsDetailsParams) middleware.Responder
+
 	/* GetInfraEnv Retrieves the details of the infra-env. */
 	GetInfraEnv(ctx context.Context, params installer.GetInfraEnvParams) middleware.Responder
 
@@ -82,6 +85,9 @@ type InstallerAPI interface {
 	/* UpdateInfraEnv Updates an infra-env. */
 	UpdateInfraEnv(ctx context.Context, params installer.UpdateInfraEnvParams) middleware.Responder
 
+	/* V2BulkHostsAction Applies an action to the hosts of the infra-env whose labels match a label selector and returns the result per host. */
+	V2BulkHostsAction(ctx context.Context, params installer.V2BulkHostsActionParams) middleware.Responder
+
 	/* V2CancelInstallation Cancels an ongoing installation. */
 	V2CancelInstallation(ctx context.Context, params installer.V2CancelInstallationParams) middleware.Responder
 
@@ -94,6 +100,10 @@ type InstallerAPI interface {
 	/* V2DownloadClusterLogs Download cluster logs. */
 	V2DownloadClusterLogs(ctx context.Context, params installer.V2DownloadClusterLogsParams) middleware.Responder
 
+	/* V2GarbageCollectionDryRun Lists the clusters, hosts and infra-envs that the next garbage collection would deregister or permanently
+delete, according to the garbage collection policies. Nothing is deregistered or deleted. */
+	V2GarbageCollectionDryRun(ctx context.Context, params installer.V2GarbageCollectionDryRunParams) middleware.Responder
+
 	/* V2GetClusterDefaultConfig Get the default values for various cluster properties. */
 	V2GetClusterDefaultConfig(ctx context.Context, params installer.V2GetClusterDefaultConfigParams) middleware.Responder
 
@@ -127,12 +137,25 @@ type InstallerAPI interface {
 	/* V2DownloadInfraEnvFiles Downloads the customized ignition file for this host */
 	V2DownloadInfraEnvFiles(ctx context.Context, params installer.V2DownloadInfraEnvFilesParams) middleware.Responder
 
+	/* V2ExportCluster Exports the desired configuration of a cluster as a versioned bundle that can be imported to register a similar cluster. The bundle contains no credentials. */
+	V2ExportCluster(ctx context.Context, params installer.V2ExportClusterParams) middleware.Responder
+
 	/* V2GetCluster Retrieves the details of the OpenShift cluster. */
 	V2GetCluster(ctx context.Context, params installer.V2GetClusterParams) middleware.Responder
 
 	/* V2GetClusterInstallConfig Get the cluster's install config YAML. */
 	V2GetClusterInstallConfig(ctx context.Context, params installer.V2GetClusterInstallConfigParams) middleware.Responder
 
+	/* V2GetClusterInstallationTimeline Builds the timeline of the last installation of the cluster from its events: the stages of the hosts, the
+status changes of the cluster, the status reports of the monitored operators and the log uploads, with their
+durations, the critical path of the installation and a compact text summary. */
+	V2GetClusterInstallationTimeline(ctx context.Context, params installer.V2GetClusterInstallationTimelineParams) middleware.Responder
+
+	/* V2GetClusterRenderedFiles Renders the install config and the manifests the service would generate if the cluster was installed now,
+without storing anything. Manifests generated by openshift-install itself are not included.
+ */
+	V2GetClusterRenderedFiles(ctx context.Context, params installer.V2GetClusterRenderedFilesParams) middleware.Responder
+
 	/* V2GetHost Retrieves the details of the OpenShift host. */
 	V2GetHost(ctx context.Context, params installer.V2GetHostParams) middleware.Responder
 
@@ -148,12 +171,22 @@ type InstallerAPI interface {
 	/* V2ImportCluster Import an AI cluster using minimal data assosiated with existing OCP cluster, in order to allow adding day2 hosts to that cluster */
 	V2ImportCluster(ctx context.Context, params installer.V2ImportClusterParams) middleware.Responder
 
+	/* V2ImportClusterBundle Registers a new cluster and infra-env from a cluster bundle. Conflicts of the bundle with the service and with other clusters are reported; the import is refused if any of them is blocking. */
+	V2ImportClusterBundle(ctx context.Context, params installer.V2ImportClusterBundleParams) middleware.Responder
+
 	/* V2InstallCluster Installs the OpenShift cluster. */
 	V2InstallCluster(ctx context.Context, params installer.V2InstallClusterParams) middleware.Responder
 
 	/* V2InstallHost install specific host for day2 cluster. */
 	V2InstallHost(ctx context.Context, params installer.V2InstallHostParams) middleware.Responder
 
+	/* V2ListClusterInstallConfigRevisions Lists the changes made to the install config overrides of the cluster, oldest first. */
+	V2ListClusterInstallConfigRevisions(ctx context.Context, params installer.V2ListClusterInstallConfigRevisionsParams) middleware.Responder
+
+	/* V2ListClusterRevisions Lists the revisions of the configuration of the cluster, oldest first. Each update of the cluster is recorded
+as a revision, and the first revision holds the configuration the cluster had before its first update. */
+	V2ListClusterRevisions(ctx context.Context, params installer.V2ListClusterRevisionsParams) middleware.Responder
+
 	/* V2ListClusters Retrieves the list of OpenShift clusters. */
 	V2ListClusters(ctx context.Context, params installer.V2ListClustersParams) middleware.Responder
 
@@ -166,6 +199,9 @@ type InstallerAPI interface {
 	/* V2PostStepReply Posts the result of the operations from the host agent. */
 	V2PostStepReply(ctx context.Context, params installer.V2PostStepReplyParams) middleware.Responder
 
+	/* V2ReclaimHost Reclaims an installed host of a day-2 cluster back into its infra-env. The assisted agent is started on the host's node using the cluster's kubeconfig, the host reboots into discovery and is unbound from the cluster. */
+	V2ReclaimHost(ctx context.Context, params installer.V2ReclaimHostParams) middleware.Responder
+
 	/* V2RegisterCluster Creates a new OpenShift cluster definition. */
 	V2RegisterCluster(ctx context.Context, params installer.V2RegisterClusterParams) middleware.Responder
 
@@ -181,6 +217,11 @@ type InstallerAPI interface {
 	/* V2ResetHostValidation Reset failed host validation. */
 	V2ResetHostValidation(ctx context.Context, params installer.V2ResetHostValidationParams) middleware.Responder
 
+	/* V2RollbackCluster Rolls the configuration of a cluster that wasn't installed yet back to a prior revision. The cluster is
+updated as it is with v2UpdateCluster, and is validated again. Credentials aren't part of revisions and
+are left unchanged. */
+	V2RollbackCluster(ctx context.Context, params installer.V2RollbackClusterParams) middleware.Responder
+
 	/* V2UpdateClusterInstallConfig Override values in the install config. */
 	V2UpdateClusterInstallConfig(ctx context.Context, params installer.V2UpdateClusterInstallConfigParams) middleware.Responder
 
@@ -388,6 +429,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.GetClusterSupportedPlatforms(ctx, params)
 	})
+	api.InstallerGetClusterSupportedPlatformsDetailsHandler = installer.GetClusterSupportedPlatformsDetailsHandlerFunc(func(params installer.GetClusterSupportedPlatformsDetailsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.GetClusterSupportedPlatformsDetails(ctx, params)
+	})
 	api.InstallerGetInfraEnvHandler = installer.GetInfraEnvHandlerFunc(func(params installer.GetInfraEnvParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -438,6 +484,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.UpdateInfraEnv(ctx, params)
 	})
+	api.InstallerV2BulkHostsActionHandler = installer.V2BulkHostsActionHandlerFunc(func(params installer.V2BulkHostsActionParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2BulkHostsAction(ctx, params)
+	})
 	api.InstallerV2CancelInstallationHandler = installer.V2CancelInstallationHandlerFunc(func(params installer.V2CancelInstallationParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -468,6 +519,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2DownloadClusterLogs(ctx, params)
 	})
+	api.InstallerV2GarbageCollectionDryRunHandler = installer.V2GarbageCollectionDryRunHandlerFunc(func(params installer.V2GarbageCollectionDryRunParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2GarbageCollectionDryRun(ctx, params)
+	})
 	api.InstallerV2GetClusterDefaultConfigHandler = installer.V2GetClusterDefaultConfigHandlerFunc(func(params installer.V2GetClusterDefaultConfigParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -553,6 +609,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2DownloadInfraEnvFiles(ctx, params)
 	})
+	api.InstallerV2ExportClusterHandler = installer.V2ExportClusterHandlerFunc(func(params installer.V2ExportClusterParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2ExportCluster(ctx, params)
+	})
 	api.InstallerV2GetClusterHandler = installer.V2GetClusterHandlerFunc(func(params installer.V2GetClusterParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -563,6 +624,16 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2GetClusterInstallConfig(ctx, params)
 	})
+	api.InstallerV2GetClusterInstallationTimelineHandler = installer.V2GetClusterInstallationTimelineHandlerFunc(func(params installer.V2GetClusterInstallationTimelineParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2GetClusterInstallationTimeline(ctx, params)
+	})
+	api.InstallerV2GetClusterRenderedFilesHandler = installer.V2GetClusterRenderedFilesHandlerFunc(func(params installer.V2GetClusterRenderedFilesParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2GetClusterRenderedFiles(ctx, params)
+	})
 	api.InstallerV2GetHostHandler = installer.V2GetHostHandlerFunc(func(params installer.V2GetHostParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -588,6 +659,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2ImportCluster(ctx, params)
 	})
+	api.InstallerV2ImportClusterBundleHandler = installer.V2ImportClusterBundleHandlerFunc(func(params installer.V2ImportClusterBundleParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2ImportClusterBundle(ctx, params)
+	})
 	api.InstallerV2InstallClusterHandler = installer.V2InstallClusterHandlerFunc(func(params installer.V2InstallClusterParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -598,6 +674,16 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2InstallHost(ctx, params)
 	})
+	api.InstallerV2ListClusterInstallConfigRevisionsHandler = installer.V2ListClusterInstallConfigRevisionsHandlerFunc(func(params installer.V2ListClusterInstallConfigRevisionsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2ListClusterInstallConfigRevisions(ctx, params)
+	})
+	api.InstallerV2ListClusterRevisionsHandler = installer.V2ListClusterRevisionsHandlerFunc(func(params installer.V2ListClusterRevisionsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2ListClusterRevisions(ctx, params)
+	})
 	api.InstallerV2ListClustersHandler = installer.V2ListClustersHandlerFunc(func(params installer.V2ListClustersParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -633,6 +719,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2PostStepReply(ctx, params)
 	})
+	api.InstallerV2ReclaimHostHandler = installer.V2ReclaimHostHandlerFunc(func(params installer.V2ReclaimHostParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2ReclaimHost(ctx, params)
+	})
 	api.InstallerV2RegisterClusterHandler = installer.V2RegisterClusterHandlerFunc(func(params installer.V2RegisterClusterParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -663,6 +754,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2ResetHostValidation(ctx, params)
 	})
+	api.InstallerV2RollbackClusterHandler = installer.V2RollbackClusterHandlerFunc(func(params installer.V2RollbackClusterParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2RollbackCluster(ctx, params)
+	})
 	api.InstallerV2UpdateClusterInstallConfigHandler = installer.V2UpdateClusterInstallConfigHandlerFunc(func(params installer.V2UpdateClusterInstallConfigParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -63,6 +63,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerGetClusterSupportedPlatformsHandler: installer.GetClusterSupportedPlatformsHandlerFunc(func(params installer.GetClusterSupportedPlatformsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.GetClusterSupportedPlatforms has not yet been implemented")
 		}),
+		InstallerGetClusterSupportedPlatformsDetailsHandler: installer.GetClusterSupportedPlatformsDetailsHandlerFunc(func(params installer.GetClusterSupportedPlatformsDetailsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.GetClusterSupportedPlatformsDetails has not yet been implemented")
+		}),
 		InstallerGetInfraEnvHandler: installer.GetInfraEnvHandlerFunc(func(params installer.GetInfraEnvParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.GetInfraEnv has not yet been implemented")
 		}),
@@ -93,6 +96,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerUpdateInfraEnvHandler: installer.UpdateInfraEnvHandlerFunc(func(params installer.UpdateInfraEnvParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.UpdateInfraEnv has not yet been implemented")
 		}),
+		InstallerV2BulkHostsActionHandler: installer.V2BulkHostsActionHandlerFunc(func(params installer.V2BulkHostsActionParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2BulkHostsAction has not yet been implemented")
+		}),
 		InstallerV2CancelInstallationHandler: installer.V2CancelInstallationHandlerFunc(func(params installer.V2CancelInstallationParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2CancelInstallation has not yet been implemented")
 		}),
@@ -111,6 +117,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerV2DownloadClusterLogsHandler: installer.V2DownloadClusterLogsHandlerFunc(func(params installer.V2DownloadClusterLogsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2DownloadClusterLogs has not yet been implemented")
 		}),
+		InstallerV2GarbageCollectionDryRunHandler: installer.V2GarbageCollectionDryRunHandlerFunc(func(params installer.V2GarbageCollectionDryRunParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2GarbageCollectionDryRun has not yet been implemented")
+		}),
 		InstallerV2GetClusterDefaultConfigHandler: installer.V2GetClusterDefaultConfigHandlerFunc(func(params installer.V2GetClusterDefaultConfigParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2GetClusterDefaultConfig has not yet been implemented")
 		}),
@@ -162,12 +171,21 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerV2DownloadInfraEnvFilesHandler: installer.V2DownloadInfraEnvFilesHandlerFunc(func(params installer.V2DownloadInfraEnvFilesParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2DownloadInfraEnvFiles has not yet been implemented")
 		}),
+		InstallerV2ExportClusterHandler: installer.V2ExportClusterHandlerFunc(func(params installer.V2ExportClusterParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2ExportCluster has not yet been implemented")
+		}),
 		InstallerV2GetClusterHandler: installer.V2GetClusterHandlerFunc(func(params installer.V2GetClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2GetCluster has not yet been implemented")
 		}),
 		InstallerV2GetClusterInstallConfigHandler: installer.V2GetClusterInstallConfigHandlerFunc(func(params installer.V2GetClusterInstallConfigParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2GetClusterInstallConfig has not yet been implemented")
 		}),
+		InstallerV2GetClusterInstallationTimelineHandler: installer.V2GetClusterInstallationTimelineHandlerFunc(func(params installer.V2GetClusterInstallationTimelineParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2GetClusterInstallationTimeline has not yet been implemented")
+		}),
+		InstallerV2GetClusterRenderedFilesHandler: installer.V2GetClusterRenderedFilesHandlerFunc(func(params installer.V2GetClusterRenderedFilesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2GetClusterRenderedFiles has not yet been implemented")
+		}),
 		InstallerV2GetHostHandler: installer.V2GetHostHandlerFunc(func(params installer.V2GetHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2GetHost has not yet been implemented")
 		}),
@@ -183,12 +201,21 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerV2ImportClusterHandler: installer.V2ImportClusterHandlerFunc(func(params installer.V2ImportClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2ImportCluster has not yet been implemented")
 		}),
+		InstallerV2ImportClusterBundleHandler: installer.V2ImportClusterBundleHandlerFunc(func(params installer.V2ImportClusterBundleParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2ImportClusterBundle has not yet been implemented")
+		}),
 		InstallerV2InstallClusterHandler: installer.V2InstallClusterHandlerFunc(func(params installer.V2InstallClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2InstallCluster has not yet been implemented")
 		}),
 		InstallerV2InstallHostHandler: installer.V2InstallHostHandlerFunc(func(params installer.V2InstallHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2InstallHost has not yet been implemented")
 		}),
+		InstallerV2ListClusterInstallConfigRevisionsHandler: installer.V2ListClusterInstallConfigRevisionsHandlerFunc(func(params installer.V2ListClusterInstallConfigRevisionsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2ListClusterInstallConfigRevisions has not yet been implemented")
+		}),
+		InstallerV2ListClusterRevisionsHandler: installer.V2ListClusterRevisionsHandlerFunc(func(params installer.V2ListClusterRevisionsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2ListClusterRevisions has not yet been implemented")
+		}),
 		InstallerV2ListClustersHandler: installer.V2ListClustersHandlerFunc(func(params installer.V2ListClustersParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2ListClusters has not yet been implemented")
 		}),
@@ -210,6 +237,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerV2PostStepReplyHandler: installer.V2PostStepReplyHandlerFunc(func(params installer.V2PostStepReplyParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2PostStepReply has not yet been implemented")
 		}),
+		InstallerV2ReclaimHostHandler: installer.V2ReclaimHostHandlerFunc(func(params installer.V2ReclaimHostParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2ReclaimHost has not yet been implemented")
+		}),
 		InstallerV2RegisterClusterHandler: installer.V2RegisterClusterHandlerFunc(func(params installer.V2RegisterClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2RegisterCluster has not yet been implemented")
 		}),
@@ -228,6 +258,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerV2ResetHostValidationHandler: installer.V2ResetHostValidationHandlerFunc(func(params installer.V2ResetHostValidationParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2ResetHostValidation has not yet been implemented")
 		}),
+		InstallerV2RollbackClusterHandler: installer.V2RollbackClusterHandlerFunc(func(params installer.V2RollbackClusterParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2RollbackCluster has not yet been implemented")
+		}),
 		InstallerV2UpdateClusterInstallConfigHandler: installer.V2UpdateClusterInstallConfigHandlerFunc(func(params installer.V2UpdateClusterInstallConfigParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2UpdateClusterInstallConfig has not yet been implemented")
 		}),
@@ -348,6 +381,8 @@ type AssistedInstallAPI struct {
 	InstallerDownloadMinimalInitrdHandler installer.DownloadMinimalInitrdHandler
 	// InstallerGetClusterSupportedPlatformsHandler sets the operation handler for the get cluster supported platforms operation
 	InstallerGetClusterSupportedPlatformsHandler installer.GetClusterSupportedPlatformsHandler
+	// InstallerGetClusterSupportedPlatformsDetailsHandler sets the operation handler for the get cluster supported platforms details operation
+	InstallerGetClusterSupportedPlatformsDetailsHandler installer.GetClusterSupportedPlatformsDetailsHandler
 	// InstallerGetInfraEnvHandler sets the operation handler for the get infra env operation
 	InstallerGetInfraEnvHandler installer.GetInfraEnvHandler
 	// InstallerGetInfraEnvDownloadURLHandler sets the operation handler for the get infra env download URL operation
@@ -368,6 +403,8 @@ type AssistedInstallAPI struct {
 	InstallerUnbindHostHandler installer.UnbindHostHandler
 	// InstallerUpdateInfraEnvHandler sets the operation handler for the update infra env operation
 	InstallerUpdateInfraEnvHandler installer.UpdateInfraEnvHandler
+	// InstallerV2BulkHostsActionHandler sets the operation handler for the v2 bulk hosts action operation
+	InstallerV2BulkHostsActionHandler installer.V2BulkHostsActionHandler
 	// InstallerV2CancelInstallationHandler sets the operation handler for the v2 cancel installation operation
 	InstallerV2CancelInstallationHandler installer.V2CancelInstallationHandler
 	// ManifestsV2CreateClusterManifestHandler sets the operation handler for the v2 create cluster manifest operation
@@ -380,6 +417,8 @@ type AssistedInstallAPI struct {
 	InstallerV2DownloadClusterFilesHandler installer.V2DownloadClusterFilesHandler
 	// InstallerV2DownloadClusterLogsHandler sets the operation handler for the v2 download cluster logs operation
 	InstallerV2DownloadClusterLogsHandler installer.V2DownloadClusterLogsHandler
+	// InstallerV2GarbageCollectionDryRunHandler sets the operation handler for the v2 garbage collection dry run operation
+	InstallerV2GarbageCollectionDryRunHandler installer.V2GarbageCollectionDryRunHandler
 	// InstallerV2GetClusterDefaultConfigHandler sets the operation handler for the v2 get cluster default config operation
 	InstallerV2GetClusterDefaultConfigHandler installer.V2GetClusterDefaultConfigHandler
 	// InstallerV2GetCredentialsHandler sets the operation handler for the v2 get credentials operation
@@ -414,10 +453,16 @@ type AssistedInstallAPI struct {
 	InstallerV2DownloadHostIgnitionHandler installer.V2DownloadHostIgnitionHandler
 	// InstallerV2DownloadInfraEnvFilesHandler sets the operation handler for the v2 download infra env files operation
 	InstallerV2DownloadInfraEnvFilesHandler installer.V2DownloadInfraEnvFilesHandler
+	// InstallerV2ExportClusterHandler sets the operation handler for the v2 export cluster operation
+	InstallerV2ExportClusterHandler installer.V2ExportClusterHandler
 	// InstallerV2GetClusterHandler sets the operation handler for the v2 get cluster operation
 	InstallerV2GetClusterHandler installer.V2GetClusterHandler
 	// InstallerV2GetClusterInstallConfigHandler sets the operation handler for the v2 get cluster install config operation
 	InstallerV2GetClusterInstallConfigHandler installer.V2GetClusterInstallConfigHandler
+	// InstallerV2GetClusterInstallationTimelineHandler sets the operation handler for the v2 get cluster installation timeline operation
+	InstallerV2GetClusterInstallationTimelineHandler installer.V2GetClusterInstallationTimelineHandler
+	// InstallerV2GetClusterRenderedFilesHandler sets the operation handler for the v2 get cluster rendered files operation
+	InstallerV2GetClusterRenderedFilesHandler installer.V2GetClusterRenderedFilesHandler
 	// InstallerV2GetHostHandler sets the operation handler for the v2 get host operation
 	InstallerV2GetHostHandler installer.V2GetHostHandler
 	// InstallerV2GetHostIgnitionHandler sets the operation handler for the v2 get host ignition operation
@@ -428,10 +473,16 @@ type AssistedInstallAPI struct {
 	InstallerV2GetPreflightRequirementsHandler installer.V2GetPreflightRequirementsHandler
 	// InstallerV2ImportClusterHandler sets the operation handler for the v2 import cluster operation
 	InstallerV2ImportClusterHandler installer.V2ImportClusterHandler
+	// InstallerV2ImportClusterBundleHandler sets the operation handler for the v2 import cluster bundle operation
+	InstallerV2ImportClusterBundleHandler installer.V2ImportClusterBundleHandler
 	// InstallerV2InstallClusterHandler sets the operation handler for the v2 install cluster operation
 	InstallerV2InstallClusterHandler installer.V2InstallClusterHandler
 	// InstallerV2InstallHostHandler sets the operation handler for the v2 install host operation
 	InstallerV2InstallHostHandler installer.V2InstallHostHandler
+	// InstallerV2ListClusterInstallConfigRevisionsHandler sets the operation handler for the v2 list cluster install config revisions operation
+	InstallerV2ListClusterInstallConfigRevisionsHandler installer.V2ListClusterInstallConfigRevisionsHandler
+	// InstallerV2ListClusterRevisionsHandler sets the operation handler for the v2 list cluster revisions operation
+	InstallerV2ListClusterRevisionsHandler installer.V2ListClusterRevisionsHandler
 	// InstallerV2ListClustersHandler sets the operation handler for the v2 list clusters operation
 	InstallerV2ListClustersHandler installer.V2ListClustersHandler
 	// VersionsV2ListComponentVersionsHandler sets the operation handler for the v2 list component versions operation
@@ -446,6 +497,8 @@ type AssistedInstallAPI struct {
 	VersionsV2ListSupportedOpenshiftVersionsHandler versions.V2ListSupportedOpenshiftVersionsHandler
 	// InstallerV2PostStepReplyHandler sets the operation handler for the v2 post step reply operation
 	InstallerV2PostStepReplyHandler installer.V2PostStepReplyHandler
+	// InstallerV2ReclaimHostHandler sets the operation handler for the v2 reclaim host operation
+	InstallerV2ReclaimHostHandler installer.V2ReclaimHostHandler
 	// InstallerV2RegisterClusterHandler sets the operation handler for the v2 register cluster operation
 	InstallerV2RegisterClusterHandler installer.V2RegisterClusterHandler
 	// InstallerV2RegisterHostHandler sets the operation handler for the v2 register host operation
@@ -458,6 +511,8 @@ type AssistedInstallAPI struct {
 	InstallerV2ResetHostHandler installer.V2ResetHostHandler
 	// InstallerV2ResetHostValidationHandler sets the operation handler for the v2 reset host validation operation
 	InstallerV2ResetHostValidationHandler installer.V2ResetHostValidationHandler
+	// InstallerV2RollbackClusterHandler sets the operation handler for the v2 rollback cluster operation
+	InstallerV2RollbackClusterHandler installer.V2RollbackClusterHandler
 	// InstallerV2UpdateClusterInstallConfigHandler sets the operation handler for the v2 update cluster install config operation
 	InstallerV2UpdateClusterInstallConfigHandler installer.V2UpdateClusterInstallConfigHandler
 	// InstallerV2UpdateClusterLogsProgressHandler sets the operation handler for the v2 update cluster logs progress operation
@@ -585,6 +640,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerGetClusterSupportedPlatformsHandler == nil {
 		unregistered = append(unregistered, "installer.GetClusterSupportedPlatformsHandler")
 	}
+	if o.InstallerGetClusterSupportedPlatformsDetailsHandler == nil {
+		unregistered = append(unregistered, "installer.GetClusterSupportedPlatformsDetailsHandler")
+	}
 	if o.InstallerGetInfraEnvHandler == nil {
 		unregistered = append(unregistered, "installer.GetInfraEnvHandler")
 	}
@@ -615,6 +673,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerUpdateInfraEnvHandler == nil {
 		unregistered = append(unregistered, "installer.UpdateInfraEnvHandler")
 	}
+	if o.InstallerV2BulkHostsActionHandler == nil {
+		unregistered = append(unregistered, "installer.V2BulkHostsActionHandler")
+	}
 	if o.InstallerV2CancelInstallationHandler == nil {
 		unregistered = append(unregistered, "installer.V2CancelInstallationHandler")
 	}
@@ -633,6 +694,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerV2DownloadClusterLogsHandler == nil {
 		unregistered = append(unregistered, "installer.V2DownloadClusterLogsHandler")
 	}
+	if o.InstallerV2GarbageCollectionDryRunHandler == nil {
+		unregistered = append(unregistered, "installer.V2GarbageCollectionDryRunHandler")
+	}
 	if o.InstallerV2GetClusterDefaultConfigHandler == nil {
 		unregistered = append(unregistered, "installer.V2GetClusterDefaultConfigHandler")
 	}
@@ -684,12 +748,21 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerV2DownloadInfraEnvFilesHandler == nil {
 		unregistered = append(unregistered, "installer.V2DownloadInfraEnvFilesHandler")
 	}
+	if o.InstallerV2ExportClusterHandler == nil {
+		unregistered = append(unregistered, "installer.V2ExportClusterHandler")
+	}
 	if o.InstallerV2GetClusterHandler == nil {
 		unregistered = append(unregistered, "installer.V2GetClusterHandler")
 	}
 	if o.InstallerV2GetClusterInstallConfigHandler == nil {
 		unregistered = append(unregistered, "installer.V2GetClusterInstallConfigHandler")
 	}
+	if o.InstallerV2GetClusterInstallationTimelineHandler == nil {
+		unregistered = append(unregistered, "installer.V2GetClusterInstallationTimelineHandler")
+	}
+	if o.InstallerV2GetClusterRenderedFilesHandler == nil {
+		unregistered = append(unregistered, "installer.V2GetClusterRenderedFilesHandler")
+	}
 	if o.InstallerV2GetHostHandler == nil {
 		unregistered = append(unregistered, "installer.V2GetHostHandler")
 	}
@@ -705,12 +778,21 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerV2ImportClusterHandler == nil {
 		unregistered = append(unregistered, "installer.V2ImportClusterHandler")
 	}
+	if o.InstallerV2ImportClusterBundleHandler == nil {
+		unregistered = append(unregistered, "installer.V2ImportClusterBundleHandler")
+	}
 	if o.InstallerV2InstallClusterHandler == nil {
 		unregistered = append(unregistered, "installer.V2InstallClusterHandler")
 	}
 	if o.InstallerV2InstallHostHandler == nil {
 		unregistered = append(unregistered, "installer.V2InstallHostHandler")
 	}
+	if o.InstallerV2ListClusterInstallConfigRevisionsHandler == nil {
+		unregistered = append(unregistered, "installer.V2ListClusterInstallConfigRevisionsHandler")
+	}
+	if o.InstallerV2ListClusterRevisionsHandler == nil {
+		unregistered = append(unregistered, "installer.V2ListClusterRevisionsHandler")
+	}
 	if o.InstallerV2ListClustersHandler == nil {
 		unregistered = append(unregistered, "installer.V2ListClustersHandler")
 	}
@@ -732,6 +814,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerV2PostStepReplyHandler == nil {
 		unregistered = append(unregistered, "installer.V2PostStepReplyHandler")
 	}
+	if o.InstallerV2ReclaimHostHandler == nil {
+		unregistered = append(unregistered, "installer.V2ReclaimHostHandler")
+	}
 	if o.InstallerV2RegisterClusterHandler == nil {
 		unregistered = append(unregistered, "installer.V2RegisterClusterHandler")
 	}
@@ -750,6 +835,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerV2ResetHostValidationHandler == nil {
 		unregistered = append(unregistered, "installer.V2ResetHostValidationHandler")
 	}
+	if o.InstallerV2RollbackClusterHandler == nil {
+		unregistered = append(unregistered, "installer.V2RollbackClusterHandler")
+	}
 	if o.InstallerV2UpdateClusterInstallConfigHandler == nil {
 		unregistered = append(unregistered, "installer.V2UpdateClusterInstallConfigHandler")
 	}
@@ -910,6 +998,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/clusters/{cluster_id}/supported-platforms/details"] = installer.NewGetClusterSupportedPlatformsDetails(o.context, o.InstallerGetClusterSupportedPlatformsDetailsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/infra-envs/{infra_env_id}"] = installer.NewGetInfraEnv(o.context, o.InstallerGetInfraEnvHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -950,6 +1042,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/v2/infra-envs/{infra_env_id}/hosts/actions/bulk"] = installer.NewV2BulkHostsAction(o.context, o.InstallerV2BulkHostsActionHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/v2/clusters/{cluster_id}/actions/cancel"] = installer.NewV2CancelInstallation(o.context, o.InstallerV2CancelInstallationHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -974,6 +1070,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/garbage-collection/dry-run"] = installer.NewV2GarbageCollectionDryRun(o.context, o.InstallerV2GarbageCollectionDryRunHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/clusters/default-config"] = installer.NewV2GetClusterDefaultConfig(o.context, o.InstallerV2GetClusterDefaultConfigHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1042,6 +1142,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/clusters/{cluster_id}/export"] = installer.NewV2ExportCluster(o.context, o.InstallerV2ExportClusterHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/clusters/{cluster_id}"] = installer.NewV2GetCluster(o.context, o.InstallerV2GetClusterHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1050,6 +1154,14 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/clusters/{cluster_id}/installation-timeline"] = installer.NewV2GetClusterInstallationTimeline(o.context, o.InstallerV2GetClusterInstallationTimelineHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v2/clusters/{cluster_id}/rendered-files"] = installer.NewV2GetClusterRenderedFiles(o.context, o.InstallerV2GetClusterRenderedFilesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/infra-envs/{infra_env_id}/hosts/{host_id}"] = installer.NewV2GetHost(o.context, o.InstallerV2GetHostHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1070,6 +1182,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/v2/clusters/import-bundle"] = installer.NewV2ImportClusterBundle(o.context, o.InstallerV2ImportClusterBundleHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/v2/clusters/{cluster_id}/actions/install"] = installer.NewV2InstallCluster(o.context, o.InstallerV2InstallClusterHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -1078,6 +1194,14 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/clusters/{cluster_id}/install-config/revisions"] = installer.NewV2ListClusterInstallConfigRevisions(o.context, o.InstallerV2ListClusterInstallConfigRevisionsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v2/clusters/{cluster_id}/revisions"] = installer.NewV2ListClusterRevisions(o.context, o.InstallerV2ListClusterRevisionsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/clusters"] = installer.NewV2ListClusters(o.context, o.InstallerV2ListClustersHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1106,6 +1230,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/reclaim"] = installer.NewV2ReclaimHost(o.context, o.InstallerV2ReclaimHostHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/v2/clusters"] = installer.NewV2RegisterCluster(o.context, o.InstallerV2RegisterClusterHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -1127,6 +1255,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
 	}
 	o.handlers["PATCH"]["/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/reset-validation/{validation_id}"] = installer.NewV2ResetHostValidation(o.context, o.InstallerV2ResetHostValidationHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/v2/clusters/{cluster_id}/revisions/{revision}/actions/rollback"] = installer.NewV2RollbackCluster(o.context, o.InstallerV2RollbackClusterHandler)
 	if o.handlers["PATCH"] == nil {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
 	}
@@ -36,6 +36,10 @@ type ListInfraEnvsParams struct {
 	  In: query
 	*/
 	ClusterID *strfmt.UUID
+	/*If provided, returns only infra-envs whose labels match this Kubernetes label selector, e.g. env=prod,team in (a,b).
+	  In: query
+	*/
+	LabelSelector *string
 	/*If provided, returns only infra-envs that are owned by the specified user.
 	  In: query
 	*/
@@ -58,6 +62,11 @@ func (o *ListInfraEnvsParams) BindRequest(r *http.Request, route *middleware.Mat
 		res = append(res, err)
 	}
 
+	qLabelSelector, qhkLabelSelector, _ := qs.GetOK("label_selector")
+	if err := o.bindLabelSelector(qLabelSelector, qhkLabelSelector, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qOwner, qhkOwner, _ := qs.GetOK("owner")
 	if err := o.bindOwner(qOwner, qhkOwner, route.Formats); err != nil {
 		res = append(res, err)
@@ -105,6 +114,24 @@ func (o *ListInfraEnvsParams) validateClusterID(formats strfmt.Registry) error {
 	return nil
 }
 
+// bindLabelSelector binds and validates parameter LabelSelector from query.
+func (o *ListInfraEnvsParams) bindLabelSelector(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.LabelSelector = &raw
+
+	return nil
+}
+
 // bindOwner binds and validates parameter Owner from query.
 func (o *ListInfraEnvsParams) bindOwner(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -60,6 +60,50 @@ func (o *ListInfraEnvsOK) WriteResponse(rw http.ResponseWriter, producer runtime
 	}
 }
 
+// ListInfraEnvsBadRequestCode is the HTTP code returned for type ListInfraEnvsBadRequest
+const ListInfraEnvsBadRequestCode int = 400
+
+/*ListInfraEnvsBadRequest Error.
+
+swagger:response listInfraEnvsBadRequest
+*/
+type ListInfraEnvsBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListInfraEnvsBadRequest creates ListInfraEnvsBadRequest with default headers values
+func NewListInfraEnvsBadRequest() *ListInfraEnvsBadRequest {
+
+	return &ListInfraEnvsBadRequest{}
+}
+
+// WithPayload adds the payload to the list infra envs bad request response
+func (o *ListInfraEnvsBadRequest) WithPayload(payload *models.Error) *ListInfraEnvsBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list infra envs bad request response
+func (o *ListInfraEnvsBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListInfraEnvsBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ListInfraEnvsUnauthorizedCode is the HTTP code returned for type ListInfraEnvsUnauthorized
 const ListInfraEnvsUnauthorizedCode int = 401
 
@@ -15,8 +15,9 @@ import (
 
 // ListInfraEnvsURL generates an URL for the list infra envs operation
 type ListInfraEnvsURL struct {
-	ClusterID *strfmt.UUID
-	Owner     *string
+	ClusterID     *strfmt.UUID
+	LabelSelector *string
+	Owner         *string
 
 	_basePath string
 	// avoid unkeyed usage
@@ -60,6 +61,14 @@ func (o *ListInfraEnvsURL) Build() (*url.URL, error) {
 		qs.Set("cluster_id", clusterIDQ)
 	}
 
+	var labelSelectorQ string
+	if o.LabelSelector != nil {
+		labelSelectorQ = *o.LabelSelector
+	}
+	if labelSelectorQ != "" {
+		qs.Set("label_selector", labelSelectorQ)
+	}
+
 	var ownerQ string
 	if o.Owner != nil {
 		ownerQ = *o.Owner
@@ -53,6 +53,10 @@ type V2ListClustersParams struct {
 	  Default: false
 	*/
 	GetUnregisteredClusters *bool
+	/*If provided, returns only clusters whose labels match this Kubernetes label selector, e.g. env=prod,team in (a,b).
+	  In: query
+	*/
+	LabelSelector *string
 	/*A specific cluster to retrieve.
 	  In: query
 	*/
@@ -88,6 +92,11 @@ func (o *V2ListClustersParams) BindRequest(r *http.Request, route *middleware.Ma
 		res = append(res, err)
 	}
 
+	qLabelSelector, qhkLabelSelector, _ := qs.GetOK("label_selector")
+	if err := o.bindLabelSelector(qLabelSelector, qhkLabelSelector, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qOpenshiftClusterID, qhkOpenshiftClusterID, _ := qs.GetOK("openshift_cluster_id")
 	if err := o.bindOpenshiftClusterID(qOpenshiftClusterID, qhkOpenshiftClusterID, route.Formats); err != nil {
 		res = append(res, err)
@@ -158,6 +167,24 @@ func (o *V2ListClustersParams) bindGetUnregisteredClusters(rawData []string, has
 	return nil
 }
 
+// bindLabelSelector binds and validates parameter LabelSelector from query.
+func (o *V2ListClustersParams) bindLabelSelector(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.LabelSelector = &raw
+
+	return nil
+}
+
 // bindOpenshiftClusterID binds and validates parameter OpenshiftClusterID from query.
 func (o *V2ListClustersParams) bindOpenshiftClusterID(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -60,6 +60,50 @@ func (o *V2ListClustersOK) WriteResponse(rw http.ResponseWriter, producer runtim
 	}
 }
 
+// V2ListClustersBadRequestCode is the HTTP code returned for type V2ListClustersBadRequest
+const V2ListClustersBadRequestCode int = 400
+
+/*V2ListClustersBadRequest Error.
+
+swagger:response v2ListClustersBadRequest
+*/
+type V2ListClustersBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ListClustersBadRequest creates V2ListClustersBadRequest with default headers values
+func NewV2ListClustersBadRequest() *V2ListClustersBadRequest {
+
+	return &V2ListClustersBadRequest{}
+}
+
+// WithPayload adds the payload to the v2 list clusters bad request response
+func (o *V2ListClustersBadRequest) WithPayload(payload *models.Error) *V2ListClustersBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list clusters bad request response
+func (o *V2ListClustersBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListClustersBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // V2ListClustersUnauthorizedCode is the HTTP code returned for type V2ListClustersUnauthorized
 const V2ListClustersUnauthorizedCode int = 401
 
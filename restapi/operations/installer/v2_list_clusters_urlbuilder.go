@@ -17,6 +17,7 @@ import (
 // V2ListClustersURL generates an URL for the v2 list clusters operation
 type V2ListClustersURL struct {
 	AmsSubscriptionIds []string
+	LabelSelector      *string
 	OpenshiftClusterID *strfmt.UUID
 	Owner              *string
 	WithHosts          bool
@@ -72,6 +73,14 @@ func (o *V2ListClustersURL) Build() (*url.URL, error) {
 		}
 	}
 
+	var labelSelectorQ string
+	if o.LabelSelector != nil {
+		labelSelectorQ = *o.LabelSelector
+	}
+	if labelSelectorQ != "" {
+		qs.Set("label_selector", labelSelectorQ)
+	}
+
 	var openshiftClusterIDQ string
 	if o.OpenshiftClusterID != nil {
 		openshiftClusterIDQ = o.OpenshiftClusterID.String()